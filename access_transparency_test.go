@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that approve or deny access requests.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/openshift-online/ocm-sdk-go/accesstransparency"
+	atv1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Access request decisions", func() {
+	const requestsPath = "/api/access_transparency/v1/access_requests"
+
+	var ctx context.Context
+	var server *ghttp.Server
+	var connection *Connection
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = MakeTCPServer()
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+		server.Close()
+	})
+
+	It("Approves a request with a justification", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, requestsPath+"/123/decisions"),
+				ghttp.VerifyJSON(`{
+					"kind": "Decision",
+					"decision": "Approved",
+					"justification": "Needed to fix the incident"
+				}`),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "Decision",
+					"id": "456",
+					"decision": "Approved"
+				}`),
+			),
+		)
+		decision, err := accesstransparency.ApproveAccessRequest(ctx, connection, "123",
+			"Needed to fix the incident")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.ID()).To(Equal("456"))
+	})
+
+	It("Requires a justification", func() {
+		_, err := accesstransparency.DenyAccessRequest(ctx, connection, "123", "")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("justification is mandatory"))
+	})
+
+	It("Watches pending requests and records the decisions", func() {
+		pending := `{
+			"kind": "AccessRequestList",
+			"page": 1,
+			"size": 2,
+			"total": 2,
+			"items": [
+				{"kind": "AccessRequest", "id": "a", "cluster_id": "123"},
+				{"kind": "AccessRequest", "id": "b", "cluster_id": "456"}
+			]
+		}`
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, requestsPath),
+				ghttp.VerifyFormKV(
+					"search",
+					"status.state = 'Pending' and (organization_id = 'my-org')",
+				),
+				RespondWithJSON(http.StatusOK, pending),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, requestsPath+"/a/decisions"),
+				ghttp.VerifyJSON(`{
+					"kind": "Decision",
+					"decision": "Approved",
+					"justification": "Cluster 123 allows access"
+				}`),
+				RespondWithJSON(http.StatusCreated, `{"kind": "Decision", "id": "1"}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, requestsPath),
+				RespondWithJSON(http.StatusOK, pending),
+				func(w http.ResponseWriter, r *http.Request) {
+					cancel()
+				},
+			),
+		)
+		var decided []string
+		decider := func(ctx context.Context,
+			request *atv1.AccessRequest) (atv1.DecisionDecision, string, error) {
+			decided = append(decided, request.ID())
+			if request.ClusterId() != "123" {
+				return "", "", nil
+			}
+			return atv1.DecisionDecisionApproved, "Cluster 123 allows access", nil
+		}
+		err := accesstransparency.WatchAccessRequests(ctx, connection, decider,
+			&accesstransparency.WatchOptions{
+				Interval: 10 * time.Millisecond,
+				Search:   "organization_id = 'my-org'",
+			})
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(decided).To(Equal([]string{"a", "b"}))
+	})
+})
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that approve or deny access requests, intended for bots that take
+// these decisions on behalf of customers.
+
+package accesstransparency // github.com/openshift-online/ocm-sdk-go/accesstransparency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accesstransparency/v1"
+)
+
+// DefaultWatchInterval is the default time between checks for new pending access requests.
+const DefaultWatchInterval = 30 * time.Second
+
+// Decider is the function that decides what to do with a pending access request. It returns the
+// decision and the justification. If the returned decision is empty the request is left pending
+// and it isn't passed again to the function.
+type Decider func(ctx context.Context,
+	request *v1.AccessRequest) (decision v1.DecisionDecision, justification string, err error)
+
+// WatchOptions contains the options that control how pending access requests are watched.
+type WatchOptions struct {
+	// Interval is the time between checks for new pending access requests. The default is
+	// thirty seconds.
+	Interval time.Duration
+
+	// Search is an additional search expression used to select the access requests, for
+	// example `cluster_id = '123'`. The default is to consider all the pending requests that
+	// the user can see.
+	Search string
+}
+
+// ApproveAccessRequest approves the given access request with the given justification. The
+// transport is usually the connection.
+func ApproveAccessRequest(ctx context.Context, transport http.RoundTripper, requestID,
+	justification string) (result *v1.Decision, err error) {
+	return Decide(ctx, transport, requestID, v1.DecisionDecisionApproved, justification)
+}
+
+// DenyAccessRequest denies the given access request with the given justification. The transport
+// is usually the connection.
+func DenyAccessRequest(ctx context.Context, transport http.RoundTripper, requestID,
+	justification string) (result *v1.Decision, err error) {
+	return Decide(ctx, transport, requestID, v1.DecisionDecisionDenied, justification)
+}
+
+// Decide records the given decision for the given access request. The justification is mandatory,
+// as it is shown to the requester.
+func Decide(ctx context.Context, transport http.RoundTripper, requestID string,
+	decision v1.DecisionDecision, justification string) (result *v1.Decision, err error) {
+	if requestID == "" {
+		err = errors.New("access request identifier is mandatory")
+		return
+	}
+	switch decision {
+	case v1.DecisionDecisionApproved, v1.DecisionDecisionDenied:
+	default:
+		err = fmt.Errorf(
+			"decision '%s' isn't valid, it should be '%s' or '%s'",
+			decision, v1.DecisionDecisionApproved, v1.DecisionDecisionDenied,
+		)
+		return
+	}
+	if justification == "" {
+		err = errors.New("justification is mandatory")
+		return
+	}
+	body, err := v1.NewDecision().
+		Decision(decision).
+		Justification(justification).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := NewClient(transport, "/api/access_transparency").V1().
+		AccessRequests().
+		AccessRequest(requestID).
+		Decisions().
+		Add().
+		Body(body).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't record decision '%s' for access request '%s': %w",
+			decision, requestID, err,
+		)
+		return
+	}
+	result = response.Body()
+	return
+}
+
+// WatchAccessRequests periodically checks for pending access requests, passes each of them to the
+// decider and records the decisions that it returns. Each request is passed to the decider only
+// once. It runs till the context is cancelled, and then it returns the error of the context. It
+// also returns if the decider or the server return an error. The options can be nil to use the
+// defaults.
+func WatchAccessRequests(ctx context.Context, transport http.RoundTripper, decider Decider,
+	options *WatchOptions) error {
+	if decider == nil {
+		return errors.New("decider is mandatory")
+	}
+	interval := DefaultWatchInterval
+	search := fmt.Sprintf("status.state = '%s'", v1.AccessRequestStatePending)
+	if options != nil {
+		if options.Interval < 0 {
+			return fmt.Errorf(
+				"interval %s isn't valid, it should be greater or equal than zero",
+				options.Interval,
+			)
+		}
+		if options.Interval > 0 {
+			interval = options.Interval
+		}
+		if options.Search != "" {
+			search = fmt.Sprintf("%s and (%s)", search, options.Search)
+		}
+	}
+	client := NewClient(transport, "/api/access_transparency").V1().AccessRequests()
+	seen := map[string]bool{}
+	for {
+		var pending []*v1.AccessRequest
+		request := client.List().Search(search).Size(100)
+		listed := 0
+		for page := 1; ; page++ {
+			response, err := request.Page(page).SendContext(ctx)
+			if err != nil {
+				return fmt.Errorf("can't list pending access requests: %w", err)
+			}
+			response.Items().Each(func(item *v1.AccessRequest) bool {
+				if !seen[item.ID()] {
+					pending = append(pending, item)
+				}
+				return true
+			})
+			listed += response.Items().Len()
+			if response.Items().Len() == 0 || listed >= response.Total() {
+				break
+			}
+		}
+		for _, item := range pending {
+			seen[item.ID()] = true
+			decision, justification, err := decider(ctx, item)
+			if err != nil {
+				return fmt.Errorf(
+					"can't decide what to do with access request '%s': %w",
+					item.ID(), err,
+				)
+			}
+			if decision == "" {
+				continue
+			}
+			_, err = Decide(ctx, transport, item.ID(), decision, justification)
+			if err != nil {
+				return err
+			}
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the checker that fetches and caches the capabilities of organizations.
+
+package capabilities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// DefaultTTL is the time that the capabilities of an organization are cached before they are
+// fetched again.
+const DefaultTTL = 5 * time.Minute
+
+// CheckerBuilder contains the data and logic needed to build a capabilities checker.
+type CheckerBuilder struct {
+	logger logging.Logger
+	client *amv1.Client
+	ttl    time.Duration
+}
+
+// Checker fetches the capabilities of organizations and caches them, so that checking multiple
+// capabilities of the same organization requires only one request. It is safe for concurrent use
+// by multiple goroutines.
+type Checker struct {
+	logger logging.Logger
+	client *amv1.Client
+	ttl    time.Duration
+
+	// Cached capabilities, indexed by organization identifier:
+	lock    sync.Mutex
+	entries map[string]*checkerEntry
+}
+
+type checkerEntry struct {
+	values map[string]string
+	expiry time.Time
+}
+
+// NewChecker creates a builder that can then be used to configure and create a capabilities
+// checker.
+func NewChecker() *CheckerBuilder {
+	return &CheckerBuilder{
+		ttl: DefaultTTL,
+	}
+}
+
+// Logger sets the logger that the checker will use to write to the log. This is optional.
+func (b *CheckerBuilder) Logger(value logging.Logger) *CheckerBuilder {
+	b.logger = value
+	return b
+}
+
+// Client sets the accounts management client that the checker will use to request the
+// capabilities. This is mandatory.
+func (b *CheckerBuilder) Client(value *amv1.Client) *CheckerBuilder {
+	b.client = value
+	return b
+}
+
+// TTL sets the time that the capabilities of an organization are cached. The default value is
+// five minutes.
+func (b *CheckerBuilder) TTL(value time.Duration) *CheckerBuilder {
+	b.ttl = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new capabilities checker.
+func (b *CheckerBuilder) Build() (result *Checker, err error) {
+	// Check parameters:
+	if b.client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	if b.ttl <= 0 {
+		err = fmt.Errorf("time to live should be positive, but it is %s", b.ttl)
+		return
+	}
+
+	// Create and populate the object:
+	result = &Checker{
+		logger:  b.logger,
+		client:  b.client,
+		ttl:     b.ttl,
+		entries: map[string]*checkerEntry{},
+	}
+	return
+}
+
+// IsEnabled returns true if the organization has the given capability and its value is true. The
+// name is the complete name of the capability, for example
+// `capability.organization.hibernate_cluster`.
+func (c *Checker) IsEnabled(ctx context.Context, organizationID, capability string) (result bool,
+	err error) {
+	if capability == "" {
+		err = errors.New("capability name is mandatory")
+		return
+	}
+	values, err := c.Capabilities(ctx, organizationID)
+	if err != nil {
+		return
+	}
+	value, ok := values[capability]
+	if !ok {
+		return
+	}
+	result, err = strconv.ParseBool(value)
+	if err != nil {
+		err = fmt.Errorf(
+			"value '%s' of capability '%s' of organization '%s' isn't a boolean",
+			value, capability, organizationID,
+		)
+	}
+	return
+}
+
+// Capabilities returns the values of the capabilities of the organization, indexed by name. The
+// returned map must not be modified.
+func (c *Checker) Capabilities(ctx context.Context, organizationID string) (result map[string]string,
+	err error) {
+	if organizationID == "" {
+		err = errors.New("organization identifier is mandatory")
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[organizationID]
+	if ok && time.Now().Before(entry.expiry) {
+		result = entry.values
+		return
+	}
+	result, err = c.fetch(ctx, organizationID)
+	if err != nil {
+		return
+	}
+	c.entries[organizationID] = &checkerEntry{
+		values: result,
+		expiry: time.Now().Add(c.ttl),
+	}
+	return
+}
+
+// Invalidate discards the cached capabilities of the organization, so that the next check will
+// request them again. This is useful after changing the labels that the capabilities are
+// calculated from.
+func (c *Checker) Invalidate(organizationID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, organizationID)
+}
+
+func (c *Checker) fetch(ctx context.Context, organizationID string) (result map[string]string,
+	err error) {
+	response, err := c.client.Organizations().Organization(organizationID).Get().
+		Parameter("fetchCapabilities", true).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get capabilities of organization '%s': %w",
+			organizationID, err,
+		)
+		return
+	}
+	capabilities := response.Body().Capabilities()
+	result = make(map[string]string, len(capabilities))
+	for _, capability := range capabilities {
+		result[capability.Name()] = capability.Value()
+	}
+	if c.logger != nil {
+		c.logger.Debug(
+			ctx,
+			"Fetched %d capabilities for organization '%s'",
+			len(result), organizationID,
+		)
+	}
+	return
+}
+
+// checkers contains the checkers used by the IsEnabled function, indexed by client.
+var checkers sync.Map
+
+// IsEnabled returns true if the organization has the given capability and its value is true. It
+// uses a checker shared by all the calls that use the same client, so the capabilities of each
+// organization are requested at most once every five minutes. Use a checker created with the
+// NewChecker function to control the time to live or to invalidate the cache.
+func IsEnabled(ctx context.Context, client *amv1.Client, organizationID,
+	capability string) (result bool, err error) {
+	if client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	value, ok := checkers.Load(client)
+	if !ok {
+		var checker *Checker
+		checker, err = NewChecker().Client(client).Build()
+		if err != nil {
+			return
+		}
+		value, _ = checkers.LoadOrStore(client, checker)
+	}
+	result, err = value.(*Checker).IsEnabled(ctx, organizationID, capability)
+	return
+}
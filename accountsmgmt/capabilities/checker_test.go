@@ -0,0 +1,241 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capabilities
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Checker", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var checker *Checker
+
+	// respondWithCapabilities creates a handler that returns the organization with the given
+	// capabilities.
+	respondWithCapabilities := func(capabilities string) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/organizations/123"),
+			ghttp.VerifyFormKV("fetchCapabilities", "true"),
+			RespondWithJSON(http.StatusOK, `{
+				"id": "123",
+				"capabilities": `+capabilities+`
+			}`),
+		)
+	}
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = MakeTCPServer()
+		checker, err = NewChecker().
+			Logger(logger).
+			Client(MakeClient(server)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Can't be built without a client", func() {
+		_, err := NewChecker().Logger(logger).Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("client is mandatory"))
+	})
+
+	It("Can't be built with a negative time to live", func() {
+		_, err := NewChecker().
+			Client(MakeClient(server)).
+			TTL(-time.Minute).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("time to live should be positive"))
+	})
+
+	It("Returns true for an enabled capability", func() {
+		server.AppendHandlers(
+			respondWithCapabilities(`[
+				{
+					"name": "capability.organization.hibernate_cluster",
+					"value": "true"
+				}
+			]`),
+		)
+		enabled, err := checker.IsEnabled(
+			ctx, "123", "capability.organization.hibernate_cluster",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+	})
+
+	It("Returns false for a disabled capability", func() {
+		server.AppendHandlers(
+			respondWithCapabilities(`[
+				{
+					"name": "capability.organization.hibernate_cluster",
+					"value": "false"
+				}
+			]`),
+		)
+		enabled, err := checker.IsEnabled(
+			ctx, "123", "capability.organization.hibernate_cluster",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+	})
+
+	It("Returns false for a missing capability", func() {
+		server.AppendHandlers(
+			respondWithCapabilities(`[]`),
+		)
+		enabled, err := checker.IsEnabled(
+			ctx, "123", "capability.organization.hibernate_cluster",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+	})
+
+	It("Fails if the value isn't a boolean", func() {
+		server.AppendHandlers(
+			respondWithCapabilities(`[
+				{
+					"name": "capability.organization.hibernate_cluster",
+					"value": "maybe"
+				}
+			]`),
+		)
+		_, err := checker.IsEnabled(
+			ctx, "123", "capability.organization.hibernate_cluster",
+		)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("isn't a boolean"))
+	})
+
+	It("Caches the capabilities of the organization", func() {
+		server.AppendHandlers(
+			respondWithCapabilities(`[
+				{
+					"name": "capability.organization.hibernate_cluster",
+					"value": "true"
+				},
+				{
+					"name": "capability.organization.bypass_pids_limits",
+					"value": "false"
+				}
+			]`),
+		)
+		enabled, err := checker.IsEnabled(
+			ctx, "123", "capability.organization.hibernate_cluster",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+		enabled, err = checker.IsEnabled(
+			ctx, "123", "capability.organization.bypass_pids_limits",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Fetches the capabilities again after invalidating them", func() {
+		server.AppendHandlers(
+			respondWithCapabilities(`[]`),
+			respondWithCapabilities(`[
+				{
+					"name": "capability.organization.hibernate_cluster",
+					"value": "true"
+				}
+			]`),
+		)
+		enabled, err := checker.IsEnabled(
+			ctx, "123", "capability.organization.hibernate_cluster",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+		checker.Invalidate("123")
+		enabled, err = checker.IsEnabled(
+			ctx, "123", "capability.organization.hibernate_cluster",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+	})
+
+	It("Fetches the capabilities again after the time to live", func() {
+		var err error
+		checker, err = NewChecker().
+			Logger(logger).
+			Client(MakeClient(server)).
+			TTL(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		server.AppendHandlers(
+			respondWithCapabilities(`[]`),
+			respondWithCapabilities(`[]`),
+		)
+		_, err = checker.Capabilities(ctx, "123")
+		Expect(err).ToNot(HaveOccurred())
+		time.Sleep(10 * time.Millisecond)
+		_, err = checker.Capabilities(ctx, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Doesn't cache errors", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusInternalServerError, `{
+				"kind": "Error",
+				"reason": "Boom"
+			}`),
+			respondWithCapabilities(`[]`),
+		)
+		_, err := checker.Capabilities(ctx, "123")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't get capabilities of organization '123'"))
+		_, err = checker.Capabilities(ctx, "123")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Shares the checker between calls of the function with the same client", func() {
+		client := MakeClient(server)
+		server.AppendHandlers(
+			respondWithCapabilities(`[
+				{
+					"name": "capability.organization.hibernate_cluster",
+					"value": "true"
+				}
+			]`),
+		)
+		for i := 0; i < 2; i++ {
+			enabled, err := IsEnabled(
+				ctx, client, "123", "capability.organization.hibernate_cluster",
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+})
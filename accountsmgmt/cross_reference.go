@@ -0,0 +1,287 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that find the subscription that corresponds to a cluster and the
+// cluster that corresponds to a subscription.
+
+package accountsmgmt // github.com/openshift-online/ocm-sdk-go/accountsmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	sdkerrors "github.com/openshift-online/ocm-sdk-go/errors"
+)
+
+// MatchKind indicates which identifier was used to match a subscription and a cluster.
+type MatchKind string
+
+const (
+	// MatchByClusterID indicates that the `cluster_id` attribute of the subscription matched the
+	// identifier assigned to the cluster by the clusters management service.
+	MatchByClusterID MatchKind = "cluster_id"
+
+	// MatchByExternalClusterID indicates that the `external_cluster_id` attribute of the
+	// subscription matched the identifier assigned to the cluster by the cluster itself.
+	MatchByExternalClusterID MatchKind = "external_cluster_id"
+)
+
+// Subscription statuses that indicate that the cluster no longer exists:
+const (
+	subscriptionStatusArchived      = "Archived"
+	subscriptionStatusDeprovisioned = "Deprovisioned"
+)
+
+// SubscriptionMatch is the result of finding the subscription of a cluster.
+type SubscriptionMatch struct {
+	// Subscription is the subscription that was found.
+	Subscription *v1.Subscription
+
+	// MatchedBy indicates which identifier matched.
+	MatchedBy MatchKind
+}
+
+// ClusterMatch is the result of finding the cluster of a subscription.
+type ClusterMatch struct {
+	// Cluster is the cluster that was found.
+	Cluster *cmv1.Cluster
+
+	// Subscription is the subscription that was used to find the cluster.
+	Subscription *v1.Subscription
+
+	// MatchedBy indicates which identifier matched.
+	MatchedBy MatchKind
+}
+
+// NotFoundError is the error returned when there is no subscription or cluster that matches the
+// given identifier.
+type NotFoundError struct {
+	// Kind is the kind of object that was searched, `Subscription` or `Cluster`.
+	Kind string
+
+	// ID is the identifier that was used in the search.
+	ID string
+}
+
+// Error is the implementation of the error interface.
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("can't find %s for identifier '%s'", strings.ToLower(e.Kind), e.ID)
+}
+
+// AmbiguousError is the error returned when more than one active subscription matches the given
+// cluster identifier.
+type AmbiguousError struct {
+	// ID is the identifier that was used in the search.
+	ID string
+
+	// Subscriptions are the identifiers of the subscriptions that matched.
+	Subscriptions []string
+}
+
+// Error is the implementation of the error interface.
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf(
+		"identifier '%s' matches %d subscriptions: '%s'",
+		e.ID, len(e.Subscriptions), strings.Join(e.Subscriptions, "', '"),
+	)
+}
+
+// FindSubscriptionForCluster finds the subscription of the cluster with the given identifier. The
+// identifier can be the one assigned by the clusters management service or the external one
+// assigned by the cluster itself. The rules used to select the subscription are the following:
+//
+//   - Subscriptions whose `cluster_id` matches are preferred to subscriptions whose
+//     `external_cluster_id` matches.
+//
+//   - Subscriptions that are archived or deprovisioned are ignored, unless there are no other
+//     subscriptions, in which case the most recently created one is returned.
+//
+// If no subscription matches a *NotFoundError is returned. If more than one active subscription
+// matches an *AmbiguousError is returned.
+func FindSubscriptionForCluster(ctx context.Context, client *v1.Client,
+	clusterID string) (result *SubscriptionMatch, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	quoted := strings.ReplaceAll(clusterID, "'", "''")
+	response, err := client.Subscriptions().List().
+		Search(fmt.Sprintf(
+			"cluster_id = '%s' or external_cluster_id = '%s'",
+			quoted, quoted,
+		)).
+		Order("created_at desc").
+		Size(100).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't find subscription for cluster '%s': %w", clusterID, err)
+		return
+	}
+	var byID, byExternalID []*v1.Subscription
+	response.Items().Each(func(item *v1.Subscription) bool {
+		switch clusterID {
+		case item.ClusterID():
+			byID = append(byID, item)
+		case item.ExternalClusterID():
+			byExternalID = append(byExternalID, item)
+		}
+		return true
+	})
+	result, err = selectSubscription(clusterID, byID, MatchByClusterID)
+	if result != nil || err != nil {
+		return
+	}
+	result, err = selectSubscription(clusterID, byExternalID, MatchByExternalClusterID)
+	if result != nil || err != nil {
+		return
+	}
+	err = &NotFoundError{
+		Kind: "Subscription",
+		ID:   clusterID,
+	}
+	return
+}
+
+// selectSubscription selects the subscription from a list of candidates sorted by creation time,
+// most recent first. It returns nil if the list is empty.
+func selectSubscription(clusterID string, candidates []*v1.Subscription,
+	kind MatchKind) (result *SubscriptionMatch, err error) {
+	if len(candidates) == 0 {
+		return
+	}
+	var active []*v1.Subscription
+	for _, candidate := range candidates {
+		switch candidate.Status() {
+		case subscriptionStatusArchived, subscriptionStatusDeprovisioned:
+		default:
+			active = append(active, candidate)
+		}
+	}
+	switch len(active) {
+	case 0:
+		result = &SubscriptionMatch{
+			Subscription: candidates[0],
+			MatchedBy:    kind,
+		}
+	case 1:
+		result = &SubscriptionMatch{
+			Subscription: active[0],
+			MatchedBy:    kind,
+		}
+	default:
+		ids := make([]string, len(active))
+		for i, subscription := range active {
+			ids[i] = subscription.ID()
+		}
+		err = &AmbiguousError{
+			ID:            clusterID,
+			Subscriptions: ids,
+		}
+	}
+	return
+}
+
+// FindClusterForSubscription finds the cluster that corresponds to the subscription with the
+// given identifier. If the subscription has a `cluster_id` the cluster is retrieved using it.
+// Otherwise the cluster is searched using the `external_cluster_id`. Note that clusters that
+// aren't managed by the clusters management service, for example self managed clusters that
+// report telemetry, have subscriptions but not clusters. For those a *NotFoundError is returned.
+// The subscription is retrieved with the accounts management client, and the cluster with the
+// clusters management client.
+func FindClusterForSubscription(ctx context.Context, client *v1.Client,
+	clustersClient *cmv1.Client, subscriptionID string) (result *ClusterMatch, err error) {
+	if subscriptionID == "" {
+		err = errors.New("subscription identifier is mandatory")
+		return
+	}
+	subscriptionResponse, err := client.Subscriptions().
+		Subscription(subscriptionID).
+		Get().
+		SendContext(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			err = &NotFoundError{
+				Kind: "Subscription",
+				ID:   subscriptionID,
+			}
+			return
+		}
+		err = fmt.Errorf("can't get subscription '%s': %w", subscriptionID, err)
+		return
+	}
+	subscription := subscriptionResponse.Body()
+	clusters := clustersClient.Clusters()
+	if clusterID := subscription.ClusterID(); clusterID != "" {
+		var clusterResponse *cmv1.ClusterGetResponse
+		clusterResponse, err = clusters.Cluster(clusterID).Get().SendContext(ctx)
+		if err != nil {
+			if isNotFound(err) {
+				err = &NotFoundError{
+					Kind: "Cluster",
+					ID:   clusterID,
+				}
+				return
+			}
+			err = fmt.Errorf("can't get cluster '%s': %w", clusterID, err)
+			return
+		}
+		result = &ClusterMatch{
+			Cluster:      clusterResponse.Body(),
+			Subscription: subscription,
+			MatchedBy:    MatchByClusterID,
+		}
+		return
+	}
+	externalID := subscription.ExternalClusterID()
+	if externalID == "" {
+		err = &NotFoundError{
+			Kind: "Cluster",
+			ID:   subscriptionID,
+		}
+		return
+	}
+	listResponse, err := clusters.List().
+		Search(fmt.Sprintf("external_id = '%s'", strings.ReplaceAll(externalID, "'", "''"))).
+		Size(1).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't find cluster with external identifier '%s': %w", externalID, err)
+		return
+	}
+	if listResponse.Items().Len() == 0 {
+		err = &NotFoundError{
+			Kind: "Cluster",
+			ID:   externalID,
+		}
+		return
+	}
+	result = &ClusterMatch{
+		Cluster:      listResponse.Items().Get(0),
+		Subscription: subscription,
+		MatchedBy:    MatchByExternalClusterID,
+	}
+	return
+}
+
+func isNotFound(err error) bool {
+	var sdkErr *sdkerrors.Error
+	return errors.As(err, &sdkErr) && sdkErr.Status() == http.StatusNotFound
+}
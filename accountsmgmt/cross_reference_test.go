@@ -0,0 +1,267 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountsmgmt
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Cross reference", func() {
+	var clustersClient *cmv1.Client
+
+	BeforeEach(func() {
+		clustersClient = cmv1.NewClient(ServerTransport(server), "/api/clusters_mgmt/v1")
+	})
+
+	Describe("Find subscription for cluster", func() {
+		It("Sends the search", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/subscriptions"),
+					ghttp.VerifyFormKV(
+						"search",
+						"cluster_id = '123' or external_cluster_id = '123'",
+					),
+					ghttp.VerifyFormKV("order", "created_at desc"),
+					RespondWithJSON(http.StatusOK, `{
+						"items": [
+							{
+								"id": "456",
+								"cluster_id": "123",
+								"status": "Active"
+							}
+						]
+					}`),
+				),
+			)
+			result, err := FindSubscriptionForCluster(ctx, client, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Subscription.ID()).To(Equal("456"))
+			Expect(result.MatchedBy).To(Equal(MatchByClusterID))
+		})
+
+		It("Prefers cluster identifier to external identifier", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "456",
+							"external_cluster_id": "123",
+							"status": "Active"
+						},
+						{
+							"id": "789",
+							"cluster_id": "123",
+							"status": "Active"
+						}
+					]
+				}`),
+			)
+			result, err := FindSubscriptionForCluster(ctx, client, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Subscription.ID()).To(Equal("789"))
+		})
+
+		It("Matches the external identifier", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "456",
+							"external_cluster_id": "123",
+							"status": "Active"
+						}
+					]
+				}`),
+			)
+			result, err := FindSubscriptionForCluster(ctx, client, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Subscription.ID()).To(Equal("456"))
+			Expect(result.MatchedBy).To(Equal(MatchByExternalClusterID))
+		})
+
+		It("Ignores deprovisioned subscriptions", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "456",
+							"external_cluster_id": "123",
+							"status": "Deprovisioned"
+						},
+						{
+							"id": "789",
+							"external_cluster_id": "123",
+							"status": "Active"
+						}
+					]
+				}`),
+			)
+			result, err := FindSubscriptionForCluster(ctx, client, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Subscription.ID()).To(Equal("789"))
+		})
+
+		It("Returns most recent subscription if all are archived", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "456",
+							"cluster_id": "123",
+							"status": "Archived"
+						},
+						{
+							"id": "789",
+							"cluster_id": "123",
+							"status": "Archived"
+						}
+					]
+				}`),
+			)
+			result, err := FindSubscriptionForCluster(ctx, client, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Subscription.ID()).To(Equal("456"))
+		})
+
+		It("Fails if several active subscriptions match", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "456",
+							"external_cluster_id": "123",
+							"status": "Active"
+						},
+						{
+							"id": "789",
+							"external_cluster_id": "123",
+							"status": "Reserved"
+						}
+					]
+				}`),
+			)
+			_, err := FindSubscriptionForCluster(ctx, client, "123")
+			var ambiguous *AmbiguousError
+			Expect(errors.As(err, &ambiguous)).To(BeTrue())
+			Expect(ambiguous.Subscriptions).To(Equal([]string{"456", "789"}))
+		})
+
+		It("Fails if nothing matches", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"items": []
+				}`),
+			)
+			_, err := FindSubscriptionForCluster(ctx, client, "123")
+			var notFound *NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+			Expect(notFound.Kind).To(Equal("Subscription"))
+			Expect(notFound.ID).To(Equal("123"))
+		})
+	})
+
+	Describe("Find cluster for subscription", func() {
+		It("Uses the cluster identifier", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodGet,
+						"/api/accounts_mgmt/v1/subscriptions/456",
+					),
+					RespondWithJSON(http.StatusOK, `{
+						"id": "456",
+						"cluster_id": "123",
+						"external_cluster_id": "abc"
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, `{
+						"id": "123",
+						"external_id": "abc"
+					}`),
+				),
+			)
+			result, err := FindClusterForSubscription(ctx, client, clustersClient, "456")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Cluster.ID()).To(Equal("123"))
+			Expect(result.Subscription.ID()).To(Equal("456"))
+			Expect(result.MatchedBy).To(Equal(MatchByClusterID))
+		})
+
+		It("Uses the external identifier", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"id": "456",
+					"external_cluster_id": "abc"
+				}`),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+					ghttp.VerifyFormKV("search", "external_id = 'abc'"),
+					RespondWithJSON(http.StatusOK, `{
+						"items": [
+							{
+								"id": "123",
+								"external_id": "abc"
+							}
+						]
+					}`),
+				),
+			)
+			result, err := FindClusterForSubscription(ctx, client, clustersClient, "456")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Cluster.ID()).To(Equal("123"))
+			Expect(result.MatchedBy).To(Equal(MatchByExternalClusterID))
+		})
+
+		It("Fails if the subscription doesn't exist", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusNotFound, `{
+					"kind": "Error",
+					"id": "404",
+					"reason": "Not found"
+				}`),
+			)
+			_, err := FindClusterForSubscription(ctx, client, clustersClient, "456")
+			var notFound *NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+			Expect(notFound.Kind).To(Equal("Subscription"))
+		})
+
+		It("Fails if the subscription has no cluster", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"id": "456"
+				}`),
+			)
+			_, err := FindClusterForSubscription(ctx, client, clustersClient, "456")
+			var notFound *NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+			Expect(notFound.Kind).To(Equal("Cluster"))
+		})
+	})
+})
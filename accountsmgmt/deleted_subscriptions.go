@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that query subscriptions including the ones that have been
+// deleted, for auditing and reconciliation tools.
+
+package accountsmgmt // github.com/openshift-online/ocm-sdk-go/accountsmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// SubscriptionRecord is a subscription returned by a subscription query. Exactly one of the
+// Subscription and DeletedSubscription fields is set, depending on the value of the Deleted
+// field. The rest of the fields are copied from the one that is set, so that callers that only
+// need them don't have to check.
+type SubscriptionRecord struct {
+	// ID is the identifier of the subscription.
+	ID string
+
+	// ClusterID is the identifier of the cluster assigned by the clusters management service.
+	ClusterID string
+
+	// ExternalClusterID is the identifier assigned to the cluster by itself.
+	ExternalClusterID string
+
+	// OrganizationID is the identifier of the organization that owns the subscription.
+	OrganizationID string
+
+	// Status is the status of the subscription, for example `Active` or `Deprovisioned`.
+	Status string
+
+	// Deleted indicates if the subscription has been deleted.
+	Deleted bool
+
+	// Subscription is the subscription, when it hasn't been deleted.
+	Subscription *v1.Subscription
+
+	// DeletedSubscription is the deleted subscription, when it has been deleted.
+	DeletedSubscription *v1.DeletedSubscription
+}
+
+// SubscriptionQuery contains the data and logic needed to list subscriptions, optionally
+// including the ones that have been deleted. Don't create objects of this type directly, use the
+// NewSubscriptionQuery function instead.
+type SubscriptionQuery struct {
+	client         *v1.Client
+	search         string
+	includeDeleted bool
+}
+
+// NewSubscriptionQuery creates a query that lists the subscriptions using the given client.
+func NewSubscriptionQuery(client *v1.Client) *SubscriptionQuery {
+	return &SubscriptionQuery{
+		client: client,
+	}
+}
+
+// Search sets the search criteria, for example `cluster_id = '123'`. The same criteria is used
+// for the deleted subscriptions, so it should only use attributes that they also have.
+func (q *SubscriptionQuery) Search(value string) *SubscriptionQuery {
+	q.search = value
+	return q
+}
+
+// IncludeDeleted sets the flag that indicates if the deleted subscriptions that match the search
+// criteria should also be returned. The default is to return only subscriptions that haven't
+// been deleted.
+func (q *SubscriptionQuery) IncludeDeleted(value bool) *SubscriptionQuery {
+	q.includeDeleted = value
+	return q
+}
+
+// List returns all the subscriptions that match the query. Subscriptions that haven't been
+// deleted are returned first, followed by the deleted ones.
+func (q *SubscriptionQuery) List(ctx context.Context) (result []*SubscriptionRecord, err error) {
+	if q.client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	request := q.client.Subscriptions().List().Size(100)
+	if q.search != "" {
+		request.Search(q.search)
+	}
+	seen := 0
+	for page := 1; ; page++ {
+		var response *v1.SubscriptionsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list subscriptions: %w", err)
+			result = nil
+			return
+		}
+		response.Items().Each(func(item *v1.Subscription) bool {
+			result = append(result, &SubscriptionRecord{
+				ID:                item.ID(),
+				ClusterID:         item.ClusterID(),
+				ExternalClusterID: item.ExternalClusterID(),
+				OrganizationID:    item.OrganizationID(),
+				Status:            item.Status(),
+				Subscription:      item,
+			})
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			break
+		}
+	}
+	if !q.includeDeleted {
+		return
+	}
+	deleted, err := ListDeletedSubscriptions(ctx, q.client, q.search)
+	if err != nil {
+		result = nil
+		return
+	}
+	for _, item := range deleted {
+		result = append(result, &SubscriptionRecord{
+			ID:                  item.ID(),
+			ClusterID:           item.ClusterID(),
+			ExternalClusterID:   item.ExternalClusterID(),
+			OrganizationID:      item.OrganizationID(),
+			Status:              item.Status(),
+			Deleted:             true,
+			DeletedSubscription: item,
+		})
+	}
+	return
+}
+
+// ListDeletedSubscriptions returns all the deleted subscriptions that match the given search
+// criteria. An empty search returns all the deleted subscriptions that the user can see.
+func ListDeletedSubscriptions(ctx context.Context, client *v1.Client,
+	search string) (result []*v1.DeletedSubscription, err error) {
+	request := client.DeletedSubscriptions().List().Size(100)
+	if search != "" {
+		request.Search(search)
+	}
+	seen := 0
+	for page := 1; ; page++ {
+		var response *v1.DeletedSubscriptionsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list deleted subscriptions: %w", err)
+			result = nil
+			return
+		}
+		result = append(result, response.Items().Slice()...)
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountsmgmt
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Deleted subscriptions", func() {
+	It("Doesn't list deleted subscriptions by default", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/subscriptions"),
+				ghttp.VerifyFormKV("search", "cluster_id = '123'"),
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "456",
+							"cluster_id": "123",
+							"status": "Active"
+						}
+					]
+				}`),
+			),
+		)
+		records, err := NewSubscriptionQuery(client).
+			Search("cluster_id = '123'").
+			List(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].ID).To(Equal("456"))
+		Expect(records[0].Deleted).To(BeFalse())
+		Expect(records[0].Subscription).ToNot(BeNil())
+		Expect(records[0].DeletedSubscription).To(BeNil())
+	})
+
+	It("Lists deleted subscriptions after the rest", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"items": [
+					{
+						"id": "456",
+						"cluster_id": "123",
+						"status": "Active"
+					}
+				]
+			}`),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/accounts_mgmt/v1/deleted_subscriptions",
+				),
+				ghttp.VerifyFormKV("search", "cluster_id = '123'"),
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "789",
+							"cluster_id": "123",
+							"organization_id": "org",
+							"status": "Deprovisioned"
+						}
+					]
+				}`),
+			),
+		)
+		records, err := NewSubscriptionQuery(client).
+			Search("cluster_id = '123'").
+			IncludeDeleted(true).
+			List(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].ID).To(Equal("456"))
+		Expect(records[0].Deleted).To(BeFalse())
+		Expect(records[1].ID).To(Equal("789"))
+		Expect(records[1].Deleted).To(BeTrue())
+		Expect(records[1].OrganizationID).To(Equal("org"))
+		Expect(records[1].Status).To(Equal("Deprovisioned"))
+		Expect(records[1].DeletedSubscription).ToNot(BeNil())
+	})
+
+	It("Fails if listing deleted subscriptions fails", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"items": []
+			}`),
+			RespondWithJSON(http.StatusForbidden, `{
+				"kind": "Error",
+				"id": "403",
+				"reason": "Forbidden"
+			}`),
+		)
+		records, err := NewSubscriptionQuery(client).
+			IncludeDeleted(true).
+			List(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't list deleted subscriptions"))
+		Expect(records).To(BeNil())
+	})
+})
@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that get, set and delete the labels of organizations and
+// subscriptions using only the key of the label.
+
+package accountsmgmt // github.com/openshift-online/ocm-sdk-go/accountsmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// GetOrganizationLabel returns the value of the label of the organization with the given key. The
+// ok result will be false if the organization doesn't have that label.
+func GetOrganizationLabel(ctx context.Context, client *v1.Client,
+	organizationID, key string) (value string, ok bool, err error) {
+	if organizationID == "" {
+		err = errors.New("organization identifier is mandatory")
+		return
+	}
+	value, ok, err = getLabel(ctx, organizationLabels(client, organizationID), key)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get label '%s' of organization '%s': %w",
+			key, organizationID, err,
+		)
+	}
+	return
+}
+
+// ListOrganizationLabels returns the labels of the organization, indexed by key.
+func ListOrganizationLabels(ctx context.Context, client *v1.Client,
+	organizationID string) (result map[string]string, err error) {
+	if organizationID == "" {
+		err = errors.New("organization identifier is mandatory")
+		return
+	}
+	result, err = listLabels(ctx, organizationLabels(client, organizationID))
+	if err != nil {
+		err = fmt.Errorf("can't list labels of organization '%s': %w", organizationID, err)
+	}
+	return
+}
+
+// SetOrganizationLabel sets the value of the label of the organization with the given key,
+// creating the label if it doesn't exist yet. It returns the label as stored by the service.
+func SetOrganizationLabel(ctx context.Context, client *v1.Client,
+	organizationID, key, value string) (result *v1.Label, err error) {
+	if organizationID == "" {
+		err = errors.New("organization identifier is mandatory")
+		return
+	}
+	result, err = setLabel(ctx, organizationLabels(client, organizationID), key, value)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't set label '%s' of organization '%s': %w",
+			key, organizationID, err,
+		)
+	}
+	return
+}
+
+// DeleteOrganizationLabel deletes the label of the organization with the given key. Deleting a
+// label that doesn't exist isn't an error.
+func DeleteOrganizationLabel(ctx context.Context, client *v1.Client,
+	organizationID, key string) error {
+	if organizationID == "" {
+		return errors.New("organization identifier is mandatory")
+	}
+	err := deleteLabel(ctx, organizationLabels(client, organizationID), key)
+	if err != nil {
+		return fmt.Errorf(
+			"can't delete label '%s' of organization '%s': %w",
+			key, organizationID, err,
+		)
+	}
+	return nil
+}
+
+// GetSubscriptionLabel returns the value of the label of the subscription with the given key. The
+// ok result will be false if the subscription doesn't have that label.
+func GetSubscriptionLabel(ctx context.Context, client *v1.Client,
+	subscriptionID, key string) (value string, ok bool, err error) {
+	if subscriptionID == "" {
+		err = errors.New("subscription identifier is mandatory")
+		return
+	}
+	value, ok, err = getLabel(ctx, subscriptionLabels(client, subscriptionID), key)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get label '%s' of subscription '%s': %w",
+			key, subscriptionID, err,
+		)
+	}
+	return
+}
+
+// ListSubscriptionLabels returns the labels of the subscription, indexed by key.
+func ListSubscriptionLabels(ctx context.Context, client *v1.Client,
+	subscriptionID string) (result map[string]string, err error) {
+	if subscriptionID == "" {
+		err = errors.New("subscription identifier is mandatory")
+		return
+	}
+	result, err = listLabels(ctx, subscriptionLabels(client, subscriptionID))
+	if err != nil {
+		err = fmt.Errorf("can't list labels of subscription '%s': %w", subscriptionID, err)
+	}
+	return
+}
+
+// SetSubscriptionLabel sets the value of the label of the subscription with the given key,
+// creating the label if it doesn't exist yet. It returns the label as stored by the service.
+func SetSubscriptionLabel(ctx context.Context, client *v1.Client,
+	subscriptionID, key, value string) (result *v1.Label, err error) {
+	if subscriptionID == "" {
+		err = errors.New("subscription identifier is mandatory")
+		return
+	}
+	result, err = setLabel(ctx, subscriptionLabels(client, subscriptionID), key, value)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't set label '%s' of subscription '%s': %w",
+			key, subscriptionID, err,
+		)
+	}
+	return
+}
+
+// DeleteSubscriptionLabel deletes the label of the subscription with the given key. Deleting a
+// label that doesn't exist isn't an error.
+func DeleteSubscriptionLabel(ctx context.Context, client *v1.Client,
+	subscriptionID, key string) error {
+	if subscriptionID == "" {
+		return errors.New("subscription identifier is mandatory")
+	}
+	err := deleteLabel(ctx, subscriptionLabels(client, subscriptionID), key)
+	if err != nil {
+		return fmt.Errorf(
+			"can't delete label '%s' of subscription '%s': %w",
+			key, subscriptionID, err,
+		)
+	}
+	return nil
+}
+
+func organizationLabels(client *v1.Client, organizationID string) *v1.GenericLabelsClient {
+	return client.Organizations().Organization(organizationID).Labels()
+}
+
+func subscriptionLabels(client *v1.Client, subscriptionID string) *v1.GenericLabelsClient {
+	return client.Subscriptions().Subscription(subscriptionID).Labels()
+}
+
+func getLabel(ctx context.Context, client *v1.GenericLabelsClient,
+	key string) (value string, ok bool, err error) {
+	if key == "" {
+		err = errors.New("label key is mandatory")
+		return
+	}
+	response, err := client.Labels(key).Get().SendContext(ctx)
+	if isNotFound(err) {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	value, ok = response.Body().Value(), true
+	return
+}
+
+func listLabels(ctx context.Context, client *v1.GenericLabelsClient) (result map[string]string,
+	err error) {
+	result = map[string]string{}
+	request := client.List().Size(100)
+	seen := 0
+	for page := 1; ; page++ {
+		var response *v1.GenericLabelsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			result = nil
+			return
+		}
+		response.Items().Each(func(label *v1.Label) bool {
+			result[label.Key()] = label.Value()
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			return
+		}
+	}
+}
+
+// setLabel updates the label, and creates it only when the update fails because it doesn't exist.
+func setLabel(ctx context.Context, client *v1.GenericLabelsClient,
+	key, value string) (result *v1.Label, err error) {
+	if key == "" {
+		err = errors.New("label key is mandatory")
+		return
+	}
+	label, err := v1.NewLabel().Key(key).Value(value).Build()
+	if err != nil {
+		return
+	}
+	updateResponse, err := client.Labels(key).Update().Body(label).SendContext(ctx)
+	if err == nil {
+		result = updateResponse.Body()
+		return
+	}
+	if !isNotFound(err) {
+		return
+	}
+	addResponse, err := client.Add().Body(label).SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = addResponse.Body()
+	return
+}
+
+func deleteLabel(ctx context.Context, client *v1.GenericLabelsClient, key string) error {
+	if key == "" {
+		return errors.New("label key is mandatory")
+	}
+	_, err := client.Labels(key).Delete().SendContext(ctx)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
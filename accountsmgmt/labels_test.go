@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountsmgmt
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Labels", func() {
+	notFound := `{
+		"kind": "Error",
+		"id": "404",
+		"reason": "Label not found"
+	}`
+
+	Describe("Get", func() {
+		It("Returns the value of the organization label", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodGet,
+						"/api/accounts_mgmt/v1/organizations/123/labels/my_key",
+					),
+					RespondWithJSON(http.StatusOK, `{
+						"id": "456",
+						"key": "my_key",
+						"value": "my_value"
+					}`),
+				),
+			)
+			value, ok, err := GetOrganizationLabel(ctx, client, "123", "my_key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("my_value"))
+		})
+
+		It("Returns the value of the subscription label", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodGet,
+						"/api/accounts_mgmt/v1/subscriptions/123/labels/my_key",
+					),
+					RespondWithJSON(http.StatusOK, `{
+						"key": "my_key",
+						"value": "my_value"
+					}`),
+				),
+			)
+			value, ok, err := GetSubscriptionLabel(ctx, client, "123", "my_key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("my_value"))
+		})
+
+		It("Returns not ok if the label doesn't exist", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusNotFound, notFound),
+			)
+			value, ok, err := GetOrganizationLabel(ctx, client, "123", "my_key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(value).To(BeEmpty())
+		})
+
+		It("Fails if the key is empty", func() {
+			_, _, err := GetOrganizationLabel(ctx, client, "123", "")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("label key is mandatory"))
+		})
+	})
+
+	Describe("List", func() {
+		It("Returns all the pages of labels", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodGet,
+						"/api/accounts_mgmt/v1/organizations/123/labels",
+					),
+					ghttp.VerifyFormKV("page", "1"),
+					ghttp.VerifyFormKV("size", "100"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 2,
+						"items": [
+							{
+								"key": "a",
+								"value": "1"
+							}
+						]
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyFormKV("page", "2"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 2,
+						"size": 1,
+						"total": 2,
+						"items": [
+							{
+								"key": "b",
+								"value": "2"
+							}
+						]
+					}`),
+				),
+			)
+			labels, err := ListOrganizationLabels(ctx, client, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(labels).To(Equal(map[string]string{
+				"a": "1",
+				"b": "2",
+			}))
+		})
+	})
+
+	Describe("Set", func() {
+		It("Updates the label if it exists", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodPatch,
+						"/api/accounts_mgmt/v1/organizations/123/labels/my_key",
+					),
+					VerifyJQ(".key", "my_key"),
+					VerifyJQ(".value", "my_value"),
+					RespondWithJSON(http.StatusOK, `{
+						"key": "my_key",
+						"value": "my_value"
+					}`),
+				),
+			)
+			label, err := SetOrganizationLabel(ctx, client, "123", "my_key", "my_value")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(label.Value()).To(Equal("my_value"))
+		})
+
+		It("Creates the label if it doesn't exist", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusNotFound, notFound),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodPost,
+						"/api/accounts_mgmt/v1/subscriptions/123/labels",
+					),
+					VerifyJQ(".key", "my_key"),
+					VerifyJQ(".value", "my_value"),
+					RespondWithJSON(http.StatusCreated, `{
+						"id": "456",
+						"key": "my_key",
+						"value": "my_value"
+					}`),
+				),
+			)
+			label, err := SetSubscriptionLabel(ctx, client, "123", "my_key", "my_value")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(label.ID()).To(Equal("456"))
+		})
+
+		It("Returns other errors", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusForbidden, `{
+					"kind": "Error",
+					"id": "403",
+					"reason": "Forbidden"
+				}`),
+			)
+			_, err := SetOrganizationLabel(ctx, client, "123", "my_key", "my_value")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"can't set label 'my_key' of organization '123'",
+			))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("Deletes the label", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodDelete,
+						"/api/accounts_mgmt/v1/subscriptions/123/labels/my_key",
+					),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+			err := DeleteSubscriptionLabel(ctx, client, "123", "my_key")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Ignores labels that don't exist", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusNotFound, notFound),
+			)
+			err := DeleteOrganizationLabel(ctx, client, "123", "my_key")
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountsmgmt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+func TestAccountsMgmt(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Accounts management")
+}
+
+// Context, server and accounts management client used by the tests. Tests that also need the
+// clusters management service create that client with the same server.
+var (
+	ctx    context.Context
+	server *ghttp.Server
+	client *v1.Client
+)
+
+var _ = BeforeEach(func() {
+	ctx = context.Background()
+	server = MakeTCPServer()
+	client = v1.NewClient(ServerTransport(server), "/api/accounts_mgmt/v1")
+})
+
+var _ = AfterEach(func() {
+	server.Close()
+})
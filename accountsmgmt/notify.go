@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that send emails to the users related to a cluster or subscription.
+
+package accountsmgmt // github.com/openshift-online/ocm-sdk-go/accountsmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// DeliveryStatus indicates what the service did with a notification that it accepted.
+type DeliveryStatus string
+
+const (
+	// DeliverySent indicates that the service sent the email before responding.
+	DeliverySent DeliveryStatus = "Sent"
+
+	// DeliveryQueued indicates that the service accepted the email for asynchronous delivery
+	// and responded before sending it.
+	DeliveryQueued DeliveryStatus = "Queued"
+)
+
+// SendNotification posts the given request to the `notify_details` endpoint, which sends an email
+// with the given subject to the users related to the cluster or subscription, and returns the
+// recipients and associates reported by the service. The service doesn't track the delivery of
+// individual emails, so the returned status only tells if the email was sent before the service
+// responded or if it was queued for later delivery.
+func SendNotification(ctx context.Context, client *v1.Client,
+	request *v1.NotificationDetailsRequest) (result *v1.GenericNotifyDetailsResponse,
+	status DeliveryStatus, err error) {
+	if request == nil {
+		err = errors.New("notification request is mandatory")
+		return
+	}
+	subject := request.Subject()
+	if subject == "" {
+		err = errors.New("subject is mandatory")
+		return
+	}
+	if request.ClusterID() == "" && request.ClusterUUID() == "" && request.SubscriptionID() == "" {
+		err = errors.New("cluster or subscription identifier is mandatory")
+		return
+	}
+	response, err := client.NotifyDetails().Post().
+		Request(request).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't send notification '%s': %w", subject, err)
+		return
+	}
+
+	// The client only returns an error for failed requests when the response has a body, so
+	// responses with an error status and no body need to be checked here:
+	if response.Status() >= http.StatusBadRequest {
+		err = fmt.Errorf(
+			"can't send notification '%s': service responded with status %d",
+			subject, response.Status(),
+		)
+		return
+	}
+	result = response.Response()
+	if response.Status() == http.StatusAccepted {
+		status = DeliveryQueued
+	} else {
+		status = DeliverySent
+	}
+	return
+}
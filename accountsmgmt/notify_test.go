@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountsmgmt
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+var _ = Describe("Notify", func() {
+	It("Sends the request and returns the recipients", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/accounts_mgmt/v1/notify_details"),
+				VerifyJQ(".cluster_id", "123"),
+				VerifyJQ(".subject", "Upgrade scheduled"),
+				VerifyJQ(".include_red_hat_associates", true),
+				RespondWithJSON(http.StatusOK, `{
+					"recipients": ["alice"],
+					"associates": ["bob@example.com"]
+				}`),
+			),
+		)
+		request, err := v1.NewNotificationDetailsRequest().
+			ClusterID("123").
+			Subject("Upgrade scheduled").
+			IncludeRedHatAssociates(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, status, err := SendNotification(ctx, client, request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status).To(Equal(DeliverySent))
+		Expect(result.Recipients()).To(ConsistOf("alice"))
+		Expect(result.Associates()).To(ConsistOf("bob@example.com"))
+	})
+
+	It("Reports queued delivery", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusAccepted, nil),
+		)
+		request, err := v1.NewNotificationDetailsRequest().
+			SubscriptionID("456").
+			Subject("Upgrade scheduled").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, status, err := SendNotification(ctx, client, request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status).To(Equal(DeliveryQueued))
+	})
+
+	It("Returns the error of the service", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Subscription '456' doesn't exist"
+			}`),
+		)
+		request, err := v1.NewNotificationDetailsRequest().
+			SubscriptionID("456").
+			Subject("Upgrade scheduled").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = SendNotification(ctx, client, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Subscription '456' doesn't exist"))
+	})
+
+	It("Fails on error status without body", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusBadRequest, nil),
+		)
+		request, err := v1.NewNotificationDetailsRequest().
+			ClusterID("123").
+			Subject("Upgrade scheduled").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = SendNotification(ctx, client, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("400"))
+	})
+
+	It("Requires the subject", func() {
+		request, err := v1.NewNotificationDetailsRequest().
+			ClusterID("123").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = SendNotification(ctx, client, request)
+		Expect(err).To(MatchError("subject is mandatory"))
+	})
+
+	It("Requires the cluster or subscription", func() {
+		request, err := v1.NewNotificationDetailsRequest().
+			Subject("Upgrade scheduled").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = SendNotification(ctx, client, request)
+		Expect(err).To(MatchError("cluster or subscription identifier is mandatory"))
+	})
+})
@@ -0,0 +1,324 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the manager that fetches, caches and refreshes the registry pull secrets
+// returned by the access token endpoint.
+
+package pullsecret
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Default values:
+const (
+	// DefaultTTL is the time that a pull secret is considered valid when the expiration time
+	// can't be extracted from the credentials.
+	DefaultTTL = time.Hour
+
+	// DefaultRefreshMargin is the time before the expiration of a pull secret when it will be
+	// fetched again.
+	DefaultRefreshMargin = 5 * time.Minute
+)
+
+// RegistryAuth contains the credentials for one registry.
+type RegistryAuth struct {
+	// Auth is the base64 encoding of the user name and password separated by a colon, as
+	// returned by the server.
+	Auth string
+
+	// Email is the email address associated to the credentials.
+	Email string
+
+	// Username is the user name extracted from the auth field.
+	Username string
+
+	// Password is the password extracted from the auth field.
+	Password string
+}
+
+// PullSecret contains the registry credentials returned by the access token endpoint, in the
+// format used by the `.dockerconfigjson` files.
+type PullSecret struct {
+	// Auths contains the credentials indexed by registry name, for example `quay.io` or
+	// `registry.redhat.io`.
+	Auths map[string]*RegistryAuth
+
+	// Expiry is the time when the credentials expire. It is calculated from the expiration time
+	// of the tokens contained in the credentials, or from the time to live of the manager when
+	// they don't contain tokens.
+	Expiry time.Time
+}
+
+// Registries returns the sorted names of the registries.
+func (s *PullSecret) Registries() []string {
+	result := make([]string, 0, len(s.Auths))
+	for registry := range s.Auths {
+		result = append(result, registry)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Expired returns true if the credentials have expired.
+func (s *PullSecret) Expired() bool {
+	return !time.Now().Before(s.Expiry)
+}
+
+// MarshalJSON generates the `.dockerconfigjson` representation of the pull secret.
+func (s *PullSecret) MarshalJSON() ([]byte, error) {
+	type auth struct {
+		Auth  string `json:"auth"`
+		Email string `json:"email,omitempty"`
+	}
+	type config struct {
+		Auths map[string]auth `json:"auths"`
+	}
+	data := config{
+		Auths: make(map[string]auth, len(s.Auths)),
+	}
+	for registry, value := range s.Auths {
+		data.Auths[registry] = auth{
+			Auth:  value.Auth,
+			Email: value.Email,
+		}
+	}
+	return json.Marshal(data)
+}
+
+// ManagerBuilder contains the data and logic needed to build a pull secret manager.
+type ManagerBuilder struct {
+	logger        logging.Logger
+	client        *amv1.Client
+	ttl           time.Duration
+	refreshMargin time.Duration
+}
+
+// Manager fetches the pull secret of the authenticated user from the access token endpoint and
+// caches it till it is about to expire. It is safe for concurrent use by multiple goroutines.
+type Manager struct {
+	logger        logging.Logger
+	client        *amv1.Client
+	ttl           time.Duration
+	refreshMargin time.Duration
+
+	// Cached pull secret:
+	lock   sync.Mutex
+	secret *PullSecret
+}
+
+// NewManager creates a builder that can then be used to configure and create a pull secret
+// manager.
+func NewManager() *ManagerBuilder {
+	return &ManagerBuilder{
+		ttl:           DefaultTTL,
+		refreshMargin: DefaultRefreshMargin,
+	}
+}
+
+// Logger sets the logger that the manager will use to write to the log. This is mandatory.
+func (b *ManagerBuilder) Logger(value logging.Logger) *ManagerBuilder {
+	b.logger = value
+	return b
+}
+
+// Client sets the accounts management client that the manager will use to request the pull
+// secret. This is mandatory.
+func (b *ManagerBuilder) Client(value *amv1.Client) *ManagerBuilder {
+	b.client = value
+	return b
+}
+
+// TTL sets the time that a pull secret is considered valid when the expiration time can't be
+// extracted from the credentials. The default value is one hour.
+func (b *ManagerBuilder) TTL(value time.Duration) *ManagerBuilder {
+	b.ttl = value
+	return b
+}
+
+// RefreshMargin sets the time before the expiration of the pull secret when the manager will
+// fetch it again. The default value is five minutes.
+func (b *ManagerBuilder) RefreshMargin(value time.Duration) *ManagerBuilder {
+	b.refreshMargin = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new pull secret manager.
+func (b *ManagerBuilder) Build() (result *Manager, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	if b.ttl <= 0 {
+		err = fmt.Errorf("time to live should be positive, but it is %s", b.ttl)
+		return
+	}
+	if b.refreshMargin < 0 {
+		err = fmt.Errorf(
+			"refresh margin should be zero or positive, but it is %s",
+			b.refreshMargin,
+		)
+		return
+	}
+
+	// Create and populate the object:
+	result = &Manager{
+		logger:        b.logger,
+		client:        b.client,
+		ttl:           b.ttl,
+		refreshMargin: b.refreshMargin,
+	}
+	return
+}
+
+// Get returns the pull secret. If there is a cached pull secret that isn't about to expire it
+// will be returned without contacting the server. Otherwise a new one will be requested.
+func (m *Manager) Get(ctx context.Context) (result *PullSecret, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.secret != nil && time.Now().Add(m.refreshMargin).Before(m.secret.Expiry) {
+		result = m.secret
+		return
+	}
+	result, err = m.fetch(ctx)
+	if err != nil {
+		return
+	}
+	m.secret = result
+	return
+}
+
+// Refresh requests a new pull secret from the server, even if the cached one is still valid.
+func (m *Manager) Refresh(ctx context.Context) (result *PullSecret, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result, err = m.fetch(ctx)
+	if err != nil {
+		return
+	}
+	m.secret = result
+	return
+}
+
+// Invalidate discards the cached pull secret, so that the next call to the Get method will
+// request a new one.
+func (m *Manager) Invalidate() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.secret = nil
+}
+
+func (m *Manager) fetch(ctx context.Context) (result *PullSecret, err error) {
+	response, err := m.client.AccessToken().Post().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't request pull secret: %w", err)
+		return
+	}
+	result, err = Parse(response.Body(), time.Now().Add(m.ttl))
+	if err != nil {
+		return
+	}
+	m.logger.Debug(
+		ctx,
+		"Fetched pull secret for registries %s, expires at %s",
+		strings.Join(result.Registries(), ", "), result.Expiry.Format(time.RFC3339),
+	)
+	return
+}
+
+// Parse converts the access token returned by the server into a pull secret. The expiration time
+// is the earliest expiration time of the tokens contained in the credentials. If none of them
+// contains a token with an expiration time the given default is used.
+func Parse(token *amv1.AccessToken, expiry time.Time) (result *PullSecret, err error) {
+	if token == nil {
+		err = errors.New("access token is mandatory")
+		return
+	}
+	var earliest time.Time
+	auths := map[string]*RegistryAuth{}
+	for registry, value := range token.Auths() {
+		if value == nil {
+			continue
+		}
+		auth := &RegistryAuth{
+			Auth:  value.Auth(),
+			Email: value.Email(),
+		}
+		var decoded []byte
+		decoded, err = base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			err = fmt.Errorf("can't decode credentials for registry '%s': %w", registry, err)
+			return
+		}
+		var ok bool
+		auth.Username, auth.Password, ok = strings.Cut(string(decoded), ":")
+		if !ok {
+			err = fmt.Errorf(
+				"credentials for registry '%s' don't contain user name and password",
+				registry,
+			)
+			return
+		}
+		exp, ok := tokenExpiry(auth.Password)
+		if ok && (earliest.IsZero() || exp.Before(earliest)) {
+			earliest = exp
+		}
+		auths[registry] = auth
+	}
+	if !earliest.IsZero() {
+		expiry = earliest
+	}
+	result = &PullSecret{
+		Auths:  auths,
+		Expiry: expiry,
+	}
+	return
+}
+
+// tokenExpiry tries to parse the given password as a JSON web token and returns its expiration
+// time. Note that the signature isn't verified, as it is only used to decide when to refresh the
+// credentials.
+func tokenExpiry(password string) (result time.Time, ok bool) {
+	claims := jwt.MapClaims{}
+	_, _, err := new(jwt.Parser).ParseUnverified(password, claims)
+	if err != nil {
+		return
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return
+	}
+	result = time.Unix(int64(exp), 0)
+	return
+}
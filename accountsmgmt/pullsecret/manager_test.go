@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsecret
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+var _ = Describe("Manager", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var manager *Manager
+
+	// respondWithAuths creates a handler that returns an access token containing the given
+	// passwords indexed by registry name.
+	respondWithAuths := func(passwords map[string]string) http.HandlerFunc {
+		auths := map[string]interface{}{}
+		for registry, password := range passwords {
+			auths[registry] = map[string]interface{}{
+				"auth":  base64.StdEncoding.EncodeToString([]byte("joe:" + password)),
+				"email": "joe@example.com",
+			}
+		}
+		body, err := json.Marshal(map[string]interface{}{
+			"auths": auths,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodPost, "/api/accounts_mgmt/v1/access_token"),
+			RespondWithJSON(http.StatusOK, string(body)),
+		)
+	}
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = MakeTCPServer()
+		manager, err = NewManager().
+			Logger(logger).
+			Client(MakeClient(server)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Can't be created without a client", func() {
+		_, err := NewManager().
+			Logger(logger).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("client is mandatory"))
+	})
+
+	It("Parses the credentials", func() {
+		server.AppendHandlers(
+			respondWithAuths(map[string]string{
+				"quay.io":            "mypassword",
+				"registry.redhat.io": "otherpassword",
+			}),
+		)
+		secret, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Registries()).To(Equal([]string{"quay.io", "registry.redhat.io"}))
+		auth := secret.Auths["quay.io"]
+		Expect(auth.Username).To(Equal("joe"))
+		Expect(auth.Password).To(Equal("mypassword"))
+		Expect(auth.Email).To(Equal("joe@example.com"))
+	})
+
+	It("Uses the time to live when credentials don't contain tokens", func() {
+		server.AppendHandlers(
+			respondWithAuths(map[string]string{
+				"quay.io": "mypassword",
+			}),
+		)
+		before := time.Now()
+		secret, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Expiry).To(BeTemporally(">=", before.Add(DefaultTTL)))
+		Expect(secret.Expired()).To(BeFalse())
+	})
+
+	It("Uses the earliest expiration time of the tokens", func() {
+		early := MakeTokenString("Bearer", 10*time.Minute)
+		late := MakeTokenString("Bearer", 2*time.Hour)
+		server.AppendHandlers(
+			respondWithAuths(map[string]string{
+				"quay.io":            late,
+				"registry.redhat.io": early,
+			}),
+		)
+		secret, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Expiry).To(BeTemporally("~", time.Now().Add(10*time.Minute), 5*time.Second))
+	})
+
+	It("Returns the cached pull secret", func() {
+		server.AppendHandlers(
+			respondWithAuths(map[string]string{
+				"quay.io": MakeTokenString("Bearer", time.Hour),
+			}),
+		)
+		first, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second).To(BeIdenticalTo(first))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Fetches a new pull secret when it is about to expire", func() {
+		server.AppendHandlers(
+			respondWithAuths(map[string]string{
+				"quay.io": MakeTokenString("Bearer", time.Minute),
+			}),
+			respondWithAuths(map[string]string{
+				"quay.io": MakeTokenString("Bearer", time.Hour),
+			}),
+		)
+		first, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second).ToNot(BeIdenticalTo(first))
+		Expect(second.Expiry).To(BeTemporally(">", first.Expiry))
+	})
+
+	It("Fetches a new pull secret after invalidation", func() {
+		server.AppendHandlers(
+			respondWithAuths(map[string]string{
+				"quay.io": "mypassword",
+			}),
+			respondWithAuths(map[string]string{
+				"quay.io": "otherpassword",
+			}),
+		)
+		_, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		manager.Invalidate()
+		secret, err := manager.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Auths["quay.io"].Password).To(Equal("otherpassword"))
+	})
+
+	It("Returns the error sent by the server", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusForbidden, `{
+				"kind": "Error",
+				"id": "403",
+				"reason": "Forbidden"
+			}`),
+		)
+		_, err := manager.Get(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't request pull secret"))
+		Expect(err.Error()).To(ContainSubstring("Forbidden"))
+	})
+
+	It("Generates the docker configuration", func() {
+		auth := base64.StdEncoding.EncodeToString([]byte("joe:mypassword"))
+		token, err := amv1.UnmarshalAccessToken(fmt.Sprintf(`{
+			"auths": {
+				"quay.io": {
+					"auth": "%s",
+					"email": "joe@example.com"
+				}
+			}
+		}`, auth))
+		Expect(err).ToNot(HaveOccurred())
+		secret, err := Parse(token, time.Now().Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		data, err := json.Marshal(secret)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(fmt.Sprintf(`{
+			"auths": {
+				"quay.io": {
+					"auth": "%s",
+					"email": "joe@example.com"
+				}
+			}
+		}`, auth)))
+	})
+})
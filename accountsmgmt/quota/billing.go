@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that find the billing models that an organization can use and
+// apply them to clusters.
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// BillingOption describes a billing model that the organization can use to create the requested
+// resources.
+type BillingOption struct {
+	// Model is the billing model, for example `standard` or `marketplace-aws`.
+	Model amv1.BillingModel
+
+	// CloudAccounts contains the cloud accounts that the organization linked to the marketplace
+	// of the cloud provider, for the billing models that correspond to a cloud provider
+	// marketplace. It is empty for other billing models.
+	CloudAccounts []*amv1.CloudAccount
+}
+
+// ListBillingOptions fetches the quota costs of the organization and returns the billing models
+// that it can use to create the requested resources. The billing model of the request is ignored.
+func ListBillingOptions(ctx context.Context, client *amv1.Client, organizationID string,
+	request *Request) (result []*BillingOption, err error) {
+	costs, err := List(ctx, client, organizationID)
+	if err != nil {
+		return
+	}
+	result = BillingOptions(costs, request)
+	return
+}
+
+// BillingOptions returns the billing models that the given quota costs allow to use to create the
+// requested resources. The billing model of the request is ignored. A billing model is included
+// when at least one of the quota costs for that model has enough quota available. The standard
+// billing model goes first, and the rest are sorted by name.
+func BillingOptions(costs []*amv1.QuotaCost, request *Request) []*BillingOption {
+	if request == nil {
+		request = &Request{}
+	}
+	count := request.Count
+	if count <= 0 {
+		count = 1
+	}
+	options := map[amv1.BillingModel]*BillingOption{}
+	for _, cost := range costs {
+		for _, resource := range cost.RelatedResources() {
+			model := resource.BillingModel()
+			if model == "" || model == anyValue {
+				continue
+			}
+			candidate := *request
+			candidate.BillingModel = model
+			if !matches(resource, &candidate) {
+				continue
+			}
+			if resource.Cost()*count > cost.Allowed()-cost.Consumed() {
+				continue
+			}
+			key := amv1.BillingModel(strings.ToLower(model))
+			option, ok := options[key]
+			if !ok {
+				option = &BillingOption{
+					Model: key,
+				}
+				options[key] = option
+			}
+			provider := marketplaceProviders[key]
+			if provider == "" {
+				continue
+			}
+			for _, account := range cost.CloudAccounts() {
+				if strings.EqualFold(account.CloudProviderID(), provider) &&
+					!hasCloudAccount(option.CloudAccounts, account.CloudAccountID()) {
+					option.CloudAccounts = append(option.CloudAccounts, account)
+				}
+			}
+		}
+	}
+	result := make([]*BillingOption, 0, len(options))
+	for _, option := range options {
+		result = append(result, option)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Model == amv1.BillingModelStandard {
+			return result[j].Model != amv1.BillingModelStandard
+		}
+		if result[j].Model == amv1.BillingModelStandard {
+			return false
+		}
+		return result[i].Model < result[j].Model
+	})
+	return result
+}
+
+// ValidateBillingAccount checks that the given billing account identifier can be used with the
+// given billing option. The `marketplace-aws` billing model requires the identifier of an AWS
+// account, twelve digits, and if the organization has linked accounts it should be one of them.
+// The rest of the billing models don't use a billing account, so the identifier should be empty.
+func ValidateBillingAccount(option *BillingOption, accountID string) error {
+	if option == nil {
+		return errors.New("billing option is mandatory")
+	}
+	if option.Model != amv1.BillingModelMarketplaceAWS {
+		if accountID != "" {
+			return fmt.Errorf(
+				"billing model '%s' doesn't use a billing account, but account '%s' "+
+					"was given",
+				option.Model, accountID,
+			)
+		}
+		return nil
+	}
+	if accountID == "" {
+		return fmt.Errorf("billing account is mandatory for billing model '%s'", option.Model)
+	}
+	if !awsAccountIDRE.MatchString(accountID) {
+		return fmt.Errorf(
+			"billing account '%s' isn't valid, it should be an AWS account identifier "+
+				"of twelve digits",
+			accountID,
+		)
+	}
+	if len(option.CloudAccounts) > 0 && !hasCloudAccount(option.CloudAccounts, accountID) {
+		linked := make([]string, len(option.CloudAccounts))
+		for i, account := range option.CloudAccounts {
+			linked[i] = fmt.Sprintf("'%s'", account.CloudAccountID())
+		}
+		return fmt.Errorf(
+			"billing account '%s' isn't linked to the organization, linked accounts are %s",
+			accountID, strings.Join(linked, ", "),
+		)
+	}
+	return nil
+}
+
+// ApplyBillingOption validates the billing account and then sets the billing model of the given
+// cluster builder and, for the `marketplace-aws` billing model, the billing account of the AWS
+// attributes. Other AWS attributes already set in the builder are preserved.
+func ApplyBillingOption(builder *cmv1.ClusterBuilder, option *BillingOption,
+	accountID string) error {
+	if builder == nil {
+		return errors.New("cluster builder is mandatory")
+	}
+	err := ValidateBillingAccount(option, accountID)
+	if err != nil {
+		return err
+	}
+	builder.BillingModel(cmv1.BillingModel(option.Model))
+	if accountID != "" {
+		cluster, err := builder.Build()
+		if err != nil {
+			return err
+		}
+		builder.AWS(cmv1.NewAWS().Copy(cluster.AWS()).BillingAccountID(accountID))
+	}
+	return nil
+}
+
+// hasCloudAccount checks if the list contains an account with the given identifier.
+func hasCloudAccount(accounts []*amv1.CloudAccount, accountID string) bool {
+	for _, account := range accounts {
+		if account.CloudAccountID() == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+// marketplaceProviders contains the cloud provider that corresponds to each marketplace billing
+// model.
+var marketplaceProviders = map[amv1.BillingModel]string{
+	amv1.BillingModelMarketplaceAWS:   "aws",
+	amv1.BillingModelMarketplaceAzure: "azure",
+	amv1.BillingModelMarketplaceGCP:   "gcp",
+}
+
+// awsAccountIDRE is the regular expression used to check AWS account identifiers.
+var awsAccountIDRE = regexp.MustCompile(`^\d{12}$`)
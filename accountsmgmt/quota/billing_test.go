@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Billing", func() {
+	const costs = `{
+		"page": 1,
+		"size": 4,
+		"total": 4,
+		"items": [
+			{
+				"quota_id": "cluster|byoc|osd|marketplace-aws",
+				"allowed": 0,
+				"consumed": 0,
+				"related_resources": [
+					{
+						"resource_type": "cluster",
+						"resource_name": "any",
+						"product": "rosa",
+						"cloud_provider": "aws",
+						"byoc": "byoc",
+						"availability_zone_type": "any",
+						"billing_model": "marketplace-aws",
+						"cost": 0
+					}
+				],
+				"cloud_accounts": [
+					{
+						"cloud_account_id": "123456789012",
+						"cloud_provider_id": "aws"
+					},
+					{
+						"cloud_account_id": "my-azure-account",
+						"cloud_provider_id": "azure"
+					}
+				]
+			},
+			{
+				"quota_id": "cluster|byoc|osd|standard",
+				"allowed": 10,
+				"consumed": 4,
+				"related_resources": [
+					{
+						"resource_type": "cluster",
+						"resource_name": "any",
+						"product": "any",
+						"cloud_provider": "any",
+						"byoc": "byoc",
+						"availability_zone_type": "any",
+						"billing_model": "standard",
+						"cost": 1
+					}
+				]
+			},
+			{
+				"quota_id": "cluster|byoc|osd|marketplace-gcp",
+				"allowed": 1,
+				"consumed": 1,
+				"related_resources": [
+					{
+						"resource_type": "cluster",
+						"resource_name": "any",
+						"product": "osd",
+						"cloud_provider": "gcp",
+						"byoc": "byoc",
+						"availability_zone_type": "any",
+						"billing_model": "marketplace-gcp",
+						"cost": 1
+					}
+				]
+			},
+			{
+				"quota_id": "cluster|byoc|osd|marketplace",
+				"allowed": 5,
+				"consumed": 0,
+				"related_resources": [
+					{
+						"resource_type": "cluster",
+						"resource_name": "any",
+						"product": "osd",
+						"cloud_provider": "any",
+						"byoc": "byoc",
+						"availability_zone_type": "any",
+						"billing_model": "marketplace",
+						"cost": 1
+					}
+				]
+			}
+		]
+	}`
+
+	It("Returns the billing models with enough quota", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/accounts_mgmt/v1/organizations/123/quota_cost",
+				),
+				ghttp.VerifyFormKV("fetchRelatedResources", "true"),
+				RespondWithJSON(http.StatusOK, costs),
+			),
+		)
+		result, err := ListBillingOptions(ctx, client, "123", &Request{
+			CloudProvider: "aws",
+			Product:       "rosa",
+			BYOC:          true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(2))
+		Expect(result[0].Model).To(Equal(amv1.BillingModelStandard))
+		Expect(result[0].CloudAccounts).To(BeEmpty())
+		Expect(result[1].Model).To(Equal(amv1.BillingModelMarketplaceAWS))
+		Expect(result[1].CloudAccounts).To(HaveLen(1))
+		Expect(result[1].CloudAccounts[0].CloudAccountID()).To(Equal("123456789012"))
+	})
+
+	It("Excludes billing models without enough quota", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, costs),
+		)
+		result, err := ListBillingOptions(ctx, client, "123", &Request{
+			CloudProvider: "gcp",
+			Product:       "osd",
+			BYOC:          true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(2))
+		Expect(result[0].Model).To(Equal(amv1.BillingModelStandard))
+		Expect(result[1].Model).To(Equal(amv1.BillingModelMarketplace))
+	})
+
+	Describe("Validate billing account", func() {
+		var option *BillingOption
+
+		BeforeEach(func() {
+			account, err := amv1.NewCloudAccount().
+				CloudAccountID("123456789012").
+				CloudProviderID("aws").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			option = &BillingOption{
+				Model:         amv1.BillingModelMarketplaceAWS,
+				CloudAccounts: []*amv1.CloudAccount{account},
+			}
+		})
+
+		It("Accepts a linked account", func() {
+			err := ValidateBillingAccount(option, "123456789012")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Requires the account for AWS marketplace", func() {
+			err := ValidateBillingAccount(option, "")
+			Expect(err).To(MatchError(
+				"billing account is mandatory for billing model 'marketplace-aws'",
+			))
+		})
+
+		It("Rejects malformed accounts", func() {
+			err := ValidateBillingAccount(option, "12345")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("billing account '12345' isn't valid"))
+		})
+
+		It("Rejects accounts that aren't linked", func() {
+			err := ValidateBillingAccount(option, "210987654321")
+			Expect(err).To(MatchError(
+				"billing account '210987654321' isn't linked to the organization, " +
+					"linked accounts are '123456789012'",
+			))
+		})
+
+		It("Rejects accounts for the standard billing model", func() {
+			err := ValidateBillingAccount(
+				&BillingOption{Model: amv1.BillingModelStandard},
+				"123456789012",
+			)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't use a billing account"))
+		})
+	})
+
+	It("Applies the billing option preserving the AWS attributes", func() {
+		builder := cmv1.NewCluster().
+			Name("my-cluster").
+			AWS(cmv1.NewAWS().AccountID("111111111111"))
+		err := ApplyBillingOption(
+			builder,
+			&BillingOption{Model: amv1.BillingModelMarketplaceAWS},
+			"123456789012",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		cluster, err := builder.Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Name()).To(Equal("my-cluster"))
+		Expect(cluster.BillingModel()).To(Equal(cmv1.BillingModelMarketplaceAWS))
+		Expect(cluster.AWS().AccountID()).To(Equal("111111111111"))
+		Expect(cluster.AWS().BillingAccountID()).To(Equal("123456789012"))
+	})
+
+	It("Applies the standard billing option", func() {
+		builder := cmv1.NewCluster()
+		err := ApplyBillingOption(
+			builder,
+			&BillingOption{Model: amv1.BillingModelStandard},
+			"",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		cluster, err := builder.Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.BillingModel()).To(Equal(cmv1.BillingModelStandard))
+		_, ok := cluster.GetAWS()
+		Expect(ok).To(BeFalse())
+	})
+})
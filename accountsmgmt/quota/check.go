@@ -0,0 +1,252 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that check if an organization has enough quota to create
+// resources.
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// Request describes the resources that the caller intends to create. Attributes that are empty
+// match any value of the corresponding attribute of the related resources of the quota costs.
+type Request struct {
+	// ResourceType is the type of resource, for example `cluster` or `addon`. The default is
+	// `cluster`.
+	ResourceType string
+
+	// ResourceName is the name of the resource, for example the identifier of the machine type
+	// or of the add-on.
+	ResourceName string
+
+	// Product is the identifier of the product, for example `osd` or `rosa`.
+	Product string
+
+	// CloudProvider is the identifier of the cloud provider, for example `aws` or `gcp`.
+	CloudProvider string
+
+	// BYOC indicates if the resources will be created in a cloud account provided by the
+	// customer.
+	BYOC bool
+
+	// MultiAZ indicates if the resources will be spread across multiple availability zones.
+	MultiAZ bool
+
+	// BillingModel is the billing model, for example `standard` or `marketplace`. The default is
+	// `standard`.
+	BillingModel string
+
+	// Count is the number of resources. The default is one.
+	Count int
+}
+
+// Result contains the answer to a quota check and the breakdown of the quota costs that were
+// considered.
+type Result struct {
+	// Allowed is true when at least one of the matching quota costs has enough quota available
+	// to create the requested resources.
+	Allowed bool
+
+	// Items contains the details of the quota costs that match the request, in the order
+	// returned by the server.
+	Items []*Item
+}
+
+// Item contains the details of a quota cost that matches a request.
+type Item struct {
+	// QuotaID is the identifier of the quota.
+	QuotaID string
+
+	// Resource is the related resource of the quota cost that matched the request.
+	Resource *amv1.RelatedResource
+
+	// Allowed is the number of quota units granted to the organization.
+	Allowed int
+
+	// Consumed is the number of quota units already consumed by the organization.
+	Consumed int
+
+	// Cost is the number of quota units consumed by each resource. Zero means that the resource
+	// doesn't consume quota.
+	Cost int
+
+	// Required is the number of quota units needed to create all the requested resources.
+	Required int
+
+	// Sufficient is true when the quota available is enough to create all the requested
+	// resources.
+	Sufficient bool
+}
+
+// Available returns the number of quota units that haven't been consumed yet.
+func (i *Item) Available() int {
+	available := i.Allowed - i.Consumed
+	if available < 0 {
+		available = 0
+	}
+	return available
+}
+
+// Check fetches the quota costs of the organization and checks if it has enough quota to create
+// the requested resources. For example, to check if the organization can create two single zone
+// clusters in AWS using the `m5.xlarge` machine type:
+//
+//	result, err := quota.Check(ctx, connection.AccountsMgmt().V1(), orgID, &quota.Request{
+//		ResourceName:  "m5.xlarge",
+//		Product:       "osd",
+//		CloudProvider: "aws",
+//		Count:         2,
+//	})
+//	if err != nil {
+//		...
+//	}
+//	if !result.Allowed {
+//		...
+//	}
+func Check(ctx context.Context, client *amv1.Client, organizationID string,
+	request *Request) (result *Result, err error) {
+	if request == nil {
+		err = errors.New("request is mandatory")
+		return
+	}
+	costs, err := List(ctx, client, organizationID)
+	if err != nil {
+		return
+	}
+	result = Evaluate(costs, request)
+	return
+}
+
+// List fetches all the quota costs of the organization, including their related resources.
+func List(ctx context.Context, client *amv1.Client,
+	organizationID string) (result []*amv1.QuotaCost, err error) {
+	resource := client.Organizations().Organization(organizationID).QuotaCost()
+	page := 1
+	for {
+		var response *amv1.QuotaCostListResponse
+		response, err = resource.List().
+			Parameter("fetchRelatedResources", true).
+			Page(page).
+			Size(listSize).
+			SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't list quota costs of organization '%s': %w",
+				organizationID, err,
+			)
+			return
+		}
+		result = append(result, response.Items().Slice()...)
+		if response.Size() < listSize {
+			break
+		}
+		page++
+	}
+	return
+}
+
+// Evaluate checks if the given quota costs allow the creation of the requested resources. It is
+// useful when the quota costs have already been fetched, for example to check several requests
+// without fetching them again.
+func Evaluate(costs []*amv1.QuotaCost, request *Request) *Result {
+	count := request.Count
+	if count <= 0 {
+		count = 1
+	}
+	result := &Result{}
+	for _, cost := range costs {
+		for _, resource := range cost.RelatedResources() {
+			if !matches(resource, request) {
+				continue
+			}
+			item := &Item{
+				QuotaID:  cost.QuotaID(),
+				Resource: resource,
+				Allowed:  cost.Allowed(),
+				Consumed: cost.Consumed(),
+				Cost:     resource.Cost(),
+				Required: resource.Cost() * count,
+			}
+			item.Sufficient = item.Required <= item.Available()
+			if item.Sufficient {
+				result.Allowed = true
+			}
+			result.Items = append(result.Items, item)
+			break
+		}
+	}
+	return result
+}
+
+// matches checks if the related resource of a quota cost applies to the request.
+func matches(resource *amv1.RelatedResource, request *Request) bool {
+	resourceType := request.ResourceType
+	if resourceType == "" {
+		resourceType = defaultResourceType
+	}
+	byoc := byocRHInfra
+	if request.BYOC {
+		byoc = byocBYOC
+	}
+	zoneType := zoneTypeSingle
+	if request.MultiAZ {
+		zoneType = zoneTypeMulti
+	}
+	billingModel := request.BillingModel
+	if billingModel == "" {
+		billingModel = defaultBillingModel
+	}
+	return matchesValue(resource.ResourceType(), resourceType) &&
+		matchesValue(resource.ResourceName(), request.ResourceName) &&
+		matchesValue(resource.Product(), request.Product) &&
+		matchesValue(resource.CloudProvider(), request.CloudProvider) &&
+		matchesValue(resource.BYOC(), byoc) &&
+		matchesValue(resource.AvailabilityZoneType(), zoneType) &&
+		matchesValue(resource.BillingModel(), billingModel)
+}
+
+// matchesValue checks if the value of an attribute of a related resource matches the requested
+// value. The `any` value, or an empty value, of the related resource matches everything, and an
+// empty requested value also matches everything.
+func matchesValue(actual, requested string) bool {
+	if actual == "" || actual == anyValue || requested == "" {
+		return true
+	}
+	return strings.EqualFold(actual, requested)
+}
+
+// Values of the related resources that have special meanings:
+const (
+	anyValue       = "any"
+	byocBYOC       = "byoc"
+	byocRHInfra    = "rhinfra"
+	zoneTypeSingle = "single"
+	zoneTypeMulti  = "multi"
+)
+
+// Default values:
+const (
+	defaultResourceType = "cluster"
+	defaultBillingModel = "standard"
+	listSize            = 100
+)
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+var _ = Describe("Check", func() {
+	It("Fetches the related resources", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/accounts_mgmt/v1/organizations/123/quota_cost",
+				),
+				ghttp.VerifyFormKV("fetchRelatedResources", "true"),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"quota_id": "cluster|byoc|osd",
+							"allowed": 10,
+							"consumed": 4,
+							"related_resources": [
+								{
+									"resource_type": "cluster",
+									"resource_name": "any",
+									"product": "osd",
+									"cloud_provider": "any",
+									"byoc": "byoc",
+									"availability_zone_type": "any",
+									"billing_model": "standard",
+									"cost": 2
+								}
+							]
+						}
+					]
+				}`),
+			),
+		)
+		result, err := Check(ctx, client, "123", &Request{
+			ResourceName:  "m5.xlarge",
+			Product:       "osd",
+			CloudProvider: "aws",
+			BYOC:          true,
+			Count:         3,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Allowed).To(BeTrue())
+		Expect(result.Items).To(HaveLen(1))
+		item := result.Items[0]
+		Expect(item.QuotaID).To(Equal("cluster|byoc|osd"))
+		Expect(item.Available()).To(Equal(6))
+		Expect(item.Cost).To(Equal(2))
+		Expect(item.Required).To(Equal(6))
+		Expect(item.Sufficient).To(BeTrue())
+	})
+
+	It("Returns error if the request fails", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusForbidden, `{
+				"kind": "Error",
+				"id": "403",
+				"href": "/api/accounts_mgmt/v1/errors/403",
+				"code": "ACCT-MGMT-403",
+				"reason": "Forbidden"
+			}`),
+		)
+		_, err := Check(ctx, client, "123", &Request{})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("123"))
+	})
+})
+
+var _ = Describe("Evaluate", func() {
+	makeCost := func(quotaID string, allowed, consumed int,
+		resources ...*amv1.RelatedResourceBuilder) *amv1.QuotaCost {
+		cost, err := amv1.NewQuotaCost().
+			QuotaID(quotaID).
+			Allowed(allowed).
+			Consumed(consumed).
+			RelatedResources(resources...).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return cost
+	}
+
+	It("Rejects if there isn't enough quota", func() {
+		costs := []*amv1.QuotaCost{
+			makeCost(
+				"cluster|rhinfra|osd", 4, 3,
+				amv1.NewRelatedResource().
+					ResourceType("cluster").
+					ResourceName("m5.xlarge").
+					Product("osd").
+					CloudProvider("aws").
+					BYOC("rhinfra").
+					AvailabilityZoneType("single").
+					BillingModel("standard").
+					Cost(1),
+			),
+		}
+		result := Evaluate(costs, &Request{
+			ResourceName:  "m5.xlarge",
+			CloudProvider: "aws",
+			Count:         2,
+		})
+		Expect(result.Allowed).To(BeFalse())
+		Expect(result.Items).To(HaveLen(1))
+		Expect(result.Items[0].Required).To(Equal(2))
+		Expect(result.Items[0].Sufficient).To(BeFalse())
+	})
+
+	It("Ignores quota costs that don't match", func() {
+		costs := []*amv1.QuotaCost{
+			makeCost(
+				"cluster|gcp", 10, 0,
+				amv1.NewRelatedResource().
+					ResourceType("cluster").
+					CloudProvider("gcp").
+					Cost(1),
+			),
+			makeCost(
+				"cluster|multi", 10, 0,
+				amv1.NewRelatedResource().
+					ResourceType("cluster").
+					AvailabilityZoneType("multi").
+					Cost(1),
+			),
+			makeCost(
+				"addon", 10, 0,
+				amv1.NewRelatedResource().
+					ResourceType("addon").
+					Cost(1),
+			),
+		}
+		result := Evaluate(costs, &Request{
+			CloudProvider: "aws",
+		})
+		Expect(result.Allowed).To(BeFalse())
+		Expect(result.Items).To(BeEmpty())
+	})
+
+	It("Accepts resources that don't have cost", func() {
+		costs := []*amv1.QuotaCost{
+			makeCost(
+				"cluster|free", 0, 0,
+				amv1.NewRelatedResource().
+					ResourceType("cluster").
+					BillingModel("marketplace").
+					Cost(0),
+			),
+		}
+		result := Evaluate(costs, &Request{
+			BillingModel: "marketplace",
+			Count:        5,
+		})
+		Expect(result.Allowed).To(BeTrue())
+		Expect(result.Items[0].Required).To(BeZero())
+	})
+})
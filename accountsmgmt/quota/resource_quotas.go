@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that export the resource quota of organizations to files and
+// reconcile it with the content of those files, for administrators that manage quota as code.
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// ResourceQuotaDiff contains the changes needed to make the resource quota of an organization
+// match the desired state. Resource quota objects are identified by their SKU.
+type ResourceQuotaDiff struct {
+	// Create contains the desired objects that don't exist yet.
+	Create []*amv1.ResourceQuota
+
+	// Update contains the desired objects that exist but have a different SKU count or type.
+	// They have the identifier of the existing object.
+	Update []*amv1.ResourceQuota
+
+	// Delete contains the existing objects that aren't in the desired state.
+	Delete []*amv1.ResourceQuota
+}
+
+// Empty returns true if the diff doesn't contain any change.
+func (d *ResourceQuotaDiff) Empty() bool {
+	return len(d.Create) == 0 && len(d.Update) == 0 && len(d.Delete) == 0
+}
+
+// ReconcileOptions controls how the desired state is applied.
+type ReconcileOptions struct {
+	// Prune indicates if the existing objects that aren't in the desired state should be
+	// deleted. When false they are preserved.
+	Prune bool
+
+	// DryRun indicates that the changes should be calculated but not applied.
+	DryRun bool
+}
+
+// ListResourceQuotas fetches all the resource quota objects of the organization.
+func ListResourceQuotas(ctx context.Context, client *amv1.Client,
+	organizationID string) (result []*amv1.ResourceQuota, err error) {
+	if organizationID == "" {
+		err = errors.New("organization identifier is mandatory")
+		return
+	}
+	resource := client.Organizations().Organization(organizationID).ResourceQuota()
+	page := 1
+	for {
+		var response *amv1.ResourceQuotasListResponse
+		response, err = resource.List().
+			Page(page).
+			Size(listSize).
+			SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't list resource quota of organization '%s': %w",
+				organizationID, err,
+			)
+			return
+		}
+		result = append(result, response.Items().Slice()...)
+		if response.Size() < listSize {
+			break
+		}
+		page++
+	}
+	return
+}
+
+// WriteResourceQuotas writes the given resource quota objects to the writer as a JSON array. Only
+// the SKU, SKU count and type are written, sorted by SKU, so that the result can be stored in a
+// version control system and applied to any organization.
+func WriteResourceQuotas(writer io.Writer, quotas []*amv1.ResourceQuota) error {
+	list := make([]*amv1.ResourceQuota, len(quotas))
+	for i, quota := range quotas {
+		clean, err := amv1.NewResourceQuota().
+			SKU(quota.SKU()).
+			SkuCount(quota.SkuCount()).
+			Type(quota.Type()).
+			Build()
+		if err != nil {
+			return err
+		}
+		list[i] = clean
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].SKU() < list[j].SKU()
+	})
+	return amv1.MarshalResourceQuotaList(list, writer)
+}
+
+// ReadResourceQuotas reads a JSON array of resource quota objects, like the ones written by the
+// WriteResourceQuotas function, and checks that they are valid.
+func ReadResourceQuotas(reader io.Reader) (result []*amv1.ResourceQuota, err error) {
+	result, err = amv1.UnmarshalResourceQuotaList(reader)
+	if err != nil {
+		err = fmt.Errorf("can't read resource quota: %w", err)
+		return
+	}
+	err = validateResourceQuotas(result)
+	if err != nil {
+		result = nil
+	}
+	return
+}
+
+// ExportResourceQuotas writes the resource quota objects of the organization to the writer, in
+// the format described in the WriteResourceQuotas function.
+func ExportResourceQuotas(ctx context.Context, client *amv1.Client, organizationID string,
+	writer io.Writer) error {
+	quotas, err := ListResourceQuotas(ctx, client, organizationID)
+	if err != nil {
+		return err
+	}
+	return WriteResourceQuotas(writer, quotas)
+}
+
+// ImportResourceQuotas reads the desired resource quota objects from the reader and reconciles
+// the organization with them. See the ReconcileResourceQuotas function for details.
+func ImportResourceQuotas(ctx context.Context, client *amv1.Client, organizationID string,
+	reader io.Reader, options *ReconcileOptions) (result *ResourceQuotaDiff, err error) {
+	desired, err := ReadResourceQuotas(reader)
+	if err != nil {
+		return
+	}
+	result, err = ReconcileResourceQuotas(ctx, client, organizationID, desired, options)
+	return
+}
+
+// DiffResourceQuotas calculates the changes needed to go from the current to the desired resource
+// quota objects. The desired objects must have a unique SKU. The result is sorted by SKU.
+func DiffResourceQuotas(current, desired []*amv1.ResourceQuota) (result *ResourceQuotaDiff,
+	err error) {
+	err = validateResourceQuotas(desired)
+	if err != nil {
+		return
+	}
+	existing := make(map[string]*amv1.ResourceQuota, len(current))
+	for _, quota := range current {
+		existing[quota.SKU()] = quota
+	}
+	wanted := make(map[string]bool, len(desired))
+	result = &ResourceQuotaDiff{}
+	for _, quota := range desired {
+		wanted[quota.SKU()] = true
+		old, ok := existing[quota.SKU()]
+		switch {
+		case !ok:
+			result.Create = append(result.Create, quota)
+		case old.SkuCount() != quota.SkuCount() || old.Type() != quota.Type():
+			var update *amv1.ResourceQuota
+			update, err = amv1.NewResourceQuota().
+				Copy(quota).
+				ID(old.ID()).
+				Build()
+			if err != nil {
+				result = nil
+				return
+			}
+			result.Update = append(result.Update, update)
+		}
+	}
+	for _, quota := range current {
+		if !wanted[quota.SKU()] {
+			result.Delete = append(result.Delete, quota)
+		}
+	}
+	for _, list := range [][]*amv1.ResourceQuota{result.Create, result.Update, result.Delete} {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].SKU() < list[j].SKU()
+		})
+	}
+	return
+}
+
+// ReconcileResourceQuotas changes the resource quota of the organization so that it matches the
+// desired objects, creating the missing ones and updating the ones that have a different SKU
+// count or type. Existing objects that aren't desired are deleted only if the Prune option is
+// set. It returns the changes, which are calculated but not applied when the DryRun option is
+// set. If applying a change fails the remaining changes aren't applied.
+func ReconcileResourceQuotas(ctx context.Context, client *amv1.Client, organizationID string,
+	desired []*amv1.ResourceQuota, options *ReconcileOptions) (result *ResourceQuotaDiff,
+	err error) {
+	if options == nil {
+		options = &ReconcileOptions{}
+	}
+	current, err := ListResourceQuotas(ctx, client, organizationID)
+	if err != nil {
+		return
+	}
+	result, err = DiffResourceQuotas(current, desired)
+	if err != nil {
+		return
+	}
+	if !options.Prune {
+		result.Delete = nil
+	}
+	if options.DryRun {
+		return
+	}
+	resource := client.Organizations().Organization(organizationID).ResourceQuota()
+	for _, quota := range result.Create {
+		_, err = resource.Add().Body(quota).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't create resource quota for SKU '%s' of organization '%s': %w",
+				quota.SKU(), organizationID, err,
+			)
+			return
+		}
+	}
+	for _, quota := range result.Update {
+		_, err = resource.ResourceQuota(quota.ID()).Update().Body(quota).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't update resource quota '%s' for SKU '%s' of organization '%s': %w",
+				quota.ID(), quota.SKU(), organizationID, err,
+			)
+			return
+		}
+	}
+	for _, quota := range result.Delete {
+		_, err = resource.ResourceQuota(quota.ID()).Delete().SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't delete resource quota '%s' for SKU '%s' of organization '%s': %w",
+				quota.ID(), quota.SKU(), organizationID, err,
+			)
+			return
+		}
+	}
+	return
+}
+
+func validateResourceQuotas(quotas []*amv1.ResourceQuota) error {
+	skus := make(map[string]bool, len(quotas))
+	for i, quota := range quotas {
+		sku := quota.SKU()
+		if sku == "" {
+			return fmt.Errorf("SKU of resource quota %d is mandatory", i)
+		}
+		if skus[sku] {
+			return fmt.Errorf("SKU '%s' is used by more than one resource quota", sku)
+		}
+		skus[sku] = true
+		if quota.SkuCount() < 0 {
+			return fmt.Errorf(
+				"SKU count of resource quota for SKU '%s' should be zero or positive, "+
+					"but it is %d",
+				sku, quota.SkuCount(),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+var _ = Describe("Resource quota", func() {
+	// current is the resource quota returned by the server in most of the tests.
+	current := `{
+		"page": 1,
+		"size": 2,
+		"total": 2,
+		"items": [
+			{
+				"id": "q1",
+				"sku": "MW00530",
+				"sku_count": 5,
+				"type": "Config",
+				"created_at": "2026-01-01T00:00:00Z"
+			},
+			{
+				"id": "q2",
+				"sku": "RH00004",
+				"sku_count": 1,
+				"type": "Config"
+			}
+		]
+	}`
+
+	// makeQuota creates a resource quota object with the given SKU and count.
+	makeQuota := func(sku string, count int) *amv1.ResourceQuota {
+		quota, err := amv1.NewResourceQuota().
+			SKU(sku).
+			SkuCount(count).
+			Type("Config").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return quota
+	}
+
+	It("Exports only the attributes managed as code", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/accounts_mgmt/v1/organizations/123/resource_quota",
+				),
+				RespondWithJSON(http.StatusOK, current),
+			),
+		)
+		buffer := &bytes.Buffer{}
+		err := ExportResourceQuotas(ctx, client, "123", buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(MatchJSON(`[
+			{
+				"kind": "ResourceQuota",
+				"sku": "MW00530",
+				"sku_count": 5,
+				"type": "Config"
+			},
+			{
+				"kind": "ResourceQuota",
+				"sku": "RH00004",
+				"sku_count": 1,
+				"type": "Config"
+			}
+		]`))
+	})
+
+	It("Reads what it writes", func() {
+		buffer := &bytes.Buffer{}
+		err := WriteResourceQuotas(buffer, []*amv1.ResourceQuota{
+			makeQuota("RH00004", 1),
+			makeQuota("MW00530", 5),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		quotas, err := ReadResourceQuotas(buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(quotas).To(HaveLen(2))
+		Expect(quotas[0].SKU()).To(Equal("MW00530"))
+		Expect(quotas[0].SkuCount()).To(Equal(5))
+		Expect(quotas[1].SKU()).To(Equal("RH00004"))
+	})
+
+	It("Rejects files with duplicated SKUs", func() {
+		_, err := ReadResourceQuotas(strings.NewReader(`[
+			{ "sku": "MW00530", "sku_count": 1 },
+			{ "sku": "MW00530", "sku_count": 2 }
+		]`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("used by more than one resource quota"))
+	})
+
+	It("Rejects negative counts", func() {
+		_, err := ReadResourceQuotas(strings.NewReader(`[
+			{ "sku": "MW00530", "sku_count": -1 }
+		]`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("should be zero or positive"))
+	})
+
+	It("Calculates the diff", func() {
+		existing, err := amv1.UnmarshalResourceQuotaList(`[
+			{ "id": "q1", "sku": "MW00530", "sku_count": 5, "type": "Config" },
+			{ "id": "q2", "sku": "RH00004", "sku_count": 1, "type": "Config" },
+			{ "id": "q3", "sku": "RH00005", "sku_count": 1, "type": "Config" }
+		]`)
+		Expect(err).ToNot(HaveOccurred())
+		diff, err := DiffResourceQuotas(existing, []*amv1.ResourceQuota{
+			makeQuota("RH00004", 1),
+			makeQuota("MW00530", 10),
+			makeQuota("MW00999", 2),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diff.Create).To(HaveLen(1))
+		Expect(diff.Create[0].SKU()).To(Equal("MW00999"))
+		Expect(diff.Update).To(HaveLen(1))
+		Expect(diff.Update[0].ID()).To(Equal("q1"))
+		Expect(diff.Update[0].SkuCount()).To(Equal(10))
+		Expect(diff.Delete).To(HaveLen(1))
+		Expect(diff.Delete[0].ID()).To(Equal("q3"))
+	})
+
+	It("Returns an empty diff when nothing changes", func() {
+		existing, err := amv1.UnmarshalResourceQuotaList(`[
+			{ "id": "q1", "sku": "MW00530", "sku_count": 5, "type": "Config" }
+		]`)
+		Expect(err).ToNot(HaveOccurred())
+		diff, err := DiffResourceQuotas(existing, []*amv1.ResourceQuota{
+			makeQuota("MW00530", 5),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diff.Empty()).To(BeTrue())
+	})
+
+	It("Doesn't send changes in dry run mode", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, current),
+		)
+		diff, err := ReconcileResourceQuotas(ctx, client, "123", []*amv1.ResourceQuota{
+			makeQuota("MW00530", 10),
+		}, &ReconcileOptions{
+			DryRun: true,
+			Prune:  true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diff.Update).To(HaveLen(1))
+		Expect(diff.Delete).To(HaveLen(1))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Creates and updates but doesn't delete without prune", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, current),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/accounts_mgmt/v1/organizations/123/resource_quota",
+				),
+				VerifyJQ(".sku", "MW00999"),
+				VerifyJQ(".sku_count", 2.0),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "q4",
+					"sku": "MW00999",
+					"sku_count": 2
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPatch,
+					"/api/accounts_mgmt/v1/organizations/123/resource_quota/q1",
+				),
+				VerifyJQ(".sku_count", 10.0),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "q1",
+					"sku": "MW00530",
+					"sku_count": 10
+				}`),
+			),
+		)
+		diff, err := ImportResourceQuotas(ctx, client, "123", strings.NewReader(`[
+			{ "sku": "MW00530", "sku_count": 10, "type": "Config" },
+			{ "sku": "MW00999", "sku_count": 2, "type": "Config" }
+		]`), nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diff.Create).To(HaveLen(1))
+		Expect(diff.Update).To(HaveLen(1))
+		Expect(diff.Delete).To(BeEmpty())
+	})
+
+	It("Deletes with prune", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, current),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodDelete,
+					"/api/accounts_mgmt/v1/organizations/123/resource_quota/q2",
+				),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		diff, err := ReconcileResourceQuotas(ctx, client, "123", []*amv1.ResourceQuota{
+			makeQuota("MW00530", 5),
+		}, &ReconcileOptions{
+			Prune: true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diff.Delete).To(HaveLen(1))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Stops at the first error", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, current),
+			RespondWithJSON(http.StatusForbidden, `{
+				"kind": "Error",
+				"id": "403",
+				"reason": "Forbidden"
+			}`),
+		)
+		_, err := ReconcileResourceQuotas(ctx, client, "123", []*amv1.ResourceQuota{
+			makeQuota("MW00530", 10),
+			makeQuota("MW00999", 2),
+		}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(
+			"can't create resource quota for SKU 'MW00999' of organization '123'",
+		))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that export the SKU rules to files and compare them with the
+// content of those files.
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// SkuRuleDiff contains the differences between the SKU rules defined by the service and the
+// expected ones. SKU rules are identified by their identifier. The service doesn't allow changing
+// SKU rules, so the diff is intended to detect drift, not to apply changes.
+type SkuRuleDiff struct {
+	// Missing contains the expected rules that the service doesn't define.
+	Missing []*amv1.SkuRule
+
+	// Changed contains the expected rules that the service defines with a different SKU, quota
+	// identifier or allowed count.
+	Changed []*amv1.SkuRule
+
+	// Extra contains the rules that the service defines but aren't expected.
+	Extra []*amv1.SkuRule
+}
+
+// Empty returns true if the diff doesn't contain any difference.
+func (d *SkuRuleDiff) Empty() bool {
+	return len(d.Missing) == 0 && len(d.Changed) == 0 && len(d.Extra) == 0
+}
+
+// ListSkuRules fetches the SKU rules that match the given search criteria. An empty search
+// returns all the rules.
+func ListSkuRules(ctx context.Context, client *amv1.Client,
+	search string) (result []*amv1.SkuRule, err error) {
+	page := 1
+	for {
+		request := client.SkuRules().List().
+			Page(page).
+			Size(listSize)
+		if search != "" {
+			request.Search(search)
+		}
+		var response *amv1.SkuRulesListResponse
+		response, err = request.SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list SKU rules: %w", err)
+			return
+		}
+		result = append(result, response.Items().Slice()...)
+		if response.Size() < listSize {
+			break
+		}
+		page++
+	}
+	return
+}
+
+// WriteSkuRules writes the given SKU rules to the writer as a JSON array. Only the identifier,
+// SKU, quota identifier and allowed count are written, sorted by identifier, so that the result
+// can be stored in a version control system.
+func WriteSkuRules(writer io.Writer, rules []*amv1.SkuRule) error {
+	list := make([]*amv1.SkuRule, len(rules))
+	for i, rule := range rules {
+		clean, err := amv1.NewSkuRule().
+			ID(rule.ID()).
+			Sku(rule.Sku()).
+			QuotaId(rule.QuotaId()).
+			Allowed(rule.Allowed()).
+			Build()
+		if err != nil {
+			return err
+		}
+		list[i] = clean
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID() < list[j].ID()
+	})
+	return amv1.MarshalSkuRuleList(list, writer)
+}
+
+// ReadSkuRules reads a JSON array of SKU rules, like the ones written by the WriteSkuRules
+// function, and checks that they are valid.
+func ReadSkuRules(reader io.Reader) (result []*amv1.SkuRule, err error) {
+	result, err = amv1.UnmarshalSkuRuleList(reader)
+	if err != nil {
+		err = fmt.Errorf("can't read SKU rules: %w", err)
+		return
+	}
+	err = validateSkuRules(result)
+	if err != nil {
+		result = nil
+	}
+	return
+}
+
+// ExportSkuRules writes all the SKU rules to the writer, in the format described in the
+// WriteSkuRules function.
+func ExportSkuRules(ctx context.Context, client *amv1.Client, writer io.Writer) error {
+	rules, err := ListSkuRules(ctx, client, "")
+	if err != nil {
+		return err
+	}
+	return WriteSkuRules(writer, rules)
+}
+
+// DiffSkuRules compares the SKU rules defined by the service with the expected ones. The expected
+// rules must have a unique identifier. The result is sorted by identifier.
+func DiffSkuRules(current, expected []*amv1.SkuRule) (result *SkuRuleDiff, err error) {
+	err = validateSkuRules(expected)
+	if err != nil {
+		return
+	}
+	existing := make(map[string]*amv1.SkuRule, len(current))
+	for _, rule := range current {
+		existing[rule.ID()] = rule
+	}
+	wanted := make(map[string]bool, len(expected))
+	result = &SkuRuleDiff{}
+	for _, rule := range expected {
+		wanted[rule.ID()] = true
+		old, ok := existing[rule.ID()]
+		switch {
+		case !ok:
+			result.Missing = append(result.Missing, rule)
+		case old.Sku() != rule.Sku() || old.QuotaId() != rule.QuotaId() ||
+			old.Allowed() != rule.Allowed():
+			result.Changed = append(result.Changed, rule)
+		}
+	}
+	for _, rule := range current {
+		if !wanted[rule.ID()] {
+			result.Extra = append(result.Extra, rule)
+		}
+	}
+	for _, list := range [][]*amv1.SkuRule{result.Missing, result.Changed, result.Extra} {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].ID() < list[j].ID()
+		})
+	}
+	return
+}
+
+func validateSkuRules(rules []*amv1.SkuRule) error {
+	ids := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		id := rule.ID()
+		if id == "" {
+			return fmt.Errorf("identifier of SKU rule %d is mandatory", i)
+		}
+		if ids[id] {
+			return fmt.Errorf("identifier '%s' is used by more than one SKU rule", id)
+		}
+		ids[id] = true
+		if rule.Sku() == "" {
+			return fmt.Errorf("SKU of SKU rule '%s' is mandatory", id)
+		}
+		if rule.QuotaId() == "" {
+			return fmt.Errorf("quota identifier of SKU rule '%s' is mandatory", id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+var _ = Describe("SKU rules", func() {
+	It("Sends the search", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/sku_rules"),
+				ghttp.VerifyFormKV("search", "sku = 'MW00530'"),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"id": "r1",
+							"sku": "MW00530",
+							"quota_id": "cluster|byoc|osd",
+							"allowed": 1
+						}
+					]
+				}`),
+			),
+		)
+		rules, err := ListSkuRules(ctx, client, "sku = 'MW00530'")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].QuotaId()).To(Equal("cluster|byoc|osd"))
+	})
+
+	It("Exports the rules sorted by identifier", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"page": 1,
+				"size": 2,
+				"total": 2,
+				"items": [
+					{
+						"id": "r2",
+						"href": "/api/accounts_mgmt/v1/sku_rules/r2",
+						"sku": "RH00004",
+						"quota_id": "addon|rhoam",
+						"allowed": 1
+					},
+					{
+						"id": "r1",
+						"sku": "MW00530",
+						"quota_id": "cluster|byoc|osd",
+						"allowed": 4
+					}
+				]
+			}`),
+		)
+		buffer := &bytes.Buffer{}
+		err := ExportSkuRules(ctx, client, buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(MatchJSON(`[
+			{
+				"kind": "SkuRule",
+				"id": "r1",
+				"sku": "MW00530",
+				"quota_id": "cluster|byoc|osd",
+				"allowed": 4
+			},
+			{
+				"kind": "SkuRule",
+				"id": "r2",
+				"sku": "RH00004",
+				"quota_id": "addon|rhoam",
+				"allowed": 1
+			}
+		]`))
+	})
+
+	It("Rejects rules without quota identifier", func() {
+		_, err := ReadSkuRules(strings.NewReader(`[
+			{ "id": "r1", "sku": "MW00530" }
+		]`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("quota identifier of SKU rule 'r1' is mandatory"))
+	})
+
+	It("Calculates the diff", func() {
+		current, err := amv1.UnmarshalSkuRuleList(`[
+			{ "id": "r1", "sku": "MW00530", "quota_id": "cluster|byoc|osd", "allowed": 4 },
+			{ "id": "r2", "sku": "RH00004", "quota_id": "addon|rhoam", "allowed": 1 },
+			{ "id": "r3", "sku": "RH00005", "quota_id": "addon|rhods", "allowed": 1 }
+		]`)
+		Expect(err).ToNot(HaveOccurred())
+		expected, err := ReadSkuRules(strings.NewReader(`[
+			{ "id": "r1", "sku": "MW00530", "quota_id": "cluster|byoc|osd", "allowed": 4 },
+			{ "id": "r2", "sku": "RH00004", "quota_id": "addon|rhoam", "allowed": 2 },
+			{ "id": "r4", "sku": "RH00006", "quota_id": "addon|other", "allowed": 1 }
+		]`))
+		Expect(err).ToNot(HaveOccurred())
+		diff, err := DiffSkuRules(current, expected)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diff.Empty()).To(BeFalse())
+		Expect(diff.Missing).To(HaveLen(1))
+		Expect(diff.Missing[0].ID()).To(Equal("r4"))
+		Expect(diff.Changed).To(HaveLen(1))
+		Expect(diff.Changed[0].ID()).To(Equal("r2"))
+		Expect(diff.Extra).To(HaveLen(1))
+		Expect(diff.Extra[0].ID()).To(Equal("r3"))
+	})
+})
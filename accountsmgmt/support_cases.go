@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that open and delete support cases through the accounts management
+// service, using the same authentication as the rest of the SDK.
+
+package accountsmgmt // github.com/openshift-online/ocm-sdk-go/accountsmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// CreateSupportCase opens a support case. The request must contain a summary, a description and
+// the cluster or subscription that the case is related to. The service forwards the request to
+// the customer support system and returns the case as created there, including the case number
+// and the URI that can be given to users.
+func CreateSupportCase(ctx context.Context, client *v1.Client,
+	request *v1.SupportCaseRequest) (result *v1.SupportCaseResponse, err error) {
+	if request == nil {
+		err = errors.New("support case request is mandatory")
+		return
+	}
+	if request.Summary() == "" {
+		err = errors.New("support case summary is mandatory")
+		return
+	}
+	if request.Description() == "" {
+		err = errors.New("support case description is mandatory")
+		return
+	}
+	if request.ClusterId() == "" && request.ClusterUuid() == "" && request.SubscriptionId() == "" {
+		err = errors.New("cluster or subscription identifier is mandatory")
+		return
+	}
+	response, err := client.SupportCases().Post().
+		Request(request).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't create support case '%s': %w", request.Summary(), err)
+		return
+	}
+	result = response.Response()
+	return
+}
+
+// DeleteSupportCase deletes the support case with the given identifier. Deleting a case that
+// doesn't exist isn't an error.
+func DeleteSupportCase(ctx context.Context, client *v1.Client, caseID string) error {
+	if caseID == "" {
+		return errors.New("support case identifier is mandatory")
+	}
+	_, err := client.SupportCases().SupportCase(caseID).Delete().
+		SendContext(ctx)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("can't delete support case '%s': %w", caseID, err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountsmgmt
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+var _ = Describe("Support cases", func() {
+	It("Creates the case", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/accounts_mgmt/v1/support_cases"),
+				VerifyJQ(".summary", "Cluster is down"),
+				VerifyJQ(".cluster_uuid", "123"),
+				VerifyJQ(".severity", "High"),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "456",
+					"case_number": "0123456",
+					"uri": "https://example.com/cases/0123456",
+					"status": "Waiting on Red Hat"
+				}`),
+			),
+		)
+		request, err := v1.NewSupportCaseRequest().
+			Summary("Cluster is down").
+			Description("The API server doesn't respond").
+			ClusterUuid("123").
+			Severity("High").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := CreateSupportCase(ctx, client, request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.CaseNumber()).To(Equal("0123456"))
+		Expect(response.URI()).To(Equal("https://example.com/cases/0123456"))
+		Expect(response.Status()).To(Equal("Waiting on Red Hat"))
+	})
+
+	It("Requires a cluster or subscription", func() {
+		request, err := v1.NewSupportCaseRequest().
+			Summary("Cluster is down").
+			Description("The API server doesn't respond").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = CreateSupportCase(ctx, client, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("cluster or subscription identifier is mandatory"))
+	})
+
+	It("Deletes the case", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodDelete,
+					"/api/accounts_mgmt/v1/support_cases/456",
+				),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		err := DeleteSupportCase(ctx, client, "456")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Ignores cases that don't exist", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Not found"
+			}`),
+		)
+		err := DeleteSupportCase(ctx, client, "456")
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
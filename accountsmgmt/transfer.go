@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the workflow that transfers the ownership of a cluster to a different user.
+
+package accountsmgmt // github.com/openshift-online/ocm-sdk-go/accountsmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// TransferState is the state of the transfer of the ownership of a cluster.
+type TransferState string
+
+const (
+	// TransferPending indicates that the owner released the cluster and that it is waiting for
+	// the recipient to register it.
+	TransferPending TransferState = "Pending"
+
+	// TransferCompleted indicates that the cluster is owned by a different user than the one
+	// that initiated the transfer.
+	TransferCompleted TransferState = "Completed"
+
+	// TransferCancelled indicates that the cluster isn't released anymore and that it is still
+	// owned by the user that initiated the transfer.
+	TransferCancelled TransferState = "Cancelled"
+)
+
+// TransferEventType is the type of the events recorded during the transfer of a cluster.
+type TransferEventType string
+
+const (
+	// TransferInitiated is recorded when the owner releases the cluster.
+	TransferInitiated TransferEventType = "Initiated"
+
+	// TransferRegistered is recorded when the recipient registers the cluster.
+	TransferRegistered TransferEventType = "Registered"
+
+	// TransferRolledBack is recorded when the owner cancels the release of the cluster.
+	TransferRolledBack TransferEventType = "RolledBack"
+
+	// TransferStateChanged is recorded when a refresh detects that the state of the transfer
+	// changed, for example because the service completed it.
+	TransferStateChanged TransferEventType = "StateChanged"
+)
+
+// TransferEvent is an event recorded during the transfer of a cluster.
+type TransferEvent struct {
+	// Type is the type of the event.
+	Type TransferEventType
+
+	// Time is the time when the event was recorded.
+	Time time.Time
+
+	// State is the state of the transfer after the event.
+	State TransferState
+
+	// Message is a human readable description of the event.
+	Message string
+}
+
+// Transfer tracks the transfer of the ownership of a cluster. The transfer uses the subscription
+// of the cluster: the owner releases it, then the recipient registers the cluster with its own
+// authorization token and the service assigns the subscription to the recipient. The fields are
+// exported so that the transfer can be saved and resumed later.
+type Transfer struct {
+	// SubscriptionID is the identifier of the subscription of the cluster.
+	SubscriptionID string
+
+	// ExternalClusterID is the identifier assigned to the cluster by itself, used to register
+	// it.
+	ExternalClusterID string
+
+	// OriginalCreatorID is the identifier of the account that owned the cluster when the
+	// transfer was initiated.
+	OriginalCreatorID string
+
+	// State is the current state of the transfer.
+	State TransferState
+
+	// Events contains the events recorded during the transfer, oldest first.
+	Events []*TransferEvent
+}
+
+// InitiateTransfer releases the cluster of the given subscription so that its ownership can be
+// transferred to a different user. It must be called with a client that is authenticated as
+// the current owner. If the subscription is already released the transfer is resumed without
+// changing it.
+func InitiateTransfer(ctx context.Context, client *v1.Client,
+	subscriptionID string) (result *Transfer, err error) {
+	if subscriptionID == "" {
+		err = errors.New("subscription identifier is mandatory")
+		return
+	}
+	subscription, err := getTransferSubscription(ctx, client, subscriptionID)
+	if err != nil {
+		return
+	}
+	if subscription.ExternalClusterID() == "" {
+		err = fmt.Errorf(
+			"can't transfer subscription '%s' because it doesn't have an external "+
+				"cluster identifier",
+			subscriptionID,
+		)
+		return
+	}
+	if subscription.Status() != "Active" {
+		err = fmt.Errorf(
+			"can't transfer subscription '%s' because its status is '%s'",
+			subscriptionID, subscription.Status(),
+		)
+		return
+	}
+	transfer := &Transfer{
+		SubscriptionID:    subscriptionID,
+		ExternalClusterID: subscription.ExternalClusterID(),
+		OriginalCreatorID: subscription.Creator().ID(),
+	}
+	if !subscription.Released() {
+		err = setReleased(ctx, client, subscriptionID, true)
+		if err != nil {
+			err = fmt.Errorf("can't release subscription '%s': %w", subscriptionID, err)
+			return
+		}
+	}
+	transfer.record(TransferInitiated, TransferPending, "Cluster released by the owner")
+	result = transfer
+	return
+}
+
+// Refresh fetches the subscription and updates the state of the transfer, recording an event if
+// it changed.
+func (t *Transfer) Refresh(ctx context.Context, client *v1.Client) error {
+	subscription, err := getTransferSubscription(ctx, client, t.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	var state TransferState
+	switch {
+	case subscription.Creator().ID() != t.OriginalCreatorID:
+		state = TransferCompleted
+	case subscription.Released():
+		state = TransferPending
+	default:
+		state = TransferCancelled
+	}
+	if state != t.State {
+		t.record(
+			TransferStateChanged, state,
+			fmt.Sprintf("Transfer changed from '%s' to '%s'", t.State, state),
+		)
+	}
+	return nil
+}
+
+// Finalize registers the cluster on behalf of the recipient, using the authorization token of the
+// recipient, which is the token that the pull secret of the recipient contains for the
+// `cloud.openshift.com` registry. It must be called with a client that is authenticated as
+// the recipient. The service may assign the subscription to the recipient asynchronously, so the
+// transfer may still be pending when this returns; use the Refresh method to track it.
+func (t *Transfer) Finalize(ctx context.Context, client *v1.Client,
+	authorizationToken string) error {
+	if authorizationToken == "" {
+		return errors.New("authorization token is mandatory")
+	}
+	if t.State != TransferPending {
+		return fmt.Errorf(
+			"can't finalize transfer of subscription '%s' because it is '%s'",
+			t.SubscriptionID, t.State,
+		)
+	}
+	request, err := v1.NewClusterRegistrationRequest().
+		ClusterID(t.ExternalClusterID).
+		AuthorizationToken(authorizationToken).
+		Build()
+	if err != nil {
+		return err
+	}
+	_, err = client.ClusterRegistrations().Post().
+		Request(request).
+		SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"can't register cluster '%s' for the recipient: %w",
+			t.ExternalClusterID, err,
+		)
+	}
+	t.record(TransferRegistered, t.State, "Cluster registered by the recipient")
+	return t.Refresh(ctx, client)
+}
+
+// Rollback cancels the transfer, removing the release of the cluster. It must be called with a
+// client that is authenticated as the owner, and fails if the transfer has already completed.
+func (t *Transfer) Rollback(ctx context.Context, client *v1.Client) error {
+	err := t.Refresh(ctx, client)
+	if err != nil {
+		return err
+	}
+	switch t.State {
+	case TransferCompleted:
+		return fmt.Errorf(
+			"can't roll back transfer of subscription '%s' because it has already completed",
+			t.SubscriptionID,
+		)
+	case TransferCancelled:
+		return nil
+	}
+	err = setReleased(ctx, client, t.SubscriptionID, false)
+	if err != nil {
+		return fmt.Errorf(
+			"can't cancel release of subscription '%s': %w",
+			t.SubscriptionID, err,
+		)
+	}
+	t.record(TransferRolledBack, TransferCancelled, "Release cancelled by the owner")
+	return nil
+}
+
+func (t *Transfer) record(kind TransferEventType, state TransferState, message string) {
+	t.State = state
+	t.Events = append(t.Events, &TransferEvent{
+		Type:    kind,
+		Time:    time.Now(),
+		State:   state,
+		Message: message,
+	})
+}
+
+func getTransferSubscription(ctx context.Context, client *v1.Client,
+	subscriptionID string) (result *v1.Subscription, err error) {
+	response, err := client.Subscriptions().
+		Subscription(subscriptionID).
+		Get().
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't get subscription '%s': %w", subscriptionID, err)
+		return
+	}
+	result = response.Body()
+	return
+}
+
+func setReleased(ctx context.Context, client *v1.Client, subscriptionID string,
+	value bool) error {
+	body, err := v1.NewSubscription().Released(value).Build()
+	if err != nil {
+		return err
+	}
+	_, err = client.Subscriptions().
+		Subscription(subscriptionID).
+		Update().
+		Body(body).
+		SendContext(ctx)
+	return err
+}
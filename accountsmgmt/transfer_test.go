@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountsmgmt
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Transfer", func() {
+	// respondWithSubscription creates a handler that returns the subscription with the given
+	// creator and released flag.
+	respondWithSubscription := func(creator string, released bool) http.HandlerFunc {
+		body := fmt.Sprintf(`{
+			"id": "123",
+			"external_cluster_id": "abc",
+			"status": "Active",
+			"creator": {
+				"id": "%s"
+			},
+			"released": %t
+		}`, creator, released)
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/subscriptions/123"),
+			RespondWithJSON(http.StatusOK, body),
+		)
+	}
+
+	// verifyRelease creates a handler that checks that the subscription is updated with the
+	// given released flag.
+	verifyRelease := func(released bool) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodPatch, "/api/accounts_mgmt/v1/subscriptions/123"),
+			VerifyJQ(".released", released),
+			RespondWithJSON(http.StatusOK, `{
+				"id": "123"
+			}`),
+		)
+	}
+
+	It("Releases the subscription when initiated", func() {
+		server.AppendHandlers(
+			respondWithSubscription("owner", false),
+			verifyRelease(true),
+		)
+		transfer, err := InitiateTransfer(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(transfer.State).To(Equal(TransferPending))
+		Expect(transfer.ExternalClusterID).To(Equal("abc"))
+		Expect(transfer.OriginalCreatorID).To(Equal("owner"))
+		Expect(transfer.Events).To(HaveLen(1))
+		Expect(transfer.Events[0].Type).To(Equal(TransferInitiated))
+	})
+
+	It("Doesn't release the subscription again", func() {
+		server.AppendHandlers(
+			respondWithSubscription("owner", true),
+		)
+		transfer, err := InitiateTransfer(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(transfer.State).To(Equal(TransferPending))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Rejects subscriptions that aren't active", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"id": "123",
+				"external_cluster_id": "abc",
+				"status": "Archived"
+			}`),
+		)
+		_, err := InitiateTransfer(ctx, client, "123")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("its status is 'Archived'"))
+	})
+
+	It("Registers the cluster when finalized", func() {
+		server.AppendHandlers(
+			respondWithSubscription("owner", false),
+			verifyRelease(true),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/accounts_mgmt/v1/cluster_registrations",
+				),
+				VerifyJQ(".cluster_id", "abc"),
+				VerifyJQ(".authorization_token", "my-token"),
+				RespondWithJSON(http.StatusOK, `{
+					"cluster_id": "abc",
+					"account_id": "recipient"
+				}`),
+			),
+			respondWithSubscription("recipient", false),
+		)
+		transfer, err := InitiateTransfer(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		err = transfer.Finalize(ctx, client, "my-token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(transfer.State).To(Equal(TransferCompleted))
+		Expect(transfer.Events).To(HaveLen(3))
+		Expect(transfer.Events[1].Type).To(Equal(TransferRegistered))
+		Expect(transfer.Events[2].Type).To(Equal(TransferStateChanged))
+	})
+
+	It("Stays pending if the service hasn't assigned the subscription yet", func() {
+		server.AppendHandlers(
+			respondWithSubscription("owner", false),
+			verifyRelease(true),
+			RespondWithJSON(http.StatusOK, `{}`),
+			respondWithSubscription("owner", true),
+		)
+		transfer, err := InitiateTransfer(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		err = transfer.Finalize(ctx, client, "my-token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(transfer.State).To(Equal(TransferPending))
+		Expect(transfer.Events).To(HaveLen(2))
+	})
+
+	It("Can't finalize a cancelled transfer", func() {
+		transfer := &Transfer{
+			SubscriptionID: "123",
+			State:          TransferCancelled,
+		}
+		err := transfer.Finalize(ctx, client, "my-token")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("because it is 'Cancelled'"))
+	})
+
+	It("Removes the release when rolled back", func() {
+		server.AppendHandlers(
+			respondWithSubscription("owner", true),
+			verifyRelease(false),
+		)
+		transfer := &Transfer{
+			SubscriptionID:    "123",
+			ExternalClusterID: "abc",
+			OriginalCreatorID: "owner",
+			State:             TransferPending,
+		}
+		err := transfer.Rollback(ctx, client)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(transfer.State).To(Equal(TransferCancelled))
+		Expect(transfer.Events).To(HaveLen(1))
+		Expect(transfer.Events[0].Type).To(Equal(TransferRolledBack))
+	})
+
+	It("Can't roll back a completed transfer", func() {
+		server.AppendHandlers(
+			respondWithSubscription("recipient", false),
+		)
+		transfer := &Transfer{
+			SubscriptionID:    "123",
+			OriginalCreatorID: "owner",
+			State:             TransferPending,
+		}
+		err := transfer.Rollback(ctx, client)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("has already completed"))
+		Expect(transfer.State).To(Equal(TransferCompleted))
+	})
+})
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+func TestUsage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Usage")
+}
+
+// logger is the logger that will be used by the tests.
+var logger logging.Logger
+
+var _ = BeforeSuite(func() {
+	var err error
+
+	// Create a logger that writes to the Ginkgo stream:
+	logger, err = logging.NewStdLoggerBuilder().
+		Streams(GinkgoWriter, GinkgoWriter).
+		Debug(true).
+		Build()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// MakeClient creates an accounts management client that sends the requests to the given server.
+func MakeClient(server *ghttp.Server) *amv1.Client {
+	return amv1.NewClient(ServerTransport(server), "/api/accounts_mgmt/v1")
+}
+
+// Usage and metric selections are both computed from the subscriptions of the accounts management
+// service, so the tests share a client that sends the requests to a server created for each test.
+var (
+	ctx    context.Context
+	server *ghttp.Server
+	client *amv1.Client
+)
+
+var _ = BeforeEach(func() {
+	ctx = context.Background()
+	server = MakeTCPServer()
+	client = MakeClient(server)
+})
+
+var _ = AfterEach(func() {
+	server.Close()
+})
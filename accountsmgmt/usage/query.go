@@ -0,0 +1,346 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the selector that extracts typed time series from the metrics of
+// subscriptions.
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// MetricName identifies one of the values contained in the metrics of a subscription.
+type MetricName string
+
+// Names of the metrics:
+const (
+	CPUUsed                   MetricName = "cpu_used"
+	CPUTotal                  MetricName = "cpu_total"
+	MemoryUsed                MetricName = "memory_used"
+	MemoryTotal               MetricName = "memory_total"
+	SocketsUsed               MetricName = "sockets_used"
+	SocketsTotal              MetricName = "sockets_total"
+	StorageUsed               MetricName = "storage_used"
+	StorageTotal              MetricName = "storage_total"
+	ComputeCPUUsed            MetricName = "compute_nodes_cpu_used"
+	ComputeCPUTotal           MetricName = "compute_nodes_cpu_total"
+	ComputeMemoryUsed         MetricName = "compute_nodes_memory_used"
+	ComputeMemoryTotal        MetricName = "compute_nodes_memory_total"
+	ComputeSocketsUsed        MetricName = "compute_nodes_sockets_used"
+	ComputeSocketsTotal       MetricName = "compute_nodes_sockets_total"
+	NodesTotal                MetricName = "nodes_total"
+	NodesCompute              MetricName = "nodes_compute"
+	NodesInfra                MetricName = "nodes_infra"
+	NodesMaster               MetricName = "nodes_master"
+	CriticalAlertsFiring      MetricName = "critical_alerts_firing"
+	OperatorsConditionFailing MetricName = "operators_condition_failing"
+)
+
+// Point is a value of a metric at a point in time.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series contains the values of one metric of one cluster.
+type Series struct {
+	// SubscriptionID is the identifier of the subscription that contains the metric.
+	SubscriptionID string
+
+	// ClusterID and ExternalClusterID are the identifiers of the cluster.
+	ClusterID         string
+	ExternalClusterID string
+
+	// Metric is the name of the metric.
+	Metric MetricName
+
+	// Unit is the unit of the values, as reported by the cluster. It is empty for metrics that
+	// are counts, like the number of nodes.
+	Unit string
+
+	// Points contains the values, sorted by time.
+	Points []Point
+}
+
+// Selector contains the criteria used to select the metrics of subscriptions. Don't create
+// objects of this type directly, use the NewSelector function instead.
+type Selector struct {
+	organizationID string
+	clusters       []string
+	metrics        []MetricName
+	since          time.Time
+	until          time.Time
+}
+
+// NewSelector creates a selector that selects all the metrics of all the subscriptions.
+func NewSelector() *Selector {
+	return &Selector{}
+}
+
+// Organization restricts the selection to the subscriptions of the given organization.
+func (s *Selector) Organization(value string) *Selector {
+	s.organizationID = value
+	return s
+}
+
+// Cluster restricts the selection to the given clusters. The identifiers can be subscription
+// identifiers, cluster identifiers or external cluster identifiers. It can be called multiple
+// times to add more clusters.
+func (s *Selector) Cluster(values ...string) *Selector {
+	s.clusters = append(s.clusters, values...)
+	return s
+}
+
+// Metric restricts the selection to the given metrics. It can be called multiple times to add
+// more metrics. The series are returned in the order of the metrics given here, or sorted by
+// name when no metric is given.
+func (s *Selector) Metric(values ...MetricName) *Selector {
+	s.metrics = append(s.metrics, values...)
+	return s
+}
+
+// Since restricts the selection to the values reported at the given time or later.
+func (s *Selector) Since(value time.Time) *Selector {
+	s.since = value
+	return s
+}
+
+// Until restricts the selection to the values reported before the given time.
+func (s *Selector) Until(value time.Time) *Selector {
+	s.until = value
+	return s
+}
+
+// Run lists the subscriptions that match the organization and cluster criteria and selects their
+// metrics. At least an organization or a cluster is mandatory, to avoid listing all the
+// subscriptions that the user can see.
+func (s *Selector) Run(ctx context.Context, client *amv1.Client) (result []*Series, err error) {
+	if s.organizationID == "" && len(s.clusters) == 0 {
+		err = errors.New("organization or cluster is mandatory")
+		return
+	}
+	var terms []string
+	if s.organizationID != "" {
+		terms = append(terms, fmt.Sprintf("organization_id = '%s'", quote(s.organizationID)))
+	}
+	if len(s.clusters) > 0 {
+		values := make([]string, len(s.clusters))
+		for i, cluster := range s.clusters {
+			values[i] = "'" + quote(cluster) + "'"
+		}
+		list := strings.Join(values, ", ")
+		terms = append(terms, fmt.Sprintf(
+			"(id in (%s) or cluster_id in (%s) or external_cluster_id in (%s))",
+			list, list, list,
+		))
+	}
+	var subscriptions []*amv1.Subscription
+	request := client.Subscriptions().List().
+		Search(strings.Join(terms, " and ")).
+		Size(listSize)
+	for page := 1; ; page++ {
+		var response *amv1.SubscriptionsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list subscriptions to select metrics: %w", err)
+			return
+		}
+		items := response.Items().Slice()
+		subscriptions = append(subscriptions, items...)
+		if len(items) == 0 || len(subscriptions) >= response.Total() {
+			break
+		}
+	}
+	result, err = s.Select(subscriptions)
+	return
+}
+
+// Select selects the metrics of the given subscriptions that match the criteria. It is useful
+// when the subscriptions have already been fetched. Series without values are omitted.
+func (s *Selector) Select(subscriptions []*amv1.Subscription) (result []*Series, err error) {
+	names := s.metrics
+	if len(names) == 0 {
+		names = make([]MetricName, 0, len(metricExtractors))
+		for name := range metricExtractors {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			return names[i] < names[j]
+		})
+	}
+	for _, name := range names {
+		if metricExtractors[name] == nil {
+			err = fmt.Errorf("metric '%s' doesn't exist", name)
+			return
+		}
+	}
+	clusters := map[string]bool{}
+	for _, cluster := range s.clusters {
+		clusters[cluster] = true
+	}
+	for _, subscription := range subscriptions {
+		if s.organizationID != "" && subscription.OrganizationID() != s.organizationID {
+			continue
+		}
+		if len(clusters) > 0 && !clusters[subscription.ID()] &&
+			!clusters[subscription.ClusterID()] && !clusters[subscription.ExternalClusterID()] {
+			continue
+		}
+		for _, name := range names {
+			series := &Series{
+				SubscriptionID:    subscription.ID(),
+				ClusterID:         subscription.ClusterID(),
+				ExternalClusterID: subscription.ExternalClusterID(),
+				Metric:            name,
+			}
+			for _, metrics := range subscription.Metrics() {
+				sample, ok := metricExtractors[name](metrics)
+				if !ok {
+					continue
+				}
+				if sample.time.IsZero() {
+					sample.time = subscription.LastTelemetryDate()
+				}
+				if !s.since.IsZero() && sample.time.Before(s.since) {
+					continue
+				}
+				if !s.until.IsZero() && !sample.time.Before(s.until) {
+					continue
+				}
+				series.Unit = sample.unit
+				series.Points = append(series.Points, Point{
+					Time:  sample.time,
+					Value: sample.value,
+				})
+			}
+			if len(series.Points) == 0 {
+				continue
+			}
+			sort.SliceStable(series.Points, func(i, j int) bool {
+				return series.Points[i].Time.Before(series.Points[j].Time)
+			})
+			result = append(result, series)
+		}
+	}
+	return
+}
+
+// metricSample is a value extracted from the metrics of a subscription. The time is zero when the
+// metrics don't say when the value was reported.
+type metricSample struct {
+	value float64
+	unit  string
+	time  time.Time
+}
+
+// metricExtractor extracts a value from the metrics of a subscription. The result is false when
+// the metrics don't contain the value.
+type metricExtractor func(metrics *amv1.SubscriptionMetrics) (metricSample, bool)
+
+// resourceExtractor creates an extractor for the used or total amount of a cluster resource.
+func resourceExtractor(resource func(*amv1.SubscriptionMetrics) (*amv1.ClusterResource, bool),
+	used bool) metricExtractor {
+	return func(metrics *amv1.SubscriptionMetrics) (result metricSample, ok bool) {
+		value, ok := resource(metrics)
+		if !ok {
+			return
+		}
+		amount, ok := value.GetTotal()
+		if used {
+			amount, ok = value.GetUsed()
+		}
+		if !ok {
+			return
+		}
+		result = metricSample{
+			value: amount.Value(),
+			unit:  amount.Unit(),
+			time:  value.UpdatedTimestamp(),
+		}
+		return
+	}
+}
+
+// nodesExtractor creates an extractor for the number of nodes of a role.
+func nodesExtractor(count func(*amv1.ClusterMetricsNodes) (float64, bool)) metricExtractor {
+	return func(metrics *amv1.SubscriptionMetrics) (result metricSample, ok bool) {
+		nodes, ok := metrics.GetNodes()
+		if !ok {
+			return
+		}
+		value, ok := count(nodes)
+		result.value = value
+		return
+	}
+}
+
+// valueExtractor creates an extractor for a value that is directly in the metrics.
+func valueExtractor(get func(*amv1.SubscriptionMetrics) (float64, bool)) metricExtractor {
+	return func(metrics *amv1.SubscriptionMetrics) (result metricSample, ok bool) {
+		result.value, ok = get(metrics)
+		return
+	}
+}
+
+var metricExtractors = map[MetricName]metricExtractor{
+	CPUUsed:      resourceExtractor((*amv1.SubscriptionMetrics).GetCpu, true),
+	CPUTotal:     resourceExtractor((*amv1.SubscriptionMetrics).GetCpu, false),
+	MemoryUsed:   resourceExtractor((*amv1.SubscriptionMetrics).GetMemory, true),
+	MemoryTotal:  resourceExtractor((*amv1.SubscriptionMetrics).GetMemory, false),
+	SocketsUsed:  resourceExtractor((*amv1.SubscriptionMetrics).GetSockets, true),
+	SocketsTotal: resourceExtractor((*amv1.SubscriptionMetrics).GetSockets, false),
+	StorageUsed:  resourceExtractor((*amv1.SubscriptionMetrics).GetStorage, true),
+	StorageTotal: resourceExtractor((*amv1.SubscriptionMetrics).GetStorage, false),
+	ComputeCPUUsed: resourceExtractor(
+		(*amv1.SubscriptionMetrics).GetComputeNodesCpu, true,
+	),
+	ComputeCPUTotal: resourceExtractor(
+		(*amv1.SubscriptionMetrics).GetComputeNodesCpu, false,
+	),
+	ComputeMemoryUsed: resourceExtractor(
+		(*amv1.SubscriptionMetrics).GetComputeNodesMemory, true,
+	),
+	ComputeMemoryTotal: resourceExtractor(
+		(*amv1.SubscriptionMetrics).GetComputeNodesMemory, false,
+	),
+	ComputeSocketsUsed: resourceExtractor(
+		(*amv1.SubscriptionMetrics).GetComputeNodesSockets, true,
+	),
+	ComputeSocketsTotal: resourceExtractor(
+		(*amv1.SubscriptionMetrics).GetComputeNodesSockets, false,
+	),
+	NodesTotal:           nodesExtractor((*amv1.ClusterMetricsNodes).GetTotal),
+	NodesCompute:         nodesExtractor((*amv1.ClusterMetricsNodes).GetCompute),
+	NodesInfra:           nodesExtractor((*amv1.ClusterMetricsNodes).GetInfra),
+	NodesMaster:          nodesExtractor((*amv1.ClusterMetricsNodes).GetMaster),
+	CriticalAlertsFiring: valueExtractor((*amv1.SubscriptionMetrics).GetCriticalAlertsFiring),
+	OperatorsConditionFailing: valueExtractor(
+		(*amv1.SubscriptionMetrics).GetOperatorsConditionFailing,
+	),
+}
+
+// quote escapes the single quotes of a value so that it can be used inside a search string.
+func quote(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
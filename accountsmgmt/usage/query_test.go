@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+var _ = Describe("Selector", func() {
+	var subscriptions []*amv1.Subscription
+
+	BeforeEach(func() {
+		var err error
+		subscriptions, err = amv1.UnmarshalSubscriptionList(`[
+			{
+				"id": "s1",
+				"cluster_id": "c1",
+				"external_cluster_id": "e1",
+				"organization_id": "123",
+				"last_telemetry_date": "2026-01-01T12:00:00Z",
+				"metrics": [
+					{
+						"cpu": {
+							"used": { "value": 2.5, "unit": "cores" },
+							"total": { "value": 8, "unit": "cores" },
+							"updated_timestamp": "2026-01-01T10:00:00Z"
+						},
+						"nodes": {
+							"total": 6,
+							"compute": 3
+						},
+						"critical_alerts_firing": 2
+					}
+				]
+			},
+			{
+				"id": "s2",
+				"cluster_id": "c2",
+				"organization_id": "456",
+				"last_telemetry_date": "2026-01-02T12:00:00Z",
+				"metrics": [
+					{
+						"cpu": {
+							"used": { "value": 1, "unit": "cores" },
+							"updated_timestamp": "2026-01-02T10:00:00Z"
+						}
+					}
+				]
+			}
+		]`)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Selects the metric of the cluster", func() {
+		series, err := NewSelector().
+			Cluster("e1").
+			Metric(CPUUsed).
+			Select(subscriptions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(series).To(HaveLen(1))
+		Expect(series[0].SubscriptionID).To(Equal("s1"))
+		Expect(series[0].ClusterID).To(Equal("c1"))
+		Expect(series[0].Metric).To(Equal(CPUUsed))
+		Expect(series[0].Unit).To(Equal("cores"))
+		Expect(series[0].Points).To(Equal([]Point{{
+			Time:  time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+			Value: 2.5,
+		}}))
+	})
+
+	It("Uses the telemetry date when the metric has no timestamp", func() {
+		series, err := NewSelector().
+			Metric(NodesCompute).
+			Select(subscriptions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(series).To(HaveLen(1))
+		Expect(series[0].Points[0].Time).To(Equal(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+		Expect(series[0].Points[0].Value).To(Equal(3.0))
+		Expect(series[0].Unit).To(BeEmpty())
+	})
+
+	It("Omits series without values", func() {
+		series, err := NewSelector().
+			Metric(CPUTotal).
+			Select(subscriptions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(series).To(HaveLen(1))
+		Expect(series[0].SubscriptionID).To(Equal("s1"))
+	})
+
+	It("Filters by organization", func() {
+		series, err := NewSelector().
+			Organization("456").
+			Select(subscriptions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(series).To(HaveLen(1))
+		Expect(series[0].SubscriptionID).To(Equal("s2"))
+		Expect(series[0].Metric).To(Equal(CPUUsed))
+	})
+
+	It("Filters by time", func() {
+		series, err := NewSelector().
+			Metric(CPUUsed).
+			Since(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)).
+			Until(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)).
+			Select(subscriptions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(series).To(HaveLen(1))
+		Expect(series[0].SubscriptionID).To(Equal("s2"))
+	})
+
+	It("Returns all the metrics sorted by name when none is given", func() {
+		series, err := NewSelector().
+			Cluster("s1").
+			Select(subscriptions)
+		Expect(err).ToNot(HaveOccurred())
+		names := make([]MetricName, len(series))
+		for i, item := range series {
+			names[i] = item.Metric
+		}
+		Expect(names).To(Equal([]MetricName{
+			CPUTotal,
+			CPUUsed,
+			CriticalAlertsFiring,
+			NodesCompute,
+			NodesTotal,
+		}))
+	})
+
+	It("Rejects unknown metrics", func() {
+		_, err := NewSelector().
+			Metric("junk").
+			Select(subscriptions)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("metric 'junk' doesn't exist"))
+	})
+
+	Describe("Run", func() {
+		It("Sends the search", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodGet,
+						"/api/accounts_mgmt/v1/subscriptions",
+					),
+					ghttp.VerifyFormKV(
+						"search",
+						"organization_id = '123' and "+
+							"(id in ('c1') or cluster_id in ('c1') or "+
+							"external_cluster_id in ('c1'))",
+					),
+					RespondWithJSON(http.StatusOK, `{
+						"items": [
+							{
+								"id": "s1",
+								"cluster_id": "c1",
+								"organization_id": "123",
+								"metrics": [
+									{
+										"nodes": {
+											"total": 6
+										}
+									}
+								]
+							}
+						]
+					}`),
+				),
+			)
+			series, err := NewSelector().
+				Organization("123").
+				Cluster("c1").
+				Metric(NodesTotal).
+				Run(ctx, client)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(series).To(HaveLen(1))
+			Expect(series[0].Points[0].Value).To(Equal(6.0))
+		})
+
+		It("Requires an organization or cluster", func() {
+			_, err := NewSelector().Run(ctx, client)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("organization or cluster is mandatory"))
+		})
+	})
+})
@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that aggregate the resource usage reported by the clusters of an
+// organization.
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+// Resource contains the used and total amounts of a resource, added for multiple clusters. The
+// units are the ones reported by the clusters, for example cores for CPU and bytes for memory.
+type Resource struct {
+	// Used is the amount of the resource that is in use.
+	Used float64
+
+	// Total is the amount of the resource that is available.
+	Total float64
+}
+
+// Nodes contains the number of nodes of multiple clusters, by role.
+type Nodes struct {
+	// Total is the number of nodes of all roles.
+	Total float64
+
+	// Compute, Infra and Master are the number of nodes of each role.
+	Compute float64
+	Infra   float64
+	Master  float64
+}
+
+// Usage contains the resource usage of the clusters of an organization, as reported in the
+// metrics of their subscriptions.
+type Usage struct {
+	// OrganizationID is the identifier of the organization.
+	OrganizationID string
+
+	// Clusters is the number of subscriptions that were added.
+	Clusters int
+
+	// Reporting is the number of subscriptions that contain metrics. Subscriptions of clusters
+	// that don't send telemetry don't contain metrics and don't contribute to the resources.
+	Reporting int
+
+	// Nodes contains the number of nodes, by role.
+	Nodes Nodes
+
+	// CPU contains the CPU cores of all the nodes.
+	CPU Resource
+
+	// Memory contains the memory of all the nodes.
+	Memory Resource
+
+	// Sockets contains the sockets of all the nodes.
+	Sockets Resource
+
+	// ComputeCPU contains the CPU cores of the compute nodes.
+	ComputeCPU Resource
+
+	// ComputeSockets contains the sockets of the compute nodes.
+	ComputeSockets Resource
+
+	// SubscriptionCPUTotal is the sum of the CPU cores that the subscriptions are billed for.
+	SubscriptionCPUTotal int
+
+	// SubscriptionSocketTotal is the sum of the sockets that the subscriptions are billed for.
+	SubscriptionSocketTotal int
+}
+
+// Window contains the aggregated samples of a metric for a time window.
+type Window struct {
+	// Start is the beginning of the window, inclusive.
+	Start time.Time
+
+	// End is the end of the window, exclusive.
+	End time.Time
+
+	// Count is the number of samples in the window.
+	Count int
+
+	// Min, Max, Sum and Average are calculated from the values of the samples.
+	Min     float64
+	Max     float64
+	Sum     float64
+	Average float64
+
+	// Last is the value of the most recent sample in the window.
+	Last float64
+
+	lastTime time.Time
+}
+
+// Current fetches the active subscriptions of the organization and adds the resources that their
+// metrics report.
+func Current(ctx context.Context, client *amv1.Client,
+	organizationID string) (result *Usage, err error) {
+	if organizationID == "" {
+		err = errors.New("organization identifier is mandatory")
+		return
+	}
+	var subscriptions []*amv1.Subscription
+	request := client.Subscriptions().List().
+		Search(fmt.Sprintf(
+			"organization_id = '%s' and status = 'Active'",
+			quote(organizationID),
+		)).
+		Size(listSize)
+	for page := 1; ; page++ {
+		var response *amv1.SubscriptionsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't list subscriptions of organization '%s': %w",
+				organizationID, err,
+			)
+			return
+		}
+		items := response.Items().Slice()
+		subscriptions = append(subscriptions, items...)
+		if len(items) == 0 || len(subscriptions) >= response.Total() {
+			break
+		}
+	}
+	result = Add(subscriptions)
+	result.OrganizationID = organizationID
+	return
+}
+
+// Add adds the resources reported by the metrics of the given subscriptions. It is useful when
+// the subscriptions have already been fetched.
+func Add(subscriptions []*amv1.Subscription) *Usage {
+	result := &Usage{}
+	for _, subscription := range subscriptions {
+		result.Clusters++
+		result.SubscriptionCPUTotal += subscription.CpuTotal()
+		result.SubscriptionSocketTotal += subscription.SocketTotal()
+		metrics := subscription.Metrics()
+		if len(metrics) == 0 {
+			continue
+		}
+		result.Reporting++
+		for _, metric := range metrics {
+			nodes := metric.Nodes()
+			result.Nodes.Total += nodes.Total()
+			result.Nodes.Compute += nodes.Compute()
+			result.Nodes.Infra += nodes.Infra()
+			result.Nodes.Master += nodes.Master()
+			addResource(&result.CPU, metric.Cpu())
+			addResource(&result.Memory, metric.Memory())
+			addResource(&result.Sockets, metric.Sockets())
+			addResource(&result.ComputeCPU, metric.ComputeNodesCpu())
+			addResource(&result.ComputeSockets, metric.ComputeNodesSockets())
+		}
+	}
+	return result
+}
+
+// History fetches the summary dashboard of the organization and aggregates the samples of each
+// metric in windows of the given size. The result is indexed by metric name, and the windows of
+// each metric are sorted by start time. Windows are aligned to multiples of the size since the
+// zero time, so daily windows start at midnight UTC. Only the windows that contain samples are
+// returned.
+func History(ctx context.Context, client *amv1.Client, organizationID string,
+	size time.Duration) (result map[string][]*Window, err error) {
+	if organizationID == "" {
+		err = errors.New("organization identifier is mandatory")
+		return
+	}
+	if size <= 0 {
+		err = fmt.Errorf("window size should be positive, but it is %s", size)
+		return
+	}
+	response, err := client.Organizations().Organization(organizationID).SummaryDashboard().Get().
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get summary dashboard of organization '%s': %w",
+			organizationID, err,
+		)
+		return
+	}
+	result = map[string][]*Window{}
+	for _, metric := range response.Body().Metrics() {
+		var windows []*Window
+		windows, err = Aggregate(metric.Vector(), size)
+		if err != nil {
+			err = fmt.Errorf("can't aggregate metric '%s': %w", metric.Name(), err)
+			result = nil
+			return
+		}
+		result[metric.Name()] = windows
+	}
+	return
+}
+
+// Aggregate groups the given samples in windows of the given size, as described in the History
+// function.
+func Aggregate(samples []*amv1.SummarySample, size time.Duration) (result []*Window, err error) {
+	if size <= 0 {
+		err = fmt.Errorf("window size should be positive, but it is %s", size)
+		return
+	}
+	windows := map[time.Time]*Window{}
+	for _, sample := range samples {
+		var timestamp time.Time
+		timestamp, err = parseSampleTime(sample.Time())
+		if err != nil {
+			return
+		}
+		start := timestamp.Truncate(size)
+		window, ok := windows[start]
+		if !ok {
+			window = &Window{
+				Start: start,
+				End:   start.Add(size),
+				Min:   math.Inf(1),
+				Max:   math.Inf(-1),
+			}
+			windows[start] = window
+		}
+		value := sample.Value()
+		window.Count++
+		window.Sum += value
+		window.Min = math.Min(window.Min, value)
+		window.Max = math.Max(window.Max, value)
+		if !timestamp.Before(window.lastTime) {
+			window.Last = value
+			window.lastTime = timestamp
+		}
+	}
+	result = make([]*Window, 0, len(windows))
+	for _, window := range windows {
+		window.Average = window.Sum / float64(window.Count)
+		result = append(result, window)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+	return
+}
+
+func addResource(total *Resource, resource *amv1.ClusterResource) {
+	total.Used += resource.Used().Value()
+	total.Total += resource.Total().Value()
+}
+
+// parseSampleTime parses the time of a sample, which can be in RFC 3339 format or a number of
+// seconds since the epoch.
+func parseSampleTime(text string) (result time.Time, err error) {
+	result, err = time.Parse(time.RFC3339, text)
+	if err == nil {
+		result = result.UTC()
+		return
+	}
+	seconds, parseErr := strconv.ParseFloat(text, 64)
+	if parseErr != nil {
+		err = fmt.Errorf("time '%s' of sample isn't valid", text)
+		return
+	}
+	whole, fraction := math.Modf(seconds)
+	result = time.Unix(int64(whole), int64(fraction*float64(time.Second))).UTC()
+	err = nil
+	return
+}
+
+// listSize is the number of subscriptions requested in each page.
+const listSize = 100
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+)
+
+var _ = Describe("Usage", func() {
+	It("Adds the metrics of the active subscriptions", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/subscriptions"),
+				ghttp.VerifyFormKV(
+					"search",
+					"organization_id = '123' and status = 'Active'",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "s1",
+							"cpu_total": 8,
+							"socket_total": 2,
+							"metrics": [
+								{
+									"nodes": {
+										"total": 5,
+										"compute": 2,
+										"infra": 0,
+										"master": 3
+									},
+									"cpu": {
+										"used": { "value": 2.5, "unit": "B" },
+										"total": { "value": 20, "unit": "B" }
+									},
+									"memory": {
+										"used": { "value": 1000, "unit": "B" },
+										"total": { "value": 4000, "unit": "B" }
+									},
+									"compute_nodes_cpu": {
+										"used": { "value": 1, "unit": "B" },
+										"total": { "value": 8, "unit": "B" }
+									}
+								}
+							]
+						},
+						{
+							"id": "s2",
+							"cpu_total": 4,
+							"metrics": [
+								{
+									"nodes": {
+										"total": 3,
+										"compute": 3
+									},
+									"cpu": {
+										"used": { "value": 1.5, "unit": "B" },
+										"total": { "value": 12, "unit": "B" }
+									}
+								}
+							]
+						},
+						{
+							"id": "s3"
+						}
+					]
+				}`),
+			),
+		)
+		usage, err := Current(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(usage.OrganizationID).To(Equal("123"))
+		Expect(usage.Clusters).To(Equal(3))
+		Expect(usage.Reporting).To(Equal(2))
+		Expect(usage.Nodes).To(Equal(Nodes{
+			Total:   8,
+			Compute: 5,
+			Master:  3,
+		}))
+		Expect(usage.CPU).To(Equal(Resource{
+			Used:  4,
+			Total: 32,
+		}))
+		Expect(usage.Memory).To(Equal(Resource{
+			Used:  1000,
+			Total: 4000,
+		}))
+		Expect(usage.ComputeCPU.Total).To(Equal(8.0))
+		Expect(usage.SubscriptionCPUTotal).To(Equal(12))
+		Expect(usage.SubscriptionSocketTotal).To(Equal(2))
+	})
+
+	It("Aggregates the summary dashboard in windows", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/accounts_mgmt/v1/organizations/123/summary_dashboard",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"metrics": [
+						{
+							"name": "cpu_total",
+							"vector": [
+								{ "time": "2026-01-01T10:00:00Z", "value": 10 },
+								{ "time": "2026-01-01T20:00:00Z", "value": 30 },
+								{ "time": "2026-01-01T15:00:00Z", "value": 20 },
+								{ "time": "2026-01-02T01:00:00Z", "value": 5 }
+							]
+						}
+					]
+				}`),
+			),
+		)
+		history, err := History(ctx, client, "123", 24*time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveKey("cpu_total"))
+		windows := history["cpu_total"]
+		Expect(windows).To(HaveLen(2))
+		first := windows[0]
+		Expect(first.Start).To(Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(first.End).To(Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)))
+		Expect(first.Count).To(Equal(3))
+		Expect(first.Min).To(Equal(10.0))
+		Expect(first.Max).To(Equal(30.0))
+		Expect(first.Sum).To(Equal(60.0))
+		Expect(first.Average).To(Equal(20.0))
+		Expect(first.Last).To(Equal(30.0))
+		second := windows[1]
+		Expect(second.Count).To(Equal(1))
+		Expect(second.Last).To(Equal(5.0))
+	})
+
+	It("Accepts sample times in seconds since the epoch", func() {
+		samples, err := amv1.UnmarshalSummarySampleList(`[
+			{ "time": "3600", "value": 1 },
+			{ "time": "7200.5", "value": 2 }
+		]`)
+		Expect(err).ToNot(HaveOccurred())
+		windows, err := Aggregate(samples, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(windows).To(HaveLen(2))
+		Expect(windows[0].Start).To(Equal(time.Unix(3600, 0).UTC()))
+		Expect(windows[1].Start).To(Equal(time.Unix(7200, 0).UTC()))
+	})
+
+	It("Rejects invalid sample times", func() {
+		samples, err := amv1.UnmarshalSummarySampleList(`[
+			{ "time": "yesterday", "value": 1 }
+		]`)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = Aggregate(samples, time.Hour)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("time 'yesterday' of sample isn't valid"))
+	})
+
+	It("Rejects invalid window sizes", func() {
+		_, err := History(ctx, client, "123", 0)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("window size should be positive"))
+	})
+})
@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the audit sink that writes records to a file.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// FileSinkBuilder contains the data and logic needed to create a sink that writes audit records to
+// a file. Don't create objects of this type directly; use the NewFileSink function instead.
+type FileSinkBuilder struct {
+	path string
+	mode os.FileMode
+}
+
+// FileSink is an audit sink that writes each record as a line containing a JSON document. The file
+// is opened in append mode, so it can be shared with other processes and rotated externally.
+type FileSink struct {
+	lock    *sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// Make sure that we implement the interface:
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink creates a builder that can then be used to configure and create a file audit sink.
+func NewFileSink() *FileSinkBuilder {
+	return &FileSinkBuilder{
+		mode: DefaultFileMode,
+	}
+}
+
+// Path sets the path of the file where the records will be written. The file will be created if it
+// doesn't exist. This is mandatory.
+func (b *FileSinkBuilder) Path(value string) *FileSinkBuilder {
+	b.path = value
+	return b
+}
+
+// Mode sets the permissions that will be used if the file needs to be created. The default value
+// is 0600.
+func (b *FileSinkBuilder) Mode(value os.FileMode) *FileSinkBuilder {
+	b.mode = value
+	return b
+}
+
+// Build uses the information stored in the builder to create a new file sink.
+func (b *FileSinkBuilder) Build() (result *FileSink, err error) {
+	// Check parameters:
+	if b.path == "" {
+		err = errors.New("path is mandatory")
+		return
+	}
+
+	// Open the file:
+	file, err := os.OpenFile(b.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, b.mode)
+	if err != nil {
+		err = fmt.Errorf("can't open audit file '%s': %w", b.path, err)
+		return
+	}
+
+	// Create and populate the object:
+	result = &FileSink{
+		lock:    &sync.Mutex{},
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}
+	return
+}
+
+// Write writes the record to the file.
+func (s *FileSink) Write(ctx context.Context, record *Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.encoder.Encode(record)
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.file.Close()
+}
+
+// Default values:
+const (
+	// DefaultFileMode is the default permissions of the audit files.
+	DefaultFileMode os.FileMode = 0600
+)
@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the audit sink that forwards records to an HTTP
+// endpoint.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// HTTPSinkBuilder contains the data and logic needed to create a sink that forwards audit records
+// to an HTTP endpoint. Don't create objects of this type directly; use the NewHTTPSink function
+// instead.
+type HTTPSinkBuilder struct {
+	url     string
+	client  *http.Client
+	headers http.Header
+	timeout time.Duration
+}
+
+// HTTPSink is an audit sink that sends each record to an HTTP endpoint as the body of a POST
+// request with the `application/json` content type. Any response status code other than 2xx is
+// considered an error.
+type HTTPSink struct {
+	url     string
+	client  *http.Client
+	headers http.Header
+	timeout time.Duration
+}
+
+// Make sure that we implement the interface:
+var _ Sink = (*HTTPSink)(nil)
+
+// NewHTTPSink creates a builder that can then be used to configure and create an HTTP audit sink.
+func NewHTTPSink() *HTTPSinkBuilder {
+	return &HTTPSinkBuilder{
+		headers: http.Header{},
+		timeout: DefaultHTTPTimeout,
+	}
+}
+
+// URL sets the URL of the endpoint that will receive the records. This is mandatory.
+func (b *HTTPSinkBuilder) URL(value string) *HTTPSinkBuilder {
+	b.url = value
+	return b
+}
+
+// Client sets the HTTP client that will be used to send the records. The default is to use the
+// default client of the `net/http` package.
+func (b *HTTPSinkBuilder) Client(value *http.Client) *HTTPSinkBuilder {
+	b.client = value
+	return b
+}
+
+// Header adds a header that will be sent with each record, for example the credentials required
+// by the endpoint.
+func (b *HTTPSinkBuilder) Header(name, value string) *HTTPSinkBuilder {
+	b.headers.Add(name, value)
+	return b
+}
+
+// Timeout sets the maximum time to wait for the endpoint to accept a record. The default value is
+// 10 seconds.
+func (b *HTTPSinkBuilder) Timeout(value time.Duration) *HTTPSinkBuilder {
+	b.timeout = value
+	return b
+}
+
+// Build uses the information stored in the builder to create a new HTTP sink.
+func (b *HTTPSinkBuilder) Build() (result *HTTPSink, err error) {
+	// Check parameters:
+	if b.url == "" {
+		err = errors.New("URL is mandatory")
+		return
+	}
+	_, err = url.Parse(b.url)
+	if err != nil {
+		err = fmt.Errorf("URL '%s' isn't valid: %w", b.url, err)
+		return
+	}
+	if b.timeout <= 0 {
+		err = fmt.Errorf("timeout should be greater than zero, but it is %s", b.timeout)
+		return
+	}
+
+	// Use the default client if needed:
+	client := b.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	// Create and populate the object:
+	result = &HTTPSink{
+		url:     b.url,
+		client:  client,
+		headers: b.headers.Clone(),
+		timeout: b.timeout,
+	}
+	return
+}
+
+// Write sends the record to the endpoint. Note that the context of the API request is only used
+// for its values, the record is sent even if the API request was cancelled.
+func (s *HTTPSink) Write(ctx context.Context, record *Record) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.timeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for name, values := range s.headers {
+		request.Header[name] = values
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("can't send audit record to '%s': %w", s.url, err)
+	}
+	defer response.Body.Close()
+	_, err = io.Copy(io.Discard, response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf(
+			"audit endpoint '%s' responded with status code %d",
+			s.url, response.StatusCode,
+		)
+	}
+	return nil
+}
+
+// Default values:
+const (
+	// DefaultHTTPTimeout is the default maximum time to wait for the endpoint to accept a record.
+	DefaultHTTPTimeout = 10 * time.Second
+)
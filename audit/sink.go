@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types that describe audit records and the sinks that receive them.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Record contains the information about one API call that is sent to the audit sink.
+type Record struct {
+	// Timestamp is the time when the request was sent.
+	Timestamp time.Time
+
+	// Caller is the identity of the caller, extracted from the access token used to send the
+	// request. It will be empty if the request wasn't authenticated.
+	Caller string
+
+	// Method is the HTTP method, for example `GET`.
+	Method string
+
+	// Path is the path of the request, for example `/api/clusters_mgmt/v1/clusters`.
+	Path string
+
+	// Status is the HTTP status code of the response. It will be zero if the request failed
+	// before receiving a response.
+	Status int
+
+	// Latency is the time that it took to receive the response.
+	Latency time.Duration
+
+	// OperationID is the identifier that the server assigned to the operation, extracted from
+	// the `X-Operation-ID` header.
+	OperationID string
+
+	// Error is the description of the error if the request failed before receiving a response.
+	Error string
+}
+
+// recordJSON is the representation of the record that is used when it is converted to JSON.
+type recordJSON struct {
+	Timestamp   time.Time `json:"timestamp"`
+	Caller      string    `json:"caller,omitempty"`
+	Method      string    `json:"method"`
+	Path        string    `json:"path"`
+	Status      int       `json:"status"`
+	Latency     float64   `json:"latency"`
+	OperationID string    `json:"operation_id,omitempty"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The latency is written as a
+// number of seconds.
+func (r *Record) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&recordJSON{
+		Timestamp:   r.Timestamp.UTC(),
+		Caller:      r.Caller,
+		Method:      r.Method,
+		Path:        r.Path,
+		Status:      r.Status,
+		Latency:     r.Latency.Seconds(),
+		OperationID: r.OperationID,
+		Error:       r.Error,
+	})
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface.
+func (r *Record) UnmarshalJSON(data []byte) error {
+	var value recordJSON
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+	*r = Record{
+		Timestamp:   value.Timestamp,
+		Caller:      value.Caller,
+		Method:      value.Method,
+		Path:        value.Path,
+		Status:      value.Status,
+		Latency:     time.Duration(value.Latency * float64(time.Second)),
+		OperationID: value.OperationID,
+		Error:       value.Error,
+	}
+	return nil
+}
+
+// Sink is the interface of the objects that receive the audit records. Implementations must be safe
+// for concurrent use, as records for requests sent in parallel may be written at the same time.
+type Sink interface {
+	// Write receives one audit record. Errors returned by this method are written to the log
+	// but they don't affect the result of the request.
+	Write(ctx context.Context, record *Record) error
+}
+
+// SinkFunc is an adapter that allows the use of ordinary functions as audit sinks.
+type SinkFunc func(ctx context.Context, record *Record) error
+
+// Write calls the function.
+func (f SinkFunc) Write(ctx context.Context, record *Record) error {
+	return f(ctx, record)
+}
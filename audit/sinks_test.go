@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the audit sinks.
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	. "github.com/onsi/gomega/ghttp"       // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Sinks", func() {
+	var (
+		ctx    context.Context
+		record *Record
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		record = &Record{
+			Timestamp:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			Caller:      "joe",
+			Method:      http.MethodDelete,
+			Path:        "/api/clusters_mgmt/v1/clusters/123",
+			Status:      http.StatusNoContent,
+			Latency:     1500 * time.Millisecond,
+			OperationID: "456",
+		}
+	})
+
+	It("Converts records to JSON", func() {
+		data, err := json.Marshal(record)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{
+			"timestamp": "2026-01-02T03:04:05Z",
+			"caller": "joe",
+			"method": "DELETE",
+			"path": "/api/clusters_mgmt/v1/clusters/123",
+			"status": 204,
+			"latency": 1.5,
+			"operation_id": "456"
+		}`))
+		var parsed Record
+		err = json.Unmarshal(data, &parsed)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed).To(Equal(*record))
+	})
+
+	Describe("File", func() {
+		var directory string
+
+		BeforeEach(func() {
+			var err error
+			directory, err = os.MkdirTemp("", "audit-*")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := os.RemoveAll(directory)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Can't be created without a path", func() {
+			_, err := NewFileSink().Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("path"))
+		})
+
+		It("Writes one line per record", func() {
+			// Create the sink and write the records:
+			path := filepath.Join(directory, "audit.log")
+			sink, err := NewFileSink().
+				Path(path).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = sink.Write(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			err = sink.Write(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			err = sink.Close()
+			Expect(err).ToNot(HaveOccurred())
+
+			// Check the content of the file:
+			file, err := os.Open(path)
+			Expect(err).ToNot(HaveOccurred())
+			defer file.Close()
+			scanner := bufio.NewScanner(file)
+			count := 0
+			for scanner.Scan() {
+				var parsed Record
+				err = json.Unmarshal(scanner.Bytes(), &parsed)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parsed.OperationID).To(Equal("456"))
+				count++
+			}
+			Expect(scanner.Err()).ToNot(HaveOccurred())
+			Expect(count).To(Equal(2))
+
+			// Check the permissions:
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+
+	Describe("HTTP", func() {
+		var server *Server
+
+		BeforeEach(func() {
+			server = NewServer()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("Can't be created without an URL", func() {
+			_, err := NewHTTPSink().Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("URL"))
+		})
+
+		It("Posts the record", func() {
+			// Prepare the server:
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/records"),
+					VerifyContentType("application/json"),
+					VerifyHeaderKV("Authorization", "Bearer mytoken"),
+					VerifyJQ(`.caller`, "joe"),
+					VerifyJQ(`.operation_id`, "456"),
+					RespondWith(http.StatusAccepted, nil),
+				),
+			)
+
+			// Create the sink and write the record:
+			sink, err := NewHTTPSink().
+				URL(server.URL()+"/records").
+				Header("Authorization", "Bearer mytoken").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = sink.Write(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Fails if the endpoint rejects the record", func() {
+			// Prepare the server:
+			server.AppendHandlers(
+				RespondWith(http.StatusInternalServerError, nil),
+			)
+
+			// Create the sink and write the record:
+			sink, err := NewHTTPSink().
+				URL(server.URL()).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = sink.Write(ctx, record)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("500"))
+		})
+
+		It("Sends the record even if the context is cancelled", func() {
+			// Prepare the server:
+			server.AppendHandlers(
+				RespondWith(http.StatusOK, nil),
+			)
+
+			// Create the sink and write the record:
+			sink, err := NewHTTPSink().
+				URL(server.URL()).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			cancelled, cancel := context.WithCancel(ctx)
+			cancel()
+			err = sink.Write(cancelled, record)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,179 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of a transport wrapper that sends an audit record for each
+// request to a sink.
+
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// TransportWrapperBuilder contains the data and logic needed to build a new audit transport
+// wrapper. Don't create objects of this type directly; use the NewTransportWrapper function
+// instead.
+type TransportWrapperBuilder struct {
+	logger logging.Logger
+	sink   Sink
+}
+
+// TransportWrapper contains the data and logic needed to wrap an HTTP round tripper with another
+// one that sends an audit record to a sink for each request.
+type TransportWrapper struct {
+	logger logging.Logger
+	sink   Sink
+}
+
+// roundTripper is a round tripper that sends audit records.
+type roundTripper struct {
+	owner     *TransportWrapper
+	transport http.RoundTripper
+}
+
+// Make sure that we implement the interface:
+var _ http.RoundTripper = (*roundTripper)(nil)
+
+// NewTransportWrapper creates a new builder that can then be used to configure and create a new
+// audit transport wrapper. Usually there is no need to use it directly, as the AuditSink method of
+// the connection builder does it automatically.
+func NewTransportWrapper() *TransportWrapperBuilder {
+	return &TransportWrapperBuilder{}
+}
+
+// Logger sets the logger that the wrapper will use to report errors writing to the sink. This is
+// mandatory.
+func (b *TransportWrapperBuilder) Logger(value logging.Logger) *TransportWrapperBuilder {
+	b.logger = value
+	return b
+}
+
+// Sink sets the sink that will receive the audit records. This is mandatory.
+func (b *TransportWrapperBuilder) Sink(value Sink) *TransportWrapperBuilder {
+	b.sink = value
+	return b
+}
+
+// Build uses the information stored in the builder to create a new transport wrapper.
+func (b *TransportWrapperBuilder) Build() (result *TransportWrapper, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.sink == nil {
+		err = errors.New("sink is mandatory")
+		return
+	}
+
+	// Create and populate the object:
+	result = &TransportWrapper{
+		logger: b.logger,
+		sink:   b.sink,
+	}
+	return
+}
+
+// Wrap creates a new round tripper that wraps the given one and sends the audit records.
+func (w *TransportWrapper) Wrap(transport http.RoundTripper) http.RoundTripper {
+	return &roundTripper{
+		owner:     w,
+		transport: transport,
+	}
+}
+
+// RoundTrip is the implementation of the round tripper interface.
+func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	// Measure the time that it takes to send the request and receive the response:
+	start := time.Now()
+	response, err = t.transport.RoundTrip(request)
+	elapsed := time.Since(start)
+
+	// Prepare the record:
+	record := &Record{
+		Timestamp: start,
+		Caller:    caller(request),
+		Method:    request.Method,
+		Path:      request.URL.Path,
+		Latency:   elapsed,
+	}
+	if response != nil {
+		record.Status = response.StatusCode
+		record.OperationID = response.Header.Get(operationIDHeader)
+	}
+	if record.OperationID == "" {
+		record.OperationID = request.Header.Get(operationIDHeader)
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	// Send the record to the sink:
+	ctx := request.Context()
+	sinkErr := t.owner.sink.Write(ctx, record)
+	if sinkErr != nil {
+		t.owner.logger.Error(
+			ctx,
+			"Can't write audit record for %s request to '%s': %v",
+			record.Method, record.Path, sinkErr,
+		)
+	}
+
+	return
+}
+
+// caller extracts the identity of the caller from the bearer token in the `Authorization` header
+// of the request. The signature of the token isn't verified, as this is the token that we are
+// sending, not one that we received.
+func caller(request *http.Request) string {
+	header := request.Header.Get("Authorization")
+	fields := strings.Fields(header)
+	if len(fields) != 2 {
+		return ""
+	}
+	claims := jwt.MapClaims{}
+	_, _, err := new(jwt.Parser).ParseUnverified(fields[1], claims)
+	if err != nil {
+		return ""
+	}
+	for _, name := range callerClaims {
+		value, ok := claims[name].(string)
+		if ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// callerClaims are the names of the token claims that are used to identify the caller, in order of
+// preference.
+var callerClaims = []string{
+	"username",
+	"preferred_username",
+	"email",
+	"sub",
+	"clientId",
+}
+
+// operationIDHeader is the name of the header that contains the operation identifier.
+const operationIDHeader = "X-Operation-ID"
@@ -0,0 +1,235 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the audit transport wrapper.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
+	. "github.com/onsi/gomega/ghttp"        // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Transport wrapper", func() {
+	var (
+		server  *Server
+		lock    *sync.Mutex
+		records []*Record
+		sink    Sink
+	)
+
+	BeforeEach(func() {
+		server = NewServer()
+		lock = &sync.Mutex{}
+		records = nil
+		sink = SinkFunc(func(ctx context.Context, record *Record) error {
+			lock.Lock()
+			defer lock.Unlock()
+			records = append(records, record)
+			return nil
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	// send sends a request using a client that uses the audit wrapper with the given sink.
+	send := func(sink Sink, method, path, token string) (*http.Response, error) {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Sink(sink).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client := &http.Client{
+			Transport: wrapper.Wrap(http.DefaultTransport),
+		}
+		request, err := http.NewRequest(method, server.URL()+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		return client.Do(request)
+	}
+
+	It("Can't be created without a logger", func() {
+		_, err := NewTransportWrapper().
+			Sink(sink).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("logger"))
+	})
+
+	It("Can't be created without a sink", func() {
+		_, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("sink"))
+	})
+
+	It("Writes a record for each request", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			CombineHandlers(
+				RespondWith(
+					http.StatusNotFound,
+					`{}`,
+					http.Header{
+						"X-Operation-Id": []string{"456"},
+					},
+				),
+			),
+		)
+
+		// Send the request:
+		token := MakeTokenStringWithClaims(jwt.MapClaims{
+			"username": "joe",
+		})
+		response, err := send(sink, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123", token)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		// Check the record:
+		Expect(records).To(HaveLen(1))
+		record := records[0]
+		Expect(record.Timestamp).ToNot(BeZero())
+		Expect(record.Caller).To(Equal("joe"))
+		Expect(record.Method).To(Equal(http.MethodGet))
+		Expect(record.Path).To(Equal("/api/clusters_mgmt/v1/clusters/123"))
+		Expect(record.Status).To(Equal(http.StatusNotFound))
+		Expect(record.Latency).To(BeNumerically(">", 0))
+		Expect(record.OperationID).To(Equal("456"))
+		Expect(record.Error).To(BeEmpty())
+	})
+
+	DescribeTable(
+		"Extracts the caller from the token",
+		func(claims jwt.MapClaims, expected string) {
+			// Prepare the server:
+			server.AppendHandlers(
+				RespondWith(http.StatusOK, `{}`),
+			)
+
+			// Send the request:
+			token := MakeTokenStringWithClaims(claims)
+			response, err := send(sink, http.MethodGet, "/api", token)
+			Expect(err).ToNot(HaveOccurred())
+			defer response.Body.Close()
+
+			// Check the record:
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Caller).To(Equal(expected))
+		},
+		Entry(
+			"User name",
+			jwt.MapClaims{
+				"username": "joe",
+				"email":    "joe@example.com",
+			},
+			"joe",
+		),
+		Entry(
+			"Preferred user name",
+			jwt.MapClaims{
+				"preferred_username": "joe",
+				"sub":                "123",
+			},
+			"joe",
+		),
+		Entry(
+			"Email",
+			jwt.MapClaims{
+				"email": "joe@example.com",
+				"sub":   "123",
+			},
+			"joe@example.com",
+		),
+		Entry(
+			"Subject",
+			jwt.MapClaims{
+				"sub": "123",
+			},
+			"123",
+		),
+	)
+
+	It("Writes a record without caller if there is no token", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWith(http.StatusOK, `{}`),
+		)
+
+		// Send the request:
+		response, err := send(sink, http.MethodGet, "/api", "")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		// Check the record:
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Caller).To(BeEmpty())
+	})
+
+	It("Writes a record if the request fails", func() {
+		// Stop the server so that the request fails:
+		url := server.URL()
+		server.Close()
+
+		// Send the request:
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Sink(sink).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client := &http.Client{
+			Transport: wrapper.Wrap(http.DefaultTransport),
+		}
+		_, err = client.Get(url + "/api") // #nosec G107
+		Expect(err).To(HaveOccurred())
+
+		// Check the record:
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Status).To(BeZero())
+		Expect(records[0].Error).ToNot(BeEmpty())
+	})
+
+	It("Ignores errors from the sink", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWith(http.StatusOK, `{}`),
+		)
+
+		// Send the request:
+		failing := SinkFunc(func(ctx context.Context, record *Record) error {
+			return errors.New("my error")
+		})
+		response, err := send(failing, http.MethodGet, "/api", "")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+	})
+})
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the audit support of the connection.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/openshift-online/ocm-sdk-go/audit"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Audit", func() {
+	It("Writes one record per call, including retries", func() {
+		// Create the token:
+		token := MakeTokenStringWithClaims(jwt.MapClaims{
+			"username": "joe",
+		})
+
+		// Prepare the server:
+		server := MakeTCPServer()
+		defer server.Close()
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusServiceUnavailable, `{}`),
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+
+		// Create the sink:
+		lock := &sync.Mutex{}
+		var records []*audit.Record
+		sink := audit.SinkFunc(func(ctx context.Context, record *audit.Record) error {
+			lock.Lock()
+			defer lock.Unlock()
+			records = append(records, record)
+			return nil
+		})
+
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			RetryInterval(10 * time.Millisecond).
+			AuditSink(sink).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Send the request:
+		response, err := connection.Get().Path("/api/clusters_mgmt/v1/clusters").Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusOK))
+
+		// Check the records:
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Caller).To(Equal("joe"))
+		Expect(records[0].Status).To(Equal(http.StatusOK))
+		Expect(records[0].Path).To(Equal("/api/clusters_mgmt/v1/clusters"))
+	})
+})
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types and functions that describe the current state of authentication,
+// intended for health and status endpoints.
+
+package authentication
+
+import (
+	"time"
+)
+
+// AuthInfo describes the current state of the authentication of a wrapper.
+type AuthInfo struct {
+	// GrantType is the OAuth grant type that was used to obtain the current tokens, for
+	// example `client_credentials` or `refresh_token`. It will be empty if the tokens were
+	// passed to the builder and no new tokens have been requested yet.
+	GrantType string
+
+	// LastRefresh is the time when the last successful token request finished. It will be the
+	// zero time if no token request has been sent yet.
+	LastRefresh time.Time
+
+	// AccessTokenExpiry is the time when the current access token expires. It will be the zero
+	// time if there is no access token or if it doesn't expire.
+	AccessTokenExpiry time.Time
+
+	// RefreshTokenExpiry is the time when the current refresh token expires. It will be the zero
+	// time if there is no refresh token, if it doesn't expire or if it is opaque.
+	RefreshTokenExpiry time.Time
+}
+
+// AuthInfo returns a description of the current state of the authentication. It doesn't send any
+// request and it doesn't wait for requests in progress, so it is safe to call it frequently, for
+// example from health endpoints.
+func (w *TransportWrapper) AuthInfo() *AuthInfo {
+	result := &AuthInfo{}
+	if w.pullSecretAccessToken != nil {
+		result.AccessTokenExpiry = expiryTime(w.pullSecretAccessToken)
+		return result
+	}
+	state := w.tokenState.Load()
+	if state == nil {
+		return result
+	}
+	result.GrantType = state.grantType
+	result.LastRefresh = state.refreshed
+	if state.accessExpires {
+		result.AccessTokenExpiry = state.accessExpiry
+	}
+	result.RefreshTokenExpiry = expiryTime(state.refresh)
+	return result
+}
+
+// expiryTime returns the time when the given token expires, or the zero time if it doesn't expire
+// or that can't be determined.
+func expiryTime(token *tokenInfo) time.Time {
+	now := time.Now()
+	expires, remaining, err := tokenRemaining(token, now)
+	if err != nil || !expires {
+		return time.Time{}
+	}
+	return now.Add(remaining)
+}
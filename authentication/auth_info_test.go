@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the authentication information.
+
+package authentication
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Authentication information", func() {
+	var ctx context.Context
+	var server *Server
+	var ca string
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server, ca = MakeTCPTLSServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+		err := os.Remove(ca)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Describes tokens passed to the builder", func() {
+		accessToken := MakeTokenString("Bearer", 5*time.Minute)
+		refreshToken := MakeTokenString("Refresh", 10*time.Hour)
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			Tokens(accessToken, refreshToken).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		info := wrapper.AuthInfo()
+		Expect(info).ToNot(BeNil())
+		Expect(info.GrantType).To(BeEmpty())
+		Expect(info.LastRefresh.IsZero()).To(BeTrue())
+		Expect(info.AccessTokenExpiry).To(
+			BeTemporally("~", time.Now().Add(5*time.Minute), 5*time.Second),
+		)
+		Expect(info.RefreshTokenExpiry).To(
+			BeTemporally("~", time.Now().Add(10*time.Hour), 5*time.Second),
+		)
+	})
+
+	It("Describes tokens obtained with the client credentials grant", func() {
+		accessToken := MakeTokenString("Bearer", 15*time.Minute)
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyClientCredentialsGrant("myclient", "mysecret"),
+				RespondWithAccessToken(accessToken),
+			),
+		)
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			Client("myclient", "mysecret").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Before requesting tokens there is nothing to report:
+		info := wrapper.AuthInfo()
+		Expect(info.GrantType).To(BeEmpty())
+		Expect(info.LastRefresh.IsZero()).To(BeTrue())
+		Expect(info.AccessTokenExpiry.IsZero()).To(BeTrue())
+
+		// After requesting them the information should be updated:
+		before := time.Now()
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		info = wrapper.AuthInfo()
+		Expect(info.GrantType).To(Equal("client_credentials"))
+		Expect(info.LastRefresh).To(BeTemporally(">=", before))
+		Expect(info.AccessTokenExpiry).To(
+			BeTemporally("~", time.Now().Add(15*time.Minute), 5*time.Second),
+		)
+		Expect(info.RefreshTokenExpiry.IsZero()).To(BeTrue())
+	})
+})
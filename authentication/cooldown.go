@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic that stops requesting tokens for a while after repeated failures.
+
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrAuthCoolingDown is the error returned when tokens aren't requested because previous requests
+// failed repeatedly and the cool-down period hasn't finished yet. Use errors.Is to check for it,
+// and errors.As with *CoolingDownError to get the details.
+var ErrAuthCoolingDown = errors.New("authentication is cooling down after repeated failures")
+
+// CoolingDownError contains the details of the cool-down period.
+type CoolingDownError struct {
+	// Failures is the number of consecutive failures that started the cool-down period.
+	Failures int
+
+	// Until is the time when the cool-down period ends.
+	Until time.Time
+
+	// Cause is the error returned by the last request that failed.
+	Cause error
+}
+
+// Error is the implementation of the error interface.
+func (e *CoolingDownError) Error() string {
+	return fmt.Sprintf(
+		"%v: %d consecutive failures to get tokens, will try again after %s: %v",
+		ErrAuthCoolingDown, e.Failures, e.Until.Format(time.RFC3339), e.Cause,
+	)
+}
+
+// Is returns true if the target is ErrAuthCoolingDown.
+func (e *CoolingDownError) Is(target error) bool {
+	return target == ErrAuthCoolingDown
+}
+
+// Unwrap returns the error of the last request that failed.
+func (e *CoolingDownError) Unwrap() error {
+	return e.Cause
+}
+
+// coolDown counts the consecutive failures to get tokens and decides when to stop sending
+// requests. It is safe to use it from multiple goroutines simultaneously.
+type coolDown struct {
+	threshold int
+	period    time.Duration
+	lock      sync.Mutex
+	failures  int
+	until     time.Time
+	cause     error
+}
+
+// check returns an error if the cool-down period is in progress.
+func (c *coolDown) check(now time.Time) error {
+	if c == nil {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if now.Before(c.until) {
+		return &CoolingDownError{
+			Failures: c.failures,
+			Until:    c.until,
+			Cause:    c.cause,
+		}
+	}
+	return nil
+}
+
+// record updates the count of consecutive failures with the result of a request to get tokens, and
+// starts the cool-down period if the threshold has been reached. It returns true if the cool-down
+// period started.
+func (c *coolDown) record(ctx context.Context, err error) bool {
+	if c == nil {
+		return false
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err == nil {
+		c.failures = 0
+		c.cause = nil
+		return false
+	}
+	// Failures caused by the caller cancelling the request aren't the fault of the server:
+	if ctx != nil && ctx.Err() != nil {
+		return false
+	}
+	c.failures++
+	c.cause = err
+	if c.failures < c.threshold {
+		return false
+	}
+	c.until = time.Now().Add(c.period)
+	return true
+}
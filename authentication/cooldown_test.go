@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the cool-down mode of the token requests.
+
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Cool-down", func() {
+	var ctx context.Context
+	var server *Server
+	var ca string
+	var refreshToken string
+	var accessToken string
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server, ca = MakeTCPTLSServer()
+		refreshToken = MakeTokenString("Refresh", 10*time.Hour)
+		accessToken = MakeTokenString("Bearer", 5*time.Minute)
+	})
+
+	AfterEach(func() {
+		server.Close()
+		err := os.Remove(ca)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// respondWithFailure creates a handler that rejects the token request with an error that
+	// isn't retried.
+	respondWithFailure := func() http.HandlerFunc {
+		return RespondWithTokenError("invalid_grant", "Session not active")
+	}
+
+	It("Stops sending requests after the given number of failures", func() {
+		server.AppendHandlers(
+			respondWithFailure(),
+			respondWithFailure(),
+		)
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			Tokens(refreshToken).
+			CoolDown(2, time.Hour).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// The first two attempts reach the server:
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, ErrAuthCoolingDown)).To(BeFalse())
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, ErrAuthCoolingDown)).To(BeFalse())
+
+		// The third doesn't:
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(errors.Is(err, ErrAuthCoolingDown)).To(BeTrue())
+		var coolingDown *CoolingDownError
+		Expect(errors.As(err, &coolingDown)).To(BeTrue())
+		Expect(coolingDown.Failures).To(Equal(2))
+		Expect(coolingDown.Until).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		Expect(coolingDown.Cause).To(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Sends requests again when the period finishes", func() {
+		server.AppendHandlers(
+			respondWithFailure(),
+			CombineHandlers(
+				VerifyRefreshGrant(refreshToken),
+				RespondWithAccessAndRefreshTokens(accessToken, refreshToken),
+			),
+		)
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			Tokens(refreshToken).
+			CoolDown(1, 50*time.Millisecond).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// The first attempt fails and starts the cool-down:
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(err).To(HaveOccurred())
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(errors.Is(err, ErrAuthCoolingDown)).To(BeTrue())
+
+		// After the period the request is sent again:
+		time.Sleep(100 * time.Millisecond)
+		returnedAccess, _, err := wrapper.Tokens(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(returnedAccess).To(Equal(accessToken))
+	})
+
+	It("Resets the count after a success", func() {
+		expiredAccess := MakeTokenString("Bearer", -5*time.Minute)
+		server.AppendHandlers(
+			respondWithFailure(),
+			RespondWithAccessAndRefreshTokens(expiredAccess, refreshToken),
+			respondWithFailure(),
+		)
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			Tokens(refreshToken).
+			CoolDown(2, time.Hour).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Failure, success and failure again don't start the cool-down:
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(err).To(HaveOccurred())
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = wrapper.Tokens(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, ErrAuthCoolingDown)).To(BeFalse())
+	})
+
+	It("Can't be created with a negative number of failures", func() {
+		_, err := NewTransportWrapper().
+			Logger(logger).
+			Tokens(refreshToken).
+			CoolDown(-1, time.Hour).
+			Build(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("cool-down failures -1 isn't valid"))
+	})
+
+	It("Can't be created without a period", func() {
+		_, err := NewTransportWrapper().
+			Logger(logger).
+			Tokens(refreshToken).
+			CoolDown(3, 0).
+			Build(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("cool-down period 0s isn't valid"))
+	})
+})
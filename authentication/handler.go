@@ -38,6 +38,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/openshift-online/ocm-sdk-go/errors"
+	"github.com/openshift-online/ocm-sdk-go/fips"
 	"github.com/openshift-online/ocm-sdk-go/logging"
 )
 
@@ -50,6 +51,7 @@ type HandlerBuilder struct {
 	keysURLs     []string
 	keysCAs      *x509.CertPool
 	keysInsecure bool
+	fips         bool
 	aclFiles     []string
 	service      string
 	error        string
@@ -69,6 +71,7 @@ type Handler struct {
 	keysURLs      []string
 	keysClient    *http.Client
 	keys          *sync.Map
+	fips          bool
 	lastKeyReload time.Time
 	aclItems      map[string]*regexp.Regexp
 	service       string
@@ -141,6 +144,15 @@ func (b *HandlerBuilder) KeysInsecure(value bool) *HandlerBuilder {
 	return b
 }
 
+// FIPS enables the enforcement of the FIPS constraints. When enabled the keys are loaded using TLS
+// with the approved versions, cipher suites and certificates, keys that aren't approved are
+// ignored, and tokens signed with algorithms that aren't approved are rejected. This can't be
+// combined with KeysInsecure.
+func (b *HandlerBuilder) FIPS(value bool) *HandlerBuilder {
+	b.fips = value
+	return b
+}
+
 // ACLFile sets a file that contains items of the access control list. This should be a YAML file
 // with the following format:
 //
@@ -324,12 +336,19 @@ func (b *HandlerBuilder) Build() (handler *Handler, err error) {
 	}
 
 	// Create the HTTP client that will be used to load the keys:
+	keysConfig := &tls.Config{
+		RootCAs:            b.keysCAs,
+		InsecureSkipVerify: b.keysInsecure, // nolint
+	}
+	if b.fips {
+		err = fips.ConfigureTLS(keysConfig)
+		if err != nil {
+			return
+		}
+	}
 	keysClient := &http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:            b.keysCAs,
-				InsecureSkipVerify: b.keysInsecure, // nolint
-			},
+			TLSClientConfig: keysConfig,
 		},
 	}
 
@@ -345,6 +364,9 @@ func (b *HandlerBuilder) Build() (handler *Handler, err error) {
 
 	// Create the bearer token parser:
 	tokenParser := &jwt.Parser{}
+	if b.fips {
+		tokenParser.ValidMethods = fips.TokenAlgorithms()
+	}
 
 	// Make copies of the lists of keys files and URLs:
 	keysFiles := make([]string, len(b.keysFiles))
@@ -373,6 +395,7 @@ func (b *HandlerBuilder) Build() (handler *Handler, err error) {
 		keysURLs:    keysURLs,
 		keysClient:  keysClient,
 		keys:        keys,
+		fips:        b.fips,
 		aclItems:    aclItems,
 		service:     b.service,
 		error:       b.error,
@@ -689,6 +712,13 @@ func (h *Handler) readKeys(ctx context.Context, reader io.Reader) error {
 			)
 			continue
 		}
+		if h.fips {
+			err = fips.CheckKey(keyData.Kid, key)
+			if err != nil {
+				h.logger.Error(ctx, "Key '%s' will be ignored: %v", keyData.Kid, err)
+				continue
+			}
+		}
 		h.keys.Store(keyData.Kid, key)
 		h.logger.Info(ctx, "Loaded key '%s'", keyData.Kid)
 	}
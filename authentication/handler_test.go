@@ -22,6 +22,7 @@ limitations under the License.
 package authentication
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -33,6 +34,8 @@ import (
 	. "github.com/onsi/gomega"                         // nolint
 	. "github.com/onsi/gomega/ghttp"                   // nolint
 	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	"github.com/openshift-online/ocm-sdk-go/fips"
 )
 
 var _ = Describe("Handler", func() {
@@ -153,6 +156,82 @@ var _ = Describe("Handler", func() {
 		Expect(err.Error()).To(ContainSubstring("mandatory"))
 	})
 
+	It("Can't be built with FIPS and insecure keys", func() {
+		// Prepare the next handler:
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+
+		// Try to create the handler:
+		_, err := NewHandler().
+			Logger(logger).
+			KeysURL("https://api.openshift.com/.well-known/jwks.json").
+			KeysInsecure(true).
+			FIPS(true).
+			Next(next).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, fips.ErrNotCompliant)).To(BeTrue())
+	})
+
+	It("Accepts token with approved algorithm when FIPS is enabled", func() {
+		// Prepare the next handler:
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+
+		// Prepare the handler:
+		handler, err := NewHandler().
+			Logger(logger).
+			KeysFile(keysFile).
+			FIPS(true).
+			Next(next).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Send the request:
+		bearer := MakeTokenString("Bearer", 1*time.Minute)
+		request := httptest.NewRequest(http.MethodGet, "/api/clusters_mgmt/v1/private", nil)
+		request.Header.Set("Authorization", "Bearer "+bearer)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		// Verify that the request is accepted:
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+	})
+
+	It("Rejects token with algorithm that isn't approved when FIPS is enabled", func() {
+		// Prepare the next handler, which should not be called:
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(true).To(BeFalse())
+			w.WriteHeader(http.StatusBadRequest)
+		})
+
+		// Prepare a token signed with HMAC:
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, MakeClaims())
+		token.Header["kid"] = "123"
+		bearer, err := token.SignedString([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// Prepare the handler:
+		handler, err := NewHandler().
+			Logger(logger).
+			KeysFile(keysFile).
+			FIPS(true).
+			Next(next).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Send the request:
+		request := httptest.NewRequest(http.MethodGet, "/api/clusters_mgmt/v1/private", nil)
+		request.Header.Set("Authorization", "Bearer "+bearer)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		// Verify that the request is rejected:
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+	})
+
 	It("Rejects request without authorization header or cookie", func() {
 		// Prepare the next handler, which should not be called:
 		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to detect offline tokens and to migrate away from them.
+
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// OfflineTokenWarning contains the details of an offline token that was passed to the wrapper.
+// Offline tokens issued by `sso.redhat.com` are deprecated, and clients should use the credentials
+// of a service account instead.
+type OfflineTokenWarning struct {
+	// Subject is the value of the `sub` claim of the token.
+	Subject string
+
+	// Username is the value of the `preferred_username` claim of the token, if present.
+	Username string
+
+	// IssuedAt is the time when the token was issued, from the `iat` claim. It will be zero if the
+	// token doesn't have that claim.
+	IssuedAt time.Time
+
+	// Migrated indicates if the offline token was replaced by the credentials of the service
+	// account configured with the ServiceAccount method of the builder.
+	Migrated bool
+}
+
+// OfflineTokenHandler is a function that is called when the wrapper is created with an offline
+// token. It can be used, for example, to tell users of a command line tool how to switch to a
+// service account.
+type OfflineTokenHandler func(ctx context.Context, warning *OfflineTokenWarning)
+
+// String returns a description of the warning suitable for logs and for users.
+func (w *OfflineTokenWarning) String() string {
+	who := w.Subject
+	if w.Username != "" {
+		who = w.Username
+	}
+	if w.Migrated {
+		return fmt.Sprintf(
+			"Offline token of '%s' is deprecated and has been replaced by the credentials "+
+				"of the service account",
+			who,
+		)
+	}
+	return fmt.Sprintf(
+		"Offline token of '%s' is deprecated and will stop working in the future, use the "+
+			"credentials of a service account instead",
+		who,
+	)
+}
+
+// IsOfflineToken checks if the given text is an offline token, that is a JWT token with the `typ`
+// claim set to `Offline`. The signature of the token isn't verified.
+func IsOfflineToken(text string) bool {
+	object, _, err := (&jwt.Parser{}).ParseUnverified(text, jwt.MapClaims{})
+	if err != nil {
+		return false
+	}
+	return isOfflineToken(object)
+}
+
+// isOfflineToken checks if the given parsed token is an offline token.
+func isOfflineToken(object *jwt.Token) bool {
+	if object == nil {
+		return false
+	}
+	claims, ok := object.Claims.(jwt.MapClaims)
+	if !ok {
+		return false
+	}
+	typ, ok := claims["typ"].(string)
+	return ok && strings.EqualFold(typ, "offline")
+}
+
+// newOfflineTokenWarning creates the warning for the given offline token.
+func newOfflineTokenWarning(object *jwt.Token, migrated bool) *OfflineTokenWarning {
+	result := &OfflineTokenWarning{
+		Migrated: migrated,
+	}
+	claims, ok := object.Claims.(jwt.MapClaims)
+	if !ok {
+		return result
+	}
+	result.Subject, _ = claims["sub"].(string)
+	result.Username, _ = claims["preferred_username"].(string)
+	iat, ok := claims["iat"].(float64)
+	if ok {
+		result.IssuedAt = time.Unix(int64(iat), 0)
+	}
+	return result
+}
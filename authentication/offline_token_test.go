@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the detection and migration of offline tokens.
+
+package authentication
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Offline tokens", func() {
+	var (
+		ctx          context.Context
+		server       *Server
+		ca           string
+		offlineToken string
+	)
+
+	BeforeEach(func() {
+		// Create the context:
+		ctx = context.Background()
+
+		// Create the server:
+		server, ca = MakeTCPTLSServer()
+
+		// Create the offline token:
+		offlineToken = MakeTokenObject(jwt.MapClaims{
+			"typ":                "Offline",
+			"sub":                "123",
+			"preferred_username": "myuser",
+			"iat":                time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			"exp":                nil,
+		}).Raw
+	})
+
+	AfterEach(func() {
+		// Stop the server:
+		server.Close()
+
+		// Remove the temporary CA file:
+		err := os.Remove(ca)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Detects offline tokens", func() {
+		Expect(IsOfflineToken(offlineToken)).To(BeTrue())
+		Expect(IsOfflineToken(MakeTokenString("Refresh", 10*time.Hour))).To(BeFalse())
+		Expect(IsOfflineToken(MakeTokenString("Bearer", 5*time.Minute))).To(BeFalse())
+		Expect(IsOfflineToken("junk")).To(BeFalse())
+	})
+
+	It("Calls the handler when there is no service account", func() {
+		var warning *OfflineTokenWarning
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			Tokens(offlineToken).
+			OfflineTokenHandler(func(ctx context.Context, value *OfflineTokenWarning) {
+				warning = value
+			}).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(warning).ToNot(BeNil())
+		Expect(warning.Subject).To(Equal("123"))
+		Expect(warning.Username).To(Equal("myuser"))
+		Expect(warning.IssuedAt.UTC()).To(Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(warning.Migrated).To(BeFalse())
+		Expect(warning.String()).To(ContainSubstring("myuser"))
+	})
+
+	It("Keeps using the offline token when there is no service account", func() {
+		accessToken := MakeTokenString("Bearer", 5*time.Minute)
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyRefreshGrant(offlineToken),
+				RespondWithAccessToken(accessToken),
+			),
+		)
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			Tokens(offlineToken).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		returnedAccess, _, err := wrapper.Tokens(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(returnedAccess).To(Equal(accessToken))
+	})
+
+	It("Replaces the offline token with the service account", func() {
+		accessToken := MakeTokenString("Bearer", 5*time.Minute)
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyClientCredentialsGrant("myaccount", "mysecret"),
+				RespondWithAccessToken(accessToken),
+			),
+		)
+		var warning *OfflineTokenWarning
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			Tokens(offlineToken).
+			ServiceAccount("myaccount", "mysecret").
+			OfflineTokenHandler(func(ctx context.Context, value *OfflineTokenWarning) {
+				warning = value
+			}).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(warning).ToNot(BeNil())
+		Expect(warning.Migrated).To(BeTrue())
+		returnedAccess, returnedRefresh, err := wrapper.Tokens(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(returnedAccess).To(Equal(accessToken))
+		Expect(returnedRefresh).To(BeEmpty())
+	})
+
+	It("Uses the service account when there are no tokens", func() {
+		accessToken := MakeTokenString("Bearer", 5*time.Minute)
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyClientCredentialsGrant("myaccount", "mysecret"),
+				RespondWithAccessToken(accessToken),
+			),
+		)
+		called := false
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TokenURL(server.URL()).
+			TrustedCA(ca).
+			ServiceAccount("myaccount", "mysecret").
+			OfflineTokenHandler(func(ctx context.Context, value *OfflineTokenWarning) {
+				called = true
+			}).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(called).To(BeFalse())
+		returnedAccess, _, err := wrapper.Tokens(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(returnedAccess).To(Equal(accessToken))
+	})
+})
@@ -17,6 +17,8 @@ limitations under the License.
 package authentication
 
 import (
+	"time"
+
 	"github.com/golang-jwt/jwt/v4"
 )
 
@@ -27,3 +29,45 @@ type tokenInfo struct {
 	text   string
 	object *jwt.Token
 }
+
+// tokenState is an immutable snapshot of the current tokens. It is published with an atomic
+// pointer every time that the tokens change, so that checking if the access token is still valid
+// doesn't require locking.
+type tokenState struct {
+	access  *tokenInfo
+	refresh *tokenInfo
+
+	// accessExpires indicates if the access token has an expiration time, and accessExpiry is
+	// that time.
+	accessExpires bool
+	accessExpiry  time.Time
+
+	// checkable is false when the expiration time of the access token couldn't be determined,
+	// in that case the slow path must be used in order to report the error.
+	checkable bool
+
+	// grantType is the grant type used to obtain the tokens, and refreshed is the time when
+	// they were obtained. Both are empty when the tokens were passed to the builder.
+	grantType string
+	refreshed time.Time
+}
+
+// newTokenState creates a snapshot of the given tokens.
+func newTokenState(access, refresh *tokenInfo) *tokenState {
+	state := &tokenState{
+		access:  access,
+		refresh: refresh,
+	}
+	if access == nil {
+		return state
+	}
+	now := time.Now()
+	expires, remaining, err := tokenRemaining(access, now)
+	if err != nil {
+		return state
+	}
+	state.accessExpires = expires
+	state.accessExpiry = now.Add(remaining)
+	state.checkable = true
+	return state
+}
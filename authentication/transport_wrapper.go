@@ -25,12 +25,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -38,8 +38,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/openshift-online/ocm-sdk-go/fips"
+	"github.com/openshift-online/ocm-sdk-go/helpers"
 	"github.com/openshift-online/ocm-sdk-go/internal"
 	"github.com/openshift-online/ocm-sdk-go/logging"
+	"github.com/openshift-online/ocm-sdk-go/metrics"
+	"github.com/openshift-online/ocm-sdk-go/retry"
 )
 
 // Default values:
@@ -63,6 +67,12 @@ type TransportWrapperBuilder struct {
 	tokenURL          string
 	clientID          string
 	clientSecret      string
+	serviceAccountID  string
+	serviceAccountKey string
+	offlineHandler    OfflineTokenHandler
+	retryHandler      retry.EventHandler
+	coolDownFailures  int
+	coolDownPeriod    time.Duration
 	user              string
 	password          string
 	tokens            []string
@@ -70,6 +80,11 @@ type TransportWrapperBuilder struct {
 	agent             string
 	trustedCAs        []interface{}
 	insecure          bool
+	fips              bool
+	proxy             *url.URL
+	ipFamily          internal.IPFamily
+	fallbackDelay     time.Duration
+	resolver          *internal.Resolver
 	transportWrappers []func(http.RoundTripper) http.RoundTripper
 
 	// Fields used for metrics:
@@ -93,9 +108,16 @@ type TransportWrapper struct {
 	tokenServer           *internal.ServerAddress
 	tokenMutex            *sync.Mutex
 	tokenParser           *jwt.Parser
+	fips                  bool
 	accessToken           *tokenInfo
 	refreshToken          *tokenInfo
 	pullSecretAccessToken *tokenInfo
+	retryHandler          retry.EventHandler
+	coolDown              *coolDown
+
+	// Snapshot of the access and refresh tokens, updated every time that they change while
+	// holding the token mutex, and read without locking:
+	tokenState atomic.Pointer[tokenState]
 
 	// Fields used for metrics:
 	metricsSubsystem    string
@@ -163,6 +185,55 @@ func (b *TransportWrapperBuilder) Client(id string, secret string) *TransportWra
 	return b
 }
 
+// ServiceAccount sets the client identifier and secret of a service account that will be used to
+// request tokens with the client credentials grant. This is similar to the Client method, but it
+// also replaces any offline token passed with the Tokens method. Offline tokens are deprecated, so
+// command line tools can use this to move to service accounts without asking users to remove the
+// offline tokens that they have already saved. For example:
+//
+//	wrapper, err := authentication.NewTransportWrapper().
+//		Tokens(savedOfflineToken).
+//		ServiceAccount(os.Getenv("OCM_CLIENT_ID"), os.Getenv("OCM_CLIENT_SECRET")).
+//		Build(ctx)
+//
+// If the identifier or the secret are empty the service account is ignored and the offline token,
+// if any, will be used. If both this and the Client method are used the service account takes
+// precedence.
+func (b *TransportWrapperBuilder) ServiceAccount(id string,
+	secret string) *TransportWrapperBuilder {
+	b.serviceAccountID = id
+	b.serviceAccountKey = secret
+	return b
+}
+
+// OfflineTokenHandler sets a function that will be called when the wrapper is created with an
+// offline token. The wrapper always writes a warning to the log in that case, this is intended for
+// tools that want to handle it in a different way, for example printing instructions for the user.
+func (b *TransportWrapperBuilder) OfflineTokenHandler(
+	value OfflineTokenHandler) *TransportWrapperBuilder {
+	b.offlineHandler = value
+	return b
+}
+
+// RetryHandler sets a function that will be called each time that a request to get tokens fails and
+// is retried.
+func (b *TransportWrapperBuilder) RetryHandler(value retry.EventHandler) *TransportWrapperBuilder {
+	b.retryHandler = value
+	return b
+}
+
+// CoolDown enables the cool-down mode: after the given number of consecutive failures to get tokens
+// no more requests will be sent to the OpenID server during the given period, and requests that
+// need new tokens will immediately fail with an error that wraps ErrAuthCoolingDown. This is
+// intended to protect the server from storms of retries from large numbers of clients. The default
+// is to not enable it.
+func (b *TransportWrapperBuilder) CoolDown(failures int,
+	period time.Duration) *TransportWrapperBuilder {
+	b.coolDownFailures = failures
+	b.coolDownPeriod = period
+	return b
+}
+
 // User sets the user name and password that will be used to request OpenID access tokens. When
 // these two values are provided the round trippers will use the resource owner password grant type
 // to obtain the token. For example:
@@ -258,6 +329,43 @@ func (b *TransportWrapperBuilder) Insecure(flag bool) *TransportWrapperBuilder {
 	return b
 }
 
+// FIPS enables the enforcement of the FIPS constraints. When enabled the OpenID server must use
+// TLS with the approved versions, cipher suites and certificates, and the tokens, both the ones
+// given explicitly and the ones returned by the server, must be signed with approved algorithms.
+func (b *TransportWrapperBuilder) FIPS(flag bool) *TransportWrapperBuilder {
+	b.fips = flag
+	return b
+}
+
+// Proxy sets the URL of the proxy server used to connect to the OpenID server. The supported schemes
+// are `http`, `https`, `socks5` and `socks5h`. The default is to take it from the `HTTPS_PROXY`,
+// `HTTP_PROXY` and `NO_PROXY` environment variables.
+func (b *TransportWrapperBuilder) Proxy(value *url.URL) *TransportWrapperBuilder {
+	b.proxy = value
+	return b
+}
+
+// IPFamily sets the IP address family that will be used first when connecting to the OpenID server.
+// The default is dual stack, using the Happy Eyeballs algorithm.
+func (b *TransportWrapperBuilder) IPFamily(value internal.IPFamily) *TransportWrapperBuilder {
+	b.ipFamily = value
+	return b
+}
+
+// FallbackDelay sets the time to wait before trying the other IP family when using dual stack.
+// Zero means the default of the Go library, and a negative value disables the fallback.
+func (b *TransportWrapperBuilder) FallbackDelay(value time.Duration) *TransportWrapperBuilder {
+	b.fallbackDelay = value
+	return b
+}
+
+// Resolver sets the resolver that will be used to cache the IP addresses of the OpenID server. The
+// default is to not cache them.
+func (b *TransportWrapperBuilder) Resolver(value *internal.Resolver) *TransportWrapperBuilder {
+	b.resolver = value
+	return b
+}
+
 // TransportWrapper adds a function that will be used to wrap the transports of the HTTP client used
 // to request tokens. If used multiple times the transport wrappers will be called in the same order
 // that they are added.
@@ -326,11 +434,29 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 		err = fmt.Errorf("logger is mandatory")
 		return
 	}
+	if b.coolDownFailures < 0 {
+		err = fmt.Errorf(
+			"cool-down failures %d isn't valid, it should be greater or equal than zero",
+			b.coolDownFailures,
+		)
+		return
+	}
+	if b.coolDownFailures > 0 && b.coolDownPeriod <= 0 {
+		err = fmt.Errorf(
+			"cool-down period %s isn't valid, it should be greater than zero",
+			b.coolDownPeriod,
+		)
+		return
+	}
 
 	// Check that we have some kind of credentials or a token:
 	haveTokens := len(b.tokens) > 0
 	havePassword := b.user != "" && b.password != ""
 	haveSecret := b.clientID != "" && b.clientSecret != ""
+	haveServiceAccount := b.serviceAccountID != "" && b.serviceAccountKey != ""
+	if haveServiceAccount {
+		haveSecret = true
+	}
 	if !haveTokens && !havePassword && !haveSecret {
 		err = fmt.Errorf(
 			"either a token, an user name and password or a client identifier and secret are " +
@@ -346,6 +472,7 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 	var accessToken *tokenInfo
 	var refreshToken *tokenInfo
 	var pullSecretAccessToken *tokenInfo
+	var offlineToken *jwt.Token
 	for i, text := range b.tokens {
 		var object *jwt.Token
 
@@ -381,6 +508,13 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 			}
 			continue
 		}
+		if b.fips {
+			err = fips.CheckToken(object)
+			if err != nil {
+				err = fmt.Errorf("token %d can't be used: %w", i, err)
+				return
+			}
+		}
 
 		claims, ok := object.Claims.(jwt.MapClaims)
 		if !ok {
@@ -440,12 +574,32 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 				text:   text,
 				object: object,
 			}
+			if isOfflineToken(object) {
+				offlineToken = object
+			}
 		default:
 			err = fmt.Errorf("type '%s' of token %d is unknown", typ, i)
 			return
 		}
 	}
 
+	// Offline tokens are deprecated, so warn about them, and replace them with the credentials of
+	// the service account if available. The access token is also discarded in that case, because
+	// it was most likely obtained with the offline token, and it belongs to a different identity.
+	if offlineToken != nil {
+		warning := newOfflineTokenWarning(offlineToken, haveServiceAccount)
+		if haveServiceAccount {
+			b.logger.Info(ctx, "%s", warning)
+			accessToken = nil
+			refreshToken = nil
+		} else {
+			b.logger.Warn(ctx, "%s", warning)
+		}
+		if b.offlineHandler != nil {
+			b.offlineHandler(ctx, warning)
+		}
+	}
+
 	// Set the default authentication details, if needed:
 	tokenURL := b.tokenURL
 	if tokenURL == "" {
@@ -461,7 +615,18 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 		err = fmt.Errorf("can't parse token URL '%s': %w", tokenURL, err)
 		return
 	}
+	if b.fips {
+		err = fips.CheckProtocol(tokenURL, tokenServer.Protocol)
+		if err != nil {
+			return
+		}
+	}
 	clientID := b.clientID
+	clientSecret := b.clientSecret
+	if haveServiceAccount {
+		clientID = b.serviceAccountID
+		clientSecret = b.serviceAccountKey
+	}
 	if clientID == "" {
 		clientID = DefaultClientID
 		b.logger.Debug(
@@ -470,7 +635,6 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 			clientID,
 		)
 	}
-	clientSecret := b.clientSecret
 	if clientSecret == "" {
 		clientSecret = DefaultClientSecret
 		b.logger.Debug(
@@ -493,6 +657,11 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 		Logger(b.logger).
 		TrustedCAs(b.trustedCAs...).
 		Insecure(b.insecure).
+		FIPS(b.fips).
+		Proxy(b.proxy).
+		IPFamily(b.ipFamily).
+		FallbackDelay(b.fallbackDelay).
+		Resolver(b.resolver).
 		TransportWrappers(b.transportWrappers...).
 		Build(ctx)
 	if err != nil {
@@ -511,16 +680,12 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 			},
 			tokenMetricsLabels,
 		)
-		err = b.metricsRegisterer.Register(tokenCountMetric)
+		var registered prometheus.Collector
+		registered, err = metrics.Register(b.metricsRegisterer, tokenCountMetric)
 		if err != nil {
-			registered, ok := err.(prometheus.AlreadyRegisteredError)
-			if ok {
-				tokenCountMetric = registered.ExistingCollector.(*prometheus.CounterVec)
-				err = nil //nolint:all
-			} else {
-				return
-			}
+			return
 		}
+		tokenCountMetric = registered.(*prometheus.CounterVec)
 
 		tokenDurationMetric = prometheus.NewHistogramVec(
 			prometheus.HistogramOpts{
@@ -536,15 +701,20 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 			},
 			tokenMetricsLabels,
 		)
-		err = b.metricsRegisterer.Register(tokenDurationMetric)
+		registered, err = metrics.Register(b.metricsRegisterer, tokenDurationMetric)
 		if err != nil {
-			registered, ok := err.(prometheus.AlreadyRegisteredError)
-			if ok {
-				tokenDurationMetric = registered.ExistingCollector.(*prometheus.HistogramVec)
-				err = nil
-			} else {
-				return
-			}
+			metrics.Unregister(b.metricsRegisterer, tokenCountMetric)
+			return
+		}
+		tokenDurationMetric = registered.(*prometheus.HistogramVec)
+	}
+
+	// Create the cool-down state, if needed:
+	var coolDownState *coolDown
+	if b.coolDownFailures > 0 {
+		coolDownState = &coolDown{
+			threshold: b.coolDownFailures,
+			period:    b.coolDownPeriod,
 		}
 	}
 
@@ -562,14 +732,18 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 		tokenServer:           tokenServer,
 		tokenMutex:            &sync.Mutex{},
 		tokenParser:           tokenParser,
+		fips:                  b.fips,
 		accessToken:           accessToken,
 		refreshToken:          refreshToken,
 		pullSecretAccessToken: pullSecretAccessToken,
+		retryHandler:          b.retryHandler,
+		coolDown:              coolDownState,
 		metricsSubsystem:      b.metricsSubsystem,
 		metricsRegisterer:     b.metricsRegisterer,
 		tokenCountMetric:      tokenCountMetric,
 		tokenDurationMetric:   tokenDurationMetric,
 	}
+	result.tokenState.Store(newTokenState(accessToken, refreshToken))
 
 	return
 }
@@ -622,6 +796,12 @@ func (w *TransportWrapper) Close() error {
 	if err != nil {
 		return err
 	}
+	if w.tokenCountMetric != nil {
+		metrics.Unregister(w.metricsRegisterer, w.tokenCountMetric)
+	}
+	if w.tokenDurationMetric != nil {
+		metrics.Unregister(w.metricsRegisterer, w.tokenDurationMetric)
+	}
 	return nil
 }
 
@@ -671,6 +851,20 @@ func (w *TransportWrapper) Tokens(ctx context.Context, expiresIn ...time.Duratio
 		expiresDuration = expiresIn[0]
 	}
 
+	// In the common case the access token is still valid, and then it can be returned without
+	// locking and without preparing the retry machinery:
+	var ok bool
+	access, refresh, ok = w.validTokens(expiresDuration)
+	if ok {
+		return
+	}
+
+	// Don't send requests if we are in the cool-down period after repeated failures:
+	err = w.coolDown.check(time.Now())
+	if err != nil {
+		return
+	}
+
 	// Configure the back-off so that it honours the deadline of the context passed
 	// to the method. Note that we need to specify explicitly the type of the variable
 	// because the backoff.NewExponentialBackOff function returns the implementation
@@ -683,9 +877,9 @@ func (w *TransportWrapper) Tokens(ctx context.Context, expiresIn ...time.Duratio
 	}
 
 	attempt := 0
+	var code int
 	operation := func() error {
 		attempt++
-		var code int
 		code, access, refresh, err = w.tokens(ctx, attempt, expiresDuration)
 		if err != nil {
 			if code >= http.StatusInternalServerError {
@@ -712,11 +906,64 @@ func (w *TransportWrapper) Tokens(ctx context.Context, expiresIn ...time.Duratio
 		return nil
 	}
 
+	// Report the retries to the handler, if any:
+	notify := func(cause error, delay time.Duration) {
+		if w.retryHandler == nil {
+			return
+		}
+		w.retryHandler(ctx, &retry.Event{
+			Attempt: attempt,
+			Delay:   delay,
+			Cause:   cause,
+			Status:  code,
+			Method:  http.MethodPost,
+			Path:    w.tokenServer.Path,
+		})
+	}
+
 	// nolint
-	backoff.Retry(operation, backoffMethod)
+	backoff.RetryNotify(operation, backoffMethod, notify)
+
+	// Update the cool-down state:
+	if w.coolDown.record(ctx, err) {
+		w.logger.Warn(
+			ctx,
+			"Failed to get tokens %d consecutive times, will not try again for %s: %v",
+			w.coolDown.threshold, w.coolDown.period, err,
+		)
+	}
 	return access, refresh, err
 }
 
+// validTokens returns the current tokens if the access token doesn't expire or if it will remain
+// valid for at least the given time. It doesn't lock or send any request, so it is safe to call it
+// very frequently from multiple goroutines.
+func (w *TransportWrapper) validTokens(minRemaining time.Duration) (access, refresh string,
+	ok bool) {
+	// A pull-secret access token can just be used as-is. Note that this is set only when the
+	// wrapper is created, so it doesn't need to be protected.
+	if w.pullSecretAccessToken != nil {
+		access = w.pullSecretAccessToken.text
+		ok = true
+		return
+	}
+
+	// Check the snapshot of the tokens:
+	state := w.tokenState.Load()
+	if state == nil || state.access == nil || !state.checkable {
+		return
+	}
+	if state.accessExpires && time.Until(state.accessExpiry) < minRemaining {
+		return
+	}
+	access = state.access.text
+	if state.refresh != nil {
+		refresh = state.refresh.text
+	}
+	ok = true
+	return
+}
+
 func (w *TransportWrapper) tokens(ctx context.Context, attempt int,
 	minRemaining time.Duration) (code int, access, refresh string, err error) {
 	// We need to make sure that this method isn't execute concurrently, as we will be updating
@@ -967,12 +1214,17 @@ func (w *TransportWrapper) sendFormTimed(ctx context.Context, form url.Values, h
 		return
 	}
 
-	// Read the response body:
-	body, err = io.ReadAll(response.Body)
+	// Read the response body, using a buffer from the shared pool because this happens very
+	// frequently in services that use many connections. Note that the body is only used to
+	// parse the response, so the buffer can be returned to the pool when this method finishes.
+	buffer := helpers.GetBuffer()
+	defer helpers.PutBuffer(buffer)
+	_, err = buffer.ReadFrom(response.Body)
 	if err != nil {
 		err = fmt.Errorf("can't read response: %w", err)
 		return
 	}
+	body = buffer.Bytes()
 
 	// Parse the response body:
 	result = &internal.TokenResponse{}
@@ -1015,6 +1267,13 @@ func (w *TransportWrapper) sendFormTimed(ctx context.Context, form url.Values, h
 	if err != nil {
 		return
 	}
+	if w.fips {
+		err = fips.CheckToken(accessTokenObject)
+		if err != nil {
+			err = fmt.Errorf("access token can't be used: %w", err)
+			return
+		}
+	}
 	if accessTokenText != "" {
 		accessToken = &tokenInfo{
 			text:   accessTokenText,
@@ -1061,6 +1320,10 @@ func (w *TransportWrapper) sendFormTimed(ctx context.Context, form url.Values, h
 	if refreshToken != nil {
 		w.refreshToken = refreshToken
 	}
+	state := newTokenState(w.accessToken, w.refreshToken)
+	state.grantType = form.Get(grantTypeField)
+	state.refreshed = time.Now()
+	w.tokenState.Store(state)
 
 	return
 }
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains benchmarks for the transport wrapper.
+
+package authentication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega" // nolint
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+// BenchmarkTokens measures the cost of obtaining the tokens when the access token is still valid,
+// which is what happens for almost every request sent by a connection. Run it with the `-cpu`
+// flag to check that there is no contention when it is called from multiple goroutines:
+//
+//	go test -run=^$ -bench=Tokens -cpu=1,4,16 ./authentication
+func BenchmarkTokens(b *testing.B) {
+	// The helpers that generate the tokens use Gomega assertions:
+	RegisterTestingT(b)
+
+	ctx := context.Background()
+	logger, err := logging.NewGoLoggerBuilder().
+		Debug(false).
+		Build()
+	if err != nil {
+		b.Fatal(err)
+	}
+	wrapper, err := NewTransportWrapper().
+		Logger(logger).
+		Tokens(MakeTokenString("Bearer", time.Hour)).
+		Build(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() {
+		err := wrapper.Close()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _, err := wrapper.Tokens(ctx)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
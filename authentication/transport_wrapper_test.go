@@ -38,6 +38,8 @@ import (
 	. "github.com/onsi/gomega"                         // nolint
 	. "github.com/onsi/gomega/ghttp"                   // nolint
 	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	"github.com/openshift-online/ocm-sdk-go/retry"
 )
 
 var _ = Describe("Tokens", func() {
@@ -1355,6 +1357,53 @@ var _ = Describe("Tokens", func() {
 			Expect(returnedRefresh).ToNot(BeEmpty())
 		})
 
+		It("Reports retries to the handler", func() {
+			// Generate tokens:
+			refreshToken := MakeTokenString("Refresh", 10*time.Hour)
+			accessToken := MakeTokenString("Bearer", 5*time.Minute)
+
+			server.AppendHandlers(
+				RespondWithContent(
+					http.StatusServiceUnavailable,
+					"text/plain",
+					"Service Unavailable",
+				),
+				CombineHandlers(
+					VerifyRefreshGrant(refreshToken),
+					RespondWithAccessAndRefreshTokens(accessToken, refreshToken),
+				),
+			)
+
+			// Create the wrapper:
+			var events []*retry.Event
+			wrapper, err := NewTransportWrapper().
+				Logger(logger).
+				TokenURL(server.URL()).
+				TrustedCA(ca).
+				Tokens(refreshToken).
+				RetryHandler(func(ctx context.Context, event *retry.Event) {
+					events = append(events, event)
+				}).
+				Build(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			defer func() {
+				err = wrapper.Close()
+				Expect(err).ToNot(HaveOccurred())
+			}()
+
+			// Get the tokens:
+			_, _, err = wrapper.Tokens(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Check the events:
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Attempt).To(Equal(1))
+			Expect(events[0].Status).To(Equal(http.StatusServiceUnavailable))
+			Expect(events[0].Method).To(Equal(http.MethodPost))
+			Expect(events[0].Cause).To(HaveOccurred())
+			Expect(events[0].Delay).To(BeNumerically(">", 0))
+		})
+
 		It("Test no retry when status is not http 5xx", func() {
 			// Generate tokens:
 			refreshToken := MakeTokenString("Refresh", 10*time.Hour)
@@ -1484,32 +1533,3 @@ func makeTestPullSecretToken() string {
 	dummyTokenText := base64.StdEncoding.EncodeToString([]byte("abcdefghijklmnopqrstuvwxyz"))
 	return fmt.Sprintf("%s:%s", id, dummyTokenText)
 }
-
-func VerifyPasswordGrant(user, password string) http.HandlerFunc {
-	return CombineHandlers(
-		VerifyRequest(http.MethodPost, "/"),
-		VerifyContentType("application/x-www-form-urlencoded"),
-		VerifyFormKV("grant_type", "password"),
-		VerifyFormKV("username", user),
-		VerifyFormKV("password", password),
-	)
-}
-
-func VerifyClientCredentialsGrant(id, secret string) http.HandlerFunc {
-	return CombineHandlers(
-		VerifyRequest(http.MethodPost, "/"),
-		VerifyContentType("application/x-www-form-urlencoded"),
-		VerifyBasicAuth(id, secret),
-		VerifyFormKV("grant_type", "client_credentials"),
-		VerifyFormKV("client_id", id),
-	)
-}
-
-func VerifyRefreshGrant(refreshToken string) http.HandlerFunc {
-	return CombineHandlers(
-		VerifyRequest(http.MethodPost, "/"),
-		VerifyContentType("application/x-www-form-urlencoded"),
-		VerifyFormKV("grant_type", "refresh_token"),
-		VerifyFormKV("refresh_token", refreshToken),
-	)
-}
@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the function that sends many access reviews concurrently.
+
+package authorizations // github.com/openshift-online/ocm-sdk-go/authorizations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
+)
+
+// DefaultBatchConcurrency is the maximum number of access reviews that the BatchAccessReview
+// function sends simultaneously when no other value is given.
+const DefaultBatchConcurrency = 10
+
+// BatchOptions controls how a batch of reviews is sent.
+type BatchOptions struct {
+	// Concurrency is the maximum number of reviews sent simultaneously. Zero means the
+	// DefaultBatchConcurrency value.
+	Concurrency int
+}
+
+// BatchAccessReviewResult is the result of one of the questions of a batch of access reviews.
+type BatchAccessReviewResult struct {
+	// Request is the question, as given to the BatchAccessReview function.
+	Request *v1.AccessReviewRequest
+
+	// Allowed indicates if the action is allowed. It is false when Err is set.
+	Allowed bool
+
+	// Reason is the explanation returned by the server, if any.
+	Reason string
+
+	// Err is the error that prevented answering the question, if any.
+	Err error
+}
+
+// BatchAccessReview answers many access review questions, returning the results in the same order
+// as the requests. The authorizations service doesn't have a batch endpoint, so identical
+// questions are sent only once and the rest are sent concurrently, with at most the number of
+// simultaneous requests given in the options. Requests without account user name are sent to the
+// self access review endpoint, so they are answered for the user that is authenticated to the
+// server. A failed question doesn't stop the others; its error is stored in the corresponding
+// result. The returned error is only used for problems with the batch itself, like a cancelled
+// context.
+func BatchAccessReview(ctx context.Context, client *v1.Client,
+	requests []*v1.AccessReviewRequest,
+	options *BatchOptions) (results []*BatchAccessReviewResult, err error) {
+	if client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	concurrency := DefaultBatchConcurrency
+	if options != nil && options.Concurrency != 0 {
+		concurrency = options.Concurrency
+	}
+	if concurrency < 0 {
+		err = fmt.Errorf("concurrency should be positive, but it is %d", concurrency)
+		return
+	}
+
+	// Group identical questions, so that each is sent only once:
+	type question struct {
+		request *v1.AccessReviewRequest
+		indexes []int
+		result  BatchAccessReviewResult
+	}
+	var questions []*question
+	index := map[accessReviewKey]*question{}
+	for i, request := range requests {
+		if request == nil {
+			err = fmt.Errorf("request %d is nil", i)
+			return
+		}
+		key := makeAccessReviewKey(request)
+		item, ok := index[key]
+		if !ok {
+			item = &question{
+				request: request,
+			}
+			index[key] = item
+			questions = append(questions, item)
+		}
+		item.indexes = append(item.indexes, i)
+	}
+
+	// Send the questions using a fixed number of workers:
+	queue := make(chan *question)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(questions); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range queue {
+				item.result.Allowed, item.result.Reason, item.result.Err = sendAccessReview(
+					ctx, client, item.request,
+				)
+			}
+		}()
+	}
+	for _, item := range questions {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- item
+	}
+	close(queue)
+	wg.Wait()
+	if ctx.Err() != nil {
+		err = fmt.Errorf("can't complete batch of access reviews: %w", ctx.Err())
+		return
+	}
+
+	// Copy the results to the positions of the requests:
+	results = make([]*BatchAccessReviewResult, len(requests))
+	for _, item := range questions {
+		for _, i := range item.indexes {
+			result := item.result
+			result.Request = requests[i]
+			results[i] = &result
+		}
+	}
+	return
+}
+
+func sendAccessReview(ctx context.Context, client *v1.Client,
+	request *v1.AccessReviewRequest) (allowed bool, reason string, err error) {
+	if request.AccountUsername() == "" {
+		var self *v1.SelfAccessReviewRequest
+		self, err = v1.NewSelfAccessReviewRequest().
+			Action(request.Action()).
+			ResourceType(request.ResourceType()).
+			OrganizationID(request.OrganizationID()).
+			SubscriptionID(request.SubscriptionID()).
+			ClusterID(request.ClusterID()).
+			ClusterUUID(request.ClusterUUID()).
+			Build()
+		if err != nil {
+			return
+		}
+		var response *v1.SelfAccessReviewPostResponse
+		response, err = client.SelfAccessReview().Post().Request(self).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't review access to action '%s': %w", request.Action(), err)
+			return
+		}
+		allowed = response.Response().Allowed()
+		return
+	}
+	response, err := client.AccessReview().Post().Request(request).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't review access of user '%s' to action '%s': %w",
+			request.AccountUsername(), request.Action(), err,
+		)
+		return
+	}
+	allowed = response.Response().Allowed()
+	reason = response.Response().Reason()
+	return
+}
+
+// accessReviewKey contains the attributes that identify an access review question.
+type accessReviewKey struct {
+	user           string
+	action         string
+	resourceType   string
+	organizationID string
+	subscriptionID string
+	clusterID      string
+	clusterUUID    string
+}
+
+func makeAccessReviewKey(request *v1.AccessReviewRequest) accessReviewKey {
+	return accessReviewKey{
+		user:           request.AccountUsername(),
+		action:         request.Action(),
+		resourceType:   request.ResourceType(),
+		organizationID: request.OrganizationID(),
+		subscriptionID: request.SubscriptionID(),
+		clusterID:      request.ClusterID(),
+		clusterUUID:    request.ClusterUUID(),
+	}
+}
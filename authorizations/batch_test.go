@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorizations
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	v1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
+)
+
+var _ = Describe("Batch access review", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var client *v1.Client
+
+	// makeRequest creates an access review request for the given user, action and cluster.
+	makeRequest := func(user, action, cluster string) *v1.AccessReviewRequest {
+		request, err := v1.NewAccessReviewRequest().
+			AccountUsername(user).
+			Action(action).
+			ResourceType("Cluster").
+			ClusterID(cluster).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return request
+	}
+
+	// allowCluster creates a handler that allows the actions on the given cluster and denies
+	// the rest.
+	allowCluster := func(allowed string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			body, err := io.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			var request struct {
+				ClusterID string `json:"cluster_id"`
+			}
+			err = json.Unmarshal(body, &request)
+			Expect(err).ToNot(HaveOccurred())
+			response, err := json.Marshal(map[string]interface{}{
+				"allowed": request.ClusterID == allowed,
+				"reason":  "because",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			w.Header().Set("Content-Type", "application/json")
+			_, err = w.Write(response)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = MakeTCPServer()
+		client = v1.NewClient(ServerTransport(server), "/api/authorizations/v1")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Returns the results in the order of the requests", func() {
+		server.RouteToHandler(
+			http.MethodPost,
+			"/api/authorizations/v1/access_review",
+			allowCluster("b"),
+		)
+		requests := []*v1.AccessReviewRequest{
+			makeRequest("joe", "get", "a"),
+			makeRequest("joe", "get", "b"),
+			makeRequest("joe", "get", "c"),
+		}
+		results, err := BatchAccessReview(ctx, client, requests, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(3))
+		for i, result := range results {
+			Expect(result.Request).To(BeIdenticalTo(requests[i]))
+			Expect(result.Err).ToNot(HaveOccurred())
+			Expect(result.Reason).To(Equal("because"))
+		}
+		Expect(results[0].Allowed).To(BeFalse())
+		Expect(results[1].Allowed).To(BeTrue())
+		Expect(results[2].Allowed).To(BeFalse())
+	})
+
+	It("Sends identical questions only once", func() {
+		server.RouteToHandler(
+			http.MethodPost,
+			"/api/authorizations/v1/access_review",
+			allowCluster("a"),
+		)
+		results, err := BatchAccessReview(ctx, client, []*v1.AccessReviewRequest{
+			makeRequest("joe", "get", "a"),
+			makeRequest("joe", "get", "a"),
+			makeRequest("joe", "delete", "a"),
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].Allowed).To(BeTrue())
+		Expect(results[1].Allowed).To(BeTrue())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Uses the self review when there is no user", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/authorizations/v1/self_access_review",
+				),
+				VerifyJQ(".action", "get"),
+				VerifyJQ(".cluster_id", "a"),
+				RespondWithJSON(http.StatusOK, `{
+					"allowed": true
+				}`),
+			),
+		)
+		results, err := BatchAccessReview(ctx, client, []*v1.AccessReviewRequest{
+			makeRequest("", "get", "a"),
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results[0].Allowed).To(BeTrue())
+	})
+
+	It("Stores errors in the results", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusInternalServerError, `{
+				"kind": "Error",
+				"reason": "Boom"
+			}`),
+		)
+		results, err := BatchAccessReview(ctx, client, []*v1.AccessReviewRequest{
+			makeRequest("joe", "get", "a"),
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results[0].Allowed).To(BeFalse())
+		Expect(results[0].Err).To(HaveOccurred())
+		Expect(results[0].Err.Error()).To(ContainSubstring(
+			"can't review access of user 'joe' to action 'get'",
+		))
+	})
+
+	It("Limits the number of simultaneous requests", func() {
+		var current, peak int32
+		server.RouteToHandler(
+			http.MethodPost,
+			"/api/authorizations/v1/access_review",
+			func(w http.ResponseWriter, r *http.Request) {
+				value := atomic.AddInt32(&current, 1)
+				defer atomic.AddInt32(&current, -1)
+				for {
+					old := atomic.LoadInt32(&peak)
+					if value <= old || atomic.CompareAndSwapInt32(&peak, old, value) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"allowed": true}`))
+			},
+		)
+		requests := make([]*v1.AccessReviewRequest, 10)
+		for i := range requests {
+			requests[i] = makeRequest("joe", "get", string(rune('a'+i)))
+		}
+		results, err := BatchAccessReview(ctx, client, requests, &BatchOptions{
+			Concurrency: 3,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(10))
+		Expect(atomic.LoadInt32(&peak)).To(BeNumerically("<=", 3))
+		Expect(server.ReceivedRequests()).To(HaveLen(10))
+	})
+
+	It("Rejects nil requests", func() {
+		_, err := BatchAccessReview(ctx, client, []*v1.AccessReviewRequest{nil}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("request 0 is nil"))
+	})
+})
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types used to add custom authorization details to requests.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Authorizer is the interface implemented by objects that add authorization details to requests, in
+// addition to the bearer token added by the connection. For example, an authorizer can sign
+// requests:
+//
+//	type signer struct {
+//		key []byte
+//	}
+//
+//	func (s *signer) Authorize(ctx context.Context, request *http.Request) error {
+//		date := time.Now().UTC().Format(http.TimeFormat)
+//		mac := hmac.New(sha256.New, s.key)
+//		fmt.Fprintf(mac, "%s\n%s\n%s", request.Method, request.URL.Path, date)
+//		request.Header.Set("Date", date)
+//		request.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+//		return nil
+//	}
+//
+// The authorizer is called for each attempt to send a request, after the bearer token has been
+// added and after waiting for the rate limiter, if any, so signatures that include the time are
+// fresh even when the request is retried. If the authorizer reads the body of the request it must
+// replace it with an equivalent one. If it returns an error the request isn't sent and the error is
+// returned to the caller.
+type Authorizer interface {
+	Authorize(ctx context.Context, request *http.Request) error
+}
+
+// AuthorizerFunc is an adapter that allows using ordinary functions as authorizers.
+type AuthorizerFunc func(ctx context.Context, request *http.Request) error
+
+// Authorize calls the function.
+func (f AuthorizerFunc) Authorize(ctx context.Context, request *http.Request) error {
+	return f(ctx, request)
+}
+
+// authorizerRoundTripper is a round tripper that calls a list of authorizers before sending each
+// request.
+type authorizerRoundTripper struct {
+	authorizers []Authorizer
+	transport   http.RoundTripper
+}
+
+// Make sure that we implement the interface:
+var _ http.RoundTripper = (*authorizerRoundTripper)(nil)
+
+// wrapAuthorizers returns a transport wrapper that calls the given authorizers, or nil if the list
+// is empty.
+func wrapAuthorizers(authorizers []Authorizer) func(http.RoundTripper) http.RoundTripper {
+	if len(authorizers) == 0 {
+		return nil
+	}
+	return func(transport http.RoundTripper) http.RoundTripper {
+		return &authorizerRoundTripper{
+			authorizers: authorizers,
+			transport:   transport,
+		}
+	}
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *authorizerRoundTripper) RoundTrip(request *http.Request) (response *http.Response,
+	err error) {
+	// Round trippers must not modify the original request, so work with a copy:
+	ctx := request.Context()
+	request = request.Clone(ctx)
+	for _, authorizer := range t.authorizers {
+		err = authorizer.Authorize(ctx, request)
+		if err != nil {
+			if request.Body != nil {
+				request.Body.Close()
+			}
+			err = fmt.Errorf("can't authorize request: %w", err)
+			return
+		}
+	}
+	response, err = t.transport.RoundTrip(request)
+	return
+}
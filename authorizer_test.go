@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the authorizers.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Authorizer", func() {
+	var (
+		ctx    context.Context
+		token  string
+		server *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		token = MakeTokenString("Bearer", 5*time.Minute)
+		server = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	// makeConnection creates a connection that uses the given authorizers.
+	makeConnection := func(authorizers ...Authorizer) *Connection {
+		builder := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			RetryInterval(10 * time.Millisecond)
+		for _, authorizer := range authorizers {
+			builder.Authorizer(authorizer)
+		}
+		connection, err := builder.Build()
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(connection.Close)
+		return connection
+	}
+
+	It("Adds headers after the bearer token", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "Bearer "+token),
+				ghttp.VerifyHeaderKV("X-Signature", "GET /api/clusters_mgmt/v1/clusters"),
+				ghttp.VerifyHeaderKV("X-Second", "yes"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Send the request:
+		first := AuthorizerFunc(func(ctx context.Context, request *http.Request) error {
+			Expect(request.Header.Get("Authorization")).To(Equal("Bearer " + token))
+			request.Header.Set("X-Signature", request.Method+" "+request.URL.Path)
+			return nil
+		})
+		second := AuthorizerFunc(func(ctx context.Context, request *http.Request) error {
+			Expect(request.Header.Get("X-Signature")).ToNot(BeEmpty())
+			request.Header.Set("X-Second", "yes")
+			return nil
+		})
+		_, err := makeConnection(first, second).Get().
+			Path("/api/clusters_mgmt/v1/clusters").
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Is called for each retry", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("X-Attempt", "1"),
+				RespondWithJSON(http.StatusServiceUnavailable, `{}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("X-Attempt", "2"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Send the request:
+		count := 0
+		authorizer := AuthorizerFunc(func(ctx context.Context, request *http.Request) error {
+			count++
+			request.Header.Set("X-Attempt", string(rune('0'+count)))
+			return nil
+		})
+		_, err := makeConnection(authorizer).Get().
+			Path("/api/clusters_mgmt/v1/clusters").
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(2))
+	})
+
+	It("Doesn't send the request if it fails", func() {
+		failure := errors.New("no key")
+		authorizer := AuthorizerFunc(func(ctx context.Context, request *http.Request) error {
+			return failure
+		})
+		_, err := makeConnection(authorizer).Get().
+			Path("/api/clusters_mgmt/v1/clusters").
+			SendContext(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, failure)).To(BeTrue())
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that check if users can provision resources according to the
+// export control and terms and conditions rules, explaining the reasons when they can't.
+
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	azv1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
+)
+
+// ComplianceReasonCode identifies the reason why a compliance review denied an action.
+type ComplianceReasonCode string
+
+const (
+	// ExportControlRestricted indicates that the user is restricted by export control rules.
+	ExportControlRestricted ComplianceReasonCode = "ExportControlRestricted"
+
+	// TermsRequired indicates that the user must accept terms and conditions first.
+	TermsRequired ComplianceReasonCode = "TermsRequired"
+
+	// AccessDenied indicates that the user isn't allowed to perform the action on the
+	// resource.
+	AccessDenied ComplianceReasonCode = "AccessDenied"
+)
+
+// ComplianceReason explains why a compliance review denied an action.
+type ComplianceReason struct {
+	// Code identifies the reason.
+	Code ComplianceReasonCode
+
+	// Message is a human readable description of the reason, suitable for end users.
+	Message string
+
+	// RedirectURL is the address of a page where the user can resolve the problem, for example
+	// to accept the terms. It is empty when there is no such page.
+	RedirectURL string
+}
+
+// ComplianceDecision is the result of a compliance review.
+type ComplianceDecision struct {
+	// Allowed indicates if the user can perform the action.
+	Allowed bool
+
+	// Reasons contains the reasons why the action was denied. It is empty when it is allowed.
+	Reasons []*ComplianceReason
+}
+
+// Err returns nil if the action is allowed, or a *ComplianceError containing the reasons if it
+// is denied.
+func (d *ComplianceDecision) Err() error {
+	if d.Allowed {
+		return nil
+	}
+	return &ComplianceError{
+		Reasons: d.Reasons,
+	}
+}
+
+// ComplianceError is the error returned by the Err method of a denied compliance decision.
+type ComplianceError struct {
+	// Reasons contains the reasons why the action was denied.
+	Reasons []*ComplianceReason
+}
+
+// Error is the implementation of the error interface.
+func (e *ComplianceError) Error() string {
+	messages := make([]string, len(e.Reasons))
+	for i, reason := range e.Reasons {
+		messages[i] = reason.Message
+	}
+	return "action isn't allowed: " + strings.Join(messages, "; ")
+}
+
+// Has returns true if the error contains a reason with the given code.
+func (e *ComplianceError) Has(code ComplianceReasonCode) bool {
+	for _, reason := range e.Reasons {
+		if reason.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// ReviewExportControl checks if the given user is restricted by export control rules.
+func ReviewExportControl(ctx context.Context, client *azv1.Client,
+	user string) (restricted bool, err error) {
+	if user == "" {
+		err = errors.New("user is mandatory")
+		return
+	}
+	request, err := azv1.NewExportControlReviewRequest().
+		AccountUsername(user).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := client.ExportControlReview().Post().Request(request).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't review export control of user '%s': %w", user, err)
+		return
+	}
+	restricted = response.Response().Restricted()
+	return
+}
+
+// ReviewCompliance checks if the given user can perform the given action on the given resource,
+// collecting all the reasons why it can't instead of stopping at the first one. It checks the
+// export control rules, the terms that the user must accept for the default registration event,
+// and, if the action isn't empty, the access review. For example:
+//
+//	decision, err := authz.ReviewCompliance(ctx, client, "joe", "create", &authz.Resource{
+//		Type:           "Cluster",
+//		OrganizationID: orgID,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	if !decision.Allowed {
+//		return decision.Err()
+//	}
+func ReviewCompliance(ctx context.Context, client *azv1.Client, user, action string,
+	resource *Resource) (result *ComplianceDecision, err error) {
+	restricted, err := ReviewExportControl(ctx, client, user)
+	if err != nil {
+		return
+	}
+	decision := &ComplianceDecision{}
+	if restricted {
+		decision.Reasons = append(decision.Reasons, &ComplianceReason{
+			Code:    ExportControlRestricted,
+			Message: fmt.Sprintf("user '%s' is restricted by export control rules", user),
+		})
+	}
+	terms, err := ReviewTerms(ctx, client, user, "", "", false)
+	if err != nil {
+		return
+	}
+	if terms.Required {
+		decision.Reasons = append(decision.Reasons, &ComplianceReason{
+			Code:        TermsRequired,
+			Message:     fmt.Sprintf("user '%s' must accept the terms and conditions", user),
+			RedirectURL: terms.RedirectURL,
+		})
+	}
+	if action != "" {
+		if resource == nil {
+			resource = &Resource{}
+		}
+		reviewer := &Reviewer{
+			client: client,
+		}
+		var allowed bool
+		allowed, err = reviewer.sendAccessReview(ctx, user, action, resource)
+		if err != nil {
+			return
+		}
+		if !allowed {
+			decision.Reasons = append(decision.Reasons, &ComplianceReason{
+				Code: AccessDenied,
+				Message: fmt.Sprintf(
+					"user '%s' isn't allowed to perform action '%s' on resource "+
+						"type '%s'",
+					user, action, resource.Type,
+				),
+			})
+		}
+	}
+	decision.Allowed = len(decision.Reasons) == 0
+	result = decision
+	return
+}
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authz
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Compliance", func() {
+	It("Sends export control review", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/authorizations/v1/export_control_review",
+				),
+				VerifyJQ(".account_username", "joe"),
+				RespondWithJSON(http.StatusOK, `{
+					"restricted": true
+				}`),
+			),
+		)
+		restricted, err := ReviewExportControl(ctx, client, "joe")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restricted).To(BeTrue())
+	})
+
+	It("Allows when all the reviews pass", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"restricted": false
+			}`),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/terms_review"),
+				VerifyJQ(".account_username", "joe"),
+				RespondWithJSON(http.StatusOK, `{
+					"terms_required": false
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/access_review"),
+				VerifyJQ(".action", "create"),
+				VerifyJQ(".resource_type", "Cluster"),
+				RespondWithJSON(http.StatusOK, `{
+					"allowed": true
+				}`),
+			),
+		)
+		decision, err := ReviewCompliance(ctx, client, "joe", "create", &Resource{
+			Type: "Cluster",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allowed).To(BeTrue())
+		Expect(decision.Reasons).To(BeEmpty())
+		Expect(decision.Err()).ToNot(HaveOccurred())
+	})
+
+	It("Collects all the reasons", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"restricted": true
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"terms_required": true,
+				"redirect_url": "https://example.com/terms"
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"allowed": false
+			}`),
+		)
+		decision, err := ReviewCompliance(ctx, client, "joe", "create", &Resource{
+			Type: "Cluster",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allowed).To(BeFalse())
+		Expect(decision.Reasons).To(HaveLen(3))
+		Expect(decision.Reasons[0].Code).To(Equal(ExportControlRestricted))
+		Expect(decision.Reasons[1].Code).To(Equal(TermsRequired))
+		Expect(decision.Reasons[1].RedirectURL).To(Equal("https://example.com/terms"))
+		Expect(decision.Reasons[2].Code).To(Equal(AccessDenied))
+		err = decision.Err()
+		var complianceErr *ComplianceError
+		Expect(errors.As(err, &complianceErr)).To(BeTrue())
+		Expect(complianceErr.Has(TermsRequired)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("restricted by export control rules"))
+		Expect(err.Error()).To(ContainSubstring("must accept the terms and conditions"))
+	})
+
+	It("Doesn't send access review without action", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"restricted": false
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"terms_required": false
+			}`),
+		)
+		decision, err := ReviewCompliance(ctx, client, "joe", "", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allowed).To(BeTrue())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Returns review errors", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusInternalServerError, `{
+				"kind": "Error",
+				"reason": "Boom"
+			}`),
+		)
+		_, err := ReviewCompliance(ctx, client, "joe", "create", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't review export control of user 'joe'"))
+	})
+})
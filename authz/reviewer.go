@@ -0,0 +1,315 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the reviewer that checks if users are allowed to perform actions.
+
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	azv1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Resource identifies the resource that an access or capability review refers to. Fields that are
+// empty aren't sent to the server.
+type Resource struct {
+	// Type is the type of the resource, for example `Cluster` or `Subscription`.
+	Type string
+
+	// OrganizationID is the identifier of the organization that owns the resource.
+	OrganizationID string
+
+	// SubscriptionID is the identifier of the subscription.
+	SubscriptionID string
+
+	// ClusterID is the identifier of the cluster.
+	ClusterID string
+}
+
+// ReviewerBuilder contains the data and logic needed to build a reviewer.
+type ReviewerBuilder struct {
+	logger logging.Logger
+	client *azv1.Client
+	ttl    time.Duration
+}
+
+// Reviewer checks if users are allowed to perform actions and if they have capabilities, using the
+// review endpoints of the authorizations service. The results are cached in memory for the
+// configured time to live, so that repeated checks don't send requests to the server. Errors
+// aren't cached. It is safe to use it from multiple goroutines simultaneously.
+type Reviewer struct {
+	logger logging.Logger
+	client *azv1.Client
+	cache  *cache
+}
+
+// NewReviewer creates a builder that can then be used to configure and create a reviewer.
+func NewReviewer() *ReviewerBuilder {
+	return &ReviewerBuilder{
+		ttl: defaultTTL,
+	}
+}
+
+// Logger sets the logger that the reviewer will use to write to the log. This is mandatory.
+func (b *ReviewerBuilder) Logger(value logging.Logger) *ReviewerBuilder {
+	b.logger = value
+	return b
+}
+
+// Client sets the authorizations client that the reviewer will use to send the reviews to the
+// server. This is mandatory.
+func (b *ReviewerBuilder) Client(value *azv1.Client) *ReviewerBuilder {
+	b.client = value
+	return b
+}
+
+// TTL sets the time that the results of the reviews will be kept in memory. A zero value disables
+// caching. The default value is five minutes.
+func (b *ReviewerBuilder) TTL(value time.Duration) *ReviewerBuilder {
+	b.ttl = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new reviewer.
+func (b *ReviewerBuilder) Build() (result *Reviewer, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	if b.ttl < 0 {
+		err = fmt.Errorf("time to live should be zero or positive, but it is %s", b.ttl)
+		return
+	}
+
+	// Create and populate the object:
+	result = &Reviewer{
+		logger: b.logger,
+		client: b.client,
+		cache:  newCache(b.ttl),
+	}
+	return
+}
+
+// CanUserPerform checks if the given user is allowed to perform the given action on the given
+// resource. If the user is empty the check is done for the user that is authenticated to the
+// server, using the self access review endpoint.
+func (r *Reviewer) CanUserPerform(ctx context.Context, user, action string,
+	resource *Resource) (result bool, err error) {
+	return r.review(ctx, nil, kindAccess, user, action, resource)
+}
+
+// HasCapability checks if the given user has the given capability for the given resource. If the
+// user is empty the check is done for the user that is authenticated to the server, using the
+// self capability review endpoint. The type of the capability review, for example `Cluster` or
+// `Organization`, is taken from the type of the resource.
+func (r *Reviewer) HasCapability(ctx context.Context, user, capability string,
+	resource *Resource) (result bool, err error) {
+	return r.review(ctx, nil, kindCapability, user, capability, resource)
+}
+
+// Flush removes all the results from the cache.
+func (r *Reviewer) Flush() {
+	r.cache.flush()
+}
+
+// CanUserPerform checks if the given user is allowed to perform the given action on the given
+// resource, using the given client. The results are cached in a cache shared by all the callers of
+// this function that use the same client, with a time to live of five minutes. Use a Reviewer if
+// you need to control the cache or to write the results to the log. For example:
+//
+//	client := connection.Authorizations().V1()
+//	allowed, err := authz.CanUserPerform(ctx, client, "joe", "create", &authz.Resource{
+//		Type:           "Cluster",
+//		OrganizationID: orgID,
+//	})
+func CanUserPerform(ctx context.Context, client *azv1.Client, user, action string,
+	resource *Resource) (result bool, err error) {
+	if client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	reviewer := &Reviewer{
+		client: client,
+		cache:  defaultCache,
+	}
+	return reviewer.review(ctx, client, kindAccess, user, action, resource)
+}
+
+func (r *Reviewer) review(ctx context.Context, owner interface{}, kind, user, name string,
+	resource *Resource) (result bool, err error) {
+	if resource == nil {
+		resource = &Resource{}
+	}
+	key := cacheKey{
+		owner:    owner,
+		kind:     kind,
+		user:     user,
+		name:     name,
+		resource: *resource,
+	}
+	result, ok := r.cache.get(key)
+	if ok {
+		return
+	}
+	switch kind {
+	case kindAccess:
+		result, err = r.sendAccessReview(ctx, user, name, resource)
+	case kindCapability:
+		result, err = r.sendCapabilityReview(ctx, user, name, resource)
+	}
+	if err != nil {
+		return
+	}
+	if r.logger != nil {
+		r.logger.Debug(
+			ctx,
+			"Review of %s '%s' for user '%s' and resource type '%s' returned %t",
+			kind, name, user, resource.Type, result,
+		)
+	}
+	r.cache.put(key, result)
+	return
+}
+
+func (r *Reviewer) sendAccessReview(ctx context.Context, user, action string,
+	resource *Resource) (result bool, err error) {
+	if user == "" {
+		var request *azv1.SelfAccessReviewRequest
+		request, err = azv1.NewSelfAccessReviewRequest().
+			Action(action).
+			ResourceType(resource.Type).
+			OrganizationID(resource.OrganizationID).
+			SubscriptionID(resource.SubscriptionID).
+			ClusterID(resource.ClusterID).
+			Build()
+		if err != nil {
+			return
+		}
+		var response *azv1.SelfAccessReviewPostResponse
+		response, err = r.client.SelfAccessReview().Post().Request(request).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't review access to action '%s': %w", action, err)
+			return
+		}
+		result = response.Response().Allowed()
+		return
+	}
+	request, err := azv1.NewAccessReviewRequest().
+		AccountUsername(user).
+		Action(action).
+		ResourceType(resource.Type).
+		OrganizationID(resource.OrganizationID).
+		SubscriptionID(resource.SubscriptionID).
+		ClusterID(resource.ClusterID).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := r.client.AccessReview().Post().Request(request).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't review access of user '%s' to action '%s': %w",
+			user, action, err,
+		)
+		return
+	}
+	result = response.Response().Allowed()
+	return
+}
+
+func (r *Reviewer) sendCapabilityReview(ctx context.Context, user, capability string,
+	resource *Resource) (result bool, err error) {
+	var text string
+	if user == "" {
+		var request *azv1.SelfCapabilityReviewRequest
+		request, err = azv1.NewSelfCapabilityReviewRequest().
+			Capability(capability).
+			Type(resource.Type).
+			OrganizationID(resource.OrganizationID).
+			SubscriptionID(resource.SubscriptionID).
+			ClusterID(resource.ClusterID).
+			Build()
+		if err != nil {
+			return
+		}
+		var response *azv1.SelfCapabilityReviewPostResponse
+		response, err = r.client.SelfCapabilityReview().Post().Request(request).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't review capability '%s': %w", capability, err)
+			return
+		}
+		text = response.Response().Result()
+	} else {
+		var request *azv1.CapabilityReviewRequest
+		request, err = azv1.NewCapabilityReviewRequest().
+			AccountUsername(user).
+			Capability(capability).
+			Type(resource.Type).
+			OrganizationID(resource.OrganizationID).
+			SubscriptionID(resource.SubscriptionID).
+			ClusterID(resource.ClusterID).
+			Build()
+		if err != nil {
+			return
+		}
+		var response *azv1.CapabilityReviewPostResponse
+		response, err = r.client.CapabilityReview().Post().Request(request).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't review capability '%s' of user '%s': %w",
+				capability, user, err,
+			)
+			return
+		}
+		text = response.Response().Result()
+	}
+	if text == "" {
+		return
+	}
+	result, err = strconv.ParseBool(text)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't parse result '%s' of review of capability '%s': %w",
+			text, capability, err,
+		)
+	}
+	return
+}
+
+// defaultCache is the cache used by the CanUserPerform function.
+var defaultCache = newCache(defaultTTL)
+
+// Kinds of reviews:
+const (
+	kindAccess     = "action"
+	kindCapability = "capability"
+)
+
+// Default values:
+const (
+	defaultTTL = 5 * time.Minute
+)
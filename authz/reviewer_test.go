@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authz
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Reviewer", func() {
+	var reviewer *Reviewer
+
+	BeforeEach(func() {
+		var err error
+		reviewer, err = NewReviewer().
+			Logger(logger).
+			Client(client).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Can't be created without a client", func() {
+		_, err := NewReviewer().Logger(logger).Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("client"))
+	})
+
+	It("Sends access review for other user", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/access_review"),
+				VerifyJQ(".account_username", "joe"),
+				VerifyJQ(".action", "create"),
+				VerifyJQ(".resource_type", "Cluster"),
+				VerifyJQ(".organization_id", "123"),
+				RespondWithJSON(http.StatusOK, `{
+					"allowed": true
+				}`),
+			),
+		)
+		allowed, err := reviewer.CanUserPerform(ctx, "joe", "create", &Resource{
+			Type:           "Cluster",
+			OrganizationID: "123",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("Sends self access review if user is empty", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/self_access_review"),
+				VerifyJQ(".action", "delete"),
+				RespondWithJSON(http.StatusOK, `{
+					"allowed": false
+				}`),
+			),
+		)
+		allowed, err := reviewer.CanUserPerform(ctx, "", "delete", &Resource{
+			Type: "Cluster",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	It("Caches the results", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"allowed": true
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"allowed": false
+			}`),
+		)
+		resource := &Resource{
+			Type: "Cluster",
+		}
+		allowed, err := reviewer.CanUserPerform(ctx, "joe", "get", resource)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		allowed, err = reviewer.CanUserPerform(ctx, "joe", "get", resource)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+
+		// A different action isn't cached:
+		allowed, err = reviewer.CanUserPerform(ctx, "joe", "update", resource)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Sends the request again when the result expires", func() {
+		var err error
+		reviewer, err = NewReviewer().
+			Logger(logger).
+			Client(client).
+			TTL(10 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"allowed": true
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"allowed": false
+			}`),
+		)
+		allowed, err := reviewer.CanUserPerform(ctx, "joe", "get", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		time.Sleep(20 * time.Millisecond)
+		allowed, err = reviewer.CanUserPerform(ctx, "joe", "get", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	It("Doesn't cache errors", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusInternalServerError, `{
+				"kind": "Error",
+				"id": "500",
+				"href": "/api/authorizations/v1/errors/500",
+				"code": "AUTHZ-500",
+				"reason": "Internal error"
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"allowed": true
+			}`),
+		)
+		_, err := reviewer.CanUserPerform(ctx, "joe", "get", nil)
+		Expect(err).To(HaveOccurred())
+		allowed, err := reviewer.CanUserPerform(ctx, "joe", "get", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("Sends capability review", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/capability_review"),
+				VerifyJQ(".account_username", "joe"),
+				VerifyJQ(".capability", "manage_cluster_admin"),
+				VerifyJQ(".type", "Cluster"),
+				VerifyJQ(".cluster_id", "456"),
+				RespondWithJSON(http.StatusOK, `{
+					"result": "true"
+				}`),
+			),
+		)
+		result, err := reviewer.HasCapability(ctx, "joe", "manage_cluster_admin", &Resource{
+			Type:      "Cluster",
+			ClusterID: "456",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("Sends self capability review if user is empty", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/authorizations/v1/self_capability_review",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"result": "false"
+				}`),
+			),
+		)
+		result, err := reviewer.HasCapability(ctx, "", "manage_cluster_admin", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeFalse())
+	})
+})
+
+var _ = Describe("CanUserPerform", func() {
+	It("Shares the cache between calls with the same client", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/access_review"),
+				VerifyJQ(".account_username", "mary"),
+				RespondWithJSON(http.StatusOK, `{
+					"allowed": true
+				}`),
+			),
+		)
+		for i := 0; i < 2; i++ {
+			allowed, err := CanUserPerform(ctx, client, "mary", "get", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Can't be used without a client", func() {
+		_, err := CanUserPerform(ctx, nil, "mary", "get", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("client"))
+	})
+})
@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that check if accounts need to accept terms and conditions before
+// performing actions, and wait till they accept them.
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	azv1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
+)
+
+// Default values of the terms reviews:
+const (
+	// DefaultTermsEventCode is the event code used when none is given. It corresponds to the
+	// registration of the account, which is what onboarding flows need to check.
+	DefaultTermsEventCode = "register"
+
+	// DefaultTermsSiteCode is the site code used when none is given.
+	DefaultTermsSiteCode = "OCM"
+
+	// DefaultTermsPollInterval is the time between reviews used by the WaitForTermsAcceptance
+	// function when no interval is given.
+	DefaultTermsPollInterval = 5 * time.Second
+)
+
+// TermsReview is the result of checking if an account needs to accept terms.
+type TermsReview struct {
+	// Required indicates if the account must accept terms before performing the action.
+	Required bool
+
+	// Available indicates if there are terms that the account can accept, even if they aren't
+	// required.
+	Available bool
+
+	// RedirectURL is the address of the page where the user can accept the terms.
+	RedirectURL string
+
+	// AccountID is the identifier of the account that was reviewed.
+	AccountID string
+
+	// OrganizationID is the identifier of the organization of the account.
+	OrganizationID string
+}
+
+// ReviewSelfTerms checks if the account that is authenticated to the server must accept terms
+// before performing the action identified by the given event and site codes. Empty codes are
+// replaced by the defaults, which correspond to the registration of the account.
+func ReviewSelfTerms(ctx context.Context, client *azv1.Client, eventCode,
+	siteCode string) (result *TermsReview, err error) {
+	eventCode, siteCode = termsCodes(eventCode, siteCode)
+	request, err := azv1.NewSelfTermsReviewRequest().
+		EventCode(eventCode).
+		SiteCode(siteCode).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := client.SelfTermsReview().Post().Request(request).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't review terms for event '%s' and site '%s': %w",
+			eventCode, siteCode, err,
+		)
+		return
+	}
+	result = makeTermsReview(response.Response())
+	return
+}
+
+// ReviewTerms checks if the given user must accept terms before performing the action identified
+// by the given event and site codes. Empty codes are replaced by the defaults. If optional is true
+// the review also reports optional terms as available.
+func ReviewTerms(ctx context.Context, client *azv1.Client, user, eventCode, siteCode string,
+	optional bool) (result *TermsReview, err error) {
+	eventCode, siteCode = termsCodes(eventCode, siteCode)
+	request, err := azv1.NewTermsReviewRequest().
+		AccountUsername(user).
+		EventCode(eventCode).
+		SiteCode(siteCode).
+		CheckOptionalTerms(optional).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := client.TermsReview().Post().Request(request).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't review terms of user '%s' for event '%s' and site '%s': %w",
+			user, eventCode, siteCode, err,
+		)
+		return
+	}
+	result = makeTermsReview(response.Response())
+	return
+}
+
+// WaitForTermsAcceptance reviews the terms of the account that is authenticated to the server
+// repeatedly, till they aren't required anymore or the context is cancelled. It is intended for
+// onboarding flows that send the user to the redirect URL and then need to wait till the user
+// accepts the terms. When the context is cancelled the last review is returned together with the
+// error. A zero interval means the default of five seconds. For example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+//	defer cancel()
+//	review, err := authz.WaitForTermsAcceptance(ctx, client, "", "", 0)
+func WaitForTermsAcceptance(ctx context.Context, client *azv1.Client, eventCode,
+	siteCode string, interval time.Duration) (result *TermsReview, err error) {
+	if interval <= 0 {
+		interval = DefaultTermsPollInterval
+	}
+	for {
+		var review *TermsReview
+		review, err = ReviewSelfTerms(ctx, client, eventCode, siteCode)
+		if err != nil {
+			// If the context was cancelled during the review return the previous one, so
+			// that the caller still has the redirect URL:
+			if result != nil && ctx.Err() != nil {
+				err = fmt.Errorf("terms weren't accepted: %w", ctx.Err())
+			}
+			return
+		}
+		result = review
+		if !result.Required {
+			return
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = fmt.Errorf("terms weren't accepted: %w", ctx.Err())
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func termsCodes(eventCode, siteCode string) (string, string) {
+	if eventCode == "" {
+		eventCode = DefaultTermsEventCode
+	}
+	if siteCode == "" {
+		siteCode = DefaultTermsSiteCode
+	}
+	return eventCode, siteCode
+}
+
+func makeTermsReview(response *azv1.TermsReviewResponse) *TermsReview {
+	return &TermsReview{
+		Required:       response.TermsRequired(),
+		Available:      response.TermsAvailable(),
+		RedirectURL:    response.RedirectUrl(),
+		AccountID:      response.AccountId(),
+		OrganizationID: response.OrganizationID(),
+	}
+}
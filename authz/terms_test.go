@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authz
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Terms", func() {
+	It("Sends self review with the default codes", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/authorizations/v1/self_terms_review",
+				),
+				VerifyJQ(".event_code", "register"),
+				VerifyJQ(".site_code", "OCM"),
+				RespondWithJSON(http.StatusOK, `{
+					"account_id": "123",
+					"organization_id": "456",
+					"terms_required": true,
+					"terms_available": true,
+					"redirect_url": "https://example.com/terms"
+				}`),
+			),
+		)
+		review, err := ReviewSelfTerms(ctx, client, "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(review.Required).To(BeTrue())
+		Expect(review.Available).To(BeTrue())
+		Expect(review.RedirectURL).To(Equal("https://example.com/terms"))
+		Expect(review.AccountID).To(Equal("123"))
+		Expect(review.OrganizationID).To(Equal("456"))
+	})
+
+	It("Sends review for other user", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/terms_review"),
+				VerifyJQ(".account_username", "joe"),
+				VerifyJQ(".event_code", "onlineService"),
+				VerifyJQ(".site_code", "OCM"),
+				VerifyJQ(".check_optional_terms", true),
+				RespondWithJSON(http.StatusOK, `{
+					"terms_required": false,
+					"terms_available": true
+				}`),
+			),
+		)
+		review, err := ReviewTerms(ctx, client, "joe", "onlineService", "", true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(review.Required).To(BeFalse())
+		Expect(review.Available).To(BeTrue())
+	})
+
+	It("Waits till the terms are accepted", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"terms_required": true
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"terms_required": true
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"terms_required": false
+			}`),
+		)
+		review, err := WaitForTermsAcceptance(ctx, client, "", "", time.Millisecond)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(review.Required).To(BeFalse())
+		Expect(server.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("Stops waiting when the context is cancelled", func() {
+		server.RouteToHandler(
+			http.MethodPost,
+			"/api/authorizations/v1/self_terms_review",
+			RespondWithJSON(http.StatusOK, `{
+				"terms_required": true,
+				"redirect_url": "https://example.com/terms"
+			}`),
+		)
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		review, err := WaitForTermsAcceptance(ctx, client, "", "", 10*time.Millisecond)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("terms weren't accepted"))
+		Expect(review.Required).To(BeTrue())
+		Expect(review.RedirectURL).To(Equal("https://example.com/terms"))
+	})
+
+	It("Returns review errors", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusInternalServerError, `{
+				"kind": "Error",
+				"reason": "Boom"
+			}`),
+		)
+		_, err := WaitForTermsAcceptance(ctx, client, "", "", time.Millisecond)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(
+			"can't review terms for event 'register' and site 'OCM'",
+		))
+	})
+})
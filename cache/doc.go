@@ -0,0 +1,25 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache contains a transport wrapper that caches the responses for reference data that
+// changes rarely, like the lists of OpenShift versions, cloud providers, regions and machine types.
+// This is intended to reduce the number of redundant requests that user interfaces and command line
+// tools send every time that they run.
+//
+// It also contains an informer that polls collections of objects, like clusters or subscriptions,
+// keeps them in an indexed in-memory store, and reports the objects that were added, updated or
+// deleted between polls.
+package cache
@@ -0,0 +1,409 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of an informer that polls a collection of objects and
+// reports the objects that were added, updated or deleted.
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// DefaultInformerInterval is the default time between polls of an informer.
+const DefaultInformerInterval = time.Minute
+
+// DefaultInformerPageSize is the default number of objects requested in each page by an informer.
+const DefaultInformerPageSize = 100
+
+// EventType indicates what happened to an object.
+type EventType string
+
+const (
+	// EventAdded indicates that the object didn't exist in the previous poll.
+	EventAdded EventType = "Added"
+
+	// EventUpdated indicates that the object existed in the previous poll but its contents have
+	// changed.
+	EventUpdated EventType = "Updated"
+
+	// EventDeleted indicates that the object existed in the previous poll but not in the last
+	// one.
+	EventDeleted EventType = "Deleted"
+)
+
+// Event describes a change of an object detected by an informer.
+type Event[T any] struct {
+	// Type indicates if the object was added, updated or deleted.
+	Type EventType
+
+	// Key is the key of the object.
+	Key string
+
+	// Object is the new version of the object. For deleted objects it is the last version that
+	// was seen.
+	Object T
+
+	// Old is the previous version of the object. It is only set for updated objects.
+	Old T
+}
+
+// EventHandler is a function that processes the events of an informer. Handlers are called
+// sequentially, in the order that they were added, after the store has been updated.
+type EventHandler[T any] func(ctx context.Context, event *Event[T])
+
+// ListFunc is the function that an informer calls to retrieve a page of objects. It receives the
+// page number, starting with one, and the requested page size. It returns the objects of the page
+// and the total number of objects of the collection. The informer stops requesting pages when it has
+// received the total number of objects or when it receives an empty page. Note that the server may
+// return less objects than requested, so short pages don't mean that there are no more objects.
+type ListFunc[T any] func(ctx context.Context, page, size int) (items []T, total int, err error)
+
+// InformerBuilder contains the data and logic needed to create an informer. Don't create instances
+// of this type directly, use the NewInformer function instead.
+type InformerBuilder[T any] struct {
+	logger   logging.Logger
+	list     ListFunc[T]
+	key      func(T) string
+	marshal  func(T, io.Writer) error
+	interval time.Duration
+	pageSize int
+	indexers map[string]IndexFunc[T]
+	handlers []EventHandler[T]
+}
+
+// Informer polls a collection of objects, keeps them in an indexed in-memory store, and reports the
+// objects that were added, updated or deleted between polls. It is intended for reconcilers that
+// need to react to changes of collections like clusters or subscriptions, which the API can't watch.
+// Don't create instances of this type directly, use the NewInformer function instead.
+type Informer[T any] struct {
+	logger   logging.Logger
+	list     ListFunc[T]
+	key      func(T) string
+	marshal  func(T, io.Writer) error
+	interval time.Duration
+	pageSize int
+	handlers []EventHandler[T]
+	store    *Store[T]
+	pollLock sync.Mutex
+	data     map[string][]byte
+	synced   chan struct{}
+}
+
+// NewInformer creates a builder that can then be used to configure and create an informer. For
+// example, to track the ready clusters of an organization:
+//
+//	informer, err := cache.NewInformer[*cmv1.Cluster]().
+//		Logger(logger).
+//		List(func(ctx context.Context, page, size int) ([]*cmv1.Cluster, int, error) {
+//			response, err := connection.ClustersMgmt().V1().Clusters().List().
+//				Search("state = 'ready'").
+//				Page(page).
+//				Size(size).
+//				SendContext(ctx)
+//			if err != nil {
+//				return nil, 0, err
+//			}
+//			return response.Items().Slice(), response.Total(), nil
+//		}).
+//		Key((*cmv1.Cluster).ID).
+//		Marshal(cmv1.MarshalCluster).
+//		Index("region", func(cluster *cmv1.Cluster) []string {
+//			return []string{cluster.Region().ID()}
+//		}).
+//		Handler(func(ctx context.Context, event *cache.Event[*cmv1.Cluster]) {
+//			...
+//		}).
+//		Build()
+//	if err != nil {
+//		return err
+//	}
+//	go informer.Run(ctx)
+func NewInformer[T any]() *InformerBuilder[T] {
+	return &InformerBuilder[T]{
+		interval: DefaultInformerInterval,
+		pageSize: DefaultInformerPageSize,
+		indexers: map[string]IndexFunc[T]{},
+	}
+}
+
+// Logger sets the logger that the informer will use to write to the log. This is mandatory.
+func (b *InformerBuilder[T]) Logger(value logging.Logger) *InformerBuilder[T] {
+	b.logger = value
+	return b
+}
+
+// List sets the function that retrieves the pages of objects. This is mandatory.
+func (b *InformerBuilder[T]) List(value ListFunc[T]) *InformerBuilder[T] {
+	b.list = value
+	return b
+}
+
+// Key sets the function that calculates the key of an object, usually the identifier. This is
+// mandatory.
+func (b *InformerBuilder[T]) Key(value func(T) string) *InformerBuilder[T] {
+	b.key = value
+	return b
+}
+
+// Marshal sets the function that writes objects as JSON, usually one of the generated functions of
+// the model. It is used to detect changes of the objects. This is mandatory.
+func (b *InformerBuilder[T]) Marshal(value func(T, io.Writer) error) *InformerBuilder[T] {
+	b.marshal = value
+	return b
+}
+
+// Interval sets the time between polls. The default is one minute.
+func (b *InformerBuilder[T]) Interval(value time.Duration) *InformerBuilder[T] {
+	b.interval = value
+	return b
+}
+
+// PageSize sets the number of objects requested in each page. The default is 100.
+func (b *InformerBuilder[T]) PageSize(value int) *InformerBuilder[T] {
+	b.pageSize = value
+	return b
+}
+
+// Index adds an index to the store of the informer. The objects can then be retrieved by the
+// values of the index using the ByIndex method of the store.
+func (b *InformerBuilder[T]) Index(name string, value IndexFunc[T]) *InformerBuilder[T] {
+	b.indexers[name] = value
+	return b
+}
+
+// Handler adds a function that will be called for each event.
+func (b *InformerBuilder[T]) Handler(value EventHandler[T]) *InformerBuilder[T] {
+	if value != nil {
+		b.handlers = append(b.handlers, value)
+	}
+	return b
+}
+
+// Build uses the configuration stored in the builder to create a new informer.
+func (b *InformerBuilder[T]) Build() (result *Informer[T], err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.list == nil {
+		err = errors.New("list function is mandatory")
+		return
+	}
+	if b.key == nil {
+		err = errors.New("key function is mandatory")
+		return
+	}
+	if b.marshal == nil {
+		err = errors.New("marshal function is mandatory")
+		return
+	}
+	if b.interval <= 0 {
+		err = fmt.Errorf("interval should be positive, but it is %s", b.interval)
+		return
+	}
+	if b.pageSize <= 0 {
+		err = fmt.Errorf("page size should be positive, but it is %d", b.pageSize)
+		return
+	}
+	for name, indexer := range b.indexers {
+		if indexer == nil {
+			err = fmt.Errorf("function for index '%s' is mandatory", name)
+			return
+		}
+	}
+
+	// Copy the indexers and handlers, so that changes to the builder don't affect the informer:
+	indexers := make(map[string]IndexFunc[T], len(b.indexers))
+	for name, indexer := range b.indexers {
+		indexers[name] = indexer
+	}
+	handlers := make([]EventHandler[T], len(b.handlers))
+	copy(handlers, b.handlers)
+
+	// Create and populate the object:
+	result = &Informer[T]{
+		logger:   b.logger,
+		list:     b.list,
+		key:      b.key,
+		marshal:  b.marshal,
+		interval: b.interval,
+		pageSize: b.pageSize,
+		handlers: handlers,
+		store:    newStore(indexers),
+		data:     map[string][]byte{},
+		synced:   make(chan struct{}),
+	}
+	return
+}
+
+// Store returns the store that contains the objects retrieved in the last successful poll.
+func (i *Informer[T]) Store() *Store[T] {
+	return i.store
+}
+
+// HasSynced returns true if at least one poll has completed successfully.
+func (i *Informer[T]) HasSynced() bool {
+	select {
+	case <-i.synced:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitForSync waits till the first poll completes successfully or the context is done.
+func (i *Informer[T]) WaitForSync(ctx context.Context) error {
+	select {
+	case <-i.synced:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("can't wait for informer to sync: %w", ctx.Err())
+	}
+}
+
+// Run polls the objects immediately and then at the configured interval, till the context is
+// cancelled. Polls that fail are written to the log and retried in the next interval, without
+// changing the store or generating events. It returns nil when the context is cancelled.
+func (i *Informer[T]) Run(ctx context.Context) error {
+	ticker := time.NewTicker(i.interval)
+	defer ticker.Stop()
+	for {
+		_, err := i.Poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			i.logger.Error(ctx, "Can't poll objects, will try again in %s: %v", i.interval, err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Poll retrieves all the objects, replaces the contents of the store, calls the handlers for the
+// changes compared to the previous poll and returns those changes. The events are sorted by key.
+// If retrieving the objects fails the store is left unchanged and no event is generated. Polls
+// are never executed concurrently; a call waits till the previous one finishes.
+func (i *Informer[T]) Poll(ctx context.Context) (events []*Event[T], err error) {
+	i.pollLock.Lock()
+	defer i.pollLock.Unlock()
+
+	// Retrieve and marshal the objects:
+	objects, data, err := i.fetch(ctx)
+	if err != nil {
+		return
+	}
+
+	// Compare the objects with the previous ones:
+	for key, object := range objects {
+		old, existed := i.store.Get(key)
+		switch {
+		case !existed:
+			events = append(events, &Event[T]{
+				Type:   EventAdded,
+				Key:    key,
+				Object: object,
+			})
+		case !bytes.Equal(i.data[key], data[key]):
+			events = append(events, &Event[T]{
+				Type:   EventUpdated,
+				Key:    key,
+				Object: object,
+				Old:    old,
+			})
+		}
+	}
+	for _, key := range i.store.Keys() {
+		if _, exists := objects[key]; !exists {
+			object, _ := i.store.Get(key)
+			events = append(events, &Event[T]{
+				Type:   EventDeleted,
+				Key:    key,
+				Object: object,
+			})
+		}
+	}
+	sort.SliceStable(events, func(a, b int) bool {
+		return events[a].Key < events[b].Key
+	})
+
+	// Update the store before calling the handlers, so that they see the new state:
+	i.store.replace(objects)
+	i.data = data
+	if !i.HasSynced() {
+		close(i.synced)
+	}
+	i.logger.Debug(
+		ctx,
+		"Polled %d objects and detected %d changes",
+		len(objects), len(events),
+	)
+
+	// Call the handlers:
+	for _, event := range events {
+		for _, handler := range i.handlers {
+			handler(ctx, event)
+		}
+	}
+	return
+}
+
+// fetch retrieves all the pages of objects, and returns them indexed by key, together with their
+// JSON representations.
+func (i *Informer[T]) fetch(ctx context.Context) (objects map[string]T, data map[string][]byte,
+	err error) {
+	objects = map[string]T{}
+	data = map[string][]byte{}
+	buffer := &bytes.Buffer{}
+	count := 0
+	page := 1
+	for {
+		var items []T
+		var total int
+		items, total, err = i.list(ctx, page, i.pageSize)
+		if err != nil {
+			err = fmt.Errorf("can't retrieve page %d: %w", page, err)
+			return
+		}
+		for _, item := range items {
+			key := i.key(item)
+			buffer.Reset()
+			err = i.marshal(item, buffer)
+			if err != nil {
+				err = fmt.Errorf("can't marshal object '%s': %w", key, err)
+				return
+			}
+			objects[key] = item
+			data[key] = bytes.Clone(buffer.Bytes())
+		}
+		count += len(items)
+		if len(items) == 0 || count >= total {
+			return
+		}
+		page++
+	}
+}
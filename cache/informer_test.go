@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the informer.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Informer", func() {
+	var ctx context.Context
+	var clusters []*cmv1.Cluster
+	var listErr error
+	var pages []int
+	var maxSize int
+
+	// makeCluster creates a cluster with the given identifier, name and region.
+	makeCluster := func(id, name, region string) *cmv1.Cluster {
+		cluster, err := cmv1.NewCluster().
+			ID(id).
+			Name(name).
+			Region(cmv1.NewCloudRegion().ID(region)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return cluster
+	}
+
+	// list returns the requested page of the current clusters.
+	list := func(ctx context.Context, page, size int) ([]*cmv1.Cluster, int, error) {
+		pages = append(pages, page)
+		if listErr != nil {
+			return nil, 0, listErr
+		}
+		if maxSize > 0 && size > maxSize {
+			size = maxSize
+		}
+		start := (page - 1) * size
+		if start > len(clusters) {
+			start = len(clusters)
+		}
+		end := start + size
+		if end > len(clusters) {
+			end = len(clusters)
+		}
+		return clusters[start:end], len(clusters), nil
+	}
+
+	// makeInformer creates an informer that uses the list function and indexes the clusters by
+	// region.
+	makeInformer := func(handlers ...EventHandler[*cmv1.Cluster]) *Informer[*cmv1.Cluster] {
+		builder := NewInformer[*cmv1.Cluster]().
+			Logger(logger).
+			List(list).
+			Key((*cmv1.Cluster).ID).
+			Marshal(cmv1.MarshalCluster).
+			PageSize(2).
+			Interval(10*time.Millisecond).
+			Index("region", func(cluster *cmv1.Cluster) []string {
+				return []string{cluster.Region().ID()}
+			})
+		for _, handler := range handlers {
+			builder.Handler(handler)
+		}
+		informer, err := builder.Build()
+		Expect(err).ToNot(HaveOccurred())
+		return informer
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clusters = []*cmv1.Cluster{
+			makeCluster("123", "mycluster", "us-east-1"),
+			makeCluster("456", "yourcluster", "us-east-1"),
+			makeCluster("789", "hercluster", "eu-west-1"),
+		}
+		listErr = nil
+		pages = nil
+		maxSize = 0
+	})
+
+	It("Can't be built without a list function", func() {
+		_, err := NewInformer[*cmv1.Cluster]().
+			Logger(logger).
+			Key((*cmv1.Cluster).ID).
+			Marshal(cmv1.MarshalCluster).
+			Build()
+		Expect(err).To(MatchError("list function is mandatory"))
+	})
+
+	It("Adds all the objects in the first poll", func() {
+		informer := makeInformer()
+		Expect(informer.HasSynced()).To(BeFalse())
+		events, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(informer.HasSynced()).To(BeTrue())
+		Expect(pages).To(Equal([]int{1, 2}))
+		Expect(events).To(HaveLen(3))
+		for _, event := range events {
+			Expect(event.Type).To(Equal(EventAdded))
+		}
+		Expect(events[0].Key).To(Equal("123"))
+		Expect(informer.Store().Keys()).To(Equal([]string{"123", "456", "789"}))
+	})
+
+	It("Doesn't stop when the server returns less objects than requested", func() {
+		maxSize = 1
+		informer := makeInformer()
+		events, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pages).To(Equal([]int{1, 2, 3}))
+		Expect(events).To(HaveLen(3))
+		Expect(informer.Store().Keys()).To(Equal([]string{"123", "456", "789"}))
+	})
+
+	It("Detects updates and deletions", func() {
+		informer := makeInformer()
+		_, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Rename one cluster, delete another one and add a new one:
+		clusters = []*cmv1.Cluster{
+			makeCluster("123", "mycluster", "us-east-1"),
+			makeCluster("456", "renamed", "us-east-1"),
+			makeCluster("abc", "newcluster", "eu-west-1"),
+		}
+		events, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(3))
+		Expect(events[0].Type).To(Equal(EventUpdated))
+		Expect(events[0].Key).To(Equal("456"))
+		Expect(events[0].Old.Name()).To(Equal("yourcluster"))
+		Expect(events[0].Object.Name()).To(Equal("renamed"))
+		Expect(events[1].Type).To(Equal(EventDeleted))
+		Expect(events[1].Key).To(Equal("789"))
+		Expect(events[1].Object.Name()).To(Equal("hercluster"))
+		Expect(events[2].Type).To(Equal(EventAdded))
+		Expect(events[2].Key).To(Equal("abc"))
+	})
+
+	It("Doesn't generate events when nothing changes", func() {
+		informer := makeInformer()
+		_, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		events, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(BeEmpty())
+	})
+
+	It("Keeps the store when a poll fails", func() {
+		informer := makeInformer()
+		_, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		listErr = errors.New("my error")
+		clusters = nil
+		events, err := informer.Poll(ctx)
+		Expect(err).To(MatchError(listErr))
+		Expect(events).To(BeEmpty())
+		Expect(informer.Store().Len()).To(Equal(3))
+	})
+
+	It("Updates the indexes", func() {
+		informer := makeInformer()
+		_, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		store := informer.Store()
+		Expect(store.IndexValues("region")).To(Equal([]string{"eu-west-1", "us-east-1"}))
+		east := store.ByIndex("region", "us-east-1")
+		Expect(east).To(HaveLen(2))
+		Expect(east[0].ID()).To(Equal("123"))
+		Expect(east[1].ID()).To(Equal("456"))
+
+		// Move one cluster to the other region:
+		clusters[0] = makeCluster("123", "mycluster", "eu-west-1")
+		_, err = informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store.ByIndex("region", "us-east-1")).To(HaveLen(1))
+		Expect(store.ByIndex("region", "eu-west-1")).To(HaveLen(2))
+		Expect(store.ByIndex("region", "ap-south-1")).To(BeNil())
+		Expect(store.ByIndex("junk", "us-east-1")).To(BeNil())
+	})
+
+	It("Calls the handlers after updating the store", func() {
+		var informer *Informer[*cmv1.Cluster]
+		var seen []string
+		informer = makeInformer(func(ctx context.Context, event *Event[*cmv1.Cluster]) {
+			_, ok := informer.Store().Get(event.Key)
+			Expect(ok).To(BeTrue())
+			seen = append(seen, string(event.Type)+":"+event.Key)
+		})
+		_, err := informer.Poll(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(seen).To(Equal([]string{"Added:123", "Added:456", "Added:789"}))
+	})
+
+	It("Polls periodically till the context is cancelled", func() {
+		events := make(chan *Event[*cmv1.Cluster], 10)
+		informer := makeInformer(func(ctx context.Context, event *Event[*cmv1.Cluster]) {
+			events <- event
+		})
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		go func() {
+			done <- informer.Run(runCtx)
+		}()
+		err := informer.WaitForSync(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		cancel()
+		Expect(<-done).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(3))
+	})
+})
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of an indexed in-memory store of objects.
+
+package cache
+
+import (
+	"sort"
+	"sync"
+)
+
+// IndexFunc calculates the values of an index for an object. For example, an index of clusters by
+// region could be calculated like this:
+//
+//	func(cluster *cmv1.Cluster) []string {
+//		return []string{cluster.Region().ID()}
+//	}
+type IndexFunc[T any] func(object T) []string
+
+// Store is an in-memory store of objects, indexed by key and optionally by other values calculated
+// by index functions. It is safe to use from multiple goroutines. The stores used by informers are
+// created and updated by the informers, so the users only need to read them.
+type Store[T any] struct {
+	lock     sync.RWMutex
+	objects  map[string]T
+	indexers map[string]IndexFunc[T]
+	indexes  map[string]map[string]map[string]struct{}
+}
+
+// newStore creates an empty store that uses the given index functions.
+func newStore[T any](indexers map[string]IndexFunc[T]) *Store[T] {
+	indexes := map[string]map[string]map[string]struct{}{}
+	for name := range indexers {
+		indexes[name] = map[string]map[string]struct{}{}
+	}
+	return &Store[T]{
+		objects:  map[string]T{},
+		indexers: indexers,
+		indexes:  indexes,
+	}
+}
+
+// Get returns the object with the given key, and a flag indicating if it exists.
+func (s *Store[T]) Get(key string) (object T, ok bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	object, ok = s.objects[key]
+	return
+}
+
+// Len returns the number of objects in the store.
+func (s *Store[T]) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return len(s.objects)
+}
+
+// Keys returns the keys of all the objects in the store, sorted alphabetically.
+func (s *Store[T]) Keys() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.sortedKeys()
+}
+
+// List returns all the objects in the store, sorted by key.
+func (s *Store[T]) List() []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	keys := s.sortedKeys()
+	result := make([]T, len(keys))
+	for i, key := range keys {
+		result[i] = s.objects[key]
+	}
+	return result
+}
+
+// ByIndex returns the objects that have the given value in the given index, sorted by key. It
+// returns nil if the index doesn't exist or if no object has that value.
+func (s *Store[T]) ByIndex(index, value string) []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	keys := s.indexes[index][value]
+	if len(keys) == 0 {
+		return nil
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	result := make([]T, len(sorted))
+	for i, key := range sorted {
+		result[i] = s.objects[key]
+	}
+	return result
+}
+
+// IndexValues returns the values of the given index that have at least one object, sorted
+// alphabetically.
+func (s *Store[T]) IndexValues(index string) []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	values := s.indexes[index]
+	result := make([]string, 0, len(values))
+	for value := range values {
+		result = append(result, value)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// replace replaces the contents of the store with the given objects, which must be indexed by
+// key.
+func (s *Store[T]) replace(objects map[string]T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.objects = objects
+	for name, indexer := range s.indexers {
+		index := map[string]map[string]struct{}{}
+		for key, object := range objects {
+			for _, value := range indexer(object) {
+				keys, ok := index[value]
+				if !ok {
+					keys = map[string]struct{}{}
+					index[value] = keys
+				}
+				keys[key] = struct{}{}
+			}
+		}
+		s.indexes[name] = index
+	}
+}
+
+// sortedKeys returns the sorted keys of the objects. It must be called holding the lock.
+func (s *Store[T]) sortedKeys() []string {
+	keys := make([]string, 0, len(s.objects))
+	for key := range s.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,316 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of a transport wrapper that caches responses.
+
+package cache
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// DefaultTTL is the default time that responses are kept in the cache.
+const DefaultTTL = 10 * time.Minute
+
+// DefaultPaths are the paths of the reference data that are cached when no paths are explicitly
+// configured. Requests for these paths and for the paths below them are cached.
+var DefaultPaths = []string{
+	"/api/clusters_mgmt/v1/cloud_providers",
+	"/api/clusters_mgmt/v1/flavours",
+	"/api/clusters_mgmt/v1/machine_types",
+	"/api/clusters_mgmt/v1/versions",
+}
+
+// impersonateUserHeader is the header used to impersonate other users. It is part of the key of the
+// cache because the results may be different for different users.
+const impersonateUserHeader = "Impersonate-User"
+
+// TransportWrapperBuilder contains the data and logic needed to build a new caching transport
+// wrapper. Don't create objects of this type directly; use the NewTransportWrapper function
+// instead.
+type TransportWrapperBuilder struct {
+	logger logging.Logger
+	ttl    time.Duration
+	paths  []string
+}
+
+// TransportWrapper contains the data and logic needed to wrap an HTTP round tripper with another
+// one that caches the responses for reference data.
+type TransportWrapper struct {
+	logger  logging.Logger
+	ttl     time.Duration
+	paths   []string
+	lock    *sync.Mutex
+	entries map[cacheKey]*cacheEntry
+}
+
+// cacheKey identifies a cached response.
+type cacheKey struct {
+	url  string
+	user string
+}
+
+// cacheEntry contains a cached response and the time when it expires.
+type cacheEntry struct {
+	path   string
+	status int
+	header http.Header
+	body   []byte
+	expiry time.Time
+}
+
+// roundTripper is a round tripper that caches responses.
+type roundTripper struct {
+	owner     *TransportWrapper
+	transport http.RoundTripper
+}
+
+// Make sure that we implement the interface:
+var _ http.RoundTripper = (*roundTripper)(nil)
+
+// NewTransportWrapper creates a new builder that can then be used to configure and create a new
+// caching transport wrapper. Usually there is no need to use it directly, as the ReferenceCache
+// method of the connection builder does it automatically.
+func NewTransportWrapper() *TransportWrapperBuilder {
+	return &TransportWrapperBuilder{
+		ttl: DefaultTTL,
+	}
+}
+
+// Logger sets the logger that the wrapper will use to write to the log. This is mandatory.
+func (b *TransportWrapperBuilder) Logger(value logging.Logger) *TransportWrapperBuilder {
+	b.logger = value
+	return b
+}
+
+// TTL sets the time that responses are kept in the cache. The default value is ten minutes.
+func (b *TransportWrapperBuilder) TTL(value time.Duration) *TransportWrapperBuilder {
+	b.ttl = value
+	return b
+}
+
+// Path adds a path whose responses will be cached, for example `/api/clusters_mgmt/v1/versions`.
+// Responses for paths below it, like `/api/clusters_mgmt/v1/versions/openshift-v4.16.0`, are also
+// cached. If no path is added the ones in DefaultPaths are used.
+func (b *TransportWrapperBuilder) Path(value string) *TransportWrapperBuilder {
+	b.paths = append(b.paths, value)
+	return b
+}
+
+// Build uses the information stored in the builder to create a new caching transport wrapper.
+func (b *TransportWrapperBuilder) Build() (result *TransportWrapper, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.ttl <= 0 {
+		err = fmt.Errorf("time to live %s isn't valid, it should be greater than zero", b.ttl)
+		return
+	}
+	for _, path := range b.paths {
+		if !strings.HasPrefix(path, "/") {
+			err = fmt.Errorf("path '%s' isn't valid, it should start with a slash", path)
+			return
+		}
+	}
+
+	// Set default values:
+	paths := b.paths
+	if len(paths) == 0 {
+		paths = DefaultPaths
+	}
+	clean := make([]string, len(paths))
+	for i, path := range paths {
+		clean[i] = strings.TrimSuffix(path, "/")
+	}
+
+	// Create and populate the object:
+	result = &TransportWrapper{
+		logger:  b.logger,
+		ttl:     b.ttl,
+		paths:   clean,
+		lock:    &sync.Mutex{},
+		entries: map[cacheKey]*cacheEntry{},
+	}
+	return
+}
+
+// Wrap creates a new round tripper that wraps the given one and caches the responses for reference
+// data.
+func (w *TransportWrapper) Wrap(transport http.RoundTripper) http.RoundTripper {
+	return &roundTripper{
+		owner:     w,
+		transport: transport,
+	}
+}
+
+// Invalidate removes from the cache the responses for the given paths and for the paths below
+// them. If no path is given all the responses are removed.
+func (w *TransportWrapper) Invalidate(paths ...string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if len(paths) == 0 {
+		w.entries = map[cacheKey]*cacheEntry{}
+		return
+	}
+	for key, entry := range w.entries {
+		for _, path := range paths {
+			if matchPath(entry.path, strings.TrimSuffix(path, "/")) {
+				delete(w.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// Close releases all the resources used by the wrapper.
+func (w *TransportWrapper) Close() error {
+	w.Invalidate()
+	return nil
+}
+
+// RoundTrip is the implementation of the round tripper interface.
+func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	ctx := request.Context()
+
+	// Requests for other paths go directly to the server:
+	path := t.owner.match(request.URL.Path)
+	if path == "" {
+		return t.transport.RoundTrip(request)
+	}
+
+	// Requests that may modify the reference data go to the server, and if they succeed the
+	// cached responses for the same data are removed:
+	if request.Method != http.MethodGet {
+		response, err = t.transport.RoundTrip(request)
+		if err == nil && response.StatusCode < http.StatusBadRequest {
+			t.owner.Invalidate(path)
+		}
+		return
+	}
+
+	// Return the cached response, unless the caller explicitly asked to bypass the cache:
+	key := cacheKey{
+		url:  request.URL.String(),
+		user: request.Header.Get(impersonateUserHeader),
+	}
+	if !strings.Contains(request.Header.Get("Cache-Control"), "no-cache") {
+		entry := t.owner.get(key)
+		if entry != nil {
+			t.owner.logger.Debug(ctx, "Returning cached response for '%s'", key.url)
+			response = entry.response(request)
+			return
+		}
+	}
+
+	// Send the request and save the response if it is successful:
+	response, err = t.transport.RoundTrip(request)
+	if err != nil || response.StatusCode != http.StatusOK {
+		return
+	}
+	body, err := io.ReadAll(response.Body)
+	closeErr := response.Body.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		response = nil
+		return
+	}
+	entry := &cacheEntry{
+		path:   request.URL.Path,
+		status: response.StatusCode,
+		header: response.Header.Clone(),
+		body:   body,
+	}
+	t.owner.put(key, entry)
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	return
+}
+
+// match returns the configured path that matches the given request path, or an empty string if
+// none matches.
+func (w *TransportWrapper) match(path string) string {
+	for _, candidate := range w.paths {
+		if matchPath(path, candidate) {
+			return candidate
+		}
+	}
+	return ""
+}
+
+func (w *TransportWrapper) get(key cacheKey) *cacheEntry {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	entry, ok := w.entries[key]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expiry) {
+		delete(w.entries, key)
+		return nil
+	}
+	return entry
+}
+
+func (w *TransportWrapper) put(key cacheKey, entry *cacheEntry) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	now := time.Now()
+	for candidate, existing := range w.entries {
+		if now.After(existing.expiry) {
+			delete(w.entries, candidate)
+		}
+	}
+	entry.expiry = now.Add(w.ttl)
+	w.entries[key] = entry
+}
+
+// response creates a new response for the given request containing the cached data.
+func (e *cacheEntry) response(request *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.status, http.StatusText(e.status)),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       request,
+	}
+}
+
+// matchPath checks if the given request path is the configured path or is below it. Note that the
+// configured path may be preceded by a prefix, for example when the URL of the connection contains
+// a path.
+func matchPath(path, configured string) bool {
+	path = strings.TrimSuffix(path, "/")
+	if strings.HasSuffix(path, configured) {
+		return true
+	}
+	return strings.Contains(path, configured+"/")
+}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the caching transport wrapper.
+
+package cache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Transport wrapper", func() {
+	var calls int
+	var transport http.RoundTripper
+
+	BeforeEach(func() {
+		calls = 0
+		transport = TransportFunc(func(request *http.Request) (*http.Response, error) {
+			calls++
+			recorder := httptest.NewRecorder()
+			recorder.Header().Set("Content-Type", "application/json")
+			if strings.HasSuffix(request.URL.Path, "/missing") {
+				recorder.WriteHeader(http.StatusNotFound)
+			}
+			_, err := recorder.WriteString(`{"calls": ` + strings.Repeat("I", calls) + `}`)
+			Expect(err).ToNot(HaveOccurred())
+			return recorder.Result(), nil
+		})
+	})
+
+	// send sends a request using the given round tripper and returns the body of the response.
+	send := func(tripper http.RoundTripper, method, path string, header ...string) string {
+		request := httptest.NewRequest(method, "https://api.example.com"+path, nil)
+		for i := 0; i+1 < len(header); i += 2 {
+			request.Header.Set(header[i], header[i+1])
+		}
+		response, err := tripper.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return string(body)
+	}
+
+	It("Can't be created without a logger", func() {
+		_, err := NewTransportWrapper().Build()
+		Expect(err).To(MatchError("logger is mandatory"))
+	})
+
+	It("Can't be created with a relative path", func() {
+		_, err := NewTransportWrapper().
+			Logger(logger).
+			Path("api/clusters_mgmt/v1/versions").
+			Build()
+		Expect(err).To(MatchError(
+			"path 'api/clusters_mgmt/v1/versions' isn't valid, it should start with a slash",
+		))
+	})
+
+	It("Returns cached responses for reference data", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		first := send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		second := send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		Expect(second).To(Equal(first))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("Caches paths below the configured ones", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers/aws/regions")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers/aws/regions")
+		Expect(calls).To(Equal(1))
+	})
+
+	It("Uses the query as part of the key", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions?page=1")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions?page=2")
+		Expect(calls).To(Equal(2))
+	})
+
+	It("Doesn't cache other paths", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/clusters")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/clusters")
+		Expect(calls).To(Equal(2))
+	})
+
+	It("Doesn't cache errors", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions/missing")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions/missing")
+		Expect(calls).To(Equal(2))
+	})
+
+	It("Sends the request when the cache is bypassed", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions", "Cache-Control", "no-cache")
+		Expect(calls).To(Equal(2))
+	})
+
+	It("Sends the request again when the response expires", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			TTL(10 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		time.Sleep(20 * time.Millisecond)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions")
+		Expect(calls).To(Equal(2))
+	})
+
+	It("Invalidates the responses for a path", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.16.0")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/machine_types")
+		wrapper.Invalidate("/api/clusters_mgmt/v1/versions")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.16.0")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/machine_types")
+		Expect(calls).To(Equal(3))
+	})
+
+	It("Invalidates the responses when the data is modified", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.16.0")
+		send(tripper, http.MethodPatch, "/api/clusters_mgmt/v1/versions/openshift-v4.16.0")
+		send(tripper, http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.16.0")
+		Expect(calls).To(Equal(3))
+	})
+
+	It("Honours a prefix in the request path", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		tripper := wrapper.Wrap(transport)
+		send(tripper, http.MethodGet, "/gateway/api/clusters_mgmt/v1/versions")
+		send(tripper, http.MethodGet, "/gateway/api/clusters_mgmt/v1/versions")
+		Expect(calls).To(Equal(1))
+	})
+})
@@ -0,0 +1,227 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the builder used to create clones of connections.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// CloneBuilder contains the configuration and logic needed to create a clone of an existing
+// connection. Don't create instances of this type directly, use the Connection.Clone method
+// instead.
+type CloneBuilder struct {
+	parent       *Connection
+	logger       logging.Logger
+	agent        string
+	headers      http.Header
+	timeout      time.Duration
+	closeTimeout time.Duration
+	urlTable     map[string]string
+	scope        context.Context
+}
+
+// Clone creates a builder pre-populated with the settings of this connection. The connections
+// created with it share the token manager and the pool of HTTP clients of this connection, so they
+// are cheap to create and use the same tokens, but they can have a different logger, user agent,
+// default headers, timeouts and URLs. For example, to create a connection that sends all requests
+// with an additional header and a shorter timeout:
+//
+//	background, err := connection.Clone().
+//		Header("X-Request-Class", "background").
+//		Timeout(10 * time.Second).
+//		Build()
+//
+// Closing the clone doesn't release the shared resources, that only happens when this connection is
+// closed. Clones can't be used after this connection has been closed.
+func (c *Connection) Clone() *CloneBuilder {
+	// Find the connection that owns the shared resources, so that clones of clones don't create
+	// chains of parents. Connections scoped to a group are used as parents, so that their requests
+	// in progress include the ones sent with the clones:
+	parent := c
+	for parent.parent != nil && !parent.scopeOwner {
+		parent = parent.parent
+	}
+
+	// Copy the URL table:
+	urlTable := map[string]string{}
+	for _, entry := range c.urlTable {
+		urlTable[entry.prefix] = entry.url.Text
+	}
+
+	return &CloneBuilder{
+		parent:       parent,
+		logger:       c.logger,
+		agent:        c.agent,
+		headers:      c.headers.Clone(),
+		timeout:      c.timeout,
+		closeTimeout: c.closeTimeout,
+		urlTable:     urlTable,
+		scope:        c.scope,
+	}
+}
+
+// Logger sets the logger that will be used by the clone. The default is to use the logger of the
+// original connection.
+func (b *CloneBuilder) Logger(value logging.Logger) *CloneBuilder {
+	b.logger = value
+	return b
+}
+
+// Agent sets the `User-Agent` header that the clone will use in all the HTTP requests. The default
+// is to use the agent of the original connection.
+func (b *CloneBuilder) Agent(value string) *CloneBuilder {
+	b.agent = value
+	return b
+}
+
+// Header adds a header that will be added to all the HTTP requests sent by the clone, in addition to
+// the headers of the original connection.
+func (b *CloneBuilder) Header(name string, value string) *CloneBuilder {
+	if b.headers == nil {
+		b.headers = http.Header{}
+	}
+	b.headers.Add(name, value)
+	return b
+}
+
+// RemoveHeader removes a header that was inherited from the original connection.
+func (b *CloneBuilder) RemoveHeader(name string) *CloneBuilder {
+	b.headers.Del(name)
+	return b
+}
+
+// Timeout sets the maximum time that the clone will wait for a request to complete. The default is
+// to use the timeout of the original connection.
+func (b *CloneBuilder) Timeout(value time.Duration) *CloneBuilder {
+	b.timeout = value
+	return b
+}
+
+// CloseTimeout sets the maximum time that the Close method of the clone will wait for the requests
+// sent with it to finish. The default is to use the close timeout of the original connection.
+func (b *CloneBuilder) CloseTimeout(value time.Duration) *CloneBuilder {
+	b.closeTimeout = value
+	return b
+}
+
+// URL sets the base URL of the API gateway. The default is to use the URL of the original
+// connection.
+func (b *CloneBuilder) URL(value string) *CloneBuilder {
+	return b.AlternativeURL("", value)
+}
+
+// AlternativeURL sets an alternative base URL for the given path prefix. See the documentation of
+// the AlternativeURL method of the connection builder for details.
+func (b *CloneBuilder) AlternativeURL(prefix, base string) *CloneBuilder {
+	b.urlTable[prefix] = base
+	return b
+}
+
+// Build uses the configuration stored in the builder to create the clone.
+func (b *CloneBuilder) Build() (connection *Connection, err error) {
+	return b.BuildContext(context.Background())
+}
+
+// BuildContext uses the configuration stored in the builder to create the clone.
+func (b *CloneBuilder) BuildContext(ctx context.Context) (connection *Connection, err error) {
+	// Check that the original connection is still usable:
+	err = b.parent.checkClosed()
+	if err != nil {
+		err = fmt.Errorf("can't clone connection: %w", err)
+		return
+	}
+	if b.logger == nil {
+		err = fmt.Errorf("logger is mandatory")
+		return
+	}
+
+	// Reuse the logic of the connection builder to create the URL table:
+	urlTable, err := (&ConnectionBuilder{
+		logger:   b.logger,
+		urlTable: b.urlTable,
+	}).createURLTable(ctx)
+	if err != nil {
+		return
+	}
+
+	// Take the region discovery object of the parent, if it has already been created, so that
+	// the discovery document isn't retrieved again:
+	b.parent.regionLock.Lock()
+	regionDiscovery := b.parent.regionDiscovery
+	b.parent.regionLock.Unlock()
+
+	// Create the connection sharing the authentication and HTTP clients of the parent:
+	connection = &Connection{
+		logger:            b.logger,
+		authnWrapper:      b.parent.authnWrapper,
+		retryWrapper:      b.parent.retryWrapper,
+		clientSelector:    b.parent.clientSelector,
+		urlTable:          urlTable,
+		agent:             b.agent,
+		headers:           b.headers.Clone(),
+		timeout:           b.timeout,
+		closeTimeout:      b.closeTimeout,
+		regionDiscovery:   regionDiscovery,
+		rateLimitTracker:  b.parent.rateLimitTracker,
+		cacheWrapper:      b.parent.cacheWrapper,
+		parent:            b.parent,
+		scope:             b.scope,
+		stopped:           b.parent.stopped,
+		metricsSubsystem:  b.parent.metricsSubsystem,
+		metricsRegisterer: b.parent.metricsRegisterer,
+	}
+	return
+}
+
+// scoped creates a connection that shares all the settings and resources of this connection, and
+// whose requests are cancelled when the given context is cancelled. The requests in progress are
+// also registered in this connection, so closing it waits for them.
+func (c *Connection) scoped(ctx context.Context) *Connection {
+	// Take the region discovery object, if it has already been created, so that the discovery
+	// document isn't retrieved again:
+	c.regionLock.Lock()
+	regionDiscovery := c.regionDiscovery
+	c.regionLock.Unlock()
+
+	return &Connection{
+		logger:            c.logger,
+		authnWrapper:      c.authnWrapper,
+		retryWrapper:      c.retryWrapper,
+		clientSelector:    c.clientSelector,
+		urlTable:          c.urlTable,
+		agent:             c.agent,
+		headers:           c.headers.Clone(),
+		timeout:           c.timeout,
+		closeTimeout:      c.closeTimeout,
+		regionDiscovery:   regionDiscovery,
+		rateLimitTracker:  c.rateLimitTracker,
+		cacheWrapper:      c.cacheWrapper,
+		parent:            c,
+		scope:             ctx,
+		scopeOwner:        true,
+		stopped:           c.stopped,
+		metricsSubsystem:  c.metricsSubsystem,
+		metricsRegisterer: c.metricsRegisterer,
+	}
+}
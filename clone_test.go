@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for connection cloning.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/openshift-online/ocm-sdk-go/regions"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Clone", func() {
+	var (
+		token      string
+		server     *ghttp.Server
+		connection *Connection
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the tokens:
+		token = MakeTokenString("Bearer", 5*time.Minute)
+
+		// Create the server:
+		server = MakeTCPServer()
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			Header("X-Tenant", "mytenant").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the server:
+		server.Close()
+	})
+
+	It("Inherits the settings of the original connection", func() {
+		clone, err := connection.Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clone.URL()).To(Equal(connection.URL()))
+		Expect(clone.Agent()).To(Equal(connection.Agent()))
+		Expect(clone.Headers()).To(Equal(connection.Headers()))
+		Expect(clone.TokenURL()).To(Equal(connection.TokenURL()))
+	})
+
+	It("Inherits the close timeout and the region discovery", func() {
+		// Create a connection with explicit close timeout and region discovery:
+		discovery, err := regions.NewDiscovery().
+			Logger(logger).
+			URL(server.URL() + "/static/ocm-shards.json").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		original, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			CloseTimeout(time.Minute).
+			RegionDiscovery(discovery).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err := original.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Check that the clone has the same settings:
+		clone, err := original.Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clone.CloseTimeout()).To(Equal(time.Minute))
+		result, err := clone.RegionDiscovery()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeIdenticalTo(discovery))
+
+		// Check that connections scoped to a group of the clone use the same region discovery:
+		group, _ := clone.WithGroup(context.Background())
+		result, err = group.Connection().RegionDiscovery()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeIdenticalTo(discovery))
+		err = group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Can use a different close timeout", func() {
+		clone, err := connection.Clone().
+			CloseTimeout(time.Second).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clone.CloseTimeout()).To(Equal(time.Second))
+		Expect(connection.CloseTimeout()).To(BeZero())
+	})
+
+	It("Sends the default and the additional headers", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "Bearer "+token),
+				ghttp.VerifyHeaderKV("X-Tenant", "mytenant"),
+				ghttp.VerifyHeaderKV("X-Request-Class", "background"),
+				ghttp.VerifyHeaderKV("User-Agent", "myagent"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Create the clone:
+		clone, err := connection.Clone().
+			Agent("myagent").
+			Header("X-Request-Class", "background").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Send the request:
+		_, err = clone.Get().Path("/api/clusters_mgmt/v1/clusters").Send()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Doesn't override headers set explicitly in the request", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("X-Tenant", "yourtenant"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Send the request:
+		_, err := connection.Get().
+			Path("/api/clusters_mgmt/v1/clusters").
+			Header("X-Tenant", "yourtenant").
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Can remove inherited headers", func() {
+		clone, err := connection.Clone().RemoveHeader("X-Tenant").Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clone.Headers()).To(BeEmpty())
+		Expect(connection.Headers()).To(HaveKey("X-Tenant"))
+	})
+
+	It("Applies the timeout of the clone", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				func(w http.ResponseWriter, r *http.Request) {
+					time.Sleep(200 * time.Millisecond)
+				},
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Create the clone:
+		clone, err := connection.Clone().
+			Timeout(10 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clone.Timeout()).To(Equal(10 * time.Millisecond))
+		Expect(connection.Timeout()).To(BeZero())
+
+		// Send the request:
+		_, err = clone.Get().Path("/api/clusters_mgmt/v1/clusters").Send()
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+
+	It("Can use a different URL", func() {
+		clone, err := connection.Clone().URL("https://api.example.com").Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clone.URL()).To(Equal("https://api.example.com"))
+		Expect(connection.URL()).To(Equal(server.URL()))
+	})
+
+	It("Doesn't close the original connection when the clone is closed", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+
+		// Create and close the clone:
+		clone, err := connection.Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = clone.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check that the original connection still works:
+		_, err = connection.Get().Path("/api/clusters_mgmt/v1/clusters").Send()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Can't be used after the original connection is closed", func() {
+		// Create a separate connection so that the close in the after each doesn't fail:
+		original, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		clone, err := original.Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = original.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check that the clone fails:
+		_, err = clone.Get().Path("/api/clusters_mgmt/v1/clusters").Send()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("closed"))
+
+		// Check that new clones can't be created:
+		_, err = original.Clone().Build()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,288 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for closing connections while requests are in progress.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Close", func() {
+	var (
+		ctx     context.Context
+		token   string
+		server  *ghttp.Server
+		started chan struct{}
+		unblock func()
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// Create the tokens:
+		token = MakeTokenString("Bearer", 5*time.Minute)
+
+		// Create the server with a handler that blocks till the test releases it:
+		started = make(chan struct{}, 1)
+		release := make(chan struct{})
+		unblock = sync.OnceFunc(func() {
+			close(release)
+		})
+		server = MakeTCPServer()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				func(w http.ResponseWriter, r *http.Request) {
+					started <- struct{}{}
+					<-release
+				},
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+	})
+
+	AfterEach(func() {
+		// Make sure that the handler isn't blocked, otherwise closing the server will hang:
+		unblock()
+		server.Close()
+	})
+
+	// send sends a request in the background and returns a channel that will receive the error.
+	send := func(connection *Connection) chan error {
+		result := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			_, err := connection.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+			result <- err
+		}()
+		Eventually(started).Should(Receive())
+		return result
+	}
+
+	It("Waits for requests in progress", func() {
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start the request and then close the connection:
+		requestErr := send(connection)
+		closeErr := make(chan error, 1)
+		go func() {
+			closeErr <- connection.CloseContext(ctx)
+		}()
+
+		// Check that close waits till the request finishes:
+		Consistently(closeErr, 100*time.Millisecond).ShouldNot(Receive())
+		unblock()
+		Eventually(requestErr).Should(Receive(BeNil()))
+		Eventually(closeErr).Should(Receive(BeNil()))
+	})
+
+	It("Rejects new requests while closing", func() {
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start the request and then close the connection:
+		requestErr := send(connection)
+		closeErr := make(chan error, 1)
+		go func() {
+			closeErr <- connection.CloseContext(ctx)
+		}()
+
+		// Wait till the connection is marked as closed and check that new requests are
+		// rejected:
+		Eventually(func() bool {
+			connection.stateLock.Lock()
+			defer connection.stateLock.Unlock()
+			return connection.closed
+		}).Should(BeTrue())
+		_, err = connection.Get().Path("/api/clusters_mgmt/v1/clusters").Send()
+		Expect(err).To(MatchError(ContainSubstring("closed")))
+
+		// Let the request finish:
+		unblock()
+		Eventually(requestErr).Should(Receive(BeNil()))
+		Eventually(closeErr).Should(Receive(BeNil()))
+	})
+
+	It("Stops waiting when the context expires", func() {
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer unblock()
+
+		// Start the request and then close the connection:
+		send(connection)
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err = connection.CloseContext(timeout)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+
+	It("Uses the close timeout of the builder", func() {
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			CloseTimeout(50 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer unblock()
+
+		// Start the request and then close the connection:
+		send(connection)
+		start := time.Now()
+		err = connection.Close()
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+
+	It("Waits for requests sent with clones", func() {
+		// Create the connection and the clone:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		clone, err := connection.Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start the request with the clone and then close the original connection:
+		requestErr := send(clone)
+		closeErr := make(chan error, 1)
+		go func() {
+			closeErr <- connection.CloseContext(ctx)
+		}()
+		Consistently(closeErr, 100*time.Millisecond).ShouldNot(Receive())
+		unblock()
+		Eventually(requestErr).Should(Receive(BeNil()))
+		Eventually(closeErr).Should(Receive(BeNil()))
+	})
+
+	It("Cancels requests still in progress when the context expires", func() {
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			RetryLimit(0).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start the request and then close the connection:
+		requestErr := send(connection)
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err = connection.CloseContext(timeout)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		// Check that the request has been cancelled, even if the server didn't respond:
+		Eventually(requestErr).Should(Receive(HaveOccurred()))
+	})
+
+	It("Doesn't leave goroutines behind", func() {
+		// Create a token server, so that the connection also needs to request tokens:
+		oidServer := MakeTCPServer()
+		defer oidServer.Close()
+		oidServer.AppendHandlers(
+			RespondWithAccessToken(token),
+		)
+
+		// Count the goroutines before creating the connection:
+		before := runtime.NumGoroutine()
+
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			TokenURL(oidServer.URL()).
+			Client("myclient", "mysecret").
+			RetryLimit(0).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start the request and close the connection before the server responds:
+		requestErr := send(connection)
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err = connection.CloseContext(timeout)
+		Expect(err).To(HaveOccurred())
+		Eventually(requestErr).Should(Receive(HaveOccurred()))
+
+		// Let the server finish and check that all the goroutines are gone:
+		unblock()
+		Eventually(runtime.NumGoroutine).Should(BeNumerically("<=", before))
+	})
+
+	It("Runs all the metrics closers even if some fail", func() {
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Replace the metrics closers with some that fail:
+		firstErr := errors.New("first")
+		secondErr := errors.New("second")
+		called := 0
+		connection.metricsClosers = []func() error{
+			func() error {
+				called++
+				return firstErr
+			},
+			func() error {
+				called++
+				return secondErr
+			},
+		}
+
+		// Check that both are called and both errors are returned:
+		err = connection.Close()
+		Expect(called).To(Equal(2))
+		Expect(errors.Is(err, firstErr)).To(BeTrue())
+		Expect(errors.Is(err, secondErr)).To(BeTrue())
+	})
+})
@@ -0,0 +1,369 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the poster that simplifies sending cluster log entries to the service logs
+// service.
+
+package clusterlogs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"text/template"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+)
+
+// validSeverities contains the severities accepted by the service logs service.
+var validSeverities = map[slv1.Severity]bool{
+	slv1.SeverityDebug:   true,
+	slv1.SeverityInfo:    true,
+	slv1.SeverityWarning: true,
+	slv1.SeverityError:   true,
+	slv1.SeverityFatal:   true,
+}
+
+// validLogTypes contains the log types accepted by the service logs service.
+var validLogTypes = map[slv1.LogType]bool{
+	slv1.LogTypeClusterCreateDetails:   true,
+	slv1.LogTypeClusterCreateHighLevel: true,
+	slv1.LogTypeClusterRemoveDetails:   true,
+	slv1.LogTypeClusterRemoveHighLevel: true,
+	slv1.LogTypeClusterStateUpdates:    true,
+}
+
+// Entry describes a log entry that will be posted for a cluster.
+type Entry struct {
+	// ClusterID is the internal identifier of the cluster. Either this or the ClusterUUID is
+	// mandatory. When only one of them is given the other is obtained from the clusters
+	// management service, if the poster has a clusters management client.
+	ClusterID string
+
+	// ClusterUUID is the external identifier of the cluster.
+	ClusterUUID string
+
+	// SubscriptionID is the identifier of the subscription of the cluster. When it is empty it is
+	// obtained from the clusters management service together with the cluster identifiers.
+	SubscriptionID string
+
+	// Severity is the severity of the entry. The default is `Info`.
+	Severity slv1.Severity
+
+	// LogType is the type of the entry. It is optional.
+	LogType slv1.LogType
+
+	// Summary is the template of the summary of the entry, using the syntax of the text/template
+	// package of the standard library. This is mandatory.
+	Summary string
+
+	// Description is the template of the description of the entry, using the syntax of the
+	// text/template package of the standard library.
+	Description string
+
+	// Data is the data that will be passed to the summary and description templates. For
+	// example, if the summary is `Node {{ .Node }} is down` the data could be a map containing
+	// the `Node` key.
+	Data interface{}
+
+	// DocReferences is the list of links to documents relevant to the entry.
+	DocReferences []string
+
+	// InternalOnly indicates if the entry should only be visible to internal users.
+	InternalOnly bool
+}
+
+// PosterBuilder contains the data and logic needed to build a poster.
+type PosterBuilder struct {
+	logger       logging.Logger
+	serviceLogs  *slv1.Client
+	clustersMgmt *cmv1.Client
+	serviceName  string
+}
+
+// Poster posts cluster log entries, rendering their templates, validating their severities and
+// completing the identifiers of the clusters.
+type Poster struct {
+	logger       logging.Logger
+	serviceLogs  *slv1.Client
+	clustersMgmt *cmv1.Client
+	serviceName  string
+}
+
+// clusterIDs contains the identifiers of a cluster.
+type clusterIDs struct {
+	id             string
+	uuid           string
+	subscriptionID string
+}
+
+// NewPoster creates a builder that can then be used to configure and create a poster.
+func NewPoster() *PosterBuilder {
+	return &PosterBuilder{}
+}
+
+// Logger sets the logger that the poster will use to write to the log. This is mandatory.
+func (b *PosterBuilder) Logger(value logging.Logger) *PosterBuilder {
+	b.logger = value
+	return b
+}
+
+// ServiceLogs sets the service logs client that will be used to post the entries. This is
+// mandatory.
+func (b *PosterBuilder) ServiceLogs(value *slv1.Client) *PosterBuilder {
+	b.serviceLogs = value
+	return b
+}
+
+// ClustersMgmt sets the clusters management client that will be used to obtain the identifiers of
+// the clusters that aren't given in the entries. This is optional, but without it the entries need
+// to contain all the identifiers.
+func (b *PosterBuilder) ClustersMgmt(value *cmv1.Client) *PosterBuilder {
+	b.clustersMgmt = value
+	return b
+}
+
+// ServiceName sets the name of the service that will be included in the entries. This is
+// mandatory.
+func (b *PosterBuilder) ServiceName(value string) *PosterBuilder {
+	b.serviceName = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new poster.
+func (b *PosterBuilder) Build() (result *Poster, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.serviceLogs == nil {
+		err = errors.New("service logs client is mandatory")
+		return
+	}
+	if b.serviceName == "" {
+		err = errors.New("service name is mandatory")
+		return
+	}
+
+	// Create and populate the object:
+	result = &Poster{
+		logger:       b.logger,
+		serviceLogs:  b.serviceLogs,
+		clustersMgmt: b.clustersMgmt,
+		serviceName:  b.serviceName,
+	}
+	return
+}
+
+// Post posts the given entries and returns the entries created by the server. All the entries are
+// rendered and validated before sending any of them, so a template or severity error means that
+// nothing was posted. If posting one of the entries fails the remaining entries aren't posted,
+// and the result contains the entries that were posted before the failure.
+func (p *Poster) Post(ctx context.Context, entries ...*Entry) (result []*slv1.LogEntry, err error) {
+	// Render all the entries before sending anything, so that errors in the templates are
+	// detected early:
+	resolved := map[string]*clusterIDs{}
+	objects := make([]*slv1.LogEntry, len(entries))
+	for i, entry := range entries {
+		objects[i], err = p.render(ctx, entry, resolved)
+		if err != nil {
+			err = fmt.Errorf("can't prepare entry %d: %w", i, err)
+			return
+		}
+	}
+
+	// Send the entries:
+	for i, object := range objects {
+		var response *slv1.ClusterLogsAddResponse
+		response, err = p.serviceLogs.ClusterLogs().Add().Body(object).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't post entry %d for cluster '%s': %w",
+				i, object.ClusterID(), err,
+			)
+			return
+		}
+		p.logger.Debug(
+			ctx,
+			"Posted log entry '%s' for cluster '%s'",
+			response.Body().ID(), object.ClusterID(),
+		)
+		result = append(result, response.Body())
+	}
+	return
+}
+
+func (p *Poster) render(ctx context.Context, entry *Entry,
+	resolved map[string]*clusterIDs) (result *slv1.LogEntry, err error) {
+	if entry == nil {
+		err = errors.New("entry is mandatory")
+		return
+	}
+	if entry.Summary == "" {
+		err = errors.New("summary is mandatory")
+		return
+	}
+	severity := entry.Severity
+	if severity == "" {
+		severity = slv1.SeverityInfo
+	}
+	if !validSeverities[severity] {
+		err = fmt.Errorf("severity '%s' isn't valid", severity)
+		return
+	}
+	if entry.LogType != "" && !validLogTypes[entry.LogType] {
+		err = fmt.Errorf("log type '%s' isn't valid", entry.LogType)
+		return
+	}
+	summary, err := execute("summary", entry.Summary, entry.Data)
+	if err != nil {
+		return
+	}
+	description, err := execute("description", entry.Description, entry.Data)
+	if err != nil {
+		return
+	}
+	ids, err := p.resolve(ctx, entry, resolved)
+	if err != nil {
+		return
+	}
+	builder := slv1.NewLogEntry().
+		ClusterID(ids.id).
+		ClusterUUID(ids.uuid).
+		SubscriptionID(ids.subscriptionID).
+		ServiceName(p.serviceName).
+		Severity(severity).
+		Summary(summary).
+		Description(description).
+		InternalOnly(entry.InternalOnly)
+	if entry.LogType != "" {
+		builder.LogType(entry.LogType)
+	}
+	if len(entry.DocReferences) > 0 {
+		builder.DocReferences(entry.DocReferences...)
+	}
+	result, err = builder.Build()
+	return
+}
+
+// resolve completes the identifiers of the cluster of the entry, using the clusters management
+// service if needed. Results are stored in the given map so that entries for the same cluster
+// don't require additional requests.
+func (p *Poster) resolve(ctx context.Context, entry *Entry,
+	resolved map[string]*clusterIDs) (result *clusterIDs, err error) {
+	result = &clusterIDs{
+		id:             entry.ClusterID,
+		uuid:           entry.ClusterUUID,
+		subscriptionID: entry.SubscriptionID,
+	}
+	if result.id == "" && result.uuid == "" {
+		err = errors.New("cluster identifier or UUID is mandatory")
+		return
+	}
+	if result.id != "" && result.uuid != "" && result.subscriptionID != "" {
+		return
+	}
+	if p.clustersMgmt == nil {
+		if result.id == "" {
+			err = fmt.Errorf(
+				"can't find identifier of cluster with UUID '%s' without a clusters "+
+					"management client",
+				result.uuid,
+			)
+		}
+		return
+	}
+	key := "id:" + result.id
+	if result.id == "" {
+		key = "uuid:" + result.uuid
+	}
+	cached, ok := resolved[key]
+	if !ok {
+		var cluster *cmv1.Cluster
+		cluster, err = p.findCluster(ctx, result.id, result.uuid)
+		if err != nil {
+			return
+		}
+		cached = &clusterIDs{
+			id:             cluster.ID(),
+			uuid:           cluster.ExternalID(),
+			subscriptionID: cluster.Subscription().ID(),
+		}
+		resolved[key] = cached
+	}
+	if result.id == "" {
+		result.id = cached.id
+	}
+	if result.uuid == "" {
+		result.uuid = cached.uuid
+	}
+	if result.subscriptionID == "" {
+		result.subscriptionID = cached.subscriptionID
+	}
+	return
+}
+
+func (p *Poster) findCluster(ctx context.Context, id, uuid string) (result *cmv1.Cluster,
+	err error) {
+	if id != "" {
+		var response *cmv1.ClusterGetResponse
+		response, err = p.clustersMgmt.Clusters().Cluster(id).Get().SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't get cluster '%s': %w", id, err)
+			return
+		}
+		result = response.Body()
+		return
+	}
+	response, err := p.clustersMgmt.Clusters().List().
+		Search(fmt.Sprintf("external_id = '%s'", uuid)).
+		Size(1).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't find cluster with UUID '%s': %w", uuid, err)
+		return
+	}
+	if response.Items().Len() == 0 {
+		err = fmt.Errorf("can't find cluster with UUID '%s'", uuid)
+		return
+	}
+	result = response.Items().Get(0)
+	return
+}
+
+// execute renders the given template text. Missing keys in the data are reported as errors.
+func execute(name, text string, data interface{}) (result string, err error) {
+	if text == "" {
+		return
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		err = fmt.Errorf("can't parse %s template: %w", name, err)
+		return
+	}
+	buffer := &bytes.Buffer{}
+	err = tmpl.Execute(buffer, data)
+	if err != nil {
+		err = fmt.Errorf("can't execute %s template: %w", name, err)
+		return
+	}
+	result = buffer.String()
+	return
+}
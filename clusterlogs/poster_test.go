@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterlogs
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+)
+
+var _ = Describe("Poster", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var poster *Poster
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = MakeTCPServer()
+		transport := ServerTransport(server)
+		poster, err = NewPoster().
+			Logger(logger).
+			ServiceLogs(slv1.NewClient(transport, "/api/service_logs/v1")).
+			ClustersMgmt(cmv1.NewClient(transport, "/api/clusters_mgmt/v1")).
+			ServiceName("my-service").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Can't be created without service name", func() {
+		_, err := NewPoster().
+			Logger(logger).
+			ServiceLogs(slv1.NewClient(ServerTransport(server), "/api/service_logs/v1")).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("service name"))
+	})
+
+	It("Renders templates and resolves the cluster", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"external_id": "my-uuid",
+					"subscription": {
+						"id": "my-subscription"
+					}
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/service_logs/v1/cluster_logs"),
+				VerifyJQ(".cluster_id", "123"),
+				VerifyJQ(".cluster_uuid", "my-uuid"),
+				VerifyJQ(".subscription_id", "my-subscription"),
+				VerifyJQ(".service_name", "my-service"),
+				VerifyJQ(".severity", "Warning"),
+				VerifyJQ(".summary", "Node worker-1 is down"),
+				VerifyJQ(".description", "Node worker-1 has been down for 5 minutes"),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "456"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/service_logs/v1/cluster_logs"),
+				VerifyJQ(".cluster_uuid", "my-uuid"),
+				VerifyJQ(".severity", "Info"),
+				VerifyJQ(".summary", "Node worker-1 is up"),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "789"
+				}`),
+			),
+		)
+		data := map[string]interface{}{
+			"Node":    "worker-1",
+			"Minutes": 5,
+		}
+		entries, err := poster.Post(
+			ctx,
+			&Entry{
+				ClusterID:   "123",
+				Severity:    slv1.SeverityWarning,
+				Summary:     "Node {{ .Node }} is down",
+				Description: "Node {{ .Node }} has been down for {{ .Minutes }} minutes",
+				Data:        data,
+			},
+			&Entry{
+				ClusterID: "123",
+				Summary:   "Node {{ .Node }} is up",
+				Data:      data,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].ID()).To(Equal("456"))
+		Expect(entries[1].ID()).To(Equal("789"))
+	})
+
+	It("Finds the cluster using the UUID", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+				ghttp.VerifyFormKV("search", "external_id = 'my-uuid'"),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"id": "123",
+							"external_id": "my-uuid"
+						}
+					]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/service_logs/v1/cluster_logs"),
+				VerifyJQ(".cluster_id", "123"),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "456"
+				}`),
+			),
+		)
+		_, err := poster.Post(ctx, &Entry{
+			ClusterUUID: "my-uuid",
+			Summary:     "Hello",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Doesn't send anything if a template is wrong", func() {
+		_, err := poster.Post(
+			ctx,
+			&Entry{
+				ClusterID:      "123",
+				ClusterUUID:    "my-uuid",
+				SubscriptionID: "my-subscription",
+				Summary:        "Good",
+			},
+			&Entry{
+				ClusterID:      "123",
+				ClusterUUID:    "my-uuid",
+				SubscriptionID: "my-subscription",
+				Summary:        "Node {{ .Node }} is down",
+				Data:           map[string]interface{}{},
+			},
+		)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("entry 1"))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Rejects invalid severity", func() {
+		_, err := poster.Post(ctx, &Entry{
+			ClusterID:      "123",
+			ClusterUUID:    "my-uuid",
+			SubscriptionID: "my-subscription",
+			Severity:       "Critical",
+			Summary:        "Hello",
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Critical"))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+})
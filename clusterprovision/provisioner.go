@@ -0,0 +1,458 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the cluster provisioner.
+
+package clusterprovision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/clustersmgmt"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Step identifies the steps of the provisioning workflow.
+type Step string
+
+const (
+	// StepCreateCluster is the step that creates the cluster, or finds the cluster created by
+	// a previous run.
+	StepCreateCluster Step = "create_cluster"
+
+	// StepWaitReady is the step that waits till the cluster is ready.
+	StepWaitReady Step = "wait_ready"
+
+	// StepIdentityProviders is the step that adds the identity providers.
+	StepIdentityProviders Step = "identity_providers"
+
+	// StepMachinePools is the step that adds the machine pools.
+	StepMachinePools Step = "machine_pools"
+
+	// StepNodePools is the step that adds the node pools of hosted control plane clusters.
+	StepNodePools Step = "node_pools"
+
+	// StepDone is the step reported when the complete workflow has finished.
+	StepDone Step = "done"
+)
+
+// Event contains the details of the progress of the provisioning workflow. It is passed to the
+// progress function configured in the builder.
+type Event struct {
+	// Step is the step of the workflow that generated the event.
+	Step Step
+
+	// Message is a human readable description of the progress.
+	Message string
+
+	// Cluster is the most recent version of the cluster retrieved from the server. It will be
+	// nil before the cluster is created.
+	Cluster *cmv1.Cluster
+}
+
+// ProvisionerBuilder contains the data and logic needed to build a cluster provisioner.
+type ProvisionerBuilder struct {
+	logger            logging.Logger
+	client            *cmv1.Client
+	cluster           *cmv1.Cluster
+	clusterID         string
+	identityProviders []*cmv1.IdentityProvider
+	machinePools      []*cmv1.MachinePool
+	nodePools         []*cmv1.NodePool
+	interval          time.Duration
+	progress          func(*Event)
+}
+
+// Provisioner knows how to run the complete workflow needed to provision a cluster: create the
+// cluster, wait till it is ready, and then add the identity providers and the machine or node
+// pools. The workflow can be resumed: if it is interrupted it can be run again, and the resources
+// that already exist will not be created again.
+type Provisioner struct {
+	logger            logging.Logger
+	client            *cmv1.Client
+	cluster           *cmv1.Cluster
+	clusterID         string
+	identityProviders []*cmv1.IdentityProvider
+	machinePools      []*cmv1.MachinePool
+	nodePools         []*cmv1.NodePool
+	interval          time.Duration
+	progress          func(*Event)
+}
+
+// NewProvisioner creates a builder that can then be used to configure and create a cluster
+// provisioner.
+func NewProvisioner() *ProvisionerBuilder {
+	return &ProvisionerBuilder{
+		interval: defaultInterval,
+	}
+}
+
+// Logger sets the logger that the provisioner will use to write to the log. This is mandatory.
+func (b *ProvisionerBuilder) Logger(value logging.Logger) *ProvisionerBuilder {
+	b.logger = value
+	return b
+}
+
+// Client sets the clusters management client that the provisioner uses to create the cluster and
+// its node pools and to poll the state of the cluster. This is mandatory.
+func (b *ProvisionerBuilder) Client(value *cmv1.Client) *ProvisionerBuilder {
+	b.client = value
+	return b
+}
+
+// Cluster sets the description of the cluster that will be created. This is mandatory unless the
+// identifier of an existing cluster is set with the ClusterID method. If the cluster has a name and
+// a cluster with that name already exists it will be reused instead of creating a new one.
+func (b *ProvisionerBuilder) Cluster(value *cmv1.Cluster) *ProvisionerBuilder {
+	b.cluster = value
+	return b
+}
+
+// ClusterID sets the identifier of a cluster that has already been created, for example by a
+// previous run that was interrupted. When this is set the cluster will not be created, the
+// workflow will continue from the next step.
+func (b *ProvisionerBuilder) ClusterID(value string) *ProvisionerBuilder {
+	b.clusterID = value
+	return b
+}
+
+// IdentityProviders sets the identity providers that will be added to the cluster when it is
+// ready. Identity providers that already exist with the same name will not be added again.
+func (b *ProvisionerBuilder) IdentityProviders(values ...*cmv1.IdentityProvider) *ProvisionerBuilder {
+	b.identityProviders = append(b.identityProviders, values...)
+	return b
+}
+
+// MachinePools sets the machine pools that will be added to the cluster when it is ready.
+// Machine pools that already exist with the same identifier will not be added again.
+func (b *ProvisionerBuilder) MachinePools(values ...*cmv1.MachinePool) *ProvisionerBuilder {
+	b.machinePools = append(b.machinePools, values...)
+	return b
+}
+
+// NodePools sets the node pools that will be added to the cluster when it is ready. This is
+// intended for clusters with hosted control planes. Node pools that already exist with the same
+// identifier will not be added again.
+func (b *ProvisionerBuilder) NodePools(values ...*cmv1.NodePool) *ProvisionerBuilder {
+	b.nodePools = append(b.nodePools, values...)
+	return b
+}
+
+// Interval sets the time to wait between checks of the state of the cluster. The default value is
+// thirty seconds.
+func (b *ProvisionerBuilder) Interval(value time.Duration) *ProvisionerBuilder {
+	b.interval = value
+	return b
+}
+
+// Progress sets a function that will be called to report the progress of the workflow. The
+// function is called synchronously, so it should return quickly.
+func (b *ProvisionerBuilder) Progress(value func(*Event)) *ProvisionerBuilder {
+	b.progress = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new cluster provisioner.
+func (b *ProvisionerBuilder) Build() (result *Provisioner, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	if b.cluster == nil && b.clusterID == "" {
+		err = errors.New("cluster or cluster identifier is mandatory")
+		return
+	}
+	if b.interval <= 0 {
+		err = errors.New("interval should be greater than zero")
+		return
+	}
+
+	// Create and populate the object:
+	result = &Provisioner{
+		logger:            b.logger,
+		client:            b.client,
+		cluster:           b.cluster,
+		clusterID:         b.clusterID,
+		identityProviders: b.identityProviders,
+		machinePools:      b.machinePools,
+		nodePools:         b.nodePools,
+		interval:          b.interval,
+		progress:          b.progress,
+	}
+
+	return
+}
+
+// Run executes the workflow and returns the cluster once it is ready and all the additional
+// resources have been added. If the workflow fails it can be run again, and it will continue from
+// the step that failed.
+func (p *Provisioner) Run(ctx context.Context) (result *cmv1.Cluster, err error) {
+	cluster, err := p.createCluster(ctx)
+	if err != nil {
+		return
+	}
+	cluster, err = p.waitReady(ctx, cluster)
+	if err != nil {
+		return
+	}
+	err = p.addIdentityProviders(ctx, cluster)
+	if err != nil {
+		return
+	}
+	err = p.addMachinePools(ctx, cluster)
+	if err != nil {
+		return
+	}
+	err = p.addNodePools(ctx, cluster)
+	if err != nil {
+		return
+	}
+	p.notify(ctx, StepDone, cluster, "Cluster '%s' has been provisioned", cluster.ID())
+	result = cluster
+	return
+}
+
+// createCluster creates the cluster, unless it has already been created.
+func (p *Provisioner) createCluster(ctx context.Context) (result *cmv1.Cluster, err error) {
+	clusterClient := p.client.Clusters()
+
+	// If we have the identifier of the cluster then we just need to fetch it:
+	if p.clusterID != "" {
+		var response *cmv1.ClusterGetResponse
+		response, err = clusterClient.Cluster(p.clusterID).Get().SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't get cluster '%s': %w", p.clusterID, err)
+			return
+		}
+		result = response.Body()
+		p.notify(ctx, StepCreateCluster, result, "Using existing cluster '%s'", result.ID())
+		return
+	}
+
+	// If there is already a cluster with the same name then we use it, as it has probably been
+	// created by a previous run:
+	name := p.cluster.Name()
+	if name != "" {
+		var response *cmv1.ClustersListResponse
+		response, err = clusterClient.List().
+			Search(fmt.Sprintf("name = '%s'", strings.ReplaceAll(name, "'", "''"))).
+			Size(1).
+			SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't find cluster with name '%s': %w", name, err)
+			return
+		}
+		if response.Items().Len() > 0 {
+			result = response.Items().Get(0)
+			p.notify(
+				ctx, StepCreateCluster, result,
+				"Using existing cluster '%s' with name '%s'", result.ID(), name,
+			)
+			return
+		}
+	}
+
+	// Create the cluster:
+	response, err := clusterClient.Add().Body(p.cluster).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't create cluster: %w", err)
+		return
+	}
+	result = response.Body()
+	if result.ID() == "" {
+		err = fmt.Errorf(
+			"server didn't return the identifier of the created cluster, status is %d",
+			response.Status(),
+		)
+		return
+	}
+	p.notify(ctx, StepCreateCluster, result, "Created cluster '%s'", result.ID())
+	return
+}
+
+// waitReady waits till the cluster is ready, or till it is in a state that means that it will
+// never be ready.
+func (p *Provisioner) waitReady(ctx context.Context,
+	cluster *cmv1.Cluster) (result *cmv1.Cluster, err error) {
+	if cluster.State() == cmv1.ClusterStateReady {
+		p.notify(ctx, StepWaitReady, cluster, "Cluster '%s' is ready", cluster.ID())
+		result = cluster
+		return
+	}
+	p.notify(
+		ctx, StepWaitReady, cluster,
+		"Cluster '%s' is in state '%s'", cluster.ID(), cluster.State(),
+	)
+	result, err = clustersmgmt.WaitForState(
+		ctx, p.client, cluster.ID(), cmv1.ClusterStateReady,
+		&clustersmgmt.WaitOptions{
+			InitialInterval: p.interval,
+			MaxInterval:     p.interval,
+			Progress: func(event *clustersmgmt.WaitEvent) {
+				p.notify(
+					ctx, StepWaitReady, event.Cluster,
+					"Cluster '%s' is in state '%s'", event.Cluster.ID(), event.Cluster.State(),
+				)
+			},
+		},
+	)
+	if err != nil {
+		return
+	}
+	p.notify(ctx, StepWaitReady, result, "Cluster '%s' is ready", result.ID())
+	return
+}
+
+// addIdentityProviders adds the identity providers that don't exist yet.
+func (p *Provisioner) addIdentityProviders(ctx context.Context, cluster *cmv1.Cluster) error {
+	if len(p.identityProviders) == 0 {
+		return nil
+	}
+	collection := p.client.Clusters().Cluster(cluster.ID()).IdentityProviders()
+	existing := map[string]bool{}
+	page := 1
+	for {
+		response, err := collection.List().Page(page).Size(listSize).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf("can't list identity providers: %w", err)
+		}
+		response.Items().Each(func(item *cmv1.IdentityProvider) bool {
+			existing[item.Name()] = true
+			return true
+		})
+		if response.Items().Len() < listSize {
+			break
+		}
+		page++
+	}
+	for _, idp := range p.identityProviders {
+		if existing[idp.Name()] {
+			p.notify(
+				ctx, StepIdentityProviders, cluster,
+				"Identity provider '%s' already exists", idp.Name(),
+			)
+			continue
+		}
+		_, err := collection.Add().Body(idp).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf("can't add identity provider '%s': %w", idp.Name(), err)
+		}
+		p.notify(ctx, StepIdentityProviders, cluster, "Added identity provider '%s'", idp.Name())
+	}
+	return nil
+}
+
+// addMachinePools adds the machine pools that don't exist yet.
+func (p *Provisioner) addMachinePools(ctx context.Context, cluster *cmv1.Cluster) error {
+	if len(p.machinePools) == 0 {
+		return nil
+	}
+	collection := p.client.Clusters().Cluster(cluster.ID()).MachinePools()
+	existing := map[string]bool{}
+	page := 1
+	for {
+		response, err := collection.List().Page(page).Size(listSize).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf("can't list machine pools: %w", err)
+		}
+		response.Items().Each(func(item *cmv1.MachinePool) bool {
+			existing[item.ID()] = true
+			return true
+		})
+		if response.Items().Len() < listSize {
+			break
+		}
+		page++
+	}
+	for _, pool := range p.machinePools {
+		if existing[pool.ID()] {
+			p.notify(ctx, StepMachinePools, cluster, "Machine pool '%s' already exists", pool.ID())
+			continue
+		}
+		_, err := collection.Add().Body(pool).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf("can't add machine pool '%s': %w", pool.ID(), err)
+		}
+		p.notify(ctx, StepMachinePools, cluster, "Added machine pool '%s'", pool.ID())
+	}
+	return nil
+}
+
+// addNodePools adds the node pools that don't exist yet.
+func (p *Provisioner) addNodePools(ctx context.Context, cluster *cmv1.Cluster) error {
+	if len(p.nodePools) == 0 {
+		return nil
+	}
+	collection := p.client.Clusters().Cluster(cluster.ID()).NodePools()
+	existing := map[string]bool{}
+	page := 1
+	for {
+		response, err := collection.List().Page(page).Size(listSize).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf("can't list node pools: %w", err)
+		}
+		response.Items().Each(func(item *cmv1.NodePool) bool {
+			existing[item.ID()] = true
+			return true
+		})
+		if response.Items().Len() < listSize {
+			break
+		}
+		page++
+	}
+	for _, pool := range p.nodePools {
+		if existing[pool.ID()] {
+			p.notify(ctx, StepNodePools, cluster, "Node pool '%s' already exists", pool.ID())
+			continue
+		}
+		_, err := collection.Add().Body(pool).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf("can't add node pool '%s': %w", pool.ID(), err)
+		}
+		p.notify(ctx, StepNodePools, cluster, "Added node pool '%s'", pool.ID())
+	}
+	return nil
+}
+
+// notify writes the progress message to the log and calls the progress function, if any.
+func (p *Provisioner) notify(ctx context.Context, step Step, cluster *cmv1.Cluster,
+	format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	p.logger.Debug(ctx, "%s", message)
+	if p.progress != nil {
+		p.progress(&Event{
+			Step:    step,
+			Message: message,
+			Cluster: cluster,
+		})
+	}
+}
+
+// Default values:
+const (
+	defaultInterval = 30 * time.Second
+	listSize        = 100
+)
@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterprovision
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	"github.com/openshift-online/ocm-sdk-go/clustersmgmt"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Provisioner", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var client *cmv1.Client
+
+	BeforeEach(func() {
+		// Create a context:
+		ctx = context.Background()
+
+		// Create the server:
+		server = MakeTCPServer()
+
+		// Create a client that sends the requests to the server:
+		client = cmv1.NewClient(ServerTransport(server), "/api/clusters_mgmt/v1")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Can't be built without client", func() {
+		_, err := NewProvisioner().
+			Logger(logger).
+			ClusterID("123").
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("client"))
+	})
+
+	It("Can't be built without cluster", func() {
+		_, err := NewProvisioner().
+			Logger(logger).
+			Client(client).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("cluster"))
+	})
+
+	It("Creates the cluster and the additional resources", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+				ghttp.VerifyFormKV("search", "name = 'my'"),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 0,
+					"total": 0,
+					"items": []
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(".name", "my"),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "123",
+					"name": "my",
+					"state": "installing"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"name": "my",
+					"state": "ready"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/identity_providers",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 0,
+					"total": 0,
+					"items": []
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/clusters_mgmt/v1/clusters/123/identity_providers",
+				),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "456",
+					"name": "github"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/machine_pools",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"id": "worker"
+						}
+					]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/clusters_mgmt/v1/clusters/123/machine_pools",
+				),
+				VerifyJQ(".id", "extra"),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "extra"
+				}`),
+			),
+		)
+
+		// Prepare the resources:
+		cluster, err := cmv1.NewCluster().Name("my").Build()
+		Expect(err).ToNot(HaveOccurred())
+		idp, err := cmv1.NewIdentityProvider().Name("github").Build()
+		Expect(err).ToNot(HaveOccurred())
+		worker, err := cmv1.NewMachinePool().ID("worker").Build()
+		Expect(err).ToNot(HaveOccurred())
+		extra, err := cmv1.NewMachinePool().ID("extra").Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Run the provisioner:
+		var steps []Step
+		provisioner, err := NewProvisioner().
+			Logger(logger).
+			Client(client).
+			Cluster(cluster).
+			IdentityProviders(idp).
+			MachinePools(worker, extra).
+			Interval(time.Millisecond).
+			Progress(func(event *Event) {
+				steps = append(steps, event.Step)
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := provisioner.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ID()).To(Equal("123"))
+		Expect(result.State()).To(Equal(cmv1.ClusterStateReady))
+		Expect(steps).To(Equal([]Step{
+			StepCreateCluster,
+			StepWaitReady,
+			StepWaitReady,
+			StepIdentityProviders,
+			StepMachinePools,
+			StepMachinePools,
+			StepDone,
+		}))
+	})
+
+	It("Reuses the existing cluster with the same name", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"id": "123",
+							"name": "my",
+							"state": "ready"
+						}
+					]
+				}`),
+			),
+		)
+		cluster, err := cmv1.NewCluster().Name("my").Build()
+		Expect(err).ToNot(HaveOccurred())
+		provisioner, err := NewProvisioner().
+			Logger(logger).
+			Client(client).
+			Cluster(cluster).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := provisioner.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ID()).To(Equal("123"))
+	})
+
+	It("Fails if the cluster is in error state", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"state": "installing"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"state": "error",
+					"status": {
+						"provision_error_code": "OCM3999",
+						"provision_error_message": "Unknown error"
+					}
+				}`),
+			),
+		)
+		provisioner, err := NewProvisioner().
+			Logger(logger).
+			Client(client).
+			ClusterID("123").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provisioner.Run(ctx)
+		Expect(err).To(HaveOccurred())
+		var stateErr *clustersmgmt.StateError
+		Expect(errors.As(err, &stateErr)).To(BeTrue())
+		Expect(stateErr.Code).To(Equal("OCM3999"))
+		Expect(err.Error()).To(ContainSubstring("OCM3999"))
+		Expect(err.Error()).To(ContainSubstring("Unknown error"))
+	})
+})
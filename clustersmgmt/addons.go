@@ -0,0 +1,544 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that install, upgrade and uninstall add-ons.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// DefaultAddOnInterval is the default time between checks of the state of add-on installations.
+const DefaultAddOnInterval = 30 * time.Second
+
+// AddOnOptions contains the options that control how add-ons are installed, upgraded and
+// uninstalled.
+type AddOnOptions struct {
+	// Version is the identifier of the version of the add-on. When installing the default is the
+	// current version of the add-on. It is mandatory when upgrading.
+	Version string
+
+	// Parameters contains the values of the parameters of the add-on, indexed by parameter
+	// identifier. When upgrading the values are merged with the values of the existing
+	// installation.
+	Parameters map[string]string
+
+	// Interval is the time between checks of the state of the installation. The default is
+	// thirty seconds.
+	Interval time.Duration
+}
+
+// InstallAddOn installs the given add-on in the given cluster and waits till the installation is
+// ready. The parameters are validated against the schema of the selected version of the add-on
+// before submitting the installation, so the client needs access to both the add-on catalog and
+// the cluster. The options can be nil to use the defaults. If the installation fails the error
+// contains the state description and the messages of the requirements that aren't fulfilled.
+func InstallAddOn(ctx context.Context, client *v1.Client, clusterID, addOnID string,
+	options *AddOnOptions) (result *v1.AddOnInstallation, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	if addOnID == "" {
+		err = errors.New("add-on identifier is mandatory")
+		return
+	}
+	interval, err := addOnInterval(options)
+	if err != nil {
+		return
+	}
+	var versionID string
+	var values map[string]string
+	if options != nil {
+		versionID = options.Version
+		values = options.Parameters
+	}
+
+	// Resolve the add-on and the version:
+	addOn, version, err := resolveAddOn(ctx, client, addOnID, versionID)
+	if err != nil {
+		return
+	}
+	values, err = validateAddOnParameters(addOn, version, values)
+	if err != nil {
+		return
+	}
+
+	// Submit the installation:
+	builder := v1.NewAddOnInstallation().
+		ID(addOnID).
+		Addon(v1.NewAddOn().ID(addOnID)).
+		Parameters(addOnParametersBuilder(values))
+	if version != nil {
+		builder.AddonVersion(v1.NewAddOnVersion().ID(version.ID()))
+	}
+	installation, err := builder.Build()
+	if err != nil {
+		return
+	}
+	_, err = client.Clusters().Cluster(clusterID).Addons().Add().
+		Body(installation).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't install add-on '%s' in cluster '%s': %w",
+			addOnID, clusterID, err,
+		)
+		return
+	}
+
+	// Wait till it is ready:
+	result, err = waitForAddOn(ctx, client, clusterID, addOnID, "", interval)
+	return
+}
+
+// UpgradeAddOn upgrades the installation of the given add-on to the version given in the options,
+// and waits till the installation is ready again. The version must be one of the upgrades
+// available for the installed version. The parameters given in the options are merged with the
+// values of the existing installation and validated against the schema of the new version.
+func UpgradeAddOn(ctx context.Context, client *v1.Client, clusterID, addOnID string,
+	options *AddOnOptions) (result *v1.AddOnInstallation, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	if addOnID == "" {
+		err = errors.New("add-on identifier is mandatory")
+		return
+	}
+	if options == nil || options.Version == "" {
+		err = errors.New("version is mandatory")
+		return
+	}
+	interval, err := addOnInterval(options)
+	if err != nil {
+		return
+	}
+	resource := client.Clusters().Cluster(clusterID).Addons().Addoninstallation(addOnID)
+
+	// Get the existing installation:
+	getResponse, err := resource.Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get installation of add-on '%s' in cluster '%s': %w",
+			addOnID, clusterID, err,
+		)
+		return
+	}
+	current := getResponse.Body()
+
+	// Check that the upgrade is possible:
+	addOn, version, err := resolveAddOn(ctx, client, addOnID, options.Version)
+	if err != nil {
+		return
+	}
+	installedID := current.AddonVersion().ID()
+	if installedID == version.ID() {
+		err = fmt.Errorf(
+			"add-on '%s' in cluster '%s' already has version '%s'",
+			addOnID, clusterID, installedID,
+		)
+		return
+	}
+	if installedID != "" {
+		var installedResponse *v1.AddOnVersionGetResponse
+		installedResponse, err = client.Addons().Addon(addOnID).
+			Versions().
+			Version(installedID).
+			Get().
+			SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't get version '%s' of add-on '%s': %w",
+				installedID, addOnID, err,
+			)
+			return
+		}
+		available := installedResponse.Body().AvailableUpgrades()
+		if !slices.Contains(available, version.ID()) {
+			err = fmt.Errorf(
+				"add-on '%s' can't be upgraded from version '%s' to version '%s', "+
+					"available upgrades are %s",
+				addOnID, installedID, version.ID(), quoteList(available),
+			)
+			return
+		}
+	}
+
+	// Merge and validate the parameters:
+	values := map[string]string{}
+	current.Parameters().Each(func(item *v1.AddOnInstallationParameter) bool {
+		values[item.ID()] = item.Value()
+		return true
+	})
+	for name, value := range options.Parameters {
+		values[name] = value
+	}
+	values, err = validateAddOnParameters(addOn, version, values)
+	if err != nil {
+		return
+	}
+
+	// Submit the upgrade:
+	update, err := v1.NewAddOnInstallation().
+		AddonVersion(v1.NewAddOnVersion().ID(version.ID())).
+		Parameters(addOnParametersBuilder(values)).
+		Build()
+	if err != nil {
+		return
+	}
+	_, err = resource.Update().Body(update).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't upgrade add-on '%s' in cluster '%s' to version '%s': %w",
+			addOnID, clusterID, version.ID(), err,
+		)
+		return
+	}
+
+	// Wait till it is ready:
+	result, err = waitForAddOn(ctx, client, clusterID, addOnID, version.ID(), interval)
+	return
+}
+
+// UninstallAddOn uninstalls the given add-on from the given cluster and waits till the
+// installation disappears. The options can be nil to use the defaults.
+func UninstallAddOn(ctx context.Context, client *v1.Client, clusterID, addOnID string,
+	options *AddOnOptions) error {
+	if clusterID == "" {
+		return errors.New("cluster identifier is mandatory")
+	}
+	if addOnID == "" {
+		return errors.New("add-on identifier is mandatory")
+	}
+	interval, err := addOnInterval(options)
+	if err != nil {
+		return err
+	}
+	resource := client.
+		Clusters().
+		Cluster(clusterID).
+		Addons().
+		Addoninstallation(addOnID)
+	_, err = resource.Delete().SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"can't uninstall add-on '%s' from cluster '%s': %w",
+			addOnID, clusterID, err,
+		)
+	}
+	for {
+		var response *v1.AddOnInstallationGetResponse
+		response, err = resource.Get().SendContext(ctx)
+		if response != nil && response.Status() == http.StatusNotFound {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf(
+				"can't get installation of add-on '%s' in cluster '%s': %w",
+				addOnID, clusterID, err,
+			)
+		}
+		if response.Body().State() == v1.AddOnInstallationStateFailed {
+			return addOnFailure(clusterID, addOnID, response.Body())
+		}
+		err = sleep(ctx, interval)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// resolveAddOn gets the add-on and the requested version. If the version identifier is empty the
+// current version of the add-on is used.
+func resolveAddOn(ctx context.Context, client *v1.Client, addOnID,
+	versionID string) (addOn *v1.AddOn, version *v1.AddOnVersion, err error) {
+	resource := client.Addons().Addon(addOnID)
+	addOnResponse, err := resource.Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't get add-on '%s': %w", addOnID, err)
+		return
+	}
+	addOn = addOnResponse.Body()
+	if !addOn.Enabled() {
+		err = fmt.Errorf("add-on '%s' isn't enabled", addOnID)
+		return
+	}
+	if versionID == "" {
+		version = addOn.Version()
+		return
+	}
+	versionResponse, err := resource.Versions().Version(versionID).Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get version '%s' of add-on '%s': %w",
+			versionID, addOnID, err,
+		)
+		return
+	}
+	version = versionResponse.Body()
+	if !version.Enabled() {
+		err = fmt.Errorf("version '%s' of add-on '%s' isn't enabled", versionID, addOnID)
+		return
+	}
+	return
+}
+
+// validateAddOnParameters checks the given values against the parameters of the add-on version,
+// or of the add-on itself if the version doesn't have parameters. It returns the values with the
+// defaults of required parameters added.
+func validateAddOnParameters(addOn *v1.AddOn, version *v1.AddOnVersion,
+	values map[string]string) (result map[string]string, err error) {
+	schema := addOn.Parameters()
+	if version != nil && version.Parameters().Len() > 0 {
+		schema = version.Parameters()
+	}
+	parameters := map[string]*v1.AddOnParameter{}
+	schema.Each(func(parameter *v1.AddOnParameter) bool {
+		if parameter.Enabled() {
+			parameters[parameter.ID()] = parameter
+		}
+		return true
+	})
+
+	// Reject unknown parameters, sorting the names so that the error is predictable:
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if parameters[name] == nil {
+			err = fmt.Errorf(
+				"add-on '%s' doesn't have a parameter named '%s'",
+				addOn.ID(), name,
+			)
+			return
+		}
+	}
+
+	// Check the values:
+	result = map[string]string{}
+	for name, value := range values {
+		result[name] = value
+	}
+	ids := make([]string, 0, len(parameters))
+	for id := range parameters {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		parameter := parameters[id]
+		value, ok := result[id]
+		if !ok {
+			if !parameter.Required() {
+				continue
+			}
+			if parameter.DefaultValue() == "" {
+				err = fmt.Errorf(
+					"parameter '%s' of add-on '%s' is mandatory",
+					id, addOn.ID(),
+				)
+				result = nil
+				return
+			}
+			value = parameter.DefaultValue()
+			result[id] = value
+		}
+		err = validateAddOnParameter(addOn, parameter, value)
+		if err != nil {
+			result = nil
+			return
+		}
+	}
+	return
+}
+
+func validateAddOnParameter(addOn *v1.AddOn, parameter *v1.AddOnParameter, value string) error {
+	switch parameter.ValueType() {
+	case "number":
+		_, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf(
+				"value '%s' of parameter '%s' of add-on '%s' isn't a number",
+				value, parameter.ID(), addOn.ID(),
+			)
+		}
+	case "boolean":
+		_, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf(
+				"value '%s' of parameter '%s' of add-on '%s' isn't a boolean",
+				value, parameter.ID(), addOn.ID(),
+			)
+		}
+	}
+	options := parameter.Options()
+	if len(options) > 0 {
+		allowed := make([]string, len(options))
+		for i, option := range options {
+			allowed[i] = option.Value()
+		}
+		if !slices.Contains(allowed, value) {
+			return fmt.Errorf(
+				"value '%s' of parameter '%s' of add-on '%s' isn't valid, "+
+					"allowed values are %s",
+				value, parameter.ID(), addOn.ID(), quoteList(allowed),
+			)
+		}
+	}
+	if parameter.Validation() != "" {
+		re, err := regexp.Compile(parameter.Validation())
+		if err != nil {
+			return fmt.Errorf(
+				"can't compile validation expression of parameter '%s' of add-on "+
+					"'%s': %w",
+				parameter.ID(), addOn.ID(), err,
+			)
+		}
+		if !re.MatchString(value) {
+			message := parameter.ValidationErrMsg()
+			if message == "" {
+				message = fmt.Sprintf(
+					"it doesn't match regular expression '%s'",
+					parameter.Validation(),
+				)
+			}
+			return fmt.Errorf(
+				"value '%s' of parameter '%s' of add-on '%s' isn't valid: %s",
+				value, parameter.ID(), addOn.ID(), message,
+			)
+		}
+	}
+	return nil
+}
+
+func addOnParametersBuilder(values map[string]string) *v1.AddOnInstallationParameterListBuilder {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	items := make([]*v1.AddOnInstallationParameterBuilder, len(names))
+	for i, name := range names {
+		items[i] = v1.NewAddOnInstallationParameter().ID(name).Value(values[name])
+	}
+	return v1.NewAddOnInstallationParameterList().Items(items...)
+}
+
+// waitForAddOn waits till the installation of the given add-on is ready. If the version identifier
+// isn't empty it also waits till the installation has that version.
+func waitForAddOn(ctx context.Context, client *v1.Client, clusterID, addOnID, versionID string,
+	interval time.Duration) (result *v1.AddOnInstallation, err error) {
+	resource := client.Clusters().Cluster(clusterID).Addons().Addoninstallation(addOnID)
+	for {
+		var response *v1.AddOnInstallationGetResponse
+		response, err = resource.Get().SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't get installation of add-on '%s' in cluster '%s': %w",
+				addOnID, clusterID, err,
+			)
+			return
+		}
+		installation := response.Body()
+		switch installation.State() {
+		case v1.AddOnInstallationStateReady:
+			if versionID == "" || installation.AddonVersion().ID() == versionID {
+				result = installation
+				return
+			}
+		case v1.AddOnInstallationStateFailed:
+			err = addOnFailure(clusterID, addOnID, installation)
+			return
+		}
+		err = sleep(ctx, interval)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// addOnFailure creates the error returned when an installation fails, including the description
+// of the state and the messages of the requirements that aren't fulfilled.
+func addOnFailure(clusterID, addOnID string, installation *v1.AddOnInstallation) error {
+	var diagnostics []string
+	if installation.StateDescription() != "" {
+		diagnostics = append(diagnostics, installation.StateDescription())
+	}
+	for _, requirement := range installation.Addon().Requirements() {
+		status := requirement.Status()
+		if status == nil || status.Fulfilled() {
+			continue
+		}
+		for _, message := range status.ErrorMsgs() {
+			diagnostics = append(diagnostics, fmt.Sprintf(
+				"requirement '%s': %s",
+				requirement.ID(), message,
+			))
+		}
+	}
+	if len(diagnostics) == 0 {
+		return fmt.Errorf(
+			"installation of add-on '%s' in cluster '%s' failed",
+			addOnID, clusterID,
+		)
+	}
+	return fmt.Errorf(
+		"installation of add-on '%s' in cluster '%s' failed: %s",
+		addOnID, clusterID, strings.Join(diagnostics, "; "),
+	)
+}
+
+func addOnInterval(options *AddOnOptions) (result time.Duration, err error) {
+	result = DefaultAddOnInterval
+	if options == nil {
+		return
+	}
+	if options.Interval < 0 {
+		err = fmt.Errorf(
+			"interval %s isn't valid, it should be greater or equal than zero",
+			options.Interval,
+		)
+		return
+	}
+	if options.Interval > 0 {
+		result = options.Interval
+	}
+	return
+}
+
+func quoteList(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = "'" + value + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,417 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that install, upgrade and uninstall add-ons.
+
+package clustersmgmt
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Add-ons", func() {
+	const addOnPath = "/api/clusters_mgmt/v1/addons/my-addon"
+	const installationsPath = "/api/clusters_mgmt/v1/clusters/123/addons"
+	const installationPath = installationsPath + "/my-addon"
+
+	const addOn = `{
+		"kind": "AddOn",
+		"id": "my-addon",
+		"enabled": true,
+		"version": {
+			"kind": "AddOnVersion",
+			"id": "1.0.0",
+			"enabled": true
+		},
+		"parameters": {
+			"items": [
+				{
+					"id": "size",
+					"enabled": true,
+					"required": true,
+					"value_type": "string",
+					"options": [
+						{"name": "Small", "value": "small"},
+						{"name": "Large", "value": "large"}
+					]
+				},
+				{
+					"id": "email",
+					"enabled": true,
+					"required": true,
+					"value_type": "string",
+					"validation": "^[^@]+@[^@]+$",
+					"validation_err_msg": "must be an email address"
+				},
+				{
+					"id": "replicas",
+					"enabled": true,
+					"required": true,
+					"value_type": "number",
+					"default_value": "3"
+				}
+			]
+		}
+	}`
+
+	It("Installs an add-on and waits till it is ready", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, addOnPath),
+				RespondWithJSON(http.StatusOK, addOn),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, installationsPath),
+				ghttp.VerifyJSON(`{
+					"kind": "AddOnInstallation",
+					"id": "my-addon",
+					"addon": {
+						"kind": "AddOn",
+						"id": "my-addon"
+					},
+					"addon_version": {
+						"kind": "AddOnVersion",
+						"id": "1.0.0"
+					},
+					"parameters": {
+						"items": [
+							{
+								"kind": "AddOnInstallationParameter",
+								"id": "email",
+								"value": "me@example.com"
+							},
+							{
+								"kind": "AddOnInstallationParameter",
+								"id": "replicas",
+								"value": "3"
+							},
+							{
+								"kind": "AddOnInstallationParameter",
+								"id": "size",
+								"value": "small"
+							}
+						]
+					}
+				}`),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "AddOnInstallation",
+					"id": "my-addon",
+					"state": "pending"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, installationPath),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnInstallation",
+					"id": "my-addon",
+					"state": "installing"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, installationPath),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnInstallation",
+					"id": "my-addon",
+					"state": "ready"
+				}`),
+			),
+		)
+		installation, err := InstallAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Parameters: map[string]string{
+					"size":  "small",
+					"email": "me@example.com",
+				},
+				Interval: time.Millisecond,
+			})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(installation.ID()).To(Equal("my-addon"))
+	})
+
+	It("Rejects a missing required parameter", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, addOn),
+		)
+		_, err := InstallAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Parameters: map[string]string{
+					"size": "small",
+				},
+			})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("parameter 'email' of add-on 'my-addon' is mandatory"))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Rejects a value that isn't one of the options", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, addOn),
+		)
+		_, err := InstallAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Parameters: map[string]string{
+					"size":  "huge",
+					"email": "me@example.com",
+				},
+			})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("allowed values are 'small', 'large'"))
+	})
+
+	It("Rejects a value that doesn't pass the validation", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, addOn),
+		)
+		_, err := InstallAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Parameters: map[string]string{
+					"size":  "small",
+					"email": "me",
+				},
+			})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("must be an email address"))
+	})
+
+	It("Rejects an unknown parameter", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, addOn),
+		)
+		_, err := InstallAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Parameters: map[string]string{
+					"colour": "blue",
+				},
+			})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("add-on 'my-addon' doesn't have a parameter named 'colour'"))
+	})
+
+	It("Reports the diagnostics when the installation fails", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, addOn),
+			RespondWithJSON(http.StatusCreated, `{
+				"kind": "AddOnInstallation",
+				"id": "my-addon"
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "AddOnInstallation",
+				"id": "my-addon",
+				"state": "failed",
+				"state_description": "Operator isn't healthy",
+				"addon": {
+					"kind": "AddOn",
+					"id": "my-addon",
+					"requirements": [{
+						"id": "nodes",
+						"resource": "machine_pool",
+						"status": {
+							"fulfilled": false,
+							"error_msgs": ["needs at least 3 nodes"]
+						}
+					}]
+				}
+			}`),
+		)
+		_, err := InstallAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Parameters: map[string]string{
+					"size":  "small",
+					"email": "me@example.com",
+				},
+				Interval: time.Millisecond,
+			})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(
+			"installation of add-on 'my-addon' in cluster '123' failed: " +
+				"Operator isn't healthy; requirement 'nodes': needs at least 3 nodes",
+		))
+	})
+
+	It("Upgrades an add-on to an available version", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, installationPath),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnInstallation",
+					"id": "my-addon",
+					"state": "ready",
+					"addon_version": {"id": "1.0.0"},
+					"parameters": {
+						"items": [
+							{"id": "size", "value": "small"},
+							{"id": "email", "value": "me@example.com"},
+							{"id": "replicas", "value": "3"}
+						]
+					}
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, addOnPath),
+				RespondWithJSON(http.StatusOK, addOn),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, addOnPath+"/versions/1.1.0"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnVersion",
+					"id": "1.1.0",
+					"enabled": true
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, addOnPath+"/versions/1.0.0"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnVersion",
+					"id": "1.0.0",
+					"enabled": true,
+					"available_upgrades": ["1.1.0"]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, installationPath),
+				ghttp.VerifyJSON(`{
+					"kind": "AddOnInstallation",
+					"addon_version": {
+						"kind": "AddOnVersion",
+						"id": "1.1.0"
+					},
+					"parameters": {
+						"items": [
+							{
+								"kind": "AddOnInstallationParameter",
+								"id": "email",
+								"value": "me@example.com"
+							},
+							{
+								"kind": "AddOnInstallationParameter",
+								"id": "replicas",
+								"value": "3"
+							},
+							{
+								"kind": "AddOnInstallationParameter",
+								"id": "size",
+								"value": "large"
+							}
+						]
+					}
+				}`),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnInstallation",
+					"id": "my-addon"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, installationPath),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnInstallation",
+					"id": "my-addon",
+					"state": "ready",
+					"addon_version": {"id": "1.0.0"}
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, installationPath),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnInstallation",
+					"id": "my-addon",
+					"state": "ready",
+					"addon_version": {"id": "1.1.0"}
+				}`),
+			),
+		)
+		installation, err := UpgradeAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Version: "1.1.0",
+				Parameters: map[string]string{
+					"size": "large",
+				},
+				Interval: time.Millisecond,
+			})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(installation.AddonVersion().ID()).To(Equal("1.1.0"))
+	})
+
+	It("Rejects an upgrade to a version that isn't available", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "AddOnInstallation",
+				"id": "my-addon",
+				"addon_version": {"id": "1.0.0"}
+			}`),
+			RespondWithJSON(http.StatusOK, addOn),
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "AddOnVersion",
+				"id": "2.0.0",
+				"enabled": true
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "AddOnVersion",
+				"id": "1.0.0",
+				"enabled": true,
+				"available_upgrades": ["1.1.0"]
+			}`),
+		)
+		_, err := UpgradeAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Version: "2.0.0",
+			})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(
+			"add-on 'my-addon' can't be upgraded from version '1.0.0' to version " +
+				"'2.0.0', available upgrades are '1.1.0'",
+		))
+	})
+
+	It("Uninstalls an add-on and waits till it disappears", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, installationPath),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, installationPath),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "AddOnInstallation",
+					"id": "my-addon",
+					"state": "deleting"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, installationPath),
+				RespondWithJSON(http.StatusNotFound, `{
+					"kind": "Error",
+					"id": "404",
+					"href": "/api/clusters_mgmt/v1/errors/404",
+					"code": "CLUSTERS-MGMT-404",
+					"reason": "Add-on installation not found"
+				}`),
+			),
+		)
+		err := UninstallAddOn(ctx, client, "123", "my-addon",
+			&AddOnOptions{
+				Interval: time.Millisecond,
+			})
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
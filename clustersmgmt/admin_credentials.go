@@ -0,0 +1,315 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains a helper that obtains administrator credentials for a cluster, creating the
+// administrator user when needed.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+)
+
+// Defaults used to create the administrator user:
+const (
+	DefaultAdminUsername             = "cluster-admin"
+	DefaultAdminIdentityProviderName = "cluster-admin"
+	DefaultAdminGroup                = "cluster-admins"
+	DefaultAdminCredentialsInterval  = 5 * time.Second
+)
+
+// AdminCredentialsOptions contains the options that control how the administrator credentials are
+// obtained.
+type AdminCredentialsOptions struct {
+	// Username is the name of the administrator user. The default is `cluster-admin`.
+	Username string
+
+	// Password is the password of the administrator user. The default is to generate a random
+	// one.
+	Password string
+
+	// Interval is the time between checks while waiting for the user to be added to the
+	// administrators group. The default is five seconds.
+	Interval time.Duration
+}
+
+// AdminCredentials contains the credentials of the administrator of a cluster. Either the kubeconfig
+// or the user name and password are populated.
+type AdminCredentials struct {
+	// Kubeconfig is the administrator kubeconfig generated when the cluster was installed. It is
+	// only available for some classic clusters.
+	Kubeconfig string
+
+	// Username and Password are the credentials of the administrator user, that can be used to
+	// log in using the URL of the API.
+	Username string
+	Password string
+
+	// APIURL is the URL of the API server of the cluster.
+	APIURL string
+
+	// ConsoleURL is the URL of the web console of the cluster.
+	ConsoleURL string
+}
+
+// GetOrCreateAdminKubeconfig returns the administrator credentials of the given cluster. If the
+// cluster has an administrator kubeconfig it is returned. Otherwise it makes sure that there is an
+// HTPasswd identity provider containing the administrator user, sets the password of that user,
+// adds it to the `cluster-admins` group, and waits till the user appears in the group. Note that
+// the authentication server of the cluster may need a few additional minutes to start accepting the
+// new credentials. All the requests, including those of the identity provider and the group, are
+// sent with the given client. The options can be nil to use the defaults.
+func GetOrCreateAdminKubeconfig(ctx context.Context, client *v1.Client, clusterID string,
+	options *AdminCredentialsOptions) (result *AdminCredentials, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	username := DefaultAdminUsername
+	password := ""
+	interval := DefaultAdminCredentialsInterval
+	if options != nil {
+		if options.Username != "" {
+			username = options.Username
+		}
+		password = options.Password
+		if options.Interval < 0 {
+			err = fmt.Errorf(
+				"interval %s isn't valid, it should be greater or equal than zero",
+				options.Interval,
+			)
+			return
+		}
+		if options.Interval > 0 {
+			interval = options.Interval
+		}
+	}
+	resource := client.Clusters().Cluster(clusterID)
+
+	// Get the cluster, to find the URLs:
+	clusterResponse, err := resource.Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't get cluster '%s': %w", clusterID, err)
+		return
+	}
+	cluster := clusterResponse.Body()
+	result = &AdminCredentials{
+		APIURL:     cluster.API().URL(),
+		ConsoleURL: cluster.Console().URL(),
+	}
+
+	// Return the kubeconfig if it is available:
+	credentialsResponse, err := resource.Credentials().Get().SendContext(ctx)
+	if err != nil && !isClientError(err) {
+		result = nil
+		err = fmt.Errorf("can't get credentials of cluster '%s': %w", clusterID, err)
+		return
+	}
+	err = nil
+	if credentialsResponse.Status() == http.StatusOK {
+		kubeconfig := credentialsResponse.Body().Kubeconfig()
+		if kubeconfig != "" {
+			result.Kubeconfig = kubeconfig
+			return
+		}
+	}
+
+	// Create the user, or set the password if it already exists:
+	if password == "" {
+		password, err = generatePassword()
+		if err != nil {
+			result = nil
+			return
+		}
+	}
+	err = ensureAdminUser(ctx, client, resource, clusterID, username, password)
+	if err != nil {
+		result = nil
+		return
+	}
+	result.Username = username
+	result.Password = password
+
+	// Add the user to the administrators group and wait till it appears there:
+	group := resource.Groups().Group(DefaultAdminGroup).Users()
+	for {
+		var userResponse *v1.UserGetResponse
+		userResponse, err = group.User(username).Get().SendContext(ctx)
+		if err != nil && !isClientError(err) {
+			result = nil
+			err = fmt.Errorf(
+				"can't check if user '%s' is in group '%s' of cluster '%s': %w",
+				username, DefaultAdminGroup, clusterID, err,
+			)
+			return
+		}
+		if err == nil && userResponse.Status() == http.StatusOK {
+			return
+		}
+		var user *v1.User
+		user, err = v1.NewUser().ID(username).Build()
+		if err != nil {
+			result = nil
+			return
+		}
+		_, err = group.Add().Body(user).SendContext(ctx)
+		if err != nil && !isClientError(err) {
+			result = nil
+			err = fmt.Errorf(
+				"can't add user '%s' to group '%s' of cluster '%s': %w",
+				username, DefaultAdminGroup, clusterID, err,
+			)
+			return
+		}
+		err = sleep(ctx, interval)
+		if err != nil {
+			result = nil
+			return
+		}
+	}
+}
+
+// ensureAdminUser makes sure that the administrator identity provider exists and contains the user
+// with the given password.
+func ensureAdminUser(ctx context.Context, client *v1.Client, resource *v1.ClusterClient,
+	clusterID, username, password string) error {
+	// Find the identity provider:
+	var idp *v1.IdentityProvider
+	request := resource.IdentityProviders().List().Size(100)
+	seen := 0
+	for page := 1; idp == nil; page++ {
+		response, err := request.Page(page).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf(
+				"can't list identity providers of cluster '%s': %w",
+				clusterID, err,
+			)
+		}
+		response.Items().Each(func(item *v1.IdentityProvider) bool {
+			if item.Name() == DefaultAdminIdentityProviderName {
+				idp = item
+				return false
+			}
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			break
+		}
+	}
+
+	// If it exists then add or update the user:
+	if idp != nil {
+		if idp.Type() != v1.IdentityProviderTypeHtpasswd {
+			return fmt.Errorf(
+				"identity provider '%s' of cluster '%s' has type '%s', but it should be '%s'",
+				idp.Name(), clusterID, idp.Type(), v1.IdentityProviderTypeHtpasswd,
+			)
+		}
+		manager := NewHTPasswdUserManager(client, clusterID, idp.ID())
+		user, err := manager.Get(ctx, username)
+		if err != nil {
+			return err
+		}
+		if user != nil {
+			return manager.Update(ctx, username, password)
+		}
+		_, err = manager.Add(ctx, username, password)
+		return err
+	}
+
+	// Create the identity provider containing the user:
+	hash, err := HashPassword(password)
+	if err != nil {
+		return err
+	}
+	idp, err = v1.NewIdentityProvider().
+		Name(DefaultAdminIdentityProviderName).
+		Type(v1.IdentityProviderTypeHtpasswd).
+		MappingMethod(v1.IdentityProviderMappingMethodClaim).
+		Htpasswd(
+			v1.NewHTPasswdIdentityProvider().Users(
+				v1.NewHTPasswdUserList().Items(
+					v1.NewHTPasswdUser().
+						Username(username).
+						HashedPassword(hash),
+				),
+			),
+		).
+		Build()
+	if err != nil {
+		return err
+	}
+	_, err = resource.IdentityProviders().Add().Body(idp).SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"can't create identity provider '%s' for cluster '%s': %w",
+			DefaultAdminIdentityProviderName, clusterID, err,
+		)
+	}
+	return nil
+}
+
+// isClientError checks if the given error is an API error with a 4xx status code, for example
+// because the object doesn't exist.
+func isClientError(err error) bool {
+	var apiErr *ocmerrors.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.Status() >= 400 && apiErr.Status() < 500
+}
+
+// passwordCharacters are the characters used to generate passwords.
+const passwordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// generatePassword generates a random password of four groups of five characters separated by
+// dashes, containing lower case letters, upper case letters and digits.
+func generatePassword() (result string, err error) {
+	max := big.NewInt(int64(len(passwordCharacters)))
+	for {
+		var buffer strings.Builder
+		for i := 0; i < 23; i++ {
+			if i%6 == 5 {
+				buffer.WriteByte('-')
+				continue
+			}
+			var index *big.Int
+			index, err = rand.Int(rand.Reader, max)
+			if err != nil {
+				err = fmt.Errorf("can't generate password: %w", err)
+				return
+			}
+			buffer.WriteByte(passwordCharacters[index.Int64()])
+		}
+		result = buffer.String()
+		if strings.ContainsAny(result, passwordCharacters[0:26]) &&
+			strings.ContainsAny(result, passwordCharacters[26:52]) &&
+			strings.ContainsAny(result, passwordCharacters[52:]) {
+			return
+		}
+	}
+}
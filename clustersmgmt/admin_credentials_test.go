@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helper that gets or creates the administrator credentials of
+// clusters.
+
+package clustersmgmt
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Admin credentials", func() {
+	const clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+
+	const cluster = `{
+		"kind": "Cluster",
+		"id": "123",
+		"api": {"url": "https://api.my.example.com:6443"},
+		"console": {"url": "https://console.my.example.com"}
+	}`
+
+	const notFound = `{
+		"kind": "Error",
+		"id": "404",
+		"href": "/api/clusters_mgmt/v1/errors/404",
+		"code": "CLUSTERS-MGMT-404",
+		"reason": "Not found"
+	}`
+
+	It("Returns the kubeconfig when it is available", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath),
+				RespondWithJSON(http.StatusOK, cluster),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/credentials"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "ClusterCredentials",
+					"kubeconfig": "my-kubeconfig"
+				}`),
+			),
+		)
+		result, err := GetOrCreateAdminKubeconfig(ctx, client, "123", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Kubeconfig).To(Equal("my-kubeconfig"))
+		Expect(result.APIURL).To(Equal("https://api.my.example.com:6443"))
+		Expect(result.ConsoleURL).To(Equal("https://console.my.example.com"))
+		Expect(result.Username).To(BeEmpty())
+	})
+
+	It("Creates the identity provider and adds the user to the group", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath),
+				RespondWithJSON(http.StatusOK, cluster),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/credentials"),
+				RespondWithJSON(http.StatusNotFound, notFound),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/identity_providers"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "IdentityProviderList",
+					"page": 1,
+					"size": 0,
+					"total": 0,
+					"items": []
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, clusterPath+"/identity_providers"),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "IdentityProvider",
+					"id": "456",
+					"name": "cluster-admin",
+					"type": "HTPasswdIdentityProvider"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/groups/cluster-admins/users/cluster-admin"),
+				RespondWithJSON(http.StatusNotFound, notFound),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, clusterPath+"/groups/cluster-admins/users"),
+				ghttp.VerifyJSON(`{
+					"kind": "User",
+					"id": "cluster-admin"
+				}`),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "User",
+					"id": "cluster-admin"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/groups/cluster-admins/users/cluster-admin"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "User",
+					"id": "cluster-admin"
+				}`),
+			),
+		)
+		result, err := GetOrCreateAdminKubeconfig(ctx, client, "123",
+			&AdminCredentialsOptions{
+				Interval: time.Millisecond,
+			})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Kubeconfig).To(BeEmpty())
+		Expect(result.Username).To(Equal("cluster-admin"))
+		Expect(result.Password).To(MatchRegexp(`^[a-zA-Z0-9]{5}(-[a-zA-Z0-9]{5}){3}$`))
+		Expect(result.APIURL).To(Equal("https://api.my.example.com:6443"))
+	})
+
+	It("Resets the password of an existing user", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath),
+				RespondWithJSON(http.StatusOK, cluster),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/credentials"),
+				RespondWithJSON(http.StatusNotFound, notFound),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/identity_providers"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "IdentityProviderList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [{
+						"kind": "IdentityProvider",
+						"id": "456",
+						"name": "cluster-admin",
+						"type": "HTPasswdIdentityProvider"
+					}]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/identity_providers/456/htpasswd_users"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "HTPasswdUserList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [{"id": "a", "username": "cluster-admin"}]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/identity_providers/456/htpasswd_users"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "HTPasswdUserList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [{"id": "a", "username": "cluster-admin"}]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, clusterPath+"/identity_providers/456/htpasswd_users/a"),
+				RespondWithJSON(http.StatusOK, `{"id": "a", "username": "cluster-admin"}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/groups/cluster-admins/users/cluster-admin"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "User",
+					"id": "cluster-admin"
+				}`),
+			),
+		)
+		result, err := GetOrCreateAdminKubeconfig(ctx, client, "123",
+			&AdminCredentialsOptions{
+				Password: "my-password",
+			})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Username).To(Equal("cluster-admin"))
+		Expect(result.Password).To(Equal("my-password"))
+	})
+
+	It("Fails if the cluster doesn't exist", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath),
+				RespondWithJSON(http.StatusNotFound, notFound),
+			),
+		)
+		result, err := GetOrCreateAdminKubeconfig(ctx, client, "123", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't get cluster '123'"))
+		Expect(result).To(BeNil())
+	})
+})
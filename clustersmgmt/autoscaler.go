@@ -0,0 +1,297 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that set defaults, validate and apply the configuration of the
+// cluster autoscaler.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// Default values of the attributes of the cluster autoscaler. They are the same defaults used by
+// the upstream cluster autoscaler, and the resource limits correspond to the maximum number of
+// nodes with 64 cores and 1280 GiB of memory each.
+const (
+	DefaultAutoscalerLogVerbosity                = 1
+	DefaultAutoscalerMaxPodGracePeriod           = 600
+	DefaultAutoscalerPodPriorityThreshold        = -10
+	DefaultAutoscalerMaxNodeProvisionTime        = "15m"
+	DefaultAutoscalerMaxNodesTotal               = 180
+	DefaultAutoscalerMaxCores                    = DefaultAutoscalerMaxNodesTotal * 64
+	DefaultAutoscalerMaxMemory                   = DefaultAutoscalerMaxNodesTotal * 1280
+	DefaultAutoscalerScaleDownUnneededTime       = "10m"
+	DefaultAutoscalerScaleDownUtilization        = "0.5"
+	DefaultAutoscalerScaleDownDelayAfterAdd      = "10m"
+	DefaultAutoscalerScaleDownDelayAfterDelete   = "0s"
+	DefaultAutoscalerScaleDownDelayAfterFailure  = "3m"
+	DefaultAutoscalerScaleDownEnabled            = true
+	DefaultAutoscalerBalanceSimilarNodeGroups    = false
+	DefaultAutoscalerSkipNodesWithLocalStorage   = false
+	DefaultAutoscalerIgnoreDaemonsetsUtilization = false
+)
+
+// ApplyClusterAutoscalerDefaults sets the attributes of the given builder that don't have a value
+// to the defaults. Attributes that already have a value aren't changed, including the attributes
+// of the resource limits and scale down configuration.
+func ApplyClusterAutoscalerDefaults(builder *v1.ClusterAutoscalerBuilder) error {
+	if builder == nil {
+		return errors.New("autoscaler builder is mandatory")
+	}
+	current, err := builder.Build()
+	if err != nil {
+		return err
+	}
+	if _, ok := current.GetBalanceSimilarNodeGroups(); !ok {
+		builder.BalanceSimilarNodeGroups(DefaultAutoscalerBalanceSimilarNodeGroups)
+	}
+	if _, ok := current.GetIgnoreDaemonsetsUtilization(); !ok {
+		builder.IgnoreDaemonsetsUtilization(DefaultAutoscalerIgnoreDaemonsetsUtilization)
+	}
+	if _, ok := current.GetLogVerbosity(); !ok {
+		builder.LogVerbosity(DefaultAutoscalerLogVerbosity)
+	}
+	if _, ok := current.GetMaxNodeProvisionTime(); !ok {
+		builder.MaxNodeProvisionTime(DefaultAutoscalerMaxNodeProvisionTime)
+	}
+	if _, ok := current.GetMaxPodGracePeriod(); !ok {
+		builder.MaxPodGracePeriod(DefaultAutoscalerMaxPodGracePeriod)
+	}
+	if _, ok := current.GetPodPriorityThreshold(); !ok {
+		builder.PodPriorityThreshold(DefaultAutoscalerPodPriorityThreshold)
+	}
+	if _, ok := current.GetSkipNodesWithLocalStorage(); !ok {
+		builder.SkipNodesWithLocalStorage(DefaultAutoscalerSkipNodesWithLocalStorage)
+	}
+
+	// Resource limits:
+	limits := current.ResourceLimits()
+	limitsBuilder := v1.NewAutoscalerResourceLimits().Copy(limits)
+	if _, ok := limits.GetMaxNodesTotal(); !ok {
+		limitsBuilder.MaxNodesTotal(DefaultAutoscalerMaxNodesTotal)
+	}
+	if _, ok := limits.GetCores(); !ok {
+		limitsBuilder.Cores(v1.NewResourceRange().Min(0).Max(DefaultAutoscalerMaxCores))
+	}
+	if _, ok := limits.GetMemory(); !ok {
+		limitsBuilder.Memory(v1.NewResourceRange().Min(0).Max(DefaultAutoscalerMaxMemory))
+	}
+	builder.ResourceLimits(limitsBuilder)
+
+	// Scale down:
+	scaleDown := current.ScaleDown()
+	scaleDownBuilder := v1.NewAutoscalerScaleDownConfig().Copy(scaleDown)
+	if _, ok := scaleDown.GetEnabled(); !ok {
+		scaleDownBuilder.Enabled(DefaultAutoscalerScaleDownEnabled)
+	}
+	if _, ok := scaleDown.GetUnneededTime(); !ok {
+		scaleDownBuilder.UnneededTime(DefaultAutoscalerScaleDownUnneededTime)
+	}
+	if _, ok := scaleDown.GetUtilizationThreshold(); !ok {
+		scaleDownBuilder.UtilizationThreshold(DefaultAutoscalerScaleDownUtilization)
+	}
+	if _, ok := scaleDown.GetDelayAfterAdd(); !ok {
+		scaleDownBuilder.DelayAfterAdd(DefaultAutoscalerScaleDownDelayAfterAdd)
+	}
+	if _, ok := scaleDown.GetDelayAfterDelete(); !ok {
+		scaleDownBuilder.DelayAfterDelete(DefaultAutoscalerScaleDownDelayAfterDelete)
+	}
+	if _, ok := scaleDown.GetDelayAfterFailure(); !ok {
+		scaleDownBuilder.DelayAfterFailure(DefaultAutoscalerScaleDownDelayAfterFailure)
+	}
+	builder.ScaleDown(scaleDownBuilder)
+
+	return nil
+}
+
+// ValidateClusterAutoscaler checks the configuration of the given autoscaler, so that mistakes are
+// detected before sending it to the server. It checks that numbers aren't negative, that the
+// minimum of each resource range isn't greater than the maximum, that GPU limits have a type and a
+// range and that there is only one limit for each type, and that durations and the utilization
+// threshold are well formed.
+func ValidateClusterAutoscaler(autoscaler *v1.ClusterAutoscaler) error {
+	if autoscaler == nil {
+		return errors.New("autoscaler is mandatory")
+	}
+	if autoscaler.LogVerbosity() < 0 {
+		return fmt.Errorf(
+			"log verbosity %d isn't valid, it should be greater or equal than zero",
+			autoscaler.LogVerbosity(),
+		)
+	}
+	if autoscaler.MaxPodGracePeriod() < 0 {
+		return fmt.Errorf(
+			"maximum pod grace period %d isn't valid, it should be greater or equal than zero",
+			autoscaler.MaxPodGracePeriod(),
+		)
+	}
+	err := validateAutoscalerDuration("maximum node provision time",
+		autoscaler.MaxNodeProvisionTime())
+	if err != nil {
+		return err
+	}
+
+	// Resource limits:
+	limits := autoscaler.ResourceLimits()
+	if limits.MaxNodesTotal() < 0 {
+		return fmt.Errorf(
+			"maximum total nodes %d isn't valid, it should be greater or equal than zero",
+			limits.MaxNodesTotal(),
+		)
+	}
+	err = validateResourceRange("cores", limits.Cores())
+	if err != nil {
+		return err
+	}
+	err = validateResourceRange("memory", limits.Memory())
+	if err != nil {
+		return err
+	}
+	types := map[string]bool{}
+	for _, gpu := range limits.GPUS() {
+		if gpu.Type() == "" {
+			return errors.New("GPU limit type is mandatory")
+		}
+		if types[gpu.Type()] {
+			return fmt.Errorf("GPU limit for type '%s' is duplicated", gpu.Type())
+		}
+		types[gpu.Type()] = true
+		if gpu.Range() == nil {
+			return fmt.Errorf("GPU limit for type '%s' should have a range", gpu.Type())
+		}
+		err = validateResourceRange(fmt.Sprintf("GPU type '%s'", gpu.Type()), gpu.Range())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Scale down:
+	scaleDown := autoscaler.ScaleDown()
+	durations := []struct {
+		name  string
+		value string
+	}{
+		{"scale down unneeded time", scaleDown.UnneededTime()},
+		{"scale down delay after add", scaleDown.DelayAfterAdd()},
+		{"scale down delay after delete", scaleDown.DelayAfterDelete()},
+		{"scale down delay after failure", scaleDown.DelayAfterFailure()},
+	}
+	for _, duration := range durations {
+		err = validateAutoscalerDuration(duration.name, duration.value)
+		if err != nil {
+			return err
+		}
+	}
+	if threshold := scaleDown.UtilizationThreshold(); threshold != "" {
+		value, err := strconv.ParseFloat(threshold, 64)
+		if err != nil || value < 0 || value > 1 {
+			return fmt.Errorf(
+				"scale down utilization threshold '%s' isn't valid, it should be a "+
+					"number between zero and one",
+				threshold,
+			)
+		}
+	}
+	return nil
+}
+
+// ApplyClusterAutoscaler validates the given autoscaler configuration and creates the autoscaler
+// of the cluster, or updates it if it already exists. The returned flag indicates if the
+// autoscaler was created. Consider calling ApplyClusterAutoscalerDefaults on the builder before
+// building the configuration, as the server rejects some of the attributes when they are missing.
+func ApplyClusterAutoscaler(ctx context.Context, client *v1.Client, clusterID string,
+	autoscaler *v1.ClusterAutoscaler) (result *v1.ClusterAutoscaler, created bool, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	err = ValidateClusterAutoscaler(autoscaler)
+	if err != nil {
+		return
+	}
+	resource := client.Clusters().Cluster(clusterID).
+		Autoscaler()
+
+	// Check if the autoscaler already exists:
+	getResponse, err := resource.Get().SendContext(ctx)
+	if getResponse != nil && getResponse.Status() == http.StatusNotFound {
+		var postResponse *v1.AutoscalerPostResponse
+		postResponse, err = resource.Post().Request(autoscaler).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't create autoscaler for cluster '%s': %w",
+				clusterID, err,
+			)
+			return
+		}
+		result = postResponse.Body()
+		created = true
+		return
+	}
+	if err != nil {
+		err = fmt.Errorf("can't get autoscaler of cluster '%s': %w", clusterID, err)
+		return
+	}
+
+	// Update it:
+	updateResponse, err := resource.Update().Body(autoscaler).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't update autoscaler of cluster '%s': %w", clusterID, err)
+		return
+	}
+	result = updateResponse.Body()
+	return
+}
+
+// validateResourceRange checks that the given range doesn't have negative values and that the
+// minimum isn't greater than the maximum.
+func validateResourceRange(name string, value *v1.ResourceRange) error {
+	if value == nil {
+		return nil
+	}
+	if value.Min() < 0 || value.Max() < value.Min() {
+		return fmt.Errorf(
+			"range from %d to %d of %s isn't valid, the minimum should be greater or "+
+				"equal than zero and less or equal than the maximum",
+			value.Min(), value.Max(), name,
+		)
+	}
+	return nil
+}
+
+// validateAutoscalerDuration checks that the given value is empty or a valid non negative
+// duration.
+func validateAutoscalerDuration(name, value string) error {
+	if value == "" {
+		return nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return fmt.Errorf(
+			"%s '%s' isn't valid, it should be a duration like '10m'",
+			name, value,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that manage the cluster autoscaler.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Cluster autoscaler", func() {
+	const autoscalerPath = "/api/clusters_mgmt/v1/clusters/123/autoscaler"
+
+	Describe("Defaults", func() {
+		It("Sets the attributes that don't have a value", func() {
+			builder := cmv1.NewClusterAutoscaler()
+			err := ApplyClusterAutoscalerDefaults(builder)
+			Expect(err).ToNot(HaveOccurred())
+			result, err := builder.Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.LogVerbosity()).To(Equal(1))
+			Expect(result.MaxPodGracePeriod()).To(Equal(600))
+			Expect(result.PodPriorityThreshold()).To(Equal(-10))
+			Expect(result.MaxNodeProvisionTime()).To(Equal("15m"))
+			_, ok := result.GetBalanceSimilarNodeGroups()
+			Expect(ok).To(BeTrue())
+			Expect(result.ResourceLimits().MaxNodesTotal()).To(Equal(180))
+			Expect(result.ResourceLimits().Cores().Max()).To(Equal(11520))
+			Expect(result.ResourceLimits().Memory().Max()).To(Equal(230400))
+			Expect(result.ScaleDown().Enabled()).To(BeTrue())
+			Expect(result.ScaleDown().UtilizationThreshold()).To(Equal("0.5"))
+			Expect(result.ScaleDown().DelayAfterFailure()).To(Equal("3m"))
+		})
+
+		It("Preserves the attributes that have a value", func() {
+			builder := cmv1.NewClusterAutoscaler().
+				LogVerbosity(4).
+				BalanceSimilarNodeGroups(true).
+				ResourceLimits(
+					cmv1.NewAutoscalerResourceLimits().
+						MaxNodesTotal(10).
+						Cores(cmv1.NewResourceRange().Min(8).Max(64)),
+				).
+				ScaleDown(
+					cmv1.NewAutoscalerScaleDownConfig().
+						Enabled(false),
+				)
+			err := ApplyClusterAutoscalerDefaults(builder)
+			Expect(err).ToNot(HaveOccurred())
+			result, err := builder.Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.LogVerbosity()).To(Equal(4))
+			Expect(result.BalanceSimilarNodeGroups()).To(BeTrue())
+			Expect(result.ResourceLimits().MaxNodesTotal()).To(Equal(10))
+			Expect(result.ResourceLimits().Cores().Min()).To(Equal(8))
+			Expect(result.ResourceLimits().Cores().Max()).To(Equal(64))
+			Expect(result.ResourceLimits().Memory().Max()).To(Equal(230400))
+			Expect(result.ScaleDown().Enabled()).To(BeFalse())
+			Expect(result.ScaleDown().UnneededTime()).To(Equal("10m"))
+		})
+	})
+
+	DescribeTable(
+		"Validation",
+		func(builder *cmv1.ClusterAutoscalerBuilder, expected string) {
+			autoscaler, err := builder.Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = ValidateClusterAutoscaler(autoscaler)
+			if expected == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expected))
+			}
+		},
+		Entry(
+			"Empty",
+			cmv1.NewClusterAutoscaler(),
+			"",
+		),
+		Entry(
+			"Valid GPU limits",
+			cmv1.NewClusterAutoscaler().
+				ResourceLimits(
+					cmv1.NewAutoscalerResourceLimits().
+						GPUS(
+							cmv1.NewAutoscalerResourceLimitsGPULimit().
+								Type("nvidia.com/gpu").
+								Range(cmv1.NewResourceRange().Min(0).Max(4)),
+							cmv1.NewAutoscalerResourceLimitsGPULimit().
+								Type("amd.com/gpu").
+								Range(cmv1.NewResourceRange().Min(1).Max(2)),
+						),
+				),
+			"",
+		),
+		Entry(
+			"Negative log verbosity",
+			cmv1.NewClusterAutoscaler().LogVerbosity(-1),
+			"log verbosity -1 isn't valid",
+		),
+		Entry(
+			"Cores minimum greater than maximum",
+			cmv1.NewClusterAutoscaler().
+				ResourceLimits(
+					cmv1.NewAutoscalerResourceLimits().
+						Cores(cmv1.NewResourceRange().Min(10).Max(5)),
+				),
+			"range from 10 to 5 of cores isn't valid",
+		),
+		Entry(
+			"Negative memory minimum",
+			cmv1.NewClusterAutoscaler().
+				ResourceLimits(
+					cmv1.NewAutoscalerResourceLimits().
+						Memory(cmv1.NewResourceRange().Min(-1).Max(5)),
+				),
+			"range from -1 to 5 of memory isn't valid",
+		),
+		Entry(
+			"GPU limit without type",
+			cmv1.NewClusterAutoscaler().
+				ResourceLimits(
+					cmv1.NewAutoscalerResourceLimits().
+						GPUS(
+							cmv1.NewAutoscalerResourceLimitsGPULimit().
+								Range(cmv1.NewResourceRange().Min(0).Max(4)),
+						),
+				),
+			"GPU limit type is mandatory",
+		),
+		Entry(
+			"GPU limit without range",
+			cmv1.NewClusterAutoscaler().
+				ResourceLimits(
+					cmv1.NewAutoscalerResourceLimits().
+						GPUS(
+							cmv1.NewAutoscalerResourceLimitsGPULimit().
+								Type("nvidia.com/gpu"),
+						),
+				),
+			"GPU limit for type 'nvidia.com/gpu' should have a range",
+		),
+		Entry(
+			"Duplicated GPU type",
+			cmv1.NewClusterAutoscaler().
+				ResourceLimits(
+					cmv1.NewAutoscalerResourceLimits().
+						GPUS(
+							cmv1.NewAutoscalerResourceLimitsGPULimit().
+								Type("nvidia.com/gpu").
+								Range(cmv1.NewResourceRange().Min(0).Max(4)),
+							cmv1.NewAutoscalerResourceLimitsGPULimit().
+								Type("nvidia.com/gpu").
+								Range(cmv1.NewResourceRange().Min(0).Max(2)),
+						),
+				),
+			"GPU limit for type 'nvidia.com/gpu' is duplicated",
+		),
+		Entry(
+			"GPU range minimum greater than maximum",
+			cmv1.NewClusterAutoscaler().
+				ResourceLimits(
+					cmv1.NewAutoscalerResourceLimits().
+						GPUS(
+							cmv1.NewAutoscalerResourceLimitsGPULimit().
+								Type("nvidia.com/gpu").
+								Range(cmv1.NewResourceRange().Min(4).Max(2)),
+						),
+				),
+			"range from 4 to 2 of GPU type 'nvidia.com/gpu' isn't valid",
+		),
+		Entry(
+			"Invalid duration",
+			cmv1.NewClusterAutoscaler().
+				ScaleDown(
+					cmv1.NewAutoscalerScaleDownConfig().
+						DelayAfterAdd("ten minutes"),
+				),
+			"scale down delay after add 'ten minutes' isn't valid",
+		),
+		Entry(
+			"Utilization threshold out of range",
+			cmv1.NewClusterAutoscaler().
+				ScaleDown(
+					cmv1.NewAutoscalerScaleDownConfig().
+						UtilizationThreshold("1.5"),
+				),
+			"scale down utilization threshold '1.5' isn't valid",
+		),
+	)
+
+	Describe("Apply", func() {
+		var autoscaler *cmv1.ClusterAutoscaler
+
+		BeforeEach(func() {
+			var err error
+			autoscaler, err = cmv1.NewClusterAutoscaler().
+				LogVerbosity(2).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Creates the autoscaler if it doesn't exist", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, autoscalerPath),
+					RespondWithJSON(http.StatusNotFound, `{
+						"kind": "Error",
+						"id": "404",
+						"reason": "Autoscaler not found"
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, autoscalerPath),
+					VerifyJQ(".log_verbosity", 2.0),
+					RespondWithJSON(http.StatusCreated, `{
+						"kind": "ClusterAutoscaler",
+						"log_verbosity": 2
+					}`),
+				),
+			)
+			result, created, err := ApplyClusterAutoscaler(
+				ctx, client, "123", autoscaler,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(result.LogVerbosity()).To(Equal(2))
+		})
+
+		It("Updates the autoscaler if it exists", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, autoscalerPath),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "ClusterAutoscaler",
+						"log_verbosity": 1
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, autoscalerPath),
+					VerifyJQ(".log_verbosity", 2.0),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "ClusterAutoscaler",
+						"log_verbosity": 2
+					}`),
+				),
+			)
+			result, created, err := ApplyClusterAutoscaler(
+				ctx, client, "123", autoscaler,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(result.LogVerbosity()).To(Equal(2))
+		})
+
+		It("Doesn't send invalid configurations", func() {
+			invalid, err := cmv1.NewClusterAutoscaler().
+				MaxPodGracePeriod(-1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = ApplyClusterAutoscaler(ctx, client, "123", invalid)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("maximum pod grace period -1 isn't valid"))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+})
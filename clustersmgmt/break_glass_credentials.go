@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that manage the lifecycle of the break glass credentials of hosted
+// control plane clusters.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// DefaultBreakGlassCredentialInterval is the default time between checks of the status of break
+// glass credentials.
+const DefaultBreakGlassCredentialInterval = 5 * time.Second
+
+// BreakGlassCredentialOptions contains the options that control how the break glass credential
+// helpers wait for the credentials to change status.
+type BreakGlassCredentialOptions struct {
+	// Interval is the time between checks of the status of the credentials. The default is
+	// five seconds.
+	Interval time.Duration
+}
+
+// CreateBreakGlassCredential creates a break glass credential for the given cluster and waits till
+// it is issued. The returned credential contains the kubeconfig. The credential can be nil to use
+// the defaults of the server for the user name and the expiration, and the options can be nil to use
+// the defaults. It returns an error if the credential fails.
+func CreateBreakGlassCredential(ctx context.Context, client *v1.Client, clusterID string,
+	credential *v1.BreakGlassCredential,
+	options *BreakGlassCredentialOptions) (result *v1.BreakGlassCredential, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	interval, err := breakGlassCredentialInterval(options)
+	if err != nil {
+		return
+	}
+	if credential == nil {
+		credential, err = v1.NewBreakGlassCredential().Build()
+		if err != nil {
+			return
+		}
+	}
+	credentials := breakGlassCredentialsClient(client, clusterID)
+
+	// Create the credential:
+	addResponse, err := credentials.Add().Body(credential).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't create break glass credential for cluster '%s': %w",
+			clusterID, err,
+		)
+		return
+	}
+	id := addResponse.Body().ID()
+	if id == "" {
+		err = fmt.Errorf(
+			"break glass credential created for cluster '%s' doesn't have an identifier",
+			clusterID,
+		)
+		return
+	}
+
+	// Wait till it is issued:
+	current := addResponse.Body()
+	for {
+		switch current.Status() {
+		case v1.BreakGlassCredentialStatusIssued:
+			if current.Kubeconfig() != "" {
+				result = current
+				return
+			}
+		case v1.BreakGlassCredentialStatusFailed,
+			v1.BreakGlassCredentialStatusExpired,
+			v1.BreakGlassCredentialStatusAwaitingRevocation,
+			v1.BreakGlassCredentialStatusRevoked:
+			err = fmt.Errorf(
+				"break glass credential '%s' of cluster '%s' wasn't issued, its status "+
+					"is '%s'",
+				id, clusterID, current.Status(),
+			)
+			return
+		}
+		err = sleep(ctx, interval)
+		if err != nil {
+			return
+		}
+		var getResponse *v1.BreakGlassCredentialGetResponse
+		getResponse, err = credentials.BreakGlassCredential(id).Get().SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't get break glass credential '%s' of cluster '%s': %w",
+				id, clusterID, err,
+			)
+			return
+		}
+		current = getResponse.Body()
+	}
+}
+
+// BreakGlassKubeconfig returns the kubeconfig of the given break glass credential. It returns an
+// error if the credential hasn't been issued or is no longer valid.
+func BreakGlassKubeconfig(ctx context.Context, client *v1.Client, clusterID,
+	credentialID string) (result string, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	if credentialID == "" {
+		err = errors.New("credential identifier is mandatory")
+		return
+	}
+	credentials := breakGlassCredentialsClient(client, clusterID)
+	response, err := credentials.BreakGlassCredential(credentialID).Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get break glass credential '%s' of cluster '%s': %w",
+			credentialID, clusterID, err,
+		)
+		return
+	}
+	credential := response.Body()
+	if credential.Status() != v1.BreakGlassCredentialStatusIssued {
+		err = fmt.Errorf(
+			"break glass credential '%s' of cluster '%s' isn't issued, its status is '%s'",
+			credentialID, clusterID, credential.Status(),
+		)
+		return
+	}
+	result = credential.Kubeconfig()
+	if result == "" {
+		err = fmt.Errorf(
+			"break glass credential '%s' of cluster '%s' doesn't have a kubeconfig",
+			credentialID, clusterID,
+		)
+	}
+	return
+}
+
+// RevokeBreakGlassCredentials revokes all the break glass credentials of the given cluster and
+// waits till the revocation finishes, listing the credentials of the cluster after each interval
+// till none of them is pending. The options can be nil to use the defaults.
+func RevokeBreakGlassCredentials(ctx context.Context, client *v1.Client, clusterID string,
+	options *BreakGlassCredentialOptions) error {
+	if clusterID == "" {
+		return errors.New("cluster identifier is mandatory")
+	}
+	interval, err := breakGlassCredentialInterval(options)
+	if err != nil {
+		return err
+	}
+	credentials := breakGlassCredentialsClient(client, clusterID)
+	_, err = credentials.Delete().SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"can't revoke break glass credentials of cluster '%s': %w",
+			clusterID, err,
+		)
+	}
+	for {
+		pending := 0
+		seen := 0
+		request := credentials.List().Size(100)
+		for page := 1; ; page++ {
+			var response *v1.BreakGlassCredentialsListResponse
+			response, err = request.Page(page).SendContext(ctx)
+			if err != nil {
+				return fmt.Errorf(
+					"can't list break glass credentials of cluster '%s': %w",
+					clusterID, err,
+				)
+			}
+			response.Items().Each(func(item *v1.BreakGlassCredential) bool {
+				switch item.Status() {
+				case v1.BreakGlassCredentialStatusRevoked,
+					v1.BreakGlassCredentialStatusExpired,
+					v1.BreakGlassCredentialStatusFailed:
+				default:
+					pending++
+				}
+				return true
+			})
+			seen += response.Items().Len()
+			if response.Items().Len() == 0 || seen >= response.Total() {
+				break
+			}
+		}
+		if pending == 0 {
+			return nil
+		}
+		err = sleep(ctx, interval)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func breakGlassCredentialsClient(client *v1.Client,
+	clusterID string) *v1.BreakGlassCredentialsClient {
+	return client.
+		Clusters().
+		Cluster(clusterID).
+		BreakGlassCredentials()
+}
+
+func breakGlassCredentialInterval(options *BreakGlassCredentialOptions) (result time.Duration,
+	err error) {
+	result = DefaultBreakGlassCredentialInterval
+	if options == nil {
+		return
+	}
+	if options.Interval < 0 {
+		err = fmt.Errorf(
+			"interval %s isn't valid, it should be greater or equal than zero",
+			options.Interval,
+		)
+		return
+	}
+	if options.Interval > 0 {
+		result = options.Interval
+	}
+	return
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that manage break glass credentials.
+
+package clustersmgmt
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Break glass credentials", func() {
+	const credentialsPath = "/api/clusters_mgmt/v1/clusters/123/break_glass_credentials"
+
+	var options *BreakGlassCredentialOptions
+
+	BeforeEach(func() {
+		options = &BreakGlassCredentialOptions{
+			Interval: time.Millisecond,
+		}
+	})
+
+	It("Creates a credential and waits till it is issued", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, credentialsPath),
+				ghttp.VerifyJSON(`{
+					"kind": "BreakGlassCredential",
+					"username": "admin"
+				}`),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "BreakGlassCredential",
+					"id": "456",
+					"status": "created"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, credentialsPath+"/456"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "BreakGlassCredential",
+					"id": "456",
+					"status": "created"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, credentialsPath+"/456"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "BreakGlassCredential",
+					"id": "456",
+					"status": "issued",
+					"kubeconfig": "my-kubeconfig"
+				}`),
+			),
+		)
+		credential, err := cmv1.NewBreakGlassCredential().
+			Username("admin").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := CreateBreakGlassCredential(ctx, client, "123", credential, options)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ID()).To(Equal("456"))
+		Expect(result.Kubeconfig()).To(Equal("my-kubeconfig"))
+	})
+
+	It("Fails if the credential fails", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusCreated, `{
+				"kind": "BreakGlassCredential",
+				"id": "456",
+				"status": "failed"
+			}`),
+		)
+		_, err := CreateBreakGlassCredential(ctx, client, "123", nil, options)
+		Expect(err).To(MatchError(
+			"break glass credential '456' of cluster '123' wasn't issued, its status is 'failed'",
+		))
+	})
+
+	It("Returns the kubeconfig of an issued credential", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, credentialsPath+"/456"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "BreakGlassCredential",
+					"id": "456",
+					"status": "issued",
+					"kubeconfig": "my-kubeconfig"
+				}`),
+			),
+		)
+		kubeconfig, err := BreakGlassKubeconfig(ctx, client, "123", "456")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeconfig).To(Equal("my-kubeconfig"))
+	})
+
+	It("Revokes the credentials and waits till they are revoked", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, credentialsPath),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, credentialsPath),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "BreakGlassCredentialList",
+					"page": 1,
+					"size": 2,
+					"total": 2,
+					"items": [
+						{"kind": "BreakGlassCredential", "id": "456", "status": "revoked"},
+						{"kind": "BreakGlassCredential", "id": "789", "status": "awaiting_revocation"}
+					]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, credentialsPath),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "BreakGlassCredentialList",
+					"page": 1,
+					"size": 2,
+					"total": 2,
+					"items": [
+						{"kind": "BreakGlassCredential", "id": "456", "status": "revoked"},
+						{"kind": "BreakGlassCredential", "id": "789", "status": "revoked"}
+					]
+				}`),
+			),
+		)
+		err := RevokeBreakGlassCredentials(ctx, client, "123", options)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(3))
+	})
+})
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains a helper that builds a consolidated view of the health of a cluster from the
+// status, inflight checks and cluster operators endpoints.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// ClusterHealth is the consolidated view of the health of a cluster.
+type ClusterHealth struct {
+	// Status is the status of the cluster, as returned by the status endpoint.
+	Status *v1.ClusterStatus
+
+	// InflightChecks are the checks that run while the cluster is being provisioned. It will be
+	// empty if the cluster doesn't have inflight checks.
+	InflightChecks []*v1.InflightCheck
+
+	// Operators are the cluster operators reported by the cluster. It will be empty if the
+	// cluster doesn't report metrics yet, for example while it is being provisioned.
+	Operators []*v1.ClusterOperatorInfo
+
+	// Problems contains a human readable description of each of the problems found.
+	Problems []string
+}
+
+// Healthy returns true if the cluster is ready and no problems were found.
+func (h *ClusterHealth) Healthy() bool {
+	return h != nil && h.Status.State() == v1.ClusterStateReady && len(h.Problems) == 0
+}
+
+// GetClusterHealth retrieves the status, the inflight checks and the cluster operators of the
+// given cluster and returns them together with the list of problems found. A problem is reported
+// for each of the following conditions:
+//
+//   - The cluster is in the error state.
+//   - An inflight check failed.
+//   - A cluster operator is degraded or failing.
+//   - The cluster has limited support reasons.
+//
+// Client errors returned by the inflight checks and cluster operators endpoints are ignored, as
+// those are expected for clusters that don't run inflight checks or don't report metrics yet.
+func GetClusterHealth(ctx context.Context, client *v1.Client,
+	clusterID string) (result *ClusterHealth, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	resource := client.Clusters().Cluster(clusterID)
+	health := &ClusterHealth{}
+
+	// Get the status:
+	statusResponse, err := resource.Status().Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't get status of cluster '%s': %w", clusterID, err)
+		return
+	}
+	health.Status = statusResponse.Body()
+
+	// Get the inflight checks:
+	checksRequest := resource.InflightChecks().List().Size(100)
+	for page := 1; ; page++ {
+		var checksResponse *v1.InflightChecksListResponse
+		checksResponse, err = checksRequest.Page(page).SendContext(ctx)
+		if isClientError(err) {
+			err = nil
+			break
+		}
+		if err != nil {
+			err = fmt.Errorf("can't get inflight checks of cluster '%s': %w", clusterID, err)
+			return
+		}
+		checks := checksResponse.Items().Slice()
+		health.InflightChecks = append(health.InflightChecks, checks...)
+		if len(checks) == 0 || len(health.InflightChecks) >= checksResponse.Total() {
+			break
+		}
+	}
+
+	// Get the cluster operators:
+	operatorsResponse, err := resource.MetricQueries().ClusterOperators().Get().SendContext(ctx)
+	switch {
+	case isClientError(err):
+		err = nil
+	case err != nil:
+		err = fmt.Errorf("can't get cluster operators of cluster '%s': %w", clusterID, err)
+		return
+	default:
+		health.Operators = operatorsResponse.Body().Operators()
+	}
+
+	health.Problems = clusterHealthProblems(health)
+	result = health
+	return
+}
+
+// clusterHealthProblems returns the descriptions of the problems found in the given health data.
+func clusterHealthProblems(health *ClusterHealth) []string {
+	var problems []string
+	status := health.Status
+	if status.State() == v1.ClusterStateError {
+		problem := "cluster is in the error state"
+		if status.ProvisionErrorMessage() != "" {
+			problem = fmt.Sprintf("%s: %s", problem, status.ProvisionErrorMessage())
+		}
+		problems = append(problems, problem)
+	}
+	for _, check := range health.InflightChecks {
+		if check.State() == v1.InflightCheckStateFailed {
+			problems = append(problems, fmt.Sprintf("inflight check '%s' failed", check.Name()))
+		}
+	}
+	for _, operator := range health.Operators {
+		switch operator.Condition() {
+		case v1.ClusterOperatorStateDegraded, v1.ClusterOperatorStateFailing:
+			problem := fmt.Sprintf("operator '%s' is %s", operator.Name(), operator.Condition())
+			if operator.Reason() != "" {
+				problem = fmt.Sprintf("%s: %s", problem, operator.Reason())
+			}
+			problems = append(problems, problem)
+		}
+	}
+	if count := status.LimitedSupportReasonCount(); count > 0 {
+		problems = append(problems, fmt.Sprintf("cluster has %d limited support reasons", count))
+	}
+	return problems
+}
@@ -0,0 +1,184 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helper that builds the consolidated health of a cluster.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Cluster health", func() {
+	const clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+
+	It("Reports a healthy cluster", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/status"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "ClusterStatus",
+					"id": "123",
+					"state": "ready",
+					"dns_ready": true
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath+"/inflight_checks"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "InflightCheckList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"kind": "InflightCheck",
+							"id": "a",
+							"name": "egress",
+							"state": "passed"
+						}
+					]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					clusterPath+"/metric_queries/cluster_operators",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"operators": [
+						{
+							"name": "dns",
+							"condition": "available"
+						}
+					]
+				}`),
+			),
+		)
+		result, err := GetClusterHealth(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.State()).To(BeEquivalentTo("ready"))
+		Expect(result.InflightChecks).To(HaveLen(1))
+		Expect(result.Operators).To(HaveLen(1))
+		Expect(result.Problems).To(BeEmpty())
+		Expect(result.Healthy()).To(BeTrue())
+	})
+
+	It("Reports the problems found", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "ClusterStatus",
+				"id": "123",
+				"state": "error",
+				"provision_error_message": "Egress blocked",
+				"limited_support_reason_count": 2
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "InflightCheckList",
+				"page": 1,
+				"size": 2,
+				"total": 2,
+				"items": [
+					{
+						"kind": "InflightCheck",
+						"id": "a",
+						"name": "egress",
+						"state": "failed"
+					},
+					{
+						"kind": "InflightCheck",
+						"id": "b",
+						"name": "dns",
+						"state": "passed"
+					}
+				]
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"operators": [
+					{
+						"name": "ingress",
+						"condition": "degraded",
+						"reason": "No routers available"
+					},
+					{
+						"name": "dns",
+						"condition": "available"
+					}
+				]
+			}`),
+		)
+		result, err := GetClusterHealth(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Healthy()).To(BeFalse())
+		Expect(result.Problems).To(ConsistOf(
+			"cluster is in the error state: Egress blocked",
+			"inflight check 'egress' failed",
+			"operator 'ingress' is degraded: No routers available",
+			"cluster has 2 limited support reasons",
+		))
+	})
+
+	It("Ignores missing inflight checks and metrics", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "ClusterStatus",
+				"id": "123",
+				"state": "installing"
+			}`),
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Not found"
+			}`),
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Not found"
+			}`),
+		)
+		result, err := GetClusterHealth(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.InflightChecks).To(BeEmpty())
+		Expect(result.Operators).To(BeEmpty())
+		Expect(result.Problems).To(BeEmpty())
+		Expect(result.Healthy()).To(BeFalse())
+	})
+
+	It("Fails if the status can't be retrieved", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Cluster '123' not found"
+			}`),
+		)
+		_, err := GetClusterHealth(ctx, client, "123")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't get status of cluster '123'"))
+	})
+
+	It("Requires the cluster identifier", func() {
+		_, err := GetClusterHealth(ctx, client, "")
+		Expect(err).To(MatchError("cluster identifier is mandatory"))
+	})
+})
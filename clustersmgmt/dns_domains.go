@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that reserve, list and delete base DNS domains.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+)
+
+// DNSDomainOptions contains the options used to reserve and delete DNS domains.
+type DNSDomainOptions struct {
+	// OrganizationID is the identifier of the organization that should own the domains. When
+	// it is set domains owned by other organizations aren't returned or deleted.
+	OrganizationID string
+
+	// RetryPolicy controls how requests that fail because of concurrent reservations are
+	// retried. If not specified helpers.DefaultRetryPolicy will be used.
+	RetryPolicy *helpers.RetryPolicy
+}
+
+// ReserveDNSDomain reserves a base DNS domain. If the domain is nil, or if it doesn't have an
+// identifier, the service selects a free domain. Otherwise the domain with the given identifier is
+// reserved, which is how user defined domains are added.
+//
+// Requests that fail with a conflict, because other clients are reserving domains at the same
+// time, are retried according to the retry policy of the options. When a domain with an
+// identifier is already reserved by the organization of the options and isn't used by any
+// cluster, the existing domain is returned, so that calling this function repeatedly is safe.
+func ReserveDNSDomain(ctx context.Context, client *v1.Client, domain *v1.DNSDomain,
+	options *DNSDomainOptions) (result *v1.DNSDomain, err error) {
+	if options == nil {
+		options = &DNSDomainOptions{}
+	}
+	if domain == nil {
+		domain, err = v1.NewDNSDomain().Build()
+		if err != nil {
+			return
+		}
+	}
+	domains := dnsDomainsClient(client)
+	err = helpers.RetryOnConflict(ctx, func(ctx context.Context) error {
+		response, addErr := domains.Add().Body(domain).SendContext(ctx)
+		if addErr == nil {
+			result = response.Body()
+			return nil
+		}
+		if domain.ID() == "" || response == nil || response.Status() != http.StatusConflict {
+			return addErr
+		}
+		existing, err := getDNSDomain(ctx, domains, domain.ID())
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			// The domain was released after the conflict, so try again:
+			return addErr
+		}
+		err = checkDNSDomainOwner(existing, options.OrganizationID)
+		if err != nil {
+			return err
+		}
+		if existing.Cluster().ID() != "" {
+			return fmt.Errorf(
+				"DNS domain '%s' is already used by cluster '%s'",
+				existing.ID(), existing.Cluster().ID(),
+			)
+		}
+		result = existing
+		return nil
+	}, options.RetryPolicy)
+	if err != nil {
+		result = nil
+		if domain.ID() != "" {
+			err = fmt.Errorf("can't reserve DNS domain '%s': %w", domain.ID(), err)
+		} else {
+			err = fmt.Errorf("can't reserve DNS domain: %w", err)
+		}
+	}
+	return
+}
+
+// ListDNSDomains returns the base DNS domains owned by the given organization. If the organization
+// identifier is empty all the domains that the user has permission to see are returned. If the
+// unused flag is true only the domains that aren't used by any cluster are returned.
+func ListDNSDomains(ctx context.Context, client *v1.Client, organizationID string,
+	unused bool) (result []*v1.DNSDomain, err error) {
+	request := dnsDomainsClient(client).List().Size(100)
+	if organizationID != "" {
+		request.Search(fmt.Sprintf(
+			"organization.id = '%s'",
+			strings.ReplaceAll(organizationID, "'", "''"),
+		))
+	}
+	seen := 0
+	for page := 1; ; page++ {
+		var response *v1.DNSDomainsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list DNS domains: %w", err)
+			result = nil
+			return
+		}
+		response.Items().Each(func(item *v1.DNSDomain) bool {
+			if unused && item.Cluster().ID() != "" {
+				return true
+			}
+			result = append(result, item)
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			break
+		}
+	}
+	return
+}
+
+// DeleteDNSDomain deletes the given base DNS domain. Deleting a domain that doesn't exist isn't
+// considered an error. Domains that are used by a cluster aren't deleted, and neither are domains
+// owned by an organization different to the one given in the options.
+func DeleteDNSDomain(ctx context.Context, client *v1.Client, domainID string,
+	options *DNSDomainOptions) error {
+	if domainID == "" {
+		return errors.New("DNS domain identifier is mandatory")
+	}
+	if options == nil {
+		options = &DNSDomainOptions{}
+	}
+	domains := dnsDomainsClient(client)
+	err := helpers.RetryOnConflict(ctx, func(ctx context.Context) error {
+		existing, err := getDNSDomain(ctx, domains, domainID)
+		if err != nil || existing == nil {
+			return err
+		}
+		err = checkDNSDomainOwner(existing, options.OrganizationID)
+		if err != nil {
+			return err
+		}
+		if existing.Cluster().ID() != "" {
+			return fmt.Errorf("DNS domain is used by cluster '%s'", existing.Cluster().ID())
+		}
+		response, err := domains.DNSDomain(domainID).Delete().SendContext(ctx)
+		if response != nil && response.Status() == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}, options.RetryPolicy)
+	if err != nil {
+		return fmt.Errorf("can't delete DNS domain '%s': %w", domainID, err)
+	}
+	return nil
+}
+
+// getDNSDomain retrieves the given DNS domain. It returns nil if the domain doesn't exist.
+func getDNSDomain(ctx context.Context, client *v1.DNSDomainsClient,
+	domainID string) (result *v1.DNSDomain, err error) {
+	response, err := client.DNSDomain(domainID).Get().SendContext(ctx)
+	if response != nil && response.Status() == http.StatusNotFound {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
+// checkDNSDomainOwner checks that the given domain is owned by the given organization. Any owner
+// is accepted if the organization identifier is empty.
+func checkDNSDomainOwner(domain *v1.DNSDomain, organizationID string) error {
+	owner := domain.Organization().ID()
+	if organizationID == "" || owner == organizationID {
+		return nil
+	}
+	return fmt.Errorf(
+		"DNS domain '%s' is owned by organization '%s' instead of '%s'",
+		domain.ID(), owner, organizationID,
+	)
+}
+
+// dnsDomainsClient returns the client for the DNS domains collection.
+func dnsDomainsClient(client *v1.Client) *v1.DNSDomainsClient {
+	return client.DNSDomains()
+}
@@ -0,0 +1,267 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that manage base DNS domains.
+
+package clustersmgmt
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("DNS domains", func() {
+	const domainsPath = "/api/clusters_mgmt/v1/dns_domains"
+
+	const conflict = `{
+		"kind": "Error",
+		"id": "409",
+		"reason": "DNS domain is already reserved"
+	}`
+
+	var options *DNSDomainOptions
+
+	BeforeEach(func() {
+		options = &DNSDomainOptions{
+			OrganizationID: "org1",
+			RetryPolicy: &helpers.RetryPolicy{
+				Steps:    3,
+				Duration: time.Millisecond,
+			},
+		}
+	})
+
+	Describe("Reserve", func() {
+		It("Reserves a domain selected by the service", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, domainsPath),
+					RespondWithJSON(http.StatusCreated, `{
+						"kind": "DNSDomain",
+						"id": "abcd.s1.devshift.org",
+						"organization": {
+							"kind": "OrganizationLink",
+							"id": "org1"
+						}
+					}`),
+				),
+			)
+			result, err := ReserveDNSDomain(ctx, client, nil, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.ID()).To(Equal("abcd.s1.devshift.org"))
+		})
+
+		It("Retries when there is contention", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusConflict, conflict),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "DNSDomain",
+					"id": "efgh.s1.devshift.org"
+				}`),
+			)
+			result, err := ReserveDNSDomain(ctx, client, nil, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.ID()).To(Equal("efgh.s1.devshift.org"))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("Returns the domain already reserved by the organization", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, domainsPath),
+					VerifyJQ(".id", "example.com"),
+					VerifyJQ(".user_defined", true),
+					RespondWithJSON(http.StatusConflict, conflict),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, domainsPath+"/example.com"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "DNSDomain",
+						"id": "example.com",
+						"user_defined": true,
+						"organization": {
+							"kind": "OrganizationLink",
+							"id": "org1"
+						}
+					}`),
+				),
+			)
+			domain, err := cmv1.NewDNSDomain().
+				ID("example.com").
+				UserDefined(true).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			result, err := ReserveDNSDomain(ctx, client, domain, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.ID()).To(Equal("example.com"))
+			Expect(result.UserDefined()).To(BeTrue())
+		})
+
+		It("Fails if the domain is owned by other organization", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusConflict, conflict),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "DNSDomain",
+					"id": "example.com",
+					"organization": {
+						"kind": "OrganizationLink",
+						"id": "org2"
+					}
+				}`),
+			)
+			domain, err := cmv1.NewDNSDomain().
+				ID("example.com").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = ReserveDNSDomain(ctx, client, domain, options)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"DNS domain 'example.com' is owned by organization 'org2' instead of 'org1'",
+			))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("Gives up after the configured number of attempts", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusConflict, conflict),
+				RespondWithJSON(http.StatusConflict, conflict),
+				RespondWithJSON(http.StatusConflict, conflict),
+			)
+			_, err := ReserveDNSDomain(ctx, client, nil, options)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't reserve DNS domain"))
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+	})
+
+	It("Lists the unused domains of the organization", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, domainsPath),
+				ghttp.VerifyFormKV("search", "organization.id = 'org1'"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "DNSDomainList",
+					"page": 1,
+					"size": 2,
+					"total": 2,
+					"items": [
+						{
+							"kind": "DNSDomain",
+							"id": "abcd.s1.devshift.org",
+							"cluster": {
+								"kind": "ClusterLink",
+								"id": "123"
+							}
+						},
+						{
+							"kind": "DNSDomain",
+							"id": "efgh.s1.devshift.org"
+						}
+					]
+				}`),
+			),
+		)
+		result, err := ListDNSDomains(ctx, client, "org1", true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(1))
+		Expect(result[0].ID()).To(Equal("efgh.s1.devshift.org"))
+	})
+
+	Describe("Delete", func() {
+		It("Deletes an unused domain", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, domainsPath+"/example.com"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "DNSDomain",
+						"id": "example.com",
+						"organization": {
+							"kind": "OrganizationLink",
+							"id": "org1"
+						}
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, domainsPath+"/example.com"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+			err := DeleteDNSDomain(ctx, client, "example.com", options)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Ignores domains that don't exist", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusNotFound, `{
+					"kind": "Error",
+					"id": "404",
+					"reason": "DNS domain 'example.com' not found"
+				}`),
+			)
+			err := DeleteDNSDomain(ctx, client, "example.com", options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("Doesn't delete domains used by clusters", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "DNSDomain",
+					"id": "example.com",
+					"cluster": {
+						"kind": "ClusterLink",
+						"id": "123"
+					},
+					"organization": {
+						"kind": "OrganizationLink",
+						"id": "org1"
+					}
+				}`),
+			)
+			err := DeleteDNSDomain(ctx, client, "example.com", options)
+			Expect(err).To(MatchError(
+				"can't delete DNS domain 'example.com': DNS domain is used by cluster '123'",
+			))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("Doesn't delete domains owned by other organization", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "DNSDomain",
+					"id": "example.com",
+					"organization": {
+						"kind": "OrganizationLink",
+						"id": "org2"
+					}
+				}`),
+			)
+			err := DeleteDNSDomain(ctx, client, "example.com", options)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("owned by organization 'org2'"))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that export clusters to specifications that can be stored, for
+// example in a Git repository, and used later to create new clusters.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// serverFields are the top level attributes of clusters that are populated by the server, and
+// therefore are removed when a cluster is exported. This includes the links to the collections of
+// sub-resources, like machine pools, because they are created with separate requests.
+var serverFields = []string{
+	"activity_timestamp",
+	"addons",
+	"aws_infrastructure_access_role_grants",
+	"console",
+	"creation_timestamp",
+	"external_configuration",
+	"external_id",
+	"groups",
+	"health_state",
+	"href",
+	"id",
+	"identity_providers",
+	"inflight_checks",
+	"infra_id",
+	"ingresses",
+	"machine_pools",
+	"node_pools",
+	"provision_shard",
+	"state",
+	"status",
+	"subscription",
+}
+
+// serverPaths are the nested attributes of clusters that are populated by the server.
+var serverPaths = [][]string{
+	{"api", "url"},
+	{"aws", "sts", "oidc_endpoint_url"},
+}
+
+// ExportCluster returns a specification of the given cluster that can be used to create a new
+// cluster with the same configuration. The attributes populated by the server, like the
+// identifier, the links, the state and the timestamps, are removed. The result is an indented JSON
+// document with the attributes sorted by name, so that it is suitable for storing in version
+// control systems. Use the ImportCluster function to load it.
+func ExportCluster(cluster *v1.Cluster) (result []byte, err error) {
+	if cluster == nil {
+		err = fmt.Errorf("cluster is mandatory")
+		return
+	}
+	buffer := &bytes.Buffer{}
+	err = v1.MarshalCluster(cluster, buffer)
+	if err != nil {
+		err = fmt.Errorf("can't marshal cluster: %w", err)
+		return
+	}
+	spec, err := stripCluster(buffer.Bytes())
+	if err != nil {
+		return
+	}
+	result, err = json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		err = fmt.Errorf("can't marshal cluster specification: %w", err)
+		return
+	}
+	result = append(result, '\n')
+	return
+}
+
+// ImportCluster loads a cluster specification, usually generated with the ExportCluster function,
+// and returns a builder populated with it. The builder can be modified, for example to change the
+// name, before using it to create the new cluster. Attributes populated by the server are removed
+// also here, so that specifications written by other means can be imported safely.
+func ImportCluster(data []byte) (result *v1.ClusterBuilder, err error) {
+	spec, err := stripCluster(data)
+	if err != nil {
+		return
+	}
+	data, err = json.Marshal(spec)
+	if err != nil {
+		err = fmt.Errorf("can't marshal cluster specification: %w", err)
+		return
+	}
+	cluster, err := v1.UnmarshalCluster(data)
+	if err != nil {
+		err = fmt.Errorf("can't unmarshal cluster specification: %w", err)
+		return
+	}
+	result = v1.NewCluster().Copy(cluster)
+	return
+}
+
+// stripCluster parses the given JSON document and removes the attributes populated by the server.
+// Links are also removed from nested objects, as they are always populated by the server.
+func stripCluster(data []byte) (result map[string]interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err = decoder.Decode(&result)
+	if err != nil {
+		err = fmt.Errorf("can't parse cluster specification: %w", err)
+		return
+	}
+	if result == nil {
+		err = fmt.Errorf("cluster specification is empty")
+		return
+	}
+	for _, field := range serverFields {
+		delete(result, field)
+	}
+	for _, path := range serverPaths {
+		deletePath(result, path)
+	}
+	stripLinks(result)
+	return
+}
+
+// deletePath removes the attribute with the given path, if it exists.
+func deletePath(object map[string]interface{}, path []string) {
+	for len(path) > 1 {
+		next, ok := object[path[0]].(map[string]interface{})
+		if !ok {
+			return
+		}
+		object = next
+		path = path[1:]
+	}
+	delete(object, path[0])
+}
+
+// stripLinks removes recursively the `href` attributes of the given value.
+func stripLinks(value interface{}) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		delete(typed, "href")
+		for _, item := range typed {
+			stripLinks(item)
+		}
+	case []interface{}:
+		for _, item := range typed {
+			stripLinks(item)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustersmgmt
+
+import (
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Export", func() {
+	// cluster is a cluster as returned by the server, including the attributes that it populates.
+	const cluster = `{
+		"kind": "Cluster",
+		"id": "123",
+		"href": "/api/clusters_mgmt/v1/clusters/123",
+		"name": "mycluster",
+		"external_id": "456",
+		"infra_id": "mycluster-abc",
+		"state": "ready",
+		"creation_timestamp": "2026-10-16T10:00:00Z",
+		"multi_az": true,
+		"api": {
+			"url": "https://api.mycluster.example.com:6443",
+			"listening": "external"
+		},
+		"region": {
+			"kind": "CloudRegionLink",
+			"id": "us-east-1",
+			"href": "/api/clusters_mgmt/v1/cloud_providers/aws/regions/us-east-1"
+		},
+		"nodes": {
+			"compute": 3
+		},
+		"machine_pools": {
+			"kind": "MachinePoolListLink",
+			"href": "/api/clusters_mgmt/v1/clusters/123/machine_pools"
+		},
+		"subscription": {
+			"kind": "SubscriptionLink",
+			"id": "789",
+			"href": "/api/accounts_mgmt/v1/subscriptions/789"
+		},
+		"status": {
+			"state": "ready"
+		}
+	}`
+
+	It("Removes the attributes populated by the server", func() {
+		object, err := cmv1.UnmarshalCluster(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		spec, err := ExportCluster(object)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec).To(MatchJSON(`{
+			"kind": "Cluster",
+			"name": "mycluster",
+			"multi_az": true,
+			"api": {
+				"listening": "external"
+			},
+			"region": {
+				"kind": "CloudRegionLink",
+				"id": "us-east-1"
+			},
+			"nodes": {
+				"compute": 3
+			}
+		}`))
+	})
+
+	It("Sorts the attributes", func() {
+		object, err := cmv1.NewCluster().
+			Name("mycluster").
+			MultiAZ(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		spec, err := ExportCluster(object)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(spec)).To(Equal("{\n" +
+			"  \"kind\": \"Cluster\",\n" +
+			"  \"multi_az\": true,\n" +
+			"  \"name\": \"mycluster\"\n" +
+			"}\n",
+		))
+	})
+
+	It("Fails if the cluster is nil", func() {
+		_, err := ExportCluster(nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Import", func() {
+	It("Populates the builder from the exported specification", func() {
+		original, err := cmv1.NewCluster().
+			Name("mycluster").
+			MultiAZ(true).
+			Region(cmv1.NewCloudRegion().ID("us-east-1")).
+			Nodes(cmv1.NewClusterNodes().Compute(3)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		spec, err := ExportCluster(original)
+		Expect(err).ToNot(HaveOccurred())
+		builder, err := ImportCluster(spec)
+		Expect(err).ToNot(HaveOccurred())
+		clone, err := builder.Name("myclone").Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clone.Name()).To(Equal("myclone"))
+		Expect(clone.MultiAZ()).To(BeTrue())
+		Expect(clone.Region().ID()).To(Equal("us-east-1"))
+		Expect(clone.Nodes().Compute()).To(Equal(3))
+	})
+
+	It("Removes the attributes populated by the server", func() {
+		builder, err := ImportCluster([]byte(`{
+			"kind": "Cluster",
+			"id": "123",
+			"href": "/api/clusters_mgmt/v1/clusters/123",
+			"name": "mycluster",
+			"state": "ready"
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		object, err := builder.Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(object.Name()).To(Equal("mycluster"))
+		_, ok := object.GetID()
+		Expect(ok).To(BeFalse())
+		_, ok = object.GetHREF()
+		Expect(ok).To(BeFalse())
+		_, ok = object.GetState()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Fails if the specification isn't valid JSON", func() {
+		_, err := ImportCluster([]byte(`junk`))
+		Expect(err).To(HaveOccurred())
+	})
+})
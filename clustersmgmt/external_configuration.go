@@ -0,0 +1,270 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that manage the manifests and sync sets of the external configuration
+// of clusters.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/manifest"
+)
+
+// ParseKubernetesObjects parses the given YAML stream, that may contain multiple documents
+// separated by `---`, and checks that each document is a valid Kubernetes object. The result can be
+// passed to ApplyManifest or ApplySyncset.
+func ParseKubernetesObjects(data []byte) (result []interface{}, err error) {
+	documents, err := manifest.YAMLStreamToJSON(data)
+	if err != nil {
+		return
+	}
+	result = make([]interface{}, len(documents))
+	for i, document := range documents {
+		var object interface{}
+		err = json.Unmarshal(document, &object)
+		if err != nil {
+			result = nil
+			return
+		}
+		err = ValidateKubernetesObject(object)
+		if err != nil {
+			err = fmt.Errorf("document %d isn't valid: %w", i, err)
+			result = nil
+			return
+		}
+		result[i] = object
+	}
+	return
+}
+
+// ValidateKubernetesObject checks that the given value is a Kubernetes object, with the
+// `apiVersion` and `kind` fields and a `metadata` field containing the name of the object.
+func ValidateKubernetesObject(object interface{}) error {
+	fields, ok := object.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("object should be a map, but it is of type %T", object)
+	}
+	for _, field := range []string{"apiVersion", "kind"} {
+		value, ok := fields[field].(string)
+		if !ok || value == "" {
+			return fmt.Errorf("field '%s' is mandatory and should be a string", field)
+		}
+	}
+	metadata, ok := fields["metadata"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf(
+			"field 'metadata' of object of kind '%s' is mandatory and should be a map",
+			fields["kind"],
+		)
+	}
+	name, ok := metadata["name"].(string)
+	if !ok || name == "" {
+		return fmt.Errorf(
+			"field 'metadata.name' of object of kind '%s' is mandatory and should be a "+
+				"string",
+			fields["kind"],
+		)
+	}
+	return nil
+}
+
+// ApplyManifest makes sure that the manifest with the given identifier exists in the external
+// configuration of the given hosted cluster and contains the given workloads. The manifest is
+// created if it doesn't exist, and updated only if the workloads are different. The returned flag
+// indicates if the manifest was created or updated. The workloads are validated before sending
+// them to the server.
+func ApplyManifest(ctx context.Context, client *v1.Client, clusterID, manifestID string,
+	workloads []interface{}) (result *v1.Manifest, changed bool, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	if manifestID == "" {
+		err = errors.New("manifest identifier is mandatory")
+		return
+	}
+	workloads, err = normalizeKubernetesObjects(workloads)
+	if err != nil {
+		return
+	}
+	manifests := externalConfigurationClient(client, clusterID).Manifests()
+	resource := manifests.Manifest(manifestID)
+
+	// Get the current manifest:
+	getResponse, err := resource.Get().SendContext(ctx)
+	if getResponse != nil && getResponse.Status() == http.StatusNotFound {
+		var object *v1.Manifest
+		object, err = v1.NewManifest().ID(manifestID).Workloads(workloads...).Build()
+		if err != nil {
+			return
+		}
+		var addResponse *v1.ManifestsAddResponse
+		addResponse, err = manifests.Add().Body(object).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't create manifest '%s' for cluster '%s': %w",
+				manifestID, clusterID, err,
+			)
+			return
+		}
+		result = addResponse.Body()
+		changed = true
+		return
+	}
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get manifest '%s' of cluster '%s': %w",
+			manifestID, clusterID, err,
+		)
+		return
+	}
+	current := getResponse.Body()
+	if reflect.DeepEqual(current.Workloads(), workloads) {
+		result = current
+		return
+	}
+
+	// Update it:
+	update, err := v1.NewManifest().Workloads(workloads...).Build()
+	if err != nil {
+		return
+	}
+	updateResponse, err := resource.Update().Body(update).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't update manifest '%s' of cluster '%s': %w",
+			manifestID, clusterID, err,
+		)
+		return
+	}
+	result = updateResponse.Body()
+	changed = true
+	return
+}
+
+// ApplySyncset makes sure that the sync set with the given identifier exists in the external
+// configuration of the given cluster and contains the given resources. The sync set is created if
+// it doesn't exist, and updated only if the resources are different. The returned flag indicates
+// if the sync set was created or updated. The resources are validated before sending them to the
+// server.
+func ApplySyncset(ctx context.Context, client *v1.Client, clusterID, syncsetID string,
+	resources []interface{}) (result *v1.Syncset, changed bool, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	if syncsetID == "" {
+		err = errors.New("sync set identifier is mandatory")
+		return
+	}
+	resources, err = normalizeKubernetesObjects(resources)
+	if err != nil {
+		return
+	}
+	syncsets := externalConfigurationClient(client, clusterID).Syncsets()
+	resource := syncsets.Syncset(syncsetID)
+
+	// Get the current sync set:
+	getResponse, err := resource.Get().SendContext(ctx)
+	if getResponse != nil && getResponse.Status() == http.StatusNotFound {
+		var object *v1.Syncset
+		object, err = v1.NewSyncset().ID(syncsetID).Resources(resources...).Build()
+		if err != nil {
+			return
+		}
+		var addResponse *v1.SyncsetsAddResponse
+		addResponse, err = syncsets.Add().Body(object).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't create sync set '%s' for cluster '%s': %w",
+				syncsetID, clusterID, err,
+			)
+			return
+		}
+		result = addResponse.Body()
+		changed = true
+		return
+	}
+	if err != nil {
+		err = fmt.Errorf(
+			"can't get sync set '%s' of cluster '%s': %w",
+			syncsetID, clusterID, err,
+		)
+		return
+	}
+	current := getResponse.Body()
+	if reflect.DeepEqual(current.Resources(), resources) {
+		result = current
+		return
+	}
+
+	// Update it:
+	update, err := v1.NewSyncset().Resources(resources...).Build()
+	if err != nil {
+		return
+	}
+	updateResponse, err := resource.Update().Body(update).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't update sync set '%s' of cluster '%s': %w",
+			syncsetID, clusterID, err,
+		)
+		return
+	}
+	result = updateResponse.Body()
+	changed = true
+	return
+}
+
+// normalizeKubernetesObjects validates the given objects and converts them to the representation
+// used by the generated JSON decoders, so that they can be compared with the objects returned by
+// the server. For example, numbers are converted to float64.
+func normalizeKubernetesObjects(objects []interface{}) (result []interface{}, err error) {
+	data, err := json.Marshal(objects)
+	if err != nil {
+		err = fmt.Errorf("can't convert objects to JSON: %w", err)
+		return
+	}
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return
+	}
+	for i, object := range result {
+		err = ValidateKubernetesObject(object)
+		if err != nil {
+			err = fmt.Errorf("object %d isn't valid: %w", i, err)
+			result = nil
+			return
+		}
+	}
+	return
+}
+
+func externalConfigurationClient(client *v1.Client,
+	clusterID string) *v1.ExternalConfigurationClient {
+	return client.
+		Clusters().
+		Cluster(clusterID).
+		ExternalConfiguration()
+}
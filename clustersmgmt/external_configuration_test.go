@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that manage the manifests and sync sets of the external
+// configuration of clusters.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("External configuration", func() {
+	const configurationPath = "/api/clusters_mgmt/v1/clusters/123/external_configuration"
+
+	const configMap = `{
+		"apiVersion": "v1",
+		"kind": "ConfigMap",
+		"metadata": {
+			"name": "my-config",
+			"namespace": "my-namespace"
+		},
+		"data": {
+			"replicas": 3
+		}
+	}`
+
+	const notFound = `{
+		"kind": "Error",
+		"id": "404",
+		"href": "/api/clusters_mgmt/v1/errors/404",
+		"code": "CLUSTERS-MGMT-404",
+		"reason": "Not found"
+	}`
+
+	var objects []interface{}
+
+	BeforeEach(func() {
+		var err error
+		objects, err = ParseKubernetesObjects([]byte(RemoveLeadingTabs(
+			`apiVersion: v1
+			kind: ConfigMap
+			metadata:
+			  name: my-config
+			  namespace: my-namespace
+			data:
+			  replicas: 3
+			`,
+		)))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Creates a manifest that doesn't exist", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, configurationPath+"/manifests/my-manifest"),
+				RespondWithJSON(http.StatusNotFound, notFound),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, configurationPath+"/manifests"),
+				ghttp.VerifyJSON(`{
+					"kind": "Manifest",
+					"id": "my-manifest",
+					"workloads": [`+configMap+`]
+				}`),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "Manifest",
+					"id": "my-manifest",
+					"workloads": [`+configMap+`]
+				}`),
+			),
+		)
+		result, changed, err := ApplyManifest(ctx, client, "123",
+			"my-manifest", objects)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(result.ID()).To(Equal("my-manifest"))
+	})
+
+	It("Doesn't update a manifest that hasn't changed", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, configurationPath+"/manifests/my-manifest"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "Manifest",
+					"id": "my-manifest",
+					"workloads": [`+configMap+`]
+				}`),
+			),
+		)
+		_, changed, err := ApplyManifest(ctx, client, "123",
+			"my-manifest", objects)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Updates a sync set that has changed", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, configurationPath+"/syncsets/my-syncset"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "Syncset",
+					"id": "my-syncset",
+					"resources": []
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, configurationPath+"/syncsets/my-syncset"),
+				ghttp.VerifyJSON(`{
+					"kind": "Syncset",
+					"resources": [`+configMap+`]
+				}`),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "Syncset",
+					"id": "my-syncset",
+					"resources": [`+configMap+`]
+				}`),
+			),
+		)
+		result, changed, err := ApplySyncset(ctx, client, "123",
+			"my-syncset", objects)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(result.Resources()).To(HaveLen(1))
+	})
+
+	It("Rejects objects without name before sending them", func() {
+		_, _, err := ApplySyncset(ctx, client, "123", "my-syncset",
+			[]interface{}{
+				map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata":   map[string]interface{}{},
+				},
+			})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("metadata.name"))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Rejects documents that aren't Kubernetes objects", func() {
+		_, err := ParseKubernetesObjects([]byte(RemoveLeadingTabs(
+			`apiVersion: v1
+			kind: ConfigMap
+			metadata:
+			  name: my-config
+			---
+			- just
+			- a list
+			`,
+		)))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("document 1"))
+	})
+})
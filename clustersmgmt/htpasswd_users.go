@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains a helper that simplifies the management of the users of HTPasswd identity
+// providers.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// HTPasswdUserManager simplifies the management of the users of an HTPasswd identity provider. It
+// hashes the passwords before sending them to the server, and finds users by name instead of by
+// identifier. Don't create objects of this type directly; use the NewHTPasswdUserManager function
+// instead.
+type HTPasswdUserManager struct {
+	cluster string
+	idp     string
+	client  *v1.HTPasswdUsersClient
+}
+
+// NewHTPasswdUserManager creates a new manager for the users of the given HTPasswd identity
+// provider of the given cluster. The users are read and modified with the given clusters management
+// client.
+func NewHTPasswdUserManager(client *v1.Client, clusterID,
+	idpID string) *HTPasswdUserManager {
+	return &HTPasswdUserManager{
+		cluster: clusterID,
+		idp:     idpID,
+		client: client.
+			Clusters().
+			Cluster(clusterID).
+			IdentityProviders().
+			IdentityProvider(idpID).
+			HtpasswdUsers(),
+	}
+}
+
+// HashPassword calculates the bcrypt hash of the given password, in the format expected by the
+// HTPasswd identity providers.
+func HashPassword(password string) (result string, err error) {
+	if password == "" {
+		err = errors.New("password is mandatory")
+		return
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		err = fmt.Errorf("can't hash password: %w", err)
+		return
+	}
+	result = string(hash)
+	return
+}
+
+// List returns all the users of the identity provider.
+func (m *HTPasswdUserManager) List(ctx context.Context) (result []*v1.HTPasswdUser, err error) {
+	request := m.client.List().Size(100)
+	for page := 1; ; page++ {
+		var response *v1.HTPasswdUsersListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't list users of identity provider '%s' of cluster '%s': %w",
+				m.idp, m.cluster, err,
+			)
+			return
+		}
+		items := response.Items().Slice()
+		result = append(result, items...)
+		if len(items) == 0 || len(result) >= response.Total() {
+			return
+		}
+	}
+}
+
+// Get returns the user with the given name, or nil if there is no such user.
+func (m *HTPasswdUserManager) Get(ctx context.Context, username string) (result *v1.HTPasswdUser,
+	err error) {
+	users, err := m.List(ctx)
+	if err != nil {
+		return
+	}
+	for _, user := range users {
+		if user.Username() == username {
+			result = user
+			return
+		}
+	}
+	return
+}
+
+// Add creates a new user with the given name and password. The password is hashed before sending
+// it to the server.
+func (m *HTPasswdUserManager) Add(ctx context.Context, username,
+	password string) (result *v1.HTPasswdUser, err error) {
+	if username == "" {
+		err = errors.New("user name is mandatory")
+		return
+	}
+	hash, err := HashPassword(password)
+	if err != nil {
+		return
+	}
+	user, err := v1.NewHTPasswdUser().
+		Username(username).
+		HashedPassword(hash).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := m.client.Add().Body(user).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't add user '%s' to identity provider '%s' of cluster '%s': %w",
+			username, m.idp, m.cluster, err,
+		)
+		return
+	}
+	result = response.Body()
+	return
+}
+
+// Update changes the password of the user with the given name. The password is hashed before
+// sending it to the server.
+func (m *HTPasswdUserManager) Update(ctx context.Context, username, password string) error {
+	hash, err := HashPassword(password)
+	if err != nil {
+		return err
+	}
+	id, err := m.find(ctx, username)
+	if err != nil {
+		return err
+	}
+	user, err := v1.NewHTPasswdUser().
+		HashedPassword(hash).
+		Build()
+	if err != nil {
+		return err
+	}
+	_, err = m.client.HtpasswdUser(id).Update().Body(user).SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"can't update user '%s' of identity provider '%s' of cluster '%s': %w",
+			username, m.idp, m.cluster, err,
+		)
+	}
+	return nil
+}
+
+// Delete deletes the user with the given name.
+func (m *HTPasswdUserManager) Delete(ctx context.Context, username string) error {
+	id, err := m.find(ctx, username)
+	if err != nil {
+		return err
+	}
+	_, err = m.client.HtpasswdUser(id).Delete().SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"can't delete user '%s' of identity provider '%s' of cluster '%s': %w",
+			username, m.idp, m.cluster, err,
+		)
+	}
+	return nil
+}
+
+// Import adds the users contained in the given HTPasswd file content, where each line contains a
+// user name and a bcrypt hash separated by a colon. Empty lines and lines starting with `#` are
+// ignored. The hashes are sent to the server as they are.
+func (m *HTPasswdUserManager) Import(ctx context.Context,
+	reader io.Reader) (result []*v1.HTPasswdUser, err error) {
+	var users []*v1.HTPasswdUser
+	scanner := bufio.NewScanner(reader)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		username, hash, found := strings.Cut(text, ":")
+		if !found || username == "" || hash == "" {
+			err = fmt.Errorf("line %d doesn't have the 'user:hash' format", line)
+			return
+		}
+		_, err = bcrypt.Cost([]byte(hash))
+		if err != nil {
+			err = fmt.Errorf("hash of user '%s' in line %d isn't a valid bcrypt hash", username, line)
+			return
+		}
+		var user *v1.HTPasswdUser
+		user, err = v1.NewHTPasswdUser().
+			Username(username).
+			HashedPassword(hash).
+			Build()
+		if err != nil {
+			return
+		}
+		users = append(users, user)
+	}
+	err = scanner.Err()
+	if err != nil {
+		err = fmt.Errorf("can't read users: %w", err)
+		return
+	}
+	if len(users) == 0 {
+		return
+	}
+	response, err := m.client.Import().Items(users).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't import users to identity provider '%s' of cluster '%s': %w",
+			m.idp, m.cluster, err,
+		)
+		return
+	}
+	result = response.Items()
+	return
+}
+
+// ImportFile adds the users contained in the given HTPasswd file. See the Import method for
+// details.
+func (m *HTPasswdUserManager) ImportFile(ctx context.Context,
+	path string) (result []*v1.HTPasswdUser, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		err = fmt.Errorf("can't open file '%s': %w", path, err)
+		return
+	}
+	defer file.Close()
+	return m.Import(ctx, file)
+}
+
+// find returns the identifier of the user with the given name.
+func (m *HTPasswdUserManager) find(ctx context.Context, username string) (result string,
+	err error) {
+	if username == "" {
+		err = errors.New("user name is mandatory")
+		return
+	}
+	user, err := m.Get(ctx, username)
+	if err != nil {
+		return
+	}
+	if user == nil {
+		err = fmt.Errorf(
+			"user '%s' doesn't exist in identity provider '%s' of cluster '%s'",
+			username, m.idp, m.cluster,
+		)
+		return
+	}
+	result = user.ID()
+	return
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helper that manages the users of HTPasswd identity providers.
+
+package clustersmgmt
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+	"golang.org/x/crypto/bcrypt"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("HTPasswd users", func() {
+	const usersPath = "/api/clusters_mgmt/v1/clusters/123/identity_providers/456/htpasswd_users"
+
+	const users = `{
+		"kind": "HTPasswdUserList",
+		"page": 1,
+		"size": 2,
+		"total": 2,
+		"items": [
+			{"kind": "HTPasswdUser", "id": "a", "username": "alice"},
+			{"kind": "HTPasswdUser", "id": "b", "username": "bob"}
+		]
+	}`
+
+	var manager *HTPasswdUserManager
+
+	// verifyHash creates a handler that checks that the request body contains the bcrypt hash of
+	// the given password, and not the password itself.
+	verifyHash := func(password string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			body, err := io.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).ToNot(ContainSubstring(password))
+			var user struct {
+				HashedPassword string `json:"hashed_password"`
+			}
+			err = json.Unmarshal(body, &user)
+			Expect(err).ToNot(HaveOccurred())
+			err = bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(password))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		manager = NewHTPasswdUserManager(client, "123", "456")
+	})
+
+	It("Adds a user with a hashed password", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, usersPath),
+				verifyHash("my-password"),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "HTPasswdUser",
+					"id": "c",
+					"username": "carol"
+				}`),
+			),
+		)
+		user, err := manager.Add(ctx, "carol", "my-password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user.ID()).To(Equal("c"))
+	})
+
+	It("Updates the password of a user found by name", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, usersPath),
+				RespondWithJSON(http.StatusOK, users),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, usersPath+"/b"),
+				verifyHash("new-password"),
+				RespondWithJSON(http.StatusOK, `{"kind": "HTPasswdUser", "id": "b"}`),
+			),
+		)
+		err := manager.Update(ctx, "bob", "new-password")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Deletes a user found by name", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, users),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, usersPath+"/a"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		err := manager.Delete(ctx, "alice")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Fails to delete a user that doesn't exist", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, users),
+		)
+		err := manager.Delete(ctx, "dave")
+		Expect(err).To(MatchError(
+			"user 'dave' doesn't exist in identity provider '456' of cluster '123'",
+		))
+	})
+
+	It("Imports users from an HTPasswd file", func() {
+		hash, err := HashPassword("my-password")
+		Expect(err).ToNot(HaveOccurred())
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, usersPath+"/import"),
+				ghttp.VerifyJSON(`{
+					"items": [
+						{"username": "alice", "hashed_password": "`+hash+`"},
+						{"username": "bob", "hashed_password": "`+hash+`"}
+					]
+				}`),
+				RespondWithJSON(http.StatusOK, users),
+			),
+		)
+		content := "# Users\nalice:" + hash + "\n\nbob:" + hash + "\n"
+		result, err := manager.Import(ctx, strings.NewReader(content))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(2))
+	})
+
+	It("Rejects a file with invalid hashes", func() {
+		_, err := manager.Import(ctx, strings.NewReader("alice:plain\n"))
+		Expect(err).To(MatchError("hash of user 'alice' in line 1 isn't a valid bcrypt hash"))
+	})
+})
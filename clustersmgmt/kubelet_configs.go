@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that validate and apply kubelet configs.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// Limits of the pod PIDs limit of kubelet configs. Note that the service only accepts values
+// greater than 16384 for organizations that have been granted that capability.
+const (
+	MinPodPidsLimit = 4096
+	MaxPodPidsLimit = 3694303
+)
+
+// ValidateKubeletConfig checks the given kubelet config, so that mistakes are detected before
+// sending it to the server. The pod PIDs limit is mandatory and should be between MinPodPidsLimit
+// and MaxPodPidsLimit. The name is optional, but when present it should be a valid DNS label.
+func ValidateKubeletConfig(config *v1.KubeletConfig) error {
+	if config == nil {
+		return errors.New("kubelet config is mandatory")
+	}
+	if config.Name() != "" {
+		err := validateNodeConfigName("kubelet config", config.Name())
+		if err != nil {
+			return err
+		}
+	}
+	limit, ok := config.GetPodPidsLimit()
+	if !ok {
+		return errors.New("pod PIDs limit is mandatory")
+	}
+	if limit < MinPodPidsLimit || limit > MaxPodPidsLimit {
+		return fmt.Errorf(
+			"pod PIDs limit %d isn't valid, it should be between %d and %d",
+			limit, MinPodPidsLimit, MaxPodPidsLimit,
+		)
+	}
+	return nil
+}
+
+// ApplyKubeletConfig validates the given kubelet config and creates it in the given cluster, or
+// updates it if it already exists. Classic clusters have only one kubelet config, that applies to
+// all the machine pools. Hosted control plane clusters can have multiple kubelet configs, that are
+// referenced by name from the node pools, so for those clusters the name is mandatory and it is
+// used to find the existing kubelet config. The type of the cluster is checked automatically. The
+// returned flag indicates if the kubelet config was created.
+func ApplyKubeletConfig(ctx context.Context, client *v1.Client, clusterID string,
+	config *v1.KubeletConfig) (result *v1.KubeletConfig, created bool, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	err = ValidateKubeletConfig(config)
+	if err != nil {
+		return
+	}
+	resource := client.Clusters().Cluster(clusterID)
+
+	// Check the type of the cluster:
+	response, err := resource.Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't get cluster '%s': %w", clusterID, err)
+		return
+	}
+	if response.Body().Hypershift().Enabled() {
+		result, created, err = applyHostedKubeletConfig(ctx, resource, clusterID, config)
+	} else {
+		result, created, err = applyClassicKubeletConfig(ctx, resource, clusterID, config)
+	}
+	return
+}
+
+// applyClassicKubeletConfig creates or updates the only kubelet config of a classic cluster.
+func applyClassicKubeletConfig(ctx context.Context, client *v1.ClusterClient, clusterID string,
+	config *v1.KubeletConfig) (result *v1.KubeletConfig, created bool, err error) {
+	resource := client.KubeletConfig()
+	getResponse, err := resource.Get().SendContext(ctx)
+	if getResponse != nil && getResponse.Status() == http.StatusNotFound {
+		var postResponse *v1.KubeletConfigPostResponse
+		postResponse, err = resource.Post().Body(config).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't create kubelet config for cluster '%s': %w",
+				clusterID, err,
+			)
+			return
+		}
+		result = postResponse.Body()
+		created = true
+		return
+	}
+	if err != nil {
+		err = fmt.Errorf("can't get kubelet config of cluster '%s': %w", clusterID, err)
+		return
+	}
+	updateResponse, err := resource.Update().Body(config).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't update kubelet config of cluster '%s': %w", clusterID, err)
+		return
+	}
+	result = updateResponse.Body()
+	return
+}
+
+// applyHostedKubeletConfig creates or updates the kubelet config with the same name in a hosted
+// control plane cluster.
+func applyHostedKubeletConfig(ctx context.Context, client *v1.ClusterClient, clusterID string,
+	config *v1.KubeletConfig) (result *v1.KubeletConfig, created bool, err error) {
+	if config.Name() == "" {
+		err = fmt.Errorf(
+			"kubelet config name is mandatory for cluster '%s' because it uses a hosted "+
+				"control plane",
+			clusterID,
+		)
+		return
+	}
+	collection := client.KubeletConfigs()
+
+	// Find the existing kubelet config:
+	var existing *v1.KubeletConfig
+	request := collection.List().Size(100)
+	seen := 0
+	for page := 1; existing == nil; page++ {
+		var response *v1.KubeletConfigsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't list kubelet configs of cluster '%s': %w",
+				clusterID, err,
+			)
+			return
+		}
+		response.Items().Each(func(item *v1.KubeletConfig) bool {
+			if item.Name() == config.Name() {
+				existing = item
+				return false
+			}
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			break
+		}
+	}
+
+	// Create it if it doesn't exist:
+	if existing == nil {
+		var addResponse *v1.KubeletConfigsAddResponse
+		addResponse, err = collection.Add().Body(config).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't create kubelet config '%s' for cluster '%s': %w",
+				config.Name(), clusterID, err,
+			)
+			return
+		}
+		result = addResponse.Body()
+		created = true
+		return
+	}
+
+	// Update it:
+	update, err := v1.NewKubeletConfig().PodPidsLimit(config.PodPidsLimit()).Build()
+	if err != nil {
+		return
+	}
+	updateResponse, err := collection.KubeletConfig(existing.ID()).Update().Body(update).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't update kubelet config '%s' of cluster '%s': %w",
+			config.Name(), clusterID, err,
+		)
+		return
+	}
+	result = updateResponse.Body()
+	return
+}
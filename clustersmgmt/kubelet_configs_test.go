@@ -0,0 +1,239 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that manage kubelet configs.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Kubelet configs", func() {
+	const clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+
+	const classicCluster = `{
+		"kind": "Cluster",
+		"id": "123"
+	}`
+
+	const hostedCluster = `{
+		"kind": "Cluster",
+		"id": "123",
+		"hypershift": {
+			"enabled": true
+		}
+	}`
+
+	Describe("Validation", func() {
+		It("Accepts a valid config", func() {
+			config, err := cmv1.NewKubeletConfig().
+				Name("my-kubelet").
+				PodPidsLimit(8192).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = ValidateKubeletConfig(config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Requires the pod PIDs limit", func() {
+			config, err := cmv1.NewKubeletConfig().
+				Name("my-kubelet").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = ValidateKubeletConfig(config)
+			Expect(err).To(MatchError("pod PIDs limit is mandatory"))
+		})
+
+		It("Rejects a pod PIDs limit out of range", func() {
+			config, err := cmv1.NewKubeletConfig().
+				PodPidsLimit(1024).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = ValidateKubeletConfig(config)
+			Expect(err).To(MatchError(
+				"pod PIDs limit 1024 isn't valid, it should be between 4096 and 3694303",
+			))
+		})
+
+		It("Rejects an invalid name", func() {
+			config, err := cmv1.NewKubeletConfig().
+				Name("-junk").
+				PodPidsLimit(8192).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = ValidateKubeletConfig(config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("kubelet config name '-junk' isn't valid"))
+		})
+	})
+
+	Describe("Classic clusters", func() {
+		It("Creates the kubelet config if it doesn't exist", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, classicCluster),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, clusterPath+"/kubelet_config"),
+					RespondWithJSON(http.StatusNotFound, `{
+						"kind": "Error",
+						"id": "404",
+						"reason": "Kubelet config not found"
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, clusterPath+"/kubelet_config"),
+					VerifyJQ(".pod_pids_limit", 8192.0),
+					RespondWithJSON(http.StatusCreated, `{
+						"kind": "KubeletConfig",
+						"pod_pids_limit": 8192
+					}`),
+				),
+			)
+			config, err := cmv1.NewKubeletConfig().
+				PodPidsLimit(8192).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			result, created, err := ApplyKubeletConfig(ctx, client, "123", config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(result.PodPidsLimit()).To(Equal(8192))
+		})
+
+		It("Updates the existing kubelet config", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, classicCluster),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "KubeletConfig",
+					"pod_pids_limit": 4096
+				}`),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, clusterPath+"/kubelet_config"),
+					VerifyJQ(".pod_pids_limit", 8192.0),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "KubeletConfig",
+						"pod_pids_limit": 8192
+					}`),
+				),
+			)
+			config, err := cmv1.NewKubeletConfig().
+				PodPidsLimit(8192).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			result, created, err := ApplyKubeletConfig(ctx, client, "123", config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(result.PodPidsLimit()).To(Equal(8192))
+		})
+	})
+
+	Describe("Hosted control plane clusters", func() {
+		It("Creates the kubelet config if it doesn't exist", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, hostedCluster),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, clusterPath+"/kubelet_configs"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "KubeletConfigList",
+						"page": 1,
+						"size": 0,
+						"total": 0,
+						"items": []
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, clusterPath+"/kubelet_configs"),
+					VerifyJQ(".name", "my-kubelet"),
+					RespondWithJSON(http.StatusCreated, `{
+						"kind": "KubeletConfig",
+						"id": "456",
+						"name": "my-kubelet",
+						"pod_pids_limit": 8192
+					}`),
+				),
+			)
+			config, err := cmv1.NewKubeletConfig().
+				Name("my-kubelet").
+				PodPidsLimit(8192).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			result, created, err := ApplyKubeletConfig(ctx, client, "123", config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(result.ID()).To(Equal("456"))
+		})
+
+		It("Updates the kubelet config with the same name", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, hostedCluster),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "KubeletConfigList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"kind": "KubeletConfig",
+							"id": "456",
+							"name": "my-kubelet",
+							"pod_pids_limit": 4096
+						}
+					]
+				}`),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, clusterPath+"/kubelet_configs/456"),
+					VerifyJQ(".pod_pids_limit", 8192.0),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "KubeletConfig",
+						"id": "456",
+						"name": "my-kubelet",
+						"pod_pids_limit": 8192
+					}`),
+				),
+			)
+			config, err := cmv1.NewKubeletConfig().
+				Name("my-kubelet").
+				PodPidsLimit(8192).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			result, created, err := ApplyKubeletConfig(ctx, client, "123", config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(result.PodPidsLimit()).To(Equal(8192))
+		})
+
+		It("Requires the name", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, hostedCluster),
+			)
+			config, err := cmv1.NewKubeletConfig().
+				PodPidsLimit(8192).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = ApplyKubeletConfig(ctx, client, "123", config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("kubelet config name is mandatory"))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that manage the limited support reasons of clusters.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// ListLimitedSupportReasons returns the limited support reasons of the given cluster. If the
+// template identifier isn't empty only the reasons created from that template are returned.
+func ListLimitedSupportReasons(ctx context.Context, client *v1.Client, clusterID,
+	templateID string) (result []*v1.LimitedSupportReason, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	request := limitedSupportReasonsClient(client, clusterID).List().Size(100)
+	seen := 0
+	for page := 1; ; page++ {
+		var response *v1.LimitedSupportReasonsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't list limited support reasons of cluster '%s': %w",
+				clusterID, err,
+			)
+			result = nil
+			return
+		}
+		response.Items().Each(func(item *v1.LimitedSupportReason) bool {
+			if templateID == "" || item.Template().ID() == templateID {
+				result = append(result, item)
+			}
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			break
+		}
+	}
+	return
+}
+
+// AddLimitedSupportReason adds the given limited support reason to the given cluster, unless the
+// cluster already has an equivalent reason. Two reasons are equivalent when they were created from
+// the same template or, for reasons that don't use a template, when they have the same summary. The
+// returned flag indicates if the reason was created. When it wasn't the existing reason is
+// returned.
+func AddLimitedSupportReason(ctx context.Context, client *v1.Client, clusterID string,
+	reason *v1.LimitedSupportReason) (result *v1.LimitedSupportReason, created bool, err error) {
+	if reason == nil {
+		err = errors.New("limited support reason is mandatory")
+		return
+	}
+	if reason.Template().ID() == "" && reason.Summary() == "" {
+		err = errors.New("limited support reason should have a template or a summary")
+		return
+	}
+	existing, err := ListLimitedSupportReasons(ctx, client, clusterID, "")
+	if err != nil {
+		return
+	}
+	for _, item := range existing {
+		if sameLimitedSupportReason(item, reason) {
+			result = item
+			return
+		}
+	}
+	response, err := limitedSupportReasonsClient(client, clusterID).Add().
+		Body(reason).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't add limited support reason to cluster '%s': %w",
+			clusterID, err,
+		)
+		return
+	}
+	result = response.Body()
+	created = true
+	return
+}
+
+// RemoveLimitedSupportReasons removes from the given cluster all the limited support reasons that
+// are equivalent to the given one, using the same criteria than AddLimitedSupportReason. It returns
+// the number of reasons removed, which is zero if there were no equivalent reasons.
+func RemoveLimitedSupportReasons(ctx context.Context, client *v1.Client,
+	clusterID string, reason *v1.LimitedSupportReason) (removed int, err error) {
+	if reason == nil {
+		err = errors.New("limited support reason is mandatory")
+		return
+	}
+	existing, err := ListLimitedSupportReasons(ctx, client, clusterID, "")
+	if err != nil {
+		return
+	}
+	reasons := limitedSupportReasonsClient(client, clusterID)
+	for _, item := range existing {
+		if !sameLimitedSupportReason(item, reason) {
+			continue
+		}
+		var response *v1.LimitedSupportReasonDeleteResponse
+		response, err = reasons.LimitedSupportReason(item.ID()).Delete().SendContext(ctx)
+		if response != nil && response.Status() == http.StatusNotFound {
+			// Somebody else removed it in the meantime, which is what we want:
+			err = nil
+			continue
+		}
+		if err != nil {
+			err = fmt.Errorf(
+				"can't remove limited support reason '%s' from cluster '%s': %w",
+				item.ID(), clusterID, err,
+			)
+			return
+		}
+		removed++
+	}
+	return
+}
+
+// sameLimitedSupportReason checks if the existing reason is equivalent to the given one.
+func sameLimitedSupportReason(existing, reason *v1.LimitedSupportReason) bool {
+	if reason.Template().ID() != "" {
+		return existing.Template().ID() == reason.Template().ID()
+	}
+	return existing.Summary() == reason.Summary()
+}
+
+func limitedSupportReasonsClient(client *v1.Client,
+	clusterID string) *v1.LimitedSupportReasonsClient {
+	return client.
+		Clusters().
+		Cluster(clusterID).
+		LimitedSupportReasons()
+}
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that manage limited support reasons.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Limited support reasons", func() {
+	const reasonsPath = "/api/clusters_mgmt/v1/clusters/123/limited_support_reasons"
+
+	const reasons = `{
+		"kind": "LimitedSupportReasonList",
+		"page": 1,
+		"size": 3,
+		"total": 3,
+		"items": [
+			{
+				"kind": "LimitedSupportReason",
+				"id": "a",
+				"summary": "Cluster is using an unsupported network plugin"
+			},
+			{
+				"kind": "LimitedSupportReason",
+				"id": "b",
+				"summary": "Cluster is over quota",
+				"template": {"kind": "LimitedSupportReasonTemplateLink", "id": "quota"}
+			},
+			{
+				"kind": "LimitedSupportReason",
+				"id": "c",
+				"summary": "Cluster is over quota",
+				"template": {"kind": "LimitedSupportReasonTemplateLink", "id": "quota"}
+			}
+		]
+	}`
+
+	It("Lists the reasons created from a template", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, reasonsPath),
+				RespondWithJSON(http.StatusOK, reasons),
+			),
+		)
+		result, err := ListLimitedSupportReasons(ctx, client, "123", "quota")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(2))
+		Expect(result[0].ID()).To(Equal("b"))
+		Expect(result[1].ID()).To(Equal("c"))
+	})
+
+	It("Doesn't duplicate a reason with the same summary", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, reasonsPath),
+				RespondWithJSON(http.StatusOK, reasons),
+			),
+		)
+		reason, err := cmv1.NewLimitedSupportReason().
+			Summary("Cluster is using an unsupported network plugin").
+			Details("Use OVN-Kubernetes").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, created, err := AddLimitedSupportReason(ctx, client, "123", reason)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeFalse())
+		Expect(result.ID()).To(Equal("a"))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Creates a reason that doesn't exist", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, reasonsPath),
+				RespondWithJSON(http.StatusOK, reasons),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, reasonsPath),
+				ghttp.VerifyJSON(`{
+					"kind": "LimitedSupportReason",
+					"summary": "Cluster has no workers",
+					"details": "Add a machine pool"
+				}`),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "LimitedSupportReason",
+					"id": "d",
+					"summary": "Cluster has no workers",
+					"details": "Add a machine pool"
+				}`),
+			),
+		)
+		reason, err := cmv1.NewLimitedSupportReason().
+			Summary("Cluster has no workers").
+			Details("Add a machine pool").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, created, err := AddLimitedSupportReason(ctx, client, "123", reason)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeTrue())
+		Expect(result.ID()).To(Equal("d"))
+	})
+
+	It("Removes all the reasons created from a template", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, reasonsPath),
+				RespondWithJSON(http.StatusOK, reasons),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, reasonsPath+"/b"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, reasonsPath+"/c"),
+				RespondWithJSON(http.StatusNotFound, `{
+					"kind": "Error",
+					"id": "404",
+					"href": "/api/clusters_mgmt/v1/errors/404",
+					"code": "CLUSTERS-MGMT-404",
+					"reason": "Not found"
+				}`),
+			),
+		)
+		reason, err := cmv1.NewLimitedSupportReason().
+			Template(cmv1.NewLimitedSupportReasonTemplate().ID("quota")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		removed, err := RemoveLimitedSupportReasons(ctx, client, "123", reason)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(removed).To(Equal(1))
+	})
+})
@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that scale the machine pools of classic clusters and the node pools of
+// hosted control plane clusters using the same functions.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// MachinePoolScalingOptions contains the options that control how machine pools are scaled.
+type MachinePoolScalingOptions struct {
+	// MaxSurge is the maximum number of nodes, or percentage of the replicas, that can be
+	// created above the desired number of replicas while the pool is updated, for example `1`
+	// or `10%`. This is only supported for the node pools of hosted control plane clusters.
+	MaxSurge string
+
+	// MaxUnavailable is the maximum number of nodes, or percentage of the replicas, that can be
+	// unavailable while the pool is updated. This is only supported for the node pools of
+	// hosted control plane clusters.
+	MaxUnavailable string
+}
+
+// ScaleMachinePool sets the number of replicas of the given pool. For classic clusters the pool is
+// a machine pool and for hosted control plane clusters it is a node pool; the type of the cluster
+// is checked automatically, fetching the cluster with the given client before updating the pool.
+// The options can be nil.
+func ScaleMachinePool(ctx context.Context, client *v1.Client, clusterID, poolID string,
+	replicas int, options *MachinePoolScalingOptions) error {
+	if replicas < 0 {
+		return fmt.Errorf(
+			"replicas %d isn't valid, it should be greater or equal than zero",
+			replicas,
+		)
+	}
+	return updateMachinePool(ctx, client, clusterID, poolID, options,
+		func(builder *v1.MachinePoolBuilder) {
+			builder.Replicas(replicas)
+		},
+		func(builder *v1.NodePoolBuilder) {
+			builder.Replicas(replicas)
+		},
+	)
+}
+
+// EnableMachinePoolAutoscaling enables autoscaling for the given pool, between the given minimum
+// and maximum number of replicas. See ScaleMachinePool for details about the types of pools.
+func EnableMachinePoolAutoscaling(ctx context.Context, client *v1.Client, clusterID,
+	poolID string, minReplicas, maxReplicas int, options *MachinePoolScalingOptions) error {
+	if minReplicas < 0 || maxReplicas < minReplicas {
+		return fmt.Errorf(
+			"autoscaling range from %d to %d replicas isn't valid, the minimum should be "+
+				"greater or equal than zero and less or equal than the maximum",
+			minReplicas, maxReplicas,
+		)
+	}
+	return updateMachinePool(ctx, client, clusterID, poolID, options,
+		func(builder *v1.MachinePoolBuilder) {
+			builder.Autoscaling(
+				v1.NewMachinePoolAutoscaling().
+					MinReplicas(minReplicas).
+					MaxReplicas(maxReplicas),
+			)
+		},
+		func(builder *v1.NodePoolBuilder) {
+			builder.Autoscaling(
+				v1.NewNodePoolAutoscaling().
+					MinReplica(minReplicas).
+					MaxReplica(maxReplicas),
+			)
+		},
+	)
+}
+
+// DisableMachinePoolAutoscaling disables autoscaling for the given pool, setting the given fixed
+// number of replicas. See ScaleMachinePool for details about the types of pools.
+func DisableMachinePoolAutoscaling(ctx context.Context, client *v1.Client, clusterID,
+	poolID string, replicas int, options *MachinePoolScalingOptions) error {
+	return ScaleMachinePool(ctx, client, clusterID, poolID, replicas, options)
+}
+
+// updateMachinePool checks the type of the cluster and then updates the machine pool or the node
+// pool using the corresponding function to populate the patch.
+func updateMachinePool(ctx context.Context, client *v1.Client, clusterID, poolID string,
+	options *MachinePoolScalingOptions, machinePool func(*v1.MachinePoolBuilder),
+	nodePool func(*v1.NodePoolBuilder)) error {
+	if clusterID == "" {
+		return errors.New("cluster identifier is mandatory")
+	}
+	if poolID == "" {
+		return errors.New("pool identifier is mandatory")
+	}
+	if options == nil {
+		options = &MachinePoolScalingOptions{}
+	}
+	resource := client.Clusters().Cluster(clusterID)
+
+	// Check the type of the cluster:
+	response, err := resource.Get().SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf("can't get cluster '%s': %w", clusterID, err)
+	}
+	hosted := response.Body().Hypershift().Enabled()
+
+	// Update the pool:
+	if hosted {
+		builder := v1.NewNodePool()
+		nodePool(builder)
+		if options.MaxSurge != "" || options.MaxUnavailable != "" {
+			upgrade := v1.NewNodePoolManagementUpgrade()
+			if options.MaxSurge != "" {
+				upgrade.MaxSurge(options.MaxSurge)
+			}
+			if options.MaxUnavailable != "" {
+				upgrade.MaxUnavailable(options.MaxUnavailable)
+			}
+			builder.ManagementUpgrade(upgrade)
+		}
+		var patch *v1.NodePool
+		patch, err = builder.Build()
+		if err != nil {
+			return err
+		}
+		_, err = resource.NodePools().NodePool(poolID).Update().Body(patch).SendContext(ctx)
+		if err != nil {
+			return fmt.Errorf(
+				"can't update node pool '%s' of cluster '%s': %w",
+				poolID, clusterID, err,
+			)
+		}
+		return nil
+	}
+	if options.MaxSurge != "" || options.MaxUnavailable != "" {
+		return fmt.Errorf(
+			"cluster '%s' doesn't use a hosted control plane, and surge settings are only "+
+				"supported for node pools of hosted control plane clusters",
+			clusterID,
+		)
+	}
+	builder := v1.NewMachinePool()
+	machinePool(builder)
+	patch, err := builder.Build()
+	if err != nil {
+		return err
+	}
+	_, err = resource.MachinePools().MachinePool(poolID).Update().Body(patch).SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"can't update machine pool '%s' of cluster '%s': %w",
+			poolID, clusterID, err,
+		)
+	}
+	return nil
+}
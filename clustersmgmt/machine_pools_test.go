@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that scale machine pools and node pools.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Machine pool scaling", func() {
+	const clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+
+	const classicCluster = `{
+		"kind": "Cluster",
+		"id": "123"
+	}`
+
+	const hostedCluster = `{
+		"kind": "Cluster",
+		"id": "123",
+		"hypershift": {
+			"enabled": true
+		}
+	}`
+
+	It("Scales the machine pool of a classic cluster", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, clusterPath),
+				RespondWithJSON(http.StatusOK, classicCluster),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, clusterPath+"/machine_pools/workers"),
+				ghttp.VerifyJSON(`{
+					"kind": "MachinePool",
+					"replicas": 5
+				}`),
+				RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "id": "workers"}`),
+			),
+		)
+		err := ScaleMachinePool(ctx, client, "123", "workers", 5, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Scales the node pool of a hosted cluster with surge settings", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, hostedCluster),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, clusterPath+"/node_pools/workers"),
+				ghttp.VerifyJSON(`{
+					"kind": "NodePool",
+					"replicas": 5,
+					"management_upgrade": {
+						"kind": "NodePoolManagementUpgrade",
+						"max_surge": "2",
+						"max_unavailable": "0"
+					}
+				}`),
+				RespondWithJSON(http.StatusOK, `{"kind": "NodePool", "id": "workers"}`),
+			),
+		)
+		err := ScaleMachinePool(ctx, client, "123", "workers", 5,
+			&MachinePoolScalingOptions{
+				MaxSurge:       "2",
+				MaxUnavailable: "0",
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Enables autoscaling for a classic machine pool", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, classicCluster),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, clusterPath+"/machine_pools/workers"),
+				ghttp.VerifyJSON(`{
+					"kind": "MachinePool",
+					"autoscaling": {
+						"kind": "MachinePoolAutoscaling",
+						"min_replicas": 2,
+						"max_replicas": 6
+					}
+				}`),
+				RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "id": "workers"}`),
+			),
+		)
+		err := EnableMachinePoolAutoscaling(ctx, client, "123", "workers", 2, 6, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Enables autoscaling for a hosted node pool", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, hostedCluster),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, clusterPath+"/node_pools/workers"),
+				ghttp.VerifyJSON(`{
+					"kind": "NodePool",
+					"autoscaling": {
+						"kind": "NodePoolAutoscaling",
+						"min_replica": 2,
+						"max_replica": 6
+					}
+				}`),
+				RespondWithJSON(http.StatusOK, `{"kind": "NodePool", "id": "workers"}`),
+			),
+		)
+		err := EnableMachinePoolAutoscaling(ctx, client, "123", "workers", 2, 6, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Rejects surge settings for classic clusters", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, classicCluster),
+		)
+		err := ScaleMachinePool(ctx, client, "123", "workers", 5,
+			&MachinePoolScalingOptions{
+				MaxSurge: "1",
+			},
+		)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("surge settings are only supported"))
+	})
+
+	It("Rejects an invalid autoscaling range", func() {
+		err := EnableMachinePoolAutoscaling(ctx, client, "123", "workers", 6, 2, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,228 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers to find the machine types that are available in a region.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// machineTypesPageSize is the number of machine types requested in each page.
+const machineTypesPageSize = 100
+
+// MachineTypeCriteria contains the conditions that the machine types returned by the
+// FindMachineTypes function should satisfy. Conditions with zero values are ignored.
+type MachineTypeCriteria struct {
+	// MinCPU and MaxCPU are the minimum and maximum number of virtual CPUs.
+	MinCPU int
+	MaxCPU int
+
+	// MinMemory and MaxMemory are the minimum and maximum amount of memory, in bytes.
+	MinMemory int64
+	MaxMemory int64
+
+	// Categories are the accepted categories, for example `general_purpose` or
+	// `memory_optimized`.
+	Categories []v1.MachineTypeCategory
+
+	// Sizes are the accepted sizes.
+	Sizes []v1.MachineTypeSize
+
+	// Architecture is the processor architecture, for example `amd64` or `arm64`.
+	Architecture v1.ProcessorType
+
+	// CCS indicates if the machine types will be used for a cluster that uses the customer
+	// cloud subscription. When false, machine types and regions that are only available with
+	// the customer cloud subscription are excluded.
+	CCS bool
+
+	// CloudProviderData contains the credentials of the customer cloud account. When set, the
+	// machine types are obtained from the cloud provider, so that only the ones that are
+	// actually offered in the region are returned. Otherwise the machine types are obtained
+	// from the list of machine types supported for the cloud provider. Only the `aws` and `gcp`
+	// cloud providers support this. The region is set automatically.
+	CloudProviderData *v1.CloudProviderData
+}
+
+// FindMachineTypes returns the machine types of the given cloud provider that are available in the
+// given region and satisfy the given criteria. The client is used to check the region and to list
+// or inquire the machine types, and the criteria can be nil to return all the machine types
+// available in the region. It returns an error if the region doesn't exist, is disabled, or is only
+// available with the customer cloud subscription and that isn't requested in the criteria.
+func FindMachineTypes(ctx context.Context, client *v1.Client, cloud, region string,
+	criteria *MachineTypeCriteria) (result []*v1.MachineType, err error) {
+	if criteria == nil {
+		criteria = &MachineTypeCriteria{}
+	}
+
+	// Check that the region is available:
+	regionResponse, err := client.CloudProviders().CloudProvider(cloud).Regions().Region(region).
+		Get().
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't get region '%s' of cloud provider '%s': %w", region, cloud, err)
+		return
+	}
+	regionObject := regionResponse.Body()
+	if !regionObject.Enabled() {
+		err = fmt.Errorf("region '%s' of cloud provider '%s' isn't enabled", region, cloud)
+		return
+	}
+	if regionObject.CCSOnly() && !criteria.CCS {
+		err = fmt.Errorf(
+			"region '%s' of cloud provider '%s' is only available for clusters that use "+
+				"the customer cloud subscription",
+			region, cloud,
+		)
+		return
+	}
+
+	// Get the candidates:
+	var candidates []*v1.MachineType
+	if criteria.CloudProviderData != nil {
+		candidates, err = inquireMachineTypes(ctx, client, cloud, region, criteria.CloudProviderData)
+	} else {
+		candidates, err = listMachineTypes(ctx, client, cloud)
+	}
+	if err != nil {
+		return
+	}
+
+	// Select the candidates that satisfy the criteria:
+	for _, candidate := range candidates {
+		if criteria.match(candidate) {
+			result = append(result, candidate)
+		}
+	}
+	return
+}
+
+// listMachineTypes returns all the machine types supported for the given cloud provider.
+func listMachineTypes(ctx context.Context, client *v1.Client,
+	cloud string) (result []*v1.MachineType, err error) {
+	request := client.MachineTypes().List().
+		Search(fmt.Sprintf("cloud_provider.id = '%s'", strings.ReplaceAll(cloud, "'", "''"))).
+		Size(machineTypesPageSize)
+	for page := 1; ; page++ {
+		var response *v1.MachineTypesListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list machine types of cloud provider '%s': %w", cloud, err)
+			return
+		}
+		items := response.Items().Slice()
+		result = append(result, items...)
+		if len(items) == 0 || len(result) >= response.Total() {
+			return
+		}
+	}
+}
+
+// inquireMachineTypes returns the machine types that the cloud provider offers in the given region,
+// using the given customer cloud account.
+func inquireMachineTypes(ctx context.Context, client *v1.Client, cloud, region string,
+	data *v1.CloudProviderData) (result []*v1.MachineType, err error) {
+	data, err = v1.NewCloudProviderData().
+		Copy(data).
+		Region(v1.NewCloudRegion().ID(region)).
+		Build()
+	if err != nil {
+		return
+	}
+	for page := 1; ; page++ {
+		var items *v1.MachineTypeList
+		var total int
+		switch cloud {
+		case "aws":
+			var response *v1.AWSRegionMachineTypesInquirySearchResponse
+			response, err = client.AWSInquiries().MachineTypes().Search().
+				Body(data).
+				Page(page).
+				Size(machineTypesPageSize).
+				SendContext(ctx)
+			if err == nil {
+				items, total = response.Items(), response.Total()
+			}
+		case "gcp":
+			var response *v1.GCPRegionMachineTypesInquirySearchResponse
+			response, err = client.GCPInquiries().MachineTypes().Search().
+				Body(data).
+				Page(page).
+				Size(machineTypesPageSize).
+				SendContext(ctx)
+			if err == nil {
+				items, total = response.Items(), response.Total()
+			}
+		default:
+			err = fmt.Errorf(
+				"cloud provider '%s' doesn't support finding machine types using the "+
+					"customer cloud account, supported cloud providers are 'aws' and "+
+					"'gcp'",
+				cloud,
+			)
+			return
+		}
+		if err != nil {
+			err = fmt.Errorf(
+				"can't find machine types of region '%s' of cloud provider '%s': %w",
+				region, cloud, err,
+			)
+			return
+		}
+		result = append(result, items.Slice()...)
+		if items.Len() == 0 || len(result) >= total {
+			return
+		}
+	}
+}
+
+// match checks if the given machine type satisfies the criteria.
+func (c *MachineTypeCriteria) match(machineType *v1.MachineType) bool {
+	if machineType.CCSOnly() && !c.CCS {
+		return false
+	}
+	cpu := machineType.CPU().Value()
+	if c.MinCPU > 0 && cpu < float64(c.MinCPU) {
+		return false
+	}
+	if c.MaxCPU > 0 && cpu > float64(c.MaxCPU) {
+		return false
+	}
+	memory := machineType.Memory().Value()
+	if c.MinMemory > 0 && memory < float64(c.MinMemory) {
+		return false
+	}
+	if c.MaxMemory > 0 && memory > float64(c.MaxMemory) {
+		return false
+	}
+	if len(c.Categories) > 0 && !slices.Contains(c.Categories, machineType.Category()) {
+		return false
+	}
+	if len(c.Sizes) > 0 && !slices.Contains(c.Sizes, machineType.Size()) {
+		return false
+	}
+	if c.Architecture != "" && machineType.Architecture() != c.Architecture {
+		return false
+	}
+	return true
+}
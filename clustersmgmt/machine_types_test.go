@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that find machine types.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Find machine types", func() {
+	const machineTypes = `{
+		"kind": "MachineTypeList",
+		"page": 1,
+		"size": 3,
+		"total": 3,
+		"items": [
+			{
+				"kind": "MachineType",
+				"id": "m5.xlarge",
+				"category": "general_purpose",
+				"cpu": {"value": 4, "unit": "vCPU"},
+				"memory": {"value": 17179869184, "unit": "B"}
+			},
+			{
+				"kind": "MachineType",
+				"id": "r5.xlarge",
+				"category": "memory_optimized",
+				"cpu": {"value": 4, "unit": "vCPU"},
+				"memory": {"value": 34359738368, "unit": "B"}
+			},
+			{
+				"kind": "MachineType",
+				"id": "m5.8xlarge",
+				"category": "general_purpose",
+				"ccs_only": true,
+				"cpu": {"value": 32, "unit": "vCPU"},
+				"memory": {"value": 137438953472, "unit": "B"}
+			}
+		]
+	}`
+
+	It("Filters the machine types of the cloud provider", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/cloud_providers/aws/regions/us-east-1",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "CloudRegion",
+					"id": "us-east-1",
+					"enabled": true
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/machine_types",
+					"page=1&search=cloud_provider.id+%3D+%27aws%27&size=100",
+				),
+				RespondWithJSON(http.StatusOK, machineTypes),
+			),
+		)
+		result, err := FindMachineTypes(ctx, client, "aws", "us-east-1",
+			&MachineTypeCriteria{
+				MinCPU:     4,
+				MinMemory:  16 << 30,
+				Categories: []cmv1.MachineTypeCategory{cmv1.MachineTypeCategoryGeneralPurpose},
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(1))
+		Expect(result[0].ID()).To(Equal("m5.xlarge"))
+	})
+
+	It("Includes machine types only available with the customer cloud subscription", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "CloudRegion",
+				"id": "us-east-1",
+				"enabled": true
+			}`),
+			RespondWithJSON(http.StatusOK, machineTypes),
+		)
+		result, err := FindMachineTypes(ctx, client, "aws", "us-east-1",
+			&MachineTypeCriteria{
+				MinCPU: 16,
+				CCS:    true,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(1))
+		Expect(result[0].ID()).To(Equal("m5.8xlarge"))
+	})
+
+	It("Fails if the region is only available with the customer cloud subscription", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "CloudRegion",
+				"id": "ap-east-1",
+				"enabled": true,
+				"ccs_only": true
+			}`),
+		)
+		_, err := FindMachineTypes(ctx, client, "aws", "ap-east-1", nil)
+		Expect(err).To(MatchError(
+			"region 'ap-east-1' of cloud provider 'aws' is only available for clusters " +
+				"that use the customer cloud subscription",
+		))
+	})
+
+	It("Asks the cloud provider when the account is given", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "CloudRegion",
+				"id": "us-east-1",
+				"enabled": true
+			}`),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/clusters_mgmt/v1/aws_inquiries/machine_types",
+					"page=1&size=100",
+				),
+				ghttp.VerifyJSON(`{
+					"aws": {
+						"access_key_id": "my-key",
+						"secret_access_key": "my-secret"
+					},
+					"region": {
+						"kind": "CloudRegion",
+						"id": "us-east-1"
+					}
+				}`),
+				RespondWithJSON(http.StatusOK, machineTypes),
+			),
+		)
+		data, err := cmv1.NewCloudProviderData().
+			AWS(cmv1.NewAWS().AccessKeyID("my-key").SecretAccessKey("my-secret")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := FindMachineTypes(ctx, client, "aws", "us-east-1",
+			&MachineTypeCriteria{
+				Categories:        []cmv1.MachineTypeCategory{cmv1.MachineTypeCategoryMemoryOptimized},
+				CloudProviderData: data,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(1))
+		Expect(result[0].ID()).To(Equal("r5.xlarge"))
+	})
+})
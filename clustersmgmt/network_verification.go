@@ -0,0 +1,217 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains a helper that runs network verifications and waits for their results.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// DefaultNetworkVerificationInterval is the default time between checks of the state of the
+// network verifications.
+const DefaultNetworkVerificationInterval = 10 * time.Second
+
+// States of the verification of a subnet:
+const (
+	SubnetVerificationPending = "pending"
+	SubnetVerificationRunning = "running"
+	SubnetVerificationPassed  = "passed"
+	SubnetVerificationFailed  = "failed"
+)
+
+// NetworkVerificationOptions contains the options that control how the VerifyNetwork function
+// waits for the verifications to complete.
+type NetworkVerificationOptions struct {
+	// Interval is the time between checks of the state of the verifications. The default is
+	// ten seconds.
+	Interval time.Duration
+
+	// Progress is called after each check with the current results.
+	Progress func(result *NetworkVerificationResult)
+}
+
+// NetworkVerificationResult contains the results of the verification of a set of subnets.
+type NetworkVerificationResult struct {
+	// Subnets contains the result for each subnet, in the order returned by the server.
+	Subnets []*SubnetVerificationResult
+}
+
+// SubnetVerificationResult contains the result of the verification of one subnet.
+type SubnetVerificationResult struct {
+	// SubnetID is the identifier of the subnet.
+	SubnetID string
+
+	// State is the state of the verification: `pending`, `running`, `passed` or `failed`.
+	State string
+
+	// Details contains the reasons of the failure, when the verification failed.
+	Details []string
+
+	// Tags contains the tags that were applied to the cloud resources created for the
+	// verification.
+	Tags map[string]string
+}
+
+// Done returns true if the verification of the subnet has finished, either passing or failing.
+func (r *SubnetVerificationResult) Done() bool {
+	return r.State == SubnetVerificationPassed || r.State == SubnetVerificationFailed
+}
+
+// Passed returns true if the verification of the subnet passed.
+func (r *SubnetVerificationResult) Passed() bool {
+	return r.State == SubnetVerificationPassed
+}
+
+// Done returns true if the verifications of all the subnets have finished.
+func (r *NetworkVerificationResult) Done() bool {
+	for _, subnet := range r.Subnets {
+		if !subnet.Done() {
+			return false
+		}
+	}
+	return true
+}
+
+// Passed returns true if the verifications of all the subnets passed.
+func (r *NetworkVerificationResult) Passed() bool {
+	for _, subnet := range r.Subnets {
+		if !subnet.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Failed returns the results of the subnets whose verification failed.
+func (r *NetworkVerificationResult) Failed() []*SubnetVerificationResult {
+	var result []*SubnetVerificationResult
+	for _, subnet := range r.Subnets {
+		if subnet.State == SubnetVerificationFailed {
+			result = append(result, subnet)
+		}
+	}
+	return result
+}
+
+// VerifyNetwork starts the verification of the subnets described by the given object and waits till
+// all of them finish. The verification is created and then polled with the given client, and the
+// options can be nil to use the defaults. The object should contain either the identifier of an
+// existing cluster, or the cloud provider data with the credentials and the subnets. Note that a
+// verification that fails isn't an error; check the Passed method of the result. If waiting fails,
+// for example because the context is cancelled, the results obtained so far are returned together
+// with the error.
+func VerifyNetwork(ctx context.Context, client *v1.Client, verification *v1.NetworkVerification,
+	options *NetworkVerificationOptions) (result *NetworkVerificationResult, err error) {
+	if verification == nil {
+		err = errors.New("network verification is mandatory")
+		return
+	}
+	interval := DefaultNetworkVerificationInterval
+	var progress func(*NetworkVerificationResult)
+	if options != nil {
+		if options.Interval < 0 {
+			err = fmt.Errorf(
+				"interval %s isn't valid, it should be greater or equal than zero",
+				options.Interval,
+			)
+			return
+		}
+		if options.Interval > 0 {
+			interval = options.Interval
+		}
+		progress = options.Progress
+	}
+	resource := client.NetworkVerifications()
+
+	// Start the verifications:
+	addResponse, err := resource.Add().Body(verification).SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't start network verification: %w", err)
+		return
+	}
+	result = &NetworkVerificationResult{}
+	for _, item := range addResponse.Body().Items() {
+		result.Subnets = append(result.Subnets, makeSubnetVerificationResult(item))
+	}
+	if len(result.Subnets) == 0 {
+		for _, subnet := range verification.CloudProviderData().Subnets() {
+			result.Subnets = append(result.Subnets, &SubnetVerificationResult{
+				SubnetID: subnet,
+				State:    SubnetVerificationPending,
+			})
+		}
+	}
+	if len(result.Subnets) == 0 {
+		err = errors.New("network verification didn't return any subnet")
+		result = nil
+		return
+	}
+
+	// Wait till all the verifications finish:
+	for !result.Done() {
+		err = sleep(ctx, interval)
+		if err != nil {
+			return
+		}
+		for i, subnet := range result.Subnets {
+			if subnet.Done() {
+				continue
+			}
+			var getResponse *v1.NetworkVerificationGetResponse
+			getResponse, err = resource.NetworkVerification(subnet.SubnetID).Get().SendContext(ctx)
+			if err != nil {
+				err = fmt.Errorf(
+					"can't get state of network verification for subnet '%s': %w",
+					subnet.SubnetID, err,
+				)
+				return
+			}
+			result.Subnets[i] = makeSubnetVerificationResult(getResponse.Body())
+		}
+		if progress != nil {
+			progress(result)
+		}
+	}
+	return
+}
+
+func makeSubnetVerificationResult(item *v1.SubnetNetworkVerification) *SubnetVerificationResult {
+	return &SubnetVerificationResult{
+		SubnetID: item.ID(),
+		State:    item.State(),
+		Details:  item.Details(),
+		Tags:     item.Tags(),
+	}
+}
+
+// sleep waits for the given duration, or till the context is cancelled.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
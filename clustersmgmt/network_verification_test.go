@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helper that runs network verifications.
+
+package clustersmgmt
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Verify network", func() {
+	var options *NetworkVerificationOptions
+
+	BeforeEach(func() {
+		options = &NetworkVerificationOptions{
+			Interval: time.Millisecond,
+		}
+	})
+
+	It("Waits for the verification of all the subnets", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/network_verifications"),
+				ghttp.VerifyJSON(`{
+					"cluster_id": "123"
+				}`),
+				RespondWithJSON(http.StatusCreated, `{
+					"items": [
+						{"id": "subnet-1", "state": "pending"},
+						{"id": "subnet-2", "state": "pending"}
+					],
+					"total": 2
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/network_verifications/subnet-1"),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-1", "state": "passed"}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/network_verifications/subnet-2"),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-2", "state": "running"}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/network_verifications/subnet-2"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "subnet-2",
+					"state": "failed",
+					"details": ["egress to quay.io blocked"]
+				}`),
+			),
+		)
+		verification, err := cmv1.NewNetworkVerification().
+			ClusterId("123").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		checks := 0
+		options.Progress = func(result *NetworkVerificationResult) {
+			checks++
+		}
+		result, err := VerifyNetwork(ctx, client, verification, options)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(checks).To(Equal(2))
+		Expect(result.Done()).To(BeTrue())
+		Expect(result.Passed()).To(BeFalse())
+		Expect(result.Subnets).To(HaveLen(2))
+		Expect(result.Subnets[0].Passed()).To(BeTrue())
+		failed := result.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(failed[0].SubnetID).To(Equal("subnet-2"))
+		Expect(failed[0].Details).To(ConsistOf("egress to quay.io blocked"))
+	})
+
+	It("Returns immediately if the verifications already finished", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusCreated, `{
+				"items": [
+					{"id": "subnet-1", "state": "passed"}
+				]
+			}`),
+		)
+		verification, err := cmv1.NewNetworkVerification().
+			CloudProviderData(cmv1.NewCloudProviderData().Subnets("subnet-1")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := VerifyNetwork(ctx, client, verification, options)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Passed()).To(BeTrue())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Stops waiting when the context is cancelled", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusCreated, `{
+				"items": [
+					{"id": "subnet-1", "state": "pending"}
+				]
+			}`),
+		)
+		verification, err := cmv1.NewNetworkVerification().
+			ClusterId("123").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		options.Interval = time.Minute
+		cancelCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		result, err := VerifyNetwork(cancelCtx, client, verification, options)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(result.Subnets).To(HaveLen(1))
+		Expect(result.Subnets[0].State).To(Equal(SubnetVerificationPending))
+	})
+})
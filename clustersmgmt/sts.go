@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers for the OIDC configurations and operator roles used by clusters that
+// use AWS STS.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// DefaultOidcConfigInterval is the default time between checks of the readiness of OIDC
+// configurations.
+const DefaultOidcConfigInterval = 10 * time.Second
+
+// OidcConfigOptions contains the options that control how the helpers wait for OIDC configurations
+// to be ready.
+type OidcConfigOptions struct {
+	// Interval is the time between checks of the readiness of the configuration. The default is
+	// ten seconds.
+	Interval time.Duration
+
+	// Client is the HTTP client used to download the discovery document from the issuer. The
+	// default is to use the default HTTP client of the Go library.
+	Client *http.Client
+}
+
+// CreateManagedOidcConfig creates an OIDC configuration managed by Red Hat and waits till it is
+// ready, polling it with the given client. The options can be nil to use the defaults.
+func CreateManagedOidcConfig(ctx context.Context, client *v1.Client,
+	options *OidcConfigOptions) (result *v1.OidcConfig, err error) {
+	config, err := v1.NewOidcConfig().Managed(true).Build()
+	if err != nil {
+		return
+	}
+	response, err := client.
+		OidcConfigs().
+		Add().
+		Body(config).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't create managed OIDC configuration: %w", err)
+		return
+	}
+	id := response.Body().ID()
+	if id == "" {
+		err = errors.New("created OIDC configuration doesn't have an identifier")
+		return
+	}
+	result, err = WaitForOidcConfig(ctx, client, id, options)
+	return
+}
+
+// WaitForOidcConfig waits till the given OIDC configuration is ready. A configuration is considered
+// ready when it has an issuer URL and the issuer serves the OpenID discovery document. The options
+// can be nil to use the defaults.
+func WaitForOidcConfig(ctx context.Context, client *v1.Client, id string,
+	options *OidcConfigOptions) (result *v1.OidcConfig, err error) {
+	if id == "" {
+		err = errors.New("OIDC configuration identifier is mandatory")
+		return
+	}
+	interval := DefaultOidcConfigInterval
+	httpClient := http.DefaultClient
+	if options != nil {
+		if options.Interval < 0 {
+			err = fmt.Errorf(
+				"interval %s isn't valid, it should be greater or equal than zero",
+				options.Interval,
+			)
+			return
+		}
+		if options.Interval > 0 {
+			interval = options.Interval
+		}
+		if options.Client != nil {
+			httpClient = options.Client
+		}
+	}
+	resource := client.OidcConfigs().OidcConfig(id)
+	for {
+		var response *v1.OidcConfigGetResponse
+		response, err = resource.Get().SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't get OIDC configuration '%s': %w", id, err)
+			return
+		}
+		config := response.Body()
+		if config.IssuerUrl() != "" && discoveryAvailable(ctx, httpClient, config.IssuerUrl()) {
+			result = config
+			return
+		}
+		err = sleep(ctx, interval)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// discoveryAvailable checks if the given issuer serves the OpenID discovery document.
+func discoveryAvailable(ctx context.Context, client *http.Client, issuer string) bool {
+	address := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return false
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return false
+	}
+	defer response.Body.Close()
+	return response.StatusCode == http.StatusOK
+}
+
+// OperatorRoleRequirements returns the credential requests of the operators that need an IAM role in
+// clusters with the given OpenShift version, for example `4.14.3` or `openshift-v4.14.3`. If the
+// version is empty the requirements of all the versions are returned.
+func OperatorRoleRequirements(ctx context.Context, client *v1.Client,
+	version string) (result []*v1.STSCredentialRequest, err error) {
+	request := client.
+		AWSInquiries().
+		STSCredentialRequests().
+		List().
+		Size(100)
+	seen := 0
+	for page := 1; ; page++ {
+		var response *v1.STSCredentialRequestsInquiryListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list STS credential requests: %w", err)
+			result = nil
+			return
+		}
+		response.Items().Each(func(item *v1.STSCredentialRequest) bool {
+			operator := item.Operator()
+			if version != "" && operator != nil {
+				if operator.MinVersion() != "" &&
+					compareVersions(version, operator.MinVersion()) < 0 {
+					return true
+				}
+				if operator.MaxVersion() != "" &&
+					compareVersions(version, operator.MaxVersion()) > 0 {
+					return true
+				}
+			}
+			result = append(result, item)
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			return
+		}
+	}
+}
+
+// compareVersions compares the given version with the given bound, using only as many components
+// as the bound has. For example `4.14.3` is considered equal to `4.14`. It returns a negative
+// number if the version is smaller than the bound, zero if it is equal and a positive number if
+// it is greater. Components that aren't numbers are considered zero.
+func compareVersions(version, bound string) int {
+	versionParts := versionComponents(version)
+	boundParts := versionComponents(bound)
+	for i, boundPart := range boundParts {
+		versionPart := 0
+		if i < len(versionParts) {
+			versionPart = versionParts[i]
+		}
+		if versionPart != boundPart {
+			return versionPart - boundPart
+		}
+	}
+	return 0
+}
+
+func versionComponents(version string) []int {
+	version = strings.TrimPrefix(version, "openshift-")
+	version = strings.TrimPrefix(version, "v")
+	if index := strings.IndexAny(version, "-+"); index != -1 {
+		version = version[:index]
+	}
+	texts := strings.Split(version, ".")
+	result := make([]int, len(texts))
+	for i, text := range texts {
+		result[i], _ = strconv.Atoi(text)
+	}
+	return result
+}
+
+// roleARNRE is the regular expression used to check the format of the ARNs of IAM roles. It accepts
+// the partitions of the commercial, GovCloud and China regions, and optional paths.
+var roleARNRE = regexp.MustCompile(
+	`^arn:aws(-us-gov|-cn)?:iam::\d{12}:role/([\w+=,.@-]+/)*[\w+=,.@-]{1,64}$`,
+)
+
+// ValidateRoleARN checks that the given text has the format of the ARN of an IAM role, for example
+// `arn:aws:iam::123456789012:role/my-role`. It doesn't check that the role exists.
+func ValidateRoleARN(arn string) error {
+	if arn == "" {
+		return errors.New("role ARN is mandatory")
+	}
+	if !roleARNRE.MatchString(arn) {
+		return fmt.Errorf(
+			"role ARN '%s' isn't valid, it should have the format "+
+				"'arn:aws:iam::<account>:role/<name>'",
+			arn,
+		)
+	}
+	return nil
+}
+
+// ValidateOperatorRoles checks that all the given operator roles have a name, a namespace and a
+// valid role ARN. It is intended to detect mistakes before sending the roles to the server.
+func ValidateOperatorRoles(roles []*v1.OperatorIAMRole) error {
+	for i, role := range roles {
+		if role.Name() == "" {
+			return fmt.Errorf("name of operator role %d is mandatory", i)
+		}
+		if role.Namespace() == "" {
+			return fmt.Errorf("namespace of operator role '%s' is mandatory", role.Name())
+		}
+		err := ValidateRoleARN(role.RoleARN())
+		if err != nil {
+			return fmt.Errorf("operator role '%s' isn't valid: %w", role.Name(), err)
+		}
+	}
+	return nil
+}
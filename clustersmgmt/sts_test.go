@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that manage OIDC configurations and operator roles.
+
+package clustersmgmt
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("STS helpers", func() {
+	const configsPath = "/api/clusters_mgmt/v1/oidc_configs"
+	const requestsPath = "/api/clusters_mgmt/v1/aws_inquiries/sts_credential_requests"
+
+	Describe("OIDC configurations", func() {
+		var issuer *ghttp.Server
+
+		BeforeEach(func() {
+			issuer = MakeTCPServer()
+		})
+
+		AfterEach(func() {
+			issuer.Close()
+		})
+
+		It("Creates a managed configuration and waits till it is ready", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, configsPath),
+					ghttp.VerifyJSON(`{
+						"managed": true
+					}`),
+					RespondWithJSON(http.StatusCreated, `{
+						"kind": "OidcConfig",
+						"id": "123",
+						"managed": true
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, configsPath+"/123"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "OidcConfig",
+						"id": "123",
+						"managed": true
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, configsPath+"/123"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "OidcConfig",
+						"id": "123",
+						"managed": true,
+						"issuer_url": "`+issuer.URL()+`/123"
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, configsPath+"/123"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "OidcConfig",
+						"id": "123",
+						"managed": true,
+						"issuer_url": "`+issuer.URL()+`/123"
+					}`),
+				),
+			)
+			issuer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/123/.well-known/openid-configuration"),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/123/.well-known/openid-configuration"),
+					RespondWithJSON(http.StatusOK, `{
+						"issuer": "`+issuer.URL()+`/123"
+					}`),
+				),
+			)
+			config, err := CreateManagedOidcConfig(ctx, client,
+				&OidcConfigOptions{
+					Interval: time.Millisecond,
+				})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ID()).To(Equal("123"))
+			Expect(config.IssuerUrl()).To(Equal(issuer.URL() + "/123"))
+			Expect(server.ReceivedRequests()).To(HaveLen(4))
+			Expect(issuer.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("Stops waiting when the context is cancelled", func() {
+			server.RouteToHandler(
+				http.MethodGet,
+				configsPath+"/123",
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "OidcConfig",
+					"id": "123"
+				}`),
+			)
+			ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			config, err := WaitForOidcConfig(ctx, client, "123",
+				&OidcConfigOptions{
+					Interval: 10 * time.Millisecond,
+				})
+			Expect(err).To(HaveOccurred())
+			Expect(config).To(BeNil())
+		})
+	})
+
+	It("Returns the operator role requirements for a version", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, requestsPath),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 3,
+					"total": 3,
+					"items": [
+						{
+							"name": "ingress",
+							"operator": {
+								"name": "cloud-credentials",
+								"namespace": "openshift-ingress-operator"
+							}
+						},
+						{
+							"name": "ebs",
+							"operator": {
+								"name": "ebs-cloud-credentials",
+								"namespace": "openshift-cluster-csi-drivers",
+								"min_version": "4.15"
+							}
+						},
+						{
+							"name": "old",
+							"operator": {
+								"name": "old-credentials",
+								"namespace": "openshift-old",
+								"max_version": "4.13"
+							}
+						}
+					]
+				}`),
+			),
+		)
+		requests, err := OperatorRoleRequirements(ctx, client, "openshift-v4.14.3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Name()).To(Equal("ingress"))
+	})
+
+	DescribeTable(
+		"Role ARN validation",
+		func(arn string, valid bool) {
+			err := ValidateRoleARN(arn)
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("Simple", "arn:aws:iam::123456789012:role/my-role", true),
+		Entry("With path", "arn:aws:iam::123456789012:role/my/path/my-role", true),
+		Entry("GovCloud", "arn:aws-us-gov:iam::123456789012:role/my-role", true),
+		Entry("Empty", "", false),
+		Entry("Short account", "arn:aws:iam::12345:role/my-role", false),
+		Entry("User instead of role", "arn:aws:iam::123456789012:user/my-user", false),
+		Entry("Missing name", "arn:aws:iam::123456789012:role/", false),
+		Entry("Wrong partition", "arn:azure:iam::123456789012:role/my-role", false),
+	)
+
+	It("Rejects operator roles with invalid ARNs", func() {
+		role, err := cmv1.NewOperatorIAMRole().
+			Name("cloud-credentials").
+			Namespace("openshift-ingress-operator").
+			RoleARN("my-role").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = ValidateOperatorRoles([]*cmv1.OperatorIAMRole{role})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("cloud-credentials"))
+	})
+})
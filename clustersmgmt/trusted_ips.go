@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that check addresses against the trusted IP addresses of the clusters
+// management service.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// ListTrustedIPs returns the address ranges of the enabled trusted IP addresses. Entries that are
+// disabled or that aren't valid addresses or CIDR blocks are ignored.
+func ListTrustedIPs(ctx context.Context, client *v1.Client) (result []netip.Prefix,
+	err error) {
+	request := client.TrustedIPAddresses().List().
+		Size(100)
+	seen := 0
+	for page := 1; ; page++ {
+		var response *v1.TrustedIpsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list trusted IP addresses: %w", err)
+			result = nil
+			return
+		}
+		response.Items().Each(func(item *v1.TrustedIp) bool {
+			if !item.Enabled() {
+				return true
+			}
+			prefix, err := parseAddressOrCIDR(item.ID())
+			if err == nil {
+				result = append(result, prefix)
+			}
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			break
+		}
+	}
+	return
+}
+
+// IsTrustedCIDR checks if the given CIDR block is completely contained in one of the enabled
+// trusted IP address ranges. The value can also be a single address. This is intended to be used
+// before creating a cluster, so that ranges that aren't allowed are detected before provisioning
+// starts instead of making it fail later.
+func IsTrustedCIDR(ctx context.Context, client *v1.Client, cidr string) (result bool,
+	err error) {
+	prefix, err := parseAddressOrCIDR(cidr)
+	if err != nil {
+		return
+	}
+	trusted, err := ListTrustedIPs(ctx, client)
+	if err != nil {
+		return
+	}
+	result = prefixContained(prefix, trusted)
+	return
+}
+
+// parseAddressOrCIDR parses a CIDR block or a single address, which is converted into a block
+// containing only that address.
+func parseAddressOrCIDR(text string) (result netip.Prefix, err error) {
+	prefix, err := netip.ParsePrefix(text)
+	if err == nil {
+		result = prefix.Masked()
+		return
+	}
+	addr, err := netip.ParseAddr(text)
+	if err != nil {
+		err = fmt.Errorf("value '%s' isn't a valid address or CIDR block", text)
+		return
+	}
+	result = netip.PrefixFrom(addr, addr.BitLen())
+	return
+}
+
+// prefixContained checks if the given prefix is completely contained in one of the candidates.
+func prefixContained(prefix netip.Prefix, candidates []netip.Prefix) bool {
+	for _, candidate := range candidates {
+		if candidate.Bits() <= prefix.Bits() && candidate.Contains(prefix.Addr()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that check trusted IP addresses.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Trusted IP addresses", func() {
+	const trustedIPsPath = "/api/clusters_mgmt/v1/trusted_ip_addresses"
+
+	const trustedIPs = `{
+		"kind": "TrustedIpList",
+		"page": 1,
+		"size": 4,
+		"total": 4,
+		"items": [
+			{
+				"kind": "TrustedIp",
+				"id": "10.0.0.0/16",
+				"enabled": true
+			},
+			{
+				"kind": "TrustedIp",
+				"id": "192.168.1.1",
+				"enabled": true
+			},
+			{
+				"kind": "TrustedIp",
+				"id": "172.16.0.0/12",
+				"enabled": false
+			},
+			{
+				"kind": "TrustedIp",
+				"id": "junk",
+				"enabled": true
+			}
+		]
+	}`
+
+	It("Lists only the enabled and valid ranges", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, trustedIPsPath),
+				RespondWithJSON(http.StatusOK, trustedIPs),
+			),
+		)
+		result, err := ListTrustedIPs(ctx, client)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(2))
+		Expect(result[0].String()).To(Equal("10.0.0.0/16"))
+		Expect(result[1].String()).To(Equal("192.168.1.1/32"))
+	})
+
+	DescribeTable(
+		"Checks if a CIDR is trusted",
+		func(cidr string, expected bool) {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, trustedIPs),
+			)
+			result, err := IsTrustedCIDR(ctx, client, cidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("Same range", "10.0.0.0/16", true),
+		Entry("Smaller range", "10.0.128.0/24", true),
+		Entry("Single address in range", "10.0.1.2", true),
+		Entry("Single trusted address", "192.168.1.1", true),
+		Entry("Larger range", "10.0.0.0/8", false),
+		Entry("Range of single trusted address", "192.168.1.0/24", false),
+		Entry("Disabled range", "172.16.0.0/16", false),
+		Entry("Unknown range", "8.8.8.0/24", false),
+		Entry("IPv6 range", "fd00::/64", false),
+	)
+
+	It("Rejects invalid values without sending requests", func() {
+		_, err := IsTrustedCIDR(ctx, client, "10.0.0.0/33")
+		Expect(err).To(MatchError("value '10.0.0.0/33' isn't a valid address or CIDR block"))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Returns the error of the service", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusForbidden, `{
+				"kind": "Error",
+				"id": "403",
+				"reason": "Forbidden"
+			}`),
+		)
+		_, err := IsTrustedCIDR(ctx, client, "10.0.0.0/24")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't list trusted IP addresses"))
+	})
+})
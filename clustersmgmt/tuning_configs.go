@@ -0,0 +1,260 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers that validate and apply node tuning configs.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// ValidateTuningConfig checks the given tuning config, so that mistakes are detected before
+// sending it to the server. The name should be a valid DNS label, and the spec should be the spec
+// of a TuneD object: a `profile` list where each item has a unique `name` and a `data` string, and
+// an optional `recommend` list where each item references one of those profiles and has a priority
+// greater or equal than zero. For example:
+//
+//	{
+//		"profile": [
+//			{
+//				"name": "tuned-1-profile",
+//				"data": "[main]\nsummary=Custom OpenShift profile\n..."
+//			}
+//		],
+//		"recommend": [
+//			{
+//				"priority": 20,
+//				"profile": "tuned-1-profile"
+//			}
+//		]
+//	}
+//
+// The spec can be any value that can be converted to JSON, for example a map or a struct.
+func ValidateTuningConfig(config *v1.TuningConfig) error {
+	if config == nil {
+		return errors.New("tuning config is mandatory")
+	}
+	err := validateNodeConfigName("tuning config", config.Name())
+	if err != nil {
+		return err
+	}
+	spec, err := tuningConfigSpec(config)
+	if err != nil {
+		return err
+	}
+	profiles, ok := spec["profile"].([]interface{})
+	if !ok || len(profiles) == 0 {
+		return fmt.Errorf(
+			"spec of tuning config '%s' should contain at least one profile",
+			config.Name(),
+		)
+	}
+	names := map[string]bool{}
+	for i, item := range profiles {
+		profile, ok := item.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf(
+				"profile %d of tuning config '%s' should be an object",
+				i, config.Name(),
+			)
+		}
+		name, _ := profile["name"].(string)
+		if name == "" {
+			return fmt.Errorf(
+				"profile %d of tuning config '%s' should have a name",
+				i, config.Name(),
+			)
+		}
+		if names[name] {
+			return fmt.Errorf(
+				"profile '%s' of tuning config '%s' is duplicated",
+				name, config.Name(),
+			)
+		}
+		names[name] = true
+		if _, ok := profile["data"].(string); !ok {
+			return fmt.Errorf(
+				"profile '%s' of tuning config '%s' should have a data string",
+				name, config.Name(),
+			)
+		}
+	}
+	recommendations, ok := spec["recommend"].([]interface{})
+	if !ok && spec["recommend"] != nil {
+		return fmt.Errorf(
+			"recommendations of tuning config '%s' should be a list",
+			config.Name(),
+		)
+	}
+	for i, item := range recommendations {
+		recommendation, ok := item.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf(
+				"recommendation %d of tuning config '%s' should be an object",
+				i, config.Name(),
+			)
+		}
+		profile, _ := recommendation["profile"].(string)
+		if !names[profile] {
+			return fmt.Errorf(
+				"recommendation %d of tuning config '%s' references profile '%s', "+
+					"but it isn't defined",
+				i, config.Name(), profile,
+			)
+		}
+		if value, present := recommendation["priority"]; present {
+			priority, ok := value.(float64)
+			if !ok || priority < 0 {
+				return fmt.Errorf(
+					"priority of recommendation %d of tuning config '%s' should be a "+
+						"number greater or equal than zero",
+					i, config.Name(),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyTuningConfig validates the given tuning config and creates it in the given cluster, or
+// updates the spec of the existing tuning config with the same name. The returned flag indicates
+// if the tuning config was created. This works the same for classic and hosted control plane
+// clusters.
+func ApplyTuningConfig(ctx context.Context, client *v1.Client, clusterID string,
+	config *v1.TuningConfig) (result *v1.TuningConfig, created bool, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	err = ValidateTuningConfig(config)
+	if err != nil {
+		return
+	}
+	resource := client.Clusters().Cluster(clusterID).
+		TuningConfigs()
+
+	// Find the existing tuning config:
+	var existing *v1.TuningConfig
+	request := resource.List().Size(100)
+	seen := 0
+	for page := 1; existing == nil; page++ {
+		var response *v1.TuningConfigsListResponse
+		response, err = request.Page(page).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't list tuning configs of cluster '%s': %w",
+				clusterID, err,
+			)
+			return
+		}
+		response.Items().Each(func(item *v1.TuningConfig) bool {
+			if item.Name() == config.Name() {
+				existing = item
+				return false
+			}
+			return true
+		})
+		seen += response.Items().Len()
+		if response.Items().Len() == 0 || seen >= response.Total() {
+			break
+		}
+	}
+
+	// Create it if it doesn't exist:
+	if existing == nil {
+		var addResponse *v1.TuningConfigsAddResponse
+		addResponse, err = resource.Add().Body(config).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't create tuning config '%s' for cluster '%s': %w",
+				config.Name(), clusterID, err,
+			)
+			return
+		}
+		result = addResponse.Body()
+		created = true
+		return
+	}
+
+	// Update it:
+	update, err := v1.NewTuningConfig().Spec(config.Spec()).Build()
+	if err != nil {
+		return
+	}
+	updateResponse, err := resource.TuningConfig(existing.ID()).Update().Body(update).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't update tuning config '%s' of cluster '%s': %w",
+			config.Name(), clusterID, err,
+		)
+		return
+	}
+	result = updateResponse.Body()
+	return
+}
+
+// tuningConfigSpec converts the spec of the given tuning config into a generic JSON object.
+func tuningConfigSpec(config *v1.TuningConfig) (result map[string]interface{}, err error) {
+	if config.Spec() == nil {
+		err = fmt.Errorf("spec of tuning config '%s' is mandatory", config.Name())
+		return
+	}
+	data, err := json.Marshal(config.Spec())
+	if err != nil {
+		err = fmt.Errorf(
+			"can't convert spec of tuning config '%s' to JSON: %w",
+			config.Name(), err,
+		)
+		return
+	}
+	err = json.Unmarshal(data, &result)
+	if err != nil || result == nil {
+		err = fmt.Errorf(
+			"spec of tuning config '%s' should be an object",
+			config.Name(),
+		)
+		result = nil
+	}
+	return
+}
+
+// nodeConfigNameRE is the regular expression used to check the names of tuning configs and kubelet
+// configs, which should be valid DNS labels.
+var nodeConfigNameRE = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// validateNodeConfigName checks that the given name of a tuning config or kubelet config is a
+// valid DNS label.
+func validateNodeConfigName(kind, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s name is mandatory", kind)
+	}
+	if !nodeConfigNameRE.MatchString(name) {
+		return fmt.Errorf(
+			"%s name '%s' isn't valid, it should contain at most 63 lower case "+
+				"letters, digits or dashes, and start and end with a letter or digit",
+			kind, name,
+		)
+	}
+	return nil
+}
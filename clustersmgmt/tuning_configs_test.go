@@ -0,0 +1,276 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the helpers that manage tuning configs.
+
+package clustersmgmt
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Tuning configs", func() {
+	const tuningConfigsPath = "/api/clusters_mgmt/v1/clusters/123/tuning_configs"
+
+	// spec returns a valid TuneD spec with the given data.
+	spec := func(data string) map[string]interface{} {
+		return map[string]interface{}{
+			"profile": []interface{}{
+				map[string]interface{}{
+					"name": "my-profile",
+					"data": data,
+				},
+			},
+			"recommend": []interface{}{
+				map[string]interface{}{
+					"priority": 20,
+					"profile":  "my-profile",
+				},
+			},
+		}
+	}
+
+	DescribeTable(
+		"Validation",
+		func(name string, spec interface{}, expected string) {
+			config, err := cmv1.NewTuningConfig().
+				Name(name).
+				Spec(spec).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = ValidateTuningConfig(config)
+			if expected == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expected))
+			}
+		},
+		Entry(
+			"Valid",
+			"my-tuning",
+			spec("[main]\nsummary=Custom profile"),
+			"",
+		),
+		Entry(
+			"Valid struct",
+			"my-tuning",
+			struct {
+				Profile []map[string]string `json:"profile"`
+			}{
+				Profile: []map[string]string{{
+					"name": "my-profile",
+					"data": "[main]",
+				}},
+			},
+			"",
+		),
+		Entry(
+			"Missing name",
+			"",
+			spec("[main]"),
+			"tuning config name is mandatory",
+		),
+		Entry(
+			"Invalid name",
+			"My_Tuning",
+			spec("[main]"),
+			"tuning config name 'My_Tuning' isn't valid",
+		),
+		Entry(
+			"Missing spec",
+			"my-tuning",
+			nil,
+			"spec of tuning config 'my-tuning' is mandatory",
+		),
+		Entry(
+			"Spec isn't an object",
+			"my-tuning",
+			[]string{"junk"},
+			"spec of tuning config 'my-tuning' should be an object",
+		),
+		Entry(
+			"No profiles",
+			"my-tuning",
+			map[string]interface{}{},
+			"should contain at least one profile",
+		),
+		Entry(
+			"Profile without data",
+			"my-tuning",
+			map[string]interface{}{
+				"profile": []interface{}{
+					map[string]interface{}{
+						"name": "my-profile",
+					},
+				},
+			},
+			"profile 'my-profile' of tuning config 'my-tuning' should have a data string",
+		),
+		Entry(
+			"Duplicated profile",
+			"my-tuning",
+			map[string]interface{}{
+				"profile": []interface{}{
+					map[string]interface{}{
+						"name": "my-profile",
+						"data": "[main]",
+					},
+					map[string]interface{}{
+						"name": "my-profile",
+						"data": "[main]",
+					},
+				},
+			},
+			"profile 'my-profile' of tuning config 'my-tuning' is duplicated",
+		),
+		Entry(
+			"Recommendation of unknown profile",
+			"my-tuning",
+			map[string]interface{}{
+				"profile": []interface{}{
+					map[string]interface{}{
+						"name": "my-profile",
+						"data": "[main]",
+					},
+				},
+				"recommend": []interface{}{
+					map[string]interface{}{
+						"priority": 20,
+						"profile":  "other-profile",
+					},
+				},
+			},
+			"references profile 'other-profile', but it isn't defined",
+		),
+		Entry(
+			"Negative priority",
+			"my-tuning",
+			map[string]interface{}{
+				"profile": []interface{}{
+					map[string]interface{}{
+						"name": "my-profile",
+						"data": "[main]",
+					},
+				},
+				"recommend": []interface{}{
+					map[string]interface{}{
+						"priority": -1,
+						"profile":  "my-profile",
+					},
+				},
+			},
+			"priority of recommendation 0 of tuning config 'my-tuning' should be a number",
+		),
+	)
+
+	Describe("Apply", func() {
+		It("Creates the tuning config if it doesn't exist", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, tuningConfigsPath),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "TuningConfigList",
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"kind": "TuningConfig",
+								"id": "456",
+								"name": "other-tuning"
+							}
+						]
+					}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, tuningConfigsPath),
+					VerifyJQ(".name", "my-tuning"),
+					VerifyJQ(".spec.profile[0].data", "[main]"),
+					RespondWithJSON(http.StatusCreated, `{
+						"kind": "TuningConfig",
+						"id": "789",
+						"name": "my-tuning"
+					}`),
+				),
+			)
+			config, err := cmv1.NewTuningConfig().
+				Name("my-tuning").
+				Spec(spec("[main]")).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			result, created, err := ApplyTuningConfig(ctx, client, "123", config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(result.ID()).To(Equal("789"))
+		})
+
+		It("Updates the tuning config with the same name", func() {
+			server.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "TuningConfigList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"kind": "TuningConfig",
+							"id": "456",
+							"name": "my-tuning"
+						}
+					]
+				}`),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, tuningConfigsPath+"/456"),
+					VerifyJQ(".spec.profile[0].data", "[main]\nupdated"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "TuningConfig",
+						"id": "456",
+						"name": "my-tuning"
+					}`),
+				),
+			)
+			config, err := cmv1.NewTuningConfig().
+				Name("my-tuning").
+				Spec(spec("[main]\nupdated")).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			result, created, err := ApplyTuningConfig(ctx, client, "123", config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(result.ID()).To(Equal("456"))
+		})
+
+		It("Doesn't send invalid tuning configs", func() {
+			config, err := cmv1.NewTuningConfig().
+				Name("my-tuning").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = ApplyTuningConfig(ctx, client, "123", config)
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+})
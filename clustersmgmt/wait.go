@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that wait for clusters to reach a given state.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// WaitOptions contains the options that control how the WaitForState function checks the state of
+// the cluster. The zero value, or a nil pointer, means that the default values will be used.
+type WaitOptions struct {
+	// InitialInterval is the time to wait after the first check of the state of the cluster. The
+	// default is ten seconds.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum time to wait between checks. The default is one minute.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor used to increase the interval after each check. The default is
+	// 1.5.
+	Multiplier float64
+
+	// Timeout is the maximum total time to wait. The default is to wait till the context is
+	// cancelled.
+	Timeout time.Duration
+
+	// Progress is a function that will be called after each check of the state of the cluster
+	// that didn't find it in the desired state. It is called synchronously, so it should return
+	// quickly.
+	Progress func(*WaitEvent)
+}
+
+// WaitEvent contains the details of one of the checks performed while waiting for a cluster.
+type WaitEvent struct {
+	// Cluster is the cluster as returned by the server in the last check.
+	Cluster *v1.Cluster
+
+	// Attempt is the number of the check, starting with one.
+	Attempt int
+
+	// Elapsed is the time elapsed since the wait started.
+	Elapsed time.Duration
+
+	// Next is the time that will be waited before the next check.
+	Next time.Duration
+}
+
+// StateError is the error returned by the WaitForState function when the cluster reaches a state
+// that means that it will never reach the desired state. For example, when it fails to install.
+type StateError struct {
+	// ClusterID is the identifier of the cluster.
+	ClusterID string
+
+	// State is the state of the cluster.
+	State v1.ClusterState
+
+	// Code is the provision error code reported by the server, if any.
+	Code string
+
+	// Message is the provision error message reported by the server, if any.
+	Message string
+}
+
+// Error is the implementation of the error interface.
+func (e *StateError) Error() string {
+	result := fmt.Sprintf("cluster '%s' is in state '%s'", e.ClusterID, e.State)
+	switch {
+	case e.Code != "" && e.Message != "":
+		result = fmt.Sprintf("%s: %s: %s", result, e.Code, e.Message)
+	case e.Code != "":
+		result = fmt.Sprintf("%s: %s", result, e.Code)
+	case e.Message != "":
+		result = fmt.Sprintf("%s: %s", result, e.Message)
+	}
+	return result
+}
+
+// WaitForState waits till the cluster reaches the desired state and returns it. The state is
+// checked with an exponential backoff controlled by the options. If the cluster reaches a state
+// that means that it will never reach the desired state, like the error or uninstalling states,
+// it returns a *StateError containing the provision error details reported by the server. For
+// example:
+//
+//	cluster, err := clustersmgmt.WaitForState(
+//		ctx, connection.ClustersMgmt().V1(), id, cmv1.ClusterStateReady,
+//		&clustersmgmt.WaitOptions{
+//			Timeout: 1 * time.Hour,
+//		},
+//	)
+func WaitForState(ctx context.Context, client *v1.Client, clusterID string,
+	desired v1.ClusterState, options *WaitOptions) (result *v1.Cluster, err error) {
+	if options == nil {
+		options = &WaitOptions{}
+	}
+	initial := options.InitialInterval
+	if initial <= 0 {
+		initial = defaultInitialInterval
+	}
+	max := options.MaxInterval
+	if max <= 0 {
+		max = defaultMaxInterval
+	}
+	multiplier := options.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultMultiplier
+	}
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	resource := client.Clusters().Cluster(clusterID)
+	start := time.Now()
+	interval := initial
+	var state v1.ClusterState
+	for attempt := 1; ; attempt++ {
+		var response *v1.ClusterGetResponse
+		response, err = resource.Get().SendContext(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = waitError(clusterID, desired, state, ctx.Err())
+			} else {
+				err = fmt.Errorf("can't get cluster '%s': %w", clusterID, err)
+			}
+			return
+		}
+		cluster := response.Body()
+		state = cluster.State()
+		if state == desired {
+			result = cluster
+			return
+		}
+		if IsTerminalState(state) {
+			status := cluster.Status()
+			message := status.ProvisionErrorMessage()
+			if message == "" {
+				message = status.Description()
+			}
+			err = &StateError{
+				ClusterID: clusterID,
+				State:     state,
+				Code:      status.ProvisionErrorCode(),
+				Message:   message,
+			}
+			return
+		}
+		if options.Progress != nil {
+			options.Progress(&WaitEvent{
+				Cluster: cluster,
+				Attempt: attempt,
+				Elapsed: time.Since(start),
+				Next:    interval,
+			})
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = waitError(clusterID, desired, state, ctx.Err())
+			return
+		case <-timer.C:
+		}
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > max {
+			interval = max
+		}
+	}
+}
+
+// waitError creates the error returned when the context is done before the cluster reaches the
+// desired state.
+func waitError(clusterID string, desired, last v1.ClusterState, cause error) error {
+	if last == "" {
+		return fmt.Errorf("cluster '%s' didn't reach state '%s': %w", clusterID, desired, cause)
+	}
+	return fmt.Errorf(
+		"cluster '%s' didn't reach state '%s', last state was '%s': %w",
+		clusterID, desired, last, cause,
+	)
+}
+
+// IsTerminalState returns true if the given state means that the cluster will not reach any other
+// state without user intervention, like the error and uninstalling states.
+func IsTerminalState(state v1.ClusterState) bool {
+	switch state {
+	case v1.ClusterStateError, v1.ClusterStateUninstalling:
+		return true
+	default:
+		return false
+	}
+}
+
+// Default values:
+const (
+	defaultInitialInterval = 10 * time.Second
+	defaultMaxInterval     = 1 * time.Minute
+	defaultMultiplier      = 1.5
+)
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Wait for state", func() {
+	RespondWithState := func(state string) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+			RespondWithJSONTemplate(http.StatusOK, `{
+				"id": "123",
+				"state": "{{ .State }}"
+			}`, "State", state),
+		)
+	}
+
+	It("Returns immediately if the cluster is already in the desired state", func() {
+		server.AppendHandlers(
+			RespondWithState("ready"),
+		)
+		cluster, err := WaitForState(ctx, client, "123", cmv1.ClusterStateReady, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStateReady))
+	})
+
+	It("Waits with increasing intervals and reports progress", func() {
+		server.AppendHandlers(
+			RespondWithState("pending"),
+			RespondWithState("installing"),
+			RespondWithState("installing"),
+			RespondWithState("ready"),
+		)
+		var events []*WaitEvent
+		cluster, err := WaitForState(ctx, client, "123", cmv1.ClusterStateReady, &WaitOptions{
+			InitialInterval: time.Millisecond,
+			MaxInterval:     3 * time.Millisecond,
+			Multiplier:      2,
+			Progress: func(event *WaitEvent) {
+				events = append(events, event)
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStateReady))
+		Expect(events).To(HaveLen(3))
+		Expect(events[0].Attempt).To(Equal(1))
+		Expect(events[0].Cluster.State()).To(Equal(cmv1.ClusterStatePending))
+		Expect(events[0].Next).To(Equal(time.Millisecond))
+		Expect(events[1].Next).To(Equal(2 * time.Millisecond))
+		Expect(events[2].Next).To(Equal(3 * time.Millisecond))
+	})
+
+	It("Fails with the provision error when the cluster is in error state", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"state": "error",
+					"status": {
+						"provision_error_code": "OCM3055",
+						"provision_error_message": "Quota exceeded"
+					}
+				}`),
+			),
+		)
+		_, err := WaitForState(ctx, client, "123", cmv1.ClusterStateReady, nil)
+		Expect(err).To(HaveOccurred())
+		var stateErr *StateError
+		Expect(errors.As(err, &stateErr)).To(BeTrue())
+		Expect(stateErr.ClusterID).To(Equal("123"))
+		Expect(stateErr.State).To(Equal(cmv1.ClusterStateError))
+		Expect(stateErr.Code).To(Equal("OCM3055"))
+		Expect(stateErr.Message).To(Equal("Quota exceeded"))
+	})
+
+	It("Doesn't fail if the terminal state is the desired one", func() {
+		server.AppendHandlers(
+			RespondWithState("uninstalling"),
+		)
+		_, err := WaitForState(ctx, client, "123", cmv1.ClusterStateUninstalling, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Fails when the timeout expires", func() {
+		server.RouteToHandler(
+			http.MethodGet,
+			"/api/clusters_mgmt/v1/clusters/123",
+			RespondWithState("installing"),
+		)
+		_, err := WaitForState(ctx, client, "123", cmv1.ClusterStateReady, &WaitOptions{
+			InitialInterval: time.Millisecond,
+			Timeout:         50 * time.Millisecond,
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("installing"))
+	})
+})
@@ -0,0 +1,254 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains helpers for the workload identity federation configurations used by GCP
+// clusters.
+
+package clustersmgmt // github.com/openshift-online/ocm-sdk-go/clustersmgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// DefaultWifConfigInterval is the default time between checks of the readiness of workload
+// identity federation configurations.
+const DefaultWifConfigInterval = 10 * time.Second
+
+// WifConfigOptions contains the options that control how the helpers wait for workload identity
+// federation configurations to be ready.
+type WifConfigOptions struct {
+	// Interval is the time between checks of the readiness of the configuration. The default is
+	// ten seconds.
+	Interval time.Duration
+}
+
+// CreateWifConfig validates and creates the given workload identity federation configuration, and
+// waits till the status reported by the service says that it is ready. The options can be nil to
+// use the defaults.
+func CreateWifConfig(ctx context.Context, client *v1.Client, config *v1.WifConfig,
+	options *WifConfigOptions) (result *v1.WifConfig, err error) {
+	err = ValidateWifConfig(config)
+	if err != nil {
+		return
+	}
+	response, err := client.
+		GCP().
+		WifConfigs().
+		Add().
+		Body(config).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't create workload identity federation configuration '%s': %w",
+			config.DisplayName(), err,
+		)
+		return
+	}
+	id := response.Body().ID()
+	if id == "" {
+		err = fmt.Errorf(
+			"created workload identity federation configuration '%s' doesn't have an "+
+				"identifier",
+			config.DisplayName(),
+		)
+		return
+	}
+	result, err = WaitForWifConfig(ctx, client, id, options)
+	return
+}
+
+// FindWifConfig returns the workload identity federation configuration that has the given display
+// name, or nil if there is no such configuration.
+func FindWifConfig(ctx context.Context, client *v1.Client,
+	displayName string) (result *v1.WifConfig, err error) {
+	if displayName == "" {
+		err = errors.New("display name is mandatory")
+		return
+	}
+	response, err := client.
+		GCP().
+		WifConfigs().
+		List().
+		Search(fmt.Sprintf("display_name = '%s'", displayName)).
+		Size(1).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't find workload identity federation configuration '%s': %w",
+			displayName, err,
+		)
+		return
+	}
+	if response.Items().Len() > 0 {
+		result = response.Items().Get(0)
+	}
+	return
+}
+
+// WaitForWifConfig waits till the given workload identity federation configuration is ready. A
+// configuration is considered ready when the server has populated the workload identity pool, with
+// its identity provider, and the service accounts. The options can be nil to use the defaults.
+func WaitForWifConfig(ctx context.Context, client *v1.Client, id string,
+	options *WifConfigOptions) (result *v1.WifConfig, err error) {
+	if id == "" {
+		err = errors.New("workload identity federation configuration identifier is mandatory")
+		return
+	}
+	interval := DefaultWifConfigInterval
+	if options != nil {
+		if options.Interval < 0 {
+			err = fmt.Errorf(
+				"interval %s isn't valid, it should be greater or equal than zero",
+				options.Interval,
+			)
+			return
+		}
+		if options.Interval > 0 {
+			interval = options.Interval
+		}
+	}
+	resource := client.
+		GCP().
+		WifConfigs().
+		WifConfig(id)
+	for {
+		var response *v1.WifConfigGetResponse
+		response, err = resource.Get().SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't get workload identity federation configuration '%s': %w",
+				id, err,
+			)
+			return
+		}
+		config := response.Body()
+		if wifConfigReady(config) {
+			result = config
+			return
+		}
+		err = sleep(ctx, interval)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func wifConfigReady(config *v1.WifConfig) bool {
+	gcp := config.Gcp()
+	if gcp == nil || len(gcp.ServiceAccounts()) == 0 {
+		return false
+	}
+	pool := gcp.WorkloadIdentityPool()
+	if pool == nil || pool.PoolId() == "" {
+		return false
+	}
+	provider := pool.IdentityProvider()
+	return provider != nil && provider.IssuerUrl() != ""
+}
+
+// gcpProjectIDRE is the regular expression used to check the format of GCP project identifiers.
+var gcpProjectIDRE = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+
+// gcpProjectNumberRE is the regular expression used to check the format of GCP project numbers.
+var gcpProjectNumberRE = regexp.MustCompile(`^[0-9]+$`)
+
+// ValidateWifConfig checks that the given workload identity federation configuration has a display
+// name and valid GCP project details. It is intended to detect mistakes before sending the
+// configuration to the server.
+func ValidateWifConfig(config *v1.WifConfig) error {
+	if config == nil {
+		return errors.New("workload identity federation configuration is mandatory")
+	}
+	if config.DisplayName() == "" {
+		return errors.New("display name of workload identity federation configuration is mandatory")
+	}
+	gcp := config.Gcp()
+	if gcp == nil {
+		return fmt.Errorf(
+			"GCP details of workload identity federation configuration '%s' are mandatory",
+			config.DisplayName(),
+		)
+	}
+	if !gcpProjectIDRE.MatchString(gcp.ProjectId()) {
+		return fmt.Errorf(
+			"project identifier '%s' of workload identity federation configuration '%s' "+
+				"isn't valid",
+			gcp.ProjectId(), config.DisplayName(),
+		)
+	}
+	if gcp.ProjectNumber() != "" && !gcpProjectNumberRE.MatchString(gcp.ProjectNumber()) {
+		return fmt.Errorf(
+			"project number '%s' of workload identity federation configuration '%s' "+
+				"isn't valid, it should contain only digits",
+			gcp.ProjectNumber(), config.DisplayName(),
+		)
+	}
+	return nil
+}
+
+// GCPFromWifConfig returns a builder for the GCP details of a cluster that uses the given workload
+// identity federation configuration. The result can be passed to the GCP method of the cluster
+// builder.
+func GCPFromWifConfig(config *v1.WifConfig) *v1.GCPBuilder {
+	builder := v1.NewGCP().Authentication(
+		v1.NewGcpAuthentication().
+			Kind(v1.WifConfigKind).
+			Id(config.ID()).
+			Href(config.HREF()),
+	)
+	if config.Gcp() != nil {
+		builder.ProjectID(config.Gcp().ProjectId())
+	}
+	return builder
+}
+
+// GCPFromServiceAccountKey returns a builder for the GCP details of a cluster that uses the given
+// service account key, as downloaded from the GCP console in JSON format. The result can be passed
+// to the GCP method of the cluster builder.
+func GCPFromServiceAccountKey(key []byte) (result *v1.GCPBuilder, err error) {
+	gcp, err := v1.UnmarshalGCP(key)
+	if err != nil {
+		err = fmt.Errorf("can't parse service account key: %w", err)
+		return
+	}
+	if gcp.Type() != "service_account" {
+		err = fmt.Errorf(
+			"key type '%s' isn't valid, it should be 'service_account'",
+			gcp.Type(),
+		)
+		return
+	}
+	if gcp.ProjectID() == "" {
+		err = errors.New("service account key doesn't contain the project identifier")
+		return
+	}
+	if gcp.ClientEmail() == "" {
+		err = errors.New("service account key doesn't contain the client email")
+		return
+	}
+	if gcp.PrivateKey() == "" {
+		err = errors.New("service account key doesn't contain the private key")
+		return
+	}
+	result = v1.NewGCP().Copy(gcp)
+	return
+}
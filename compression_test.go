@@ -19,15 +19,25 @@ limitations under the License.
 package sdk
 
 import (
+	"bytes"
 	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
-	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
-	. "github.com/onsi/gomega"             // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
 
 	"github.com/onsi/gomega/ghttp"
 
+	"github.com/openshift-online/ocm-sdk-go/recording"
 	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
 )
 
@@ -98,4 +108,209 @@ var _ = Describe("Compression", func() {
 		Expect(result.HREF()).To(Equal("/api/clusters_mgmt/v1/clusters/123"))
 		Expect(result.Name()).To(Equal("mycluster"))
 	})
+
+	It("Doesn't override explicit accept encoding", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Accept-Encoding", "identity"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Send the request:
+		response, err := connection.Get().
+			Path("/api/clusters_mgmt/v1/clusters/123").
+			Header("Accept-Encoding", "identity").
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.String()).To(Equal(`{}`))
+	})
+
+	It("Passes decompressed bodies to transport wrappers", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Encoding", "gzip")
+				w.WriteHeader(http.StatusOK)
+				compressor := gzip.NewWriter(w)
+				_, err := compressor.Write([]byte(`{
+					"kind": "Cluster",
+					"id": "123",
+					"token": "mytoken"
+				}`))
+				Expect(err).ToNot(HaveOccurred())
+				err = compressor.Close()
+				Expect(err).ToNot(HaveOccurred())
+			}),
+		)
+
+		// Create a wrapper that saves the response body seen by the user:
+		var seen []byte
+		wrapper := func(transport http.RoundTripper) http.RoundTripper {
+			return TransportFunc(func(request *http.Request) (*http.Response, error) {
+				response, err := transport.RoundTrip(request)
+				if err != nil {
+					return nil, err
+				}
+				seen, err = io.ReadAll(response.Body)
+				Expect(err).ToNot(HaveOccurred())
+				err = response.Body.Close()
+				Expect(err).ToNot(HaveOccurred())
+				response.Body = io.NopCloser(bytes.NewReader(seen))
+				return response, nil
+			})
+		}
+
+		// Create the recorder:
+		fixtures, err := os.MkdirTemp("", "fixtures-*")
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err := os.RemoveAll(fixtures)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		recorder, err := recording.NewTransportWrapper().
+			Logger(logger).
+			Mode(recording.ModeRecord).
+			Directory(fixtures).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create a connection that uses the wrapper and the recorder:
+		wrapped, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			TransportWrapper(wrapper).
+			TransportWrapper(recorder.Wrap).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err := wrapped.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Send the request:
+		response, err := wrapped.ClustersMgmt().V1().Clusters().Cluster("123").Get().
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().ID()).To(Equal("123"))
+
+		// Check that the wrapper saw the plain JSON body:
+		Expect(seen).To(MatchJSON(`{
+			"kind": "Cluster",
+			"id": "123",
+			"token": "mytoken"
+		}`))
+
+		// Check that the recorder saved the plain JSON body, with the token redacted:
+		files, err := filepath.Glob(filepath.Join(fixtures, "*.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		data, err := os.ReadFile(files[0])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).ToNot(ContainSubstring("mytoken"))
+		var interactions []struct {
+			Response struct {
+				Header http.Header     `json:"header"`
+				Body   json.RawMessage `json:"body"`
+			} `json:"response"`
+		}
+		err = json.Unmarshal(data, &interactions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(interactions).To(HaveLen(1))
+		Expect(interactions[0].Response.Header.Get("Content-Encoding")).To(BeEmpty())
+		Expect(interactions[0].Response.Body).To(MatchJSON(`{
+			"kind": "Cluster",
+			"id": "123",
+			"token": "REDACTED"
+		}`))
+	})
+})
+
+var _ = Describe("Maximum response size", func() {
+	var (
+		server     *ghttp.Server
+		connection *Connection
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the tokens:
+		token := MakeTokenString("Bearer", 5*time.Minute)
+
+		// Create the server:
+		server = MakeTCPServer()
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			MaxResponseSize(100).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the server:
+		server.Close()
+	})
+
+	// respond returns a handler that responds with a cluster with a name of the given length,
+	// optionally compressed.
+	respond := func(length int, compress bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			body := fmt.Sprintf(`{"kind":"Cluster","id":"123","name":"%s"}`, strings.Repeat("x", length))
+			w.Header().Set("Content-Type", "application/json")
+			if compress {
+				w.Header().Set("Content-Encoding", "gzip")
+			}
+			w.WriteHeader(http.StatusOK)
+			if compress {
+				compressor := gzip.NewWriter(w)
+				_, err := compressor.Write([]byte(body))
+				Expect(err).ToNot(HaveOccurred())
+				err = compressor.Close()
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				_, err := w.Write([]byte(body))
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+	}
+
+	DescribeTable(
+		"Accepts small responses",
+		func(compress bool) {
+			server.AppendHandlers(respond(10, compress))
+			response, err := connection.ClustersMgmt().V1().Clusters().Cluster("123").Get().
+				Send()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Body().Name()).To(Equal(strings.Repeat("x", 10)))
+		},
+		Entry("Uncompressed", false),
+		Entry("Compressed", true),
+	)
+
+	DescribeTable(
+		"Rejects large responses",
+		func(compress bool) {
+			server.AppendHandlers(respond(1000, compress))
+			_, err := connection.ClustersMgmt().V1().Clusters().Cluster("123").Get().
+				Send()
+			Expect(err).To(HaveOccurred())
+			var tooLarge *ResponseTooLargeError
+			Expect(errors.As(err, &tooLarge)).To(BeTrue())
+			Expect(tooLarge.Limit).To(BeNumerically("==", 100))
+		},
+		Entry("Uncompressed", false),
+		Entry("Compressed", true),
+	)
 })
@@ -0,0 +1,331 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that load the connection settings from the configuration file
+// used by the OCM command line tool and from environment variables.
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Config contains the connection settings. The JSON tags are the same used by the configuration
+// file of the OCM command line tool, so the file can be shared.
+type Config struct {
+	URL          string   `json:"url,omitempty"`
+	TokenURL     string   `json:"token_url,omitempty"`
+	ClientID     string   `json:"client_id,omitempty"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	User         string   `json:"user,omitempty"`
+	Password     string   `json:"password,omitempty"`
+	AccessToken  string   `json:"access_token,omitempty"`
+	RefreshToken string   `json:"refresh_token,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+	Insecure     bool     `json:"insecure,omitempty"`
+}
+
+// LoaderBuilder contains the data and logic needed to create a loader.
+type LoaderBuilder struct {
+	file        string
+	environment bool
+	overrides   []*Config
+}
+
+// Loader loads the connection settings from the configuration file, from the environment variables
+// and from explicit overrides, in that order, so that the values from later sources replace the
+// values from earlier sources.
+type Loader struct {
+	file        string
+	environment bool
+	overrides   []*Config
+}
+
+// NewLoader creates a builder that can then be used to configure and create a loader.
+func NewLoader() *LoaderBuilder {
+	return &LoaderBuilder{
+		environment: true,
+	}
+}
+
+// File sets the path of the configuration file. The default is the value of the `OCM_CONFIG`
+// environment variable, or `ocm/ocm.json` inside the user configuration directory, usually
+// `~/.config/ocm/ocm.json`. If that file doesn't exist but the legacy `~/.ocm.json` file exists
+// then the legacy file is used. It isn't an error if the file doesn't exist.
+func (b *LoaderBuilder) File(value string) *LoaderBuilder {
+	b.file = value
+	return b
+}
+
+// Environment sets the flag that indicates if the `OCM_*` environment variables should be used.
+// The default value is true. The supported variables are:
+//
+//	OCM_URL - URL of the API gateway.
+//	OCM_TOKEN_URL - URL of the token endpoint of the OpenID server.
+//	OCM_CLIENT_ID - OpenID client identifier.
+//	OCM_CLIENT_SECRET - OpenID client secret.
+//	OCM_USER - User name.
+//	OCM_PASSWORD - Password.
+//	OCM_ACCESS_TOKEN - Access token.
+//	OCM_REFRESH_TOKEN - Refresh or offline token.
+//	OCM_TOKEN - Alias of OCM_REFRESH_TOKEN, used when the refresh token variable isn't set.
+//	OCM_SCOPES - OpenID scopes, separated by commas or spaces.
+//	OCM_INSECURE - Set to `true` to skip verification of TLS certificates.
+func (b *LoaderBuilder) Environment(value bool) *LoaderBuilder {
+	b.environment = value
+	return b
+}
+
+// Override adds settings that will replace the values loaded from the file and from the
+// environment. Only the non empty fields are used, and for the insecure flag only the true value.
+func (b *LoaderBuilder) Override(value *Config) *LoaderBuilder {
+	if value != nil {
+		b.overrides = append(b.overrides, value)
+	}
+	return b
+}
+
+// Build uses the data stored in the builder to create a new loader.
+func (b *LoaderBuilder) Build() (result *Loader, err error) {
+	file := b.file
+	if file == "" {
+		file, err = Location()
+		if err != nil {
+			return
+		}
+	}
+	result = &Loader{
+		file:        file,
+		environment: b.environment,
+		overrides:   append([]*Config(nil), b.overrides...),
+	}
+	return
+}
+
+// File returns the path of the configuration file used by the loader.
+func (l *Loader) File() string {
+	return l.file
+}
+
+// Load loads the settings.
+func (l *Loader) Load() (result *Config, err error) {
+	result, err = Read(l.file)
+	if err != nil {
+		return
+	}
+	if l.environment {
+		result.merge(fromEnvironment())
+	}
+	for _, override := range l.overrides {
+		result.merge(override)
+	}
+	return
+}
+
+// Location returns the path of the configuration file used by the OCM command line tool. See the
+// File method of the loader builder for details.
+func Location() (result string, err error) {
+	result = os.Getenv(configEnv)
+	if result != "" {
+		return
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		err = fmt.Errorf("can't find user configuration directory: %w", err)
+		return
+	}
+	result = filepath.Join(dir, "ocm", "ocm.json")
+	_, err = os.Stat(result)
+	if err == nil || !errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	err = nil
+	home, homeErr := os.UserHomeDir()
+	if homeErr != nil {
+		return
+	}
+	legacy := filepath.Join(home, ".ocm.json")
+	_, statErr := os.Stat(legacy)
+	if statErr == nil {
+		result = legacy
+	}
+	return
+}
+
+// Read reads the settings from the given configuration file. If the file doesn't exist it returns
+// empty settings.
+func Read(file string) (result *Config, err error) {
+	result = &Config{}
+	data, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = nil
+		return
+	}
+	if err != nil {
+		err = fmt.Errorf("can't read configuration file '%s': %w", file, err)
+		return
+	}
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		err = fmt.Errorf("can't parse configuration file '%s': %w", file, err)
+	}
+	return
+}
+
+// Write writes the settings to the given configuration file, creating the directory if needed. The
+// file is only readable by the owner, as it may contain credentials.
+func Write(file string, config *Config) error {
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(file), 0700)
+	if err != nil {
+		return fmt.Errorf("can't create directory for configuration file '%s': %w", file, err)
+	}
+	err = os.WriteFile(file, data, 0600)
+	if err != nil {
+		return fmt.Errorf("can't write configuration file '%s': %w", file, err)
+	}
+	return nil
+}
+
+// ConnectionBuilder creates a connection builder configured with the settings. The caller can
+// further configure the builder before creating the connection. The logger is optional, if it is
+// nil the default logger of the connection will be used.
+func (c *Config) ConnectionBuilder(logger logging.Logger) *sdk.ConnectionBuilder {
+	builder := sdk.NewConnectionBuilder()
+	if logger != nil {
+		builder.Logger(logger)
+	}
+	if c.URL != "" {
+		builder.URL(c.URL)
+	}
+	if c.TokenURL != "" {
+		builder.TokenURL(c.TokenURL)
+	}
+	if c.ClientID != "" || c.ClientSecret != "" {
+		builder.Client(c.ClientID, c.ClientSecret)
+	}
+	if c.User != "" || c.Password != "" {
+		builder.User(c.User, c.Password)
+	}
+	var tokens []string
+	if c.AccessToken != "" {
+		tokens = append(tokens, c.AccessToken)
+	}
+	if c.RefreshToken != "" {
+		tokens = append(tokens, c.RefreshToken)
+	}
+	if len(tokens) > 0 {
+		builder.Tokens(tokens...)
+	}
+	if len(c.Scopes) > 0 {
+		builder.Scopes(c.Scopes...)
+	}
+	if c.Insecure {
+		builder.Insecure(true)
+	}
+	return builder
+}
+
+// merge copies into this object the non empty fields of the given one.
+func (c *Config) merge(other *Config) {
+	if other.URL != "" {
+		c.URL = other.URL
+	}
+	if other.TokenURL != "" {
+		c.TokenURL = other.TokenURL
+	}
+	if other.ClientID != "" {
+		c.ClientID = other.ClientID
+	}
+	if other.ClientSecret != "" {
+		c.ClientSecret = other.ClientSecret
+	}
+	if other.User != "" {
+		c.User = other.User
+	}
+	if other.Password != "" {
+		c.Password = other.Password
+	}
+	if other.AccessToken != "" {
+		c.AccessToken = other.AccessToken
+	}
+	if other.RefreshToken != "" {
+		c.RefreshToken = other.RefreshToken
+	}
+	if len(other.Scopes) > 0 {
+		c.Scopes = append([]string(nil), other.Scopes...)
+	}
+	if other.Insecure {
+		c.Insecure = true
+	}
+}
+
+// fromEnvironment creates settings from the values of the environment variables.
+func fromEnvironment() *Config {
+	result := &Config{
+		URL:          os.Getenv(urlEnv),
+		TokenURL:     os.Getenv(tokenURLEnv),
+		ClientID:     os.Getenv(clientIDEnv),
+		ClientSecret: os.Getenv(clientSecretEnv),
+		User:         os.Getenv(userEnv),
+		Password:     os.Getenv(passwordEnv),
+		AccessToken:  os.Getenv(accessTokenEnv),
+		RefreshToken: os.Getenv(refreshTokenEnv),
+	}
+	if result.RefreshToken == "" {
+		result.RefreshToken = os.Getenv(tokenEnv)
+	}
+	scopes := os.Getenv(scopesEnv)
+	if scopes != "" {
+		result.Scopes = strings.FieldsFunc(scopes, func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+	}
+	insecure, err := strconv.ParseBool(os.Getenv(insecureEnv))
+	if err == nil {
+		result.Insecure = insecure
+	}
+	return result
+}
+
+// Names of the environment variables:
+const (
+	configEnv       = "OCM_CONFIG"
+	urlEnv          = "OCM_URL"
+	tokenURLEnv     = "OCM_TOKEN_URL"
+	clientIDEnv     = "OCM_CLIENT_ID"
+	clientSecretEnv = "OCM_CLIENT_SECRET"
+	userEnv         = "OCM_USER"
+	passwordEnv     = "OCM_PASSWORD"
+	accessTokenEnv  = "OCM_ACCESS_TOKEN"
+	refreshTokenEnv = "OCM_REFRESH_TOKEN"
+	tokenEnv        = "OCM_TOKEN"
+	scopesEnv       = "OCM_SCOPES"
+	insecureEnv     = "OCM_INSECURE"
+)
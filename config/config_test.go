@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Loader", func() {
+	var directory string
+	var file string
+	var saved map[string]string
+
+	BeforeEach(func() {
+		var err error
+		directory, err = os.MkdirTemp("", "config-*")
+		Expect(err).ToNot(HaveOccurred())
+		file = filepath.Join(directory, "ocm", "ocm.json")
+
+		// Save and clear the environment variables, so that the tests aren't affected by
+		// the environment where they run:
+		saved = map[string]string{}
+		for _, name := range []string{
+			configEnv, urlEnv, tokenURLEnv, clientIDEnv, clientSecretEnv, userEnv,
+			passwordEnv, accessTokenEnv, refreshTokenEnv, tokenEnv, scopesEnv,
+			insecureEnv,
+		} {
+			value, ok := os.LookupEnv(name)
+			if ok {
+				saved[name] = value
+			}
+			Expect(os.Unsetenv(name)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		for name, value := range saved {
+			Expect(os.Setenv(name, value)).To(Succeed())
+		}
+		Expect(os.RemoveAll(directory)).To(Succeed())
+	})
+
+	It("Returns empty settings if the file doesn't exist", func() {
+		loader, err := NewLoader().File(file).Build()
+		Expect(err).ToNot(HaveOccurred())
+		config, err := loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*config).To(Equal(Config{}))
+	})
+
+	It("Reads the file written by the command line tool", func() {
+		err := os.MkdirAll(filepath.Dir(file), 0700)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.WriteFile(file, []byte(`{
+			"access_token": "my-access",
+			"client_id": "cloud-services",
+			"insecure": true,
+			"pager": "less",
+			"refresh_token": "my-refresh",
+			"scopes": ["openid"],
+			"token_url": "https://sso.example.com/token",
+			"url": "https://api.example.com"
+		}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		loader, err := NewLoader().File(file).Build()
+		Expect(err).ToNot(HaveOccurred())
+		config, err := loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.URL).To(Equal("https://api.example.com"))
+		Expect(config.TokenURL).To(Equal("https://sso.example.com/token"))
+		Expect(config.ClientID).To(Equal("cloud-services"))
+		Expect(config.AccessToken).To(Equal("my-access"))
+		Expect(config.RefreshToken).To(Equal("my-refresh"))
+		Expect(config.Scopes).To(ConsistOf("openid"))
+		Expect(config.Insecure).To(BeTrue())
+	})
+
+	It("Uses the file from the environment", func() {
+		Expect(os.Setenv(configEnv, file)).To(Succeed())
+		loader, err := NewLoader().Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loader.File()).To(Equal(file))
+	})
+
+	It("Gives precedence to environment and overrides", func() {
+		err := Write(file, &Config{
+			URL:          "https://file.example.com",
+			ClientID:     "file-client",
+			RefreshToken: "file-refresh",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Setenv(urlEnv, "https://env.example.com")).To(Succeed())
+		Expect(os.Setenv(tokenEnv, "env-token")).To(Succeed())
+		Expect(os.Setenv(scopesEnv, "openid, offline_access")).To(Succeed())
+		loader, err := NewLoader().
+			File(file).
+			Override(&Config{
+				ClientID: "override-client",
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		config, err := loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.URL).To(Equal("https://env.example.com"))
+		Expect(config.ClientID).To(Equal("override-client"))
+		Expect(config.RefreshToken).To(Equal("env-token"))
+		Expect(config.Scopes).To(Equal([]string{"openid", "offline_access"}))
+	})
+
+	It("Ignores the environment if requested", func() {
+		Expect(os.Setenv(urlEnv, "https://env.example.com")).To(Succeed())
+		loader, err := NewLoader().File(file).Environment(false).Build()
+		Expect(err).ToNot(HaveOccurred())
+		config, err := loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.URL).To(BeEmpty())
+	})
+
+	It("Fails if the file isn't valid", func() {
+		err := os.MkdirAll(filepath.Dir(file), 0700)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.WriteFile(file, []byte("junk"), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		loader, err := NewLoader().File(file).Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = loader.Load()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(file))
+	})
+
+	It("Creates a connection builder", func() {
+		server := MakeTCPServer()
+		defer server.Close()
+		config := &Config{
+			URL:         server.URL(),
+			AccessToken: MakeTokenString("Bearer", 5*time.Minute),
+		}
+		connection, err := config.ConnectionBuilder(logger).BuildContext(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			Expect(connection.Close()).To(Succeed())
+		}()
+		Expect(connection.URL()).To(Equal(server.URL()))
+	})
+})
@@ -0,0 +1,2616 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that convert objects and enumerated values of the clusters management
+// service between versions 1 and 2alpha1 of the API. Objects are converted writing them in the JSON
+// format of one version and reading that text with the other version.
+
+package conversion // github.com/openshift-online/ocm-sdk-go/conversion
+
+import (
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	cmv2alpha1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v2alpha1"
+)
+
+// AMIOverrideToV2alpha1 converts a version 1 'AMI_override' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func AMIOverrideToV2alpha1(object *cmv1.AMIOverride) (*cmv2alpha1.AMIOverride, error) {
+	return convert(object, cmv1.MarshalAMIOverride, cmv2alpha1.UnmarshalAMIOverride)
+}
+
+// AMIOverrideToV1 converts a version 2alpha1 'AMI_override' object of the clusters management
+// service into the equivalent version 1 object.
+func AMIOverrideToV1(object *cmv2alpha1.AMIOverride) (*cmv1.AMIOverride, error) {
+	return convert(object, cmv2alpha1.MarshalAMIOverride, cmv1.UnmarshalAMIOverride)
+}
+
+// AWSToV2alpha1 converts a version 1 'AWS' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func AWSToV2alpha1(object *cmv1.AWS) (*cmv2alpha1.AWS, error) {
+	return convert(object, cmv1.MarshalAWS, cmv2alpha1.UnmarshalAWS)
+}
+
+// AWSToV1 converts a version 2alpha1 'AWS' object of the clusters management service into the
+// equivalent version 1 object.
+func AWSToV1(object *cmv2alpha1.AWS) (*cmv1.AWS, error) {
+	return convert(object, cmv2alpha1.MarshalAWS, cmv1.UnmarshalAWS)
+}
+
+// AWSFlavourToV2alpha1 converts a version 1 'AWS_flavour' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func AWSFlavourToV2alpha1(object *cmv1.AWSFlavour) (*cmv2alpha1.AWSFlavour, error) {
+	return convert(object, cmv1.MarshalAWSFlavour, cmv2alpha1.UnmarshalAWSFlavour)
+}
+
+// AWSFlavourToV1 converts a version 2alpha1 'AWS_flavour' object of the clusters management service
+// into the equivalent version 1 object.
+func AWSFlavourToV1(object *cmv2alpha1.AWSFlavour) (*cmv1.AWSFlavour, error) {
+	return convert(object, cmv2alpha1.MarshalAWSFlavour, cmv1.UnmarshalAWSFlavour)
+}
+
+// AWSInfrastructureAccessRoleToV2alpha1 converts a version 1 'AWS_infrastructure_access_role'
+// object of the clusters management service into the equivalent version 2alpha1 object.
+func AWSInfrastructureAccessRoleToV2alpha1(object *cmv1.AWSInfrastructureAccessRole) (*cmv2alpha1.AWSInfrastructureAccessRole, error) {
+	return convert(object, cmv1.MarshalAWSInfrastructureAccessRole, cmv2alpha1.UnmarshalAWSInfrastructureAccessRole)
+}
+
+// AWSInfrastructureAccessRoleToV1 converts a version 2alpha1 'AWS_infrastructure_access_role'
+// object of the clusters management service into the equivalent version 1 object.
+func AWSInfrastructureAccessRoleToV1(object *cmv2alpha1.AWSInfrastructureAccessRole) (*cmv1.AWSInfrastructureAccessRole, error) {
+	return convert(object, cmv2alpha1.MarshalAWSInfrastructureAccessRole, cmv1.UnmarshalAWSInfrastructureAccessRole)
+}
+
+// AWSInfrastructureAccessRoleGrantToV2alpha1 converts a version 1
+// 'AWS_infrastructure_access_role_grant' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func AWSInfrastructureAccessRoleGrantToV2alpha1(object *cmv1.AWSInfrastructureAccessRoleGrant) (*cmv2alpha1.AWSInfrastructureAccessRoleGrant, error) {
+	return convert(object, cmv1.MarshalAWSInfrastructureAccessRoleGrant, cmv2alpha1.UnmarshalAWSInfrastructureAccessRoleGrant)
+}
+
+// AWSInfrastructureAccessRoleGrantToV1 converts a version 2alpha1
+// 'AWS_infrastructure_access_role_grant' object of the clusters management service into the
+// equivalent version 1 object.
+func AWSInfrastructureAccessRoleGrantToV1(object *cmv2alpha1.AWSInfrastructureAccessRoleGrant) (*cmv1.AWSInfrastructureAccessRoleGrant, error) {
+	return convert(object, cmv2alpha1.MarshalAWSInfrastructureAccessRoleGrant, cmv1.UnmarshalAWSInfrastructureAccessRoleGrant)
+}
+
+// AWSMachinePoolToV2alpha1 converts a version 1 'AWS_machine_pool' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AWSMachinePoolToV2alpha1(object *cmv1.AWSMachinePool) (*cmv2alpha1.AWSMachinePool, error) {
+	return convert(object, cmv1.MarshalAWSMachinePool, cmv2alpha1.UnmarshalAWSMachinePool)
+}
+
+// AWSMachinePoolToV1 converts a version 2alpha1 'AWS_machine_pool' object of the clusters
+// management service into the equivalent version 1 object.
+func AWSMachinePoolToV1(object *cmv2alpha1.AWSMachinePool) (*cmv1.AWSMachinePool, error) {
+	return convert(object, cmv2alpha1.MarshalAWSMachinePool, cmv1.UnmarshalAWSMachinePool)
+}
+
+// AWSNodePoolToV2alpha1 converts a version 1 'AWS_node_pool' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func AWSNodePoolToV2alpha1(object *cmv1.AWSNodePool) (*cmv2alpha1.AWSNodePool, error) {
+	return convert(object, cmv1.MarshalAWSNodePool, cmv2alpha1.UnmarshalAWSNodePool)
+}
+
+// AWSNodePoolToV1 converts a version 2alpha1 'AWS_node_pool' object of the clusters management
+// service into the equivalent version 1 object.
+func AWSNodePoolToV1(object *cmv2alpha1.AWSNodePool) (*cmv1.AWSNodePool, error) {
+	return convert(object, cmv2alpha1.MarshalAWSNodePool, cmv1.UnmarshalAWSNodePool)
+}
+
+// AWSSTSAccountRoleToV2alpha1 converts a version 1 'AWSSTS_account_role' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AWSSTSAccountRoleToV2alpha1(object *cmv1.AWSSTSAccountRole) (*cmv2alpha1.AWSSTSAccountRole, error) {
+	return convert(object, cmv1.MarshalAWSSTSAccountRole, cmv2alpha1.UnmarshalAWSSTSAccountRole)
+}
+
+// AWSSTSAccountRoleToV1 converts a version 2alpha1 'AWSSTS_account_role' object of the clusters
+// management service into the equivalent version 1 object.
+func AWSSTSAccountRoleToV1(object *cmv2alpha1.AWSSTSAccountRole) (*cmv1.AWSSTSAccountRole, error) {
+	return convert(object, cmv2alpha1.MarshalAWSSTSAccountRole, cmv1.UnmarshalAWSSTSAccountRole)
+}
+
+// AWSSTSPolicyToV2alpha1 converts a version 1 'AWSSTS_policy' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func AWSSTSPolicyToV2alpha1(object *cmv1.AWSSTSPolicy) (*cmv2alpha1.AWSSTSPolicy, error) {
+	return convert(object, cmv1.MarshalAWSSTSPolicy, cmv2alpha1.UnmarshalAWSSTSPolicy)
+}
+
+// AWSSTSPolicyToV1 converts a version 2alpha1 'AWSSTS_policy' object of the clusters management
+// service into the equivalent version 1 object.
+func AWSSTSPolicyToV1(object *cmv2alpha1.AWSSTSPolicy) (*cmv1.AWSSTSPolicy, error) {
+	return convert(object, cmv2alpha1.MarshalAWSSTSPolicy, cmv1.UnmarshalAWSSTSPolicy)
+}
+
+// AWSSTSRoleToV2alpha1 converts a version 1 'AWSSTS_role' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func AWSSTSRoleToV2alpha1(object *cmv1.AWSSTSRole) (*cmv2alpha1.AWSSTSRole, error) {
+	return convert(object, cmv1.MarshalAWSSTSRole, cmv2alpha1.UnmarshalAWSSTSRole)
+}
+
+// AWSSTSRoleToV1 converts a version 2alpha1 'AWSSTS_role' object of the clusters management service
+// into the equivalent version 1 object.
+func AWSSTSRoleToV1(object *cmv2alpha1.AWSSTSRole) (*cmv1.AWSSTSRole, error) {
+	return convert(object, cmv2alpha1.MarshalAWSSTSRole, cmv1.UnmarshalAWSSTSRole)
+}
+
+// AWSSpotMarketOptionsToV2alpha1 converts a version 1 'AWS_spot_market_options' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func AWSSpotMarketOptionsToV2alpha1(object *cmv1.AWSSpotMarketOptions) (*cmv2alpha1.AWSSpotMarketOptions, error) {
+	return convert(object, cmv1.MarshalAWSSpotMarketOptions, cmv2alpha1.UnmarshalAWSSpotMarketOptions)
+}
+
+// AWSSpotMarketOptionsToV1 converts a version 2alpha1 'AWS_spot_market_options' object of the
+// clusters management service into the equivalent version 1 object.
+func AWSSpotMarketOptionsToV1(object *cmv2alpha1.AWSSpotMarketOptions) (*cmv1.AWSSpotMarketOptions, error) {
+	return convert(object, cmv2alpha1.MarshalAWSSpotMarketOptions, cmv1.UnmarshalAWSSpotMarketOptions)
+}
+
+// AWSVolumeToV2alpha1 converts a version 1 'AWS_volume' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func AWSVolumeToV2alpha1(object *cmv1.AWSVolume) (*cmv2alpha1.AWSVolume, error) {
+	return convert(object, cmv1.MarshalAWSVolume, cmv2alpha1.UnmarshalAWSVolume)
+}
+
+// AWSVolumeToV1 converts a version 2alpha1 'AWS_volume' object of the clusters management service
+// into the equivalent version 1 object.
+func AWSVolumeToV1(object *cmv2alpha1.AWSVolume) (*cmv1.AWSVolume, error) {
+	return convert(object, cmv2alpha1.MarshalAWSVolume, cmv1.UnmarshalAWSVolume)
+}
+
+// AddOnToV2alpha1 converts a version 1 'add_on' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func AddOnToV2alpha1(object *cmv1.AddOn) (*cmv2alpha1.AddOn, error) {
+	return convert(object, cmv1.MarshalAddOn, cmv2alpha1.UnmarshalAddOn)
+}
+
+// AddOnToV1 converts a version 2alpha1 'add_on' object of the clusters management service into the
+// equivalent version 1 object.
+func AddOnToV1(object *cmv2alpha1.AddOn) (*cmv1.AddOn, error) {
+	return convert(object, cmv2alpha1.MarshalAddOn, cmv1.UnmarshalAddOn)
+}
+
+// AddOnConfigToV2alpha1 converts a version 1 'add_on_config' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func AddOnConfigToV2alpha1(object *cmv1.AddOnConfig) (*cmv2alpha1.AddOnConfig, error) {
+	return convert(object, cmv1.MarshalAddOnConfig, cmv2alpha1.UnmarshalAddOnConfig)
+}
+
+// AddOnConfigToV1 converts a version 2alpha1 'add_on_config' object of the clusters management
+// service into the equivalent version 1 object.
+func AddOnConfigToV1(object *cmv2alpha1.AddOnConfig) (*cmv1.AddOnConfig, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnConfig, cmv1.UnmarshalAddOnConfig)
+}
+
+// AddOnEnvironmentVariableToV2alpha1 converts a version 1 'add_on_environment_variable' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func AddOnEnvironmentVariableToV2alpha1(object *cmv1.AddOnEnvironmentVariable) (*cmv2alpha1.AddOnEnvironmentVariable, error) {
+	return convert(object, cmv1.MarshalAddOnEnvironmentVariable, cmv2alpha1.UnmarshalAddOnEnvironmentVariable)
+}
+
+// AddOnEnvironmentVariableToV1 converts a version 2alpha1 'add_on_environment_variable' object of
+// the clusters management service into the equivalent version 1 object.
+func AddOnEnvironmentVariableToV1(object *cmv2alpha1.AddOnEnvironmentVariable) (*cmv1.AddOnEnvironmentVariable, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnEnvironmentVariable, cmv1.UnmarshalAddOnEnvironmentVariable)
+}
+
+// AddOnInstallationToV2alpha1 converts a version 1 'add_on_installation' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AddOnInstallationToV2alpha1(object *cmv1.AddOnInstallation) (*cmv2alpha1.AddOnInstallation, error) {
+	return convert(object, cmv1.MarshalAddOnInstallation, cmv2alpha1.UnmarshalAddOnInstallation)
+}
+
+// AddOnInstallationToV1 converts a version 2alpha1 'add_on_installation' object of the clusters
+// management service into the equivalent version 1 object.
+func AddOnInstallationToV1(object *cmv2alpha1.AddOnInstallation) (*cmv1.AddOnInstallation, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnInstallation, cmv1.UnmarshalAddOnInstallation)
+}
+
+// AddOnInstallationBillingToV2alpha1 converts a version 1 'add_on_installation_billing' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func AddOnInstallationBillingToV2alpha1(object *cmv1.AddOnInstallationBilling) (*cmv2alpha1.AddOnInstallationBilling, error) {
+	return convert(object, cmv1.MarshalAddOnInstallationBilling, cmv2alpha1.UnmarshalAddOnInstallationBilling)
+}
+
+// AddOnInstallationBillingToV1 converts a version 2alpha1 'add_on_installation_billing' object of
+// the clusters management service into the equivalent version 1 object.
+func AddOnInstallationBillingToV1(object *cmv2alpha1.AddOnInstallationBilling) (*cmv1.AddOnInstallationBilling, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnInstallationBilling, cmv1.UnmarshalAddOnInstallationBilling)
+}
+
+// AddOnInstallationParameterToV2alpha1 converts a version 1 'add_on_installation_parameter' object
+// of the clusters management service into the equivalent version 2alpha1 object.
+func AddOnInstallationParameterToV2alpha1(object *cmv1.AddOnInstallationParameter) (*cmv2alpha1.AddOnInstallationParameter, error) {
+	return convert(object, cmv1.MarshalAddOnInstallationParameter, cmv2alpha1.UnmarshalAddOnInstallationParameter)
+}
+
+// AddOnInstallationParameterToV1 converts a version 2alpha1 'add_on_installation_parameter' object
+// of the clusters management service into the equivalent version 1 object.
+func AddOnInstallationParameterToV1(object *cmv2alpha1.AddOnInstallationParameter) (*cmv1.AddOnInstallationParameter, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnInstallationParameter, cmv1.UnmarshalAddOnInstallationParameter)
+}
+
+// AddOnNamespaceToV2alpha1 converts a version 1 'add_on_namespace' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AddOnNamespaceToV2alpha1(object *cmv1.AddOnNamespace) (*cmv2alpha1.AddOnNamespace, error) {
+	return convert(object, cmv1.MarshalAddOnNamespace, cmv2alpha1.UnmarshalAddOnNamespace)
+}
+
+// AddOnNamespaceToV1 converts a version 2alpha1 'add_on_namespace' object of the clusters
+// management service into the equivalent version 1 object.
+func AddOnNamespaceToV1(object *cmv2alpha1.AddOnNamespace) (*cmv1.AddOnNamespace, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnNamespace, cmv1.UnmarshalAddOnNamespace)
+}
+
+// AddOnParameterToV2alpha1 converts a version 1 'add_on_parameter' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AddOnParameterToV2alpha1(object *cmv1.AddOnParameter) (*cmv2alpha1.AddOnParameter, error) {
+	return convert(object, cmv1.MarshalAddOnParameter, cmv2alpha1.UnmarshalAddOnParameter)
+}
+
+// AddOnParameterToV1 converts a version 2alpha1 'add_on_parameter' object of the clusters
+// management service into the equivalent version 1 object.
+func AddOnParameterToV1(object *cmv2alpha1.AddOnParameter) (*cmv1.AddOnParameter, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnParameter, cmv1.UnmarshalAddOnParameter)
+}
+
+// AddOnParameterOptionToV2alpha1 converts a version 1 'add_on_parameter_option' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func AddOnParameterOptionToV2alpha1(object *cmv1.AddOnParameterOption) (*cmv2alpha1.AddOnParameterOption, error) {
+	return convert(object, cmv1.MarshalAddOnParameterOption, cmv2alpha1.UnmarshalAddOnParameterOption)
+}
+
+// AddOnParameterOptionToV1 converts a version 2alpha1 'add_on_parameter_option' object of the
+// clusters management service into the equivalent version 1 object.
+func AddOnParameterOptionToV1(object *cmv2alpha1.AddOnParameterOption) (*cmv1.AddOnParameterOption, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnParameterOption, cmv1.UnmarshalAddOnParameterOption)
+}
+
+// AddOnRequirementToV2alpha1 converts a version 1 'add_on_requirement' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AddOnRequirementToV2alpha1(object *cmv1.AddOnRequirement) (*cmv2alpha1.AddOnRequirement, error) {
+	return convert(object, cmv1.MarshalAddOnRequirement, cmv2alpha1.UnmarshalAddOnRequirement)
+}
+
+// AddOnRequirementToV1 converts a version 2alpha1 'add_on_requirement' object of the clusters
+// management service into the equivalent version 1 object.
+func AddOnRequirementToV1(object *cmv2alpha1.AddOnRequirement) (*cmv1.AddOnRequirement, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnRequirement, cmv1.UnmarshalAddOnRequirement)
+}
+
+// AddOnRequirementStatusToV2alpha1 converts a version 1 'add_on_requirement_status' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func AddOnRequirementStatusToV2alpha1(object *cmv1.AddOnRequirementStatus) (*cmv2alpha1.AddOnRequirementStatus, error) {
+	return convert(object, cmv1.MarshalAddOnRequirementStatus, cmv2alpha1.UnmarshalAddOnRequirementStatus)
+}
+
+// AddOnRequirementStatusToV1 converts a version 2alpha1 'add_on_requirement_status' object of the
+// clusters management service into the equivalent version 1 object.
+func AddOnRequirementStatusToV1(object *cmv2alpha1.AddOnRequirementStatus) (*cmv1.AddOnRequirementStatus, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnRequirementStatus, cmv1.UnmarshalAddOnRequirementStatus)
+}
+
+// AddOnSecretPropagationToV2alpha1 converts a version 1 'add_on_secret_propagation' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func AddOnSecretPropagationToV2alpha1(object *cmv1.AddOnSecretPropagation) (*cmv2alpha1.AddOnSecretPropagation, error) {
+	return convert(object, cmv1.MarshalAddOnSecretPropagation, cmv2alpha1.UnmarshalAddOnSecretPropagation)
+}
+
+// AddOnSecretPropagationToV1 converts a version 2alpha1 'add_on_secret_propagation' object of the
+// clusters management service into the equivalent version 1 object.
+func AddOnSecretPropagationToV1(object *cmv2alpha1.AddOnSecretPropagation) (*cmv1.AddOnSecretPropagation, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnSecretPropagation, cmv1.UnmarshalAddOnSecretPropagation)
+}
+
+// AddOnSubOperatorToV2alpha1 converts a version 1 'add_on_sub_operator' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AddOnSubOperatorToV2alpha1(object *cmv1.AddOnSubOperator) (*cmv2alpha1.AddOnSubOperator, error) {
+	return convert(object, cmv1.MarshalAddOnSubOperator, cmv2alpha1.UnmarshalAddOnSubOperator)
+}
+
+// AddOnSubOperatorToV1 converts a version 2alpha1 'add_on_sub_operator' object of the clusters
+// management service into the equivalent version 1 object.
+func AddOnSubOperatorToV1(object *cmv2alpha1.AddOnSubOperator) (*cmv1.AddOnSubOperator, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnSubOperator, cmv1.UnmarshalAddOnSubOperator)
+}
+
+// AddOnVersionToV2alpha1 converts a version 1 'add_on_version' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func AddOnVersionToV2alpha1(object *cmv1.AddOnVersion) (*cmv2alpha1.AddOnVersion, error) {
+	return convert(object, cmv1.MarshalAddOnVersion, cmv2alpha1.UnmarshalAddOnVersion)
+}
+
+// AddOnVersionToV1 converts a version 2alpha1 'add_on_version' object of the clusters management
+// service into the equivalent version 1 object.
+func AddOnVersionToV1(object *cmv2alpha1.AddOnVersion) (*cmv1.AddOnVersion, error) {
+	return convert(object, cmv2alpha1.MarshalAddOnVersion, cmv1.UnmarshalAddOnVersion)
+}
+
+// AdditionalCatalogSourceToV2alpha1 converts a version 1 'additional_catalog_source' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func AdditionalCatalogSourceToV2alpha1(object *cmv1.AdditionalCatalogSource) (*cmv2alpha1.AdditionalCatalogSource, error) {
+	return convert(object, cmv1.MarshalAdditionalCatalogSource, cmv2alpha1.UnmarshalAdditionalCatalogSource)
+}
+
+// AdditionalCatalogSourceToV1 converts a version 2alpha1 'additional_catalog_source' object of the
+// clusters management service into the equivalent version 1 object.
+func AdditionalCatalogSourceToV1(object *cmv2alpha1.AdditionalCatalogSource) (*cmv1.AdditionalCatalogSource, error) {
+	return convert(object, cmv2alpha1.MarshalAdditionalCatalogSource, cmv1.UnmarshalAdditionalCatalogSource)
+}
+
+// AddonUpgradePolicyToV2alpha1 converts a version 1 'addon_upgrade_policy' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AddonUpgradePolicyToV2alpha1(object *cmv1.AddonUpgradePolicy) (*cmv2alpha1.AddonUpgradePolicy, error) {
+	return convert(object, cmv1.MarshalAddonUpgradePolicy, cmv2alpha1.UnmarshalAddonUpgradePolicy)
+}
+
+// AddonUpgradePolicyToV1 converts a version 2alpha1 'addon_upgrade_policy' object of the clusters
+// management service into the equivalent version 1 object.
+func AddonUpgradePolicyToV1(object *cmv2alpha1.AddonUpgradePolicy) (*cmv1.AddonUpgradePolicy, error) {
+	return convert(object, cmv2alpha1.MarshalAddonUpgradePolicy, cmv1.UnmarshalAddonUpgradePolicy)
+}
+
+// AddonUpgradePolicyStateToV2alpha1 converts a version 1 'addon_upgrade_policy_state' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func AddonUpgradePolicyStateToV2alpha1(object *cmv1.AddonUpgradePolicyState) (*cmv2alpha1.AddonUpgradePolicyState, error) {
+	return convert(object, cmv1.MarshalAddonUpgradePolicyState, cmv2alpha1.UnmarshalAddonUpgradePolicyState)
+}
+
+// AddonUpgradePolicyStateToV1 converts a version 2alpha1 'addon_upgrade_policy_state' object of the
+// clusters management service into the equivalent version 1 object.
+func AddonUpgradePolicyStateToV1(object *cmv2alpha1.AddonUpgradePolicyState) (*cmv1.AddonUpgradePolicyState, error) {
+	return convert(object, cmv2alpha1.MarshalAddonUpgradePolicyState, cmv1.UnmarshalAddonUpgradePolicyState)
+}
+
+// AdminCredentialsToV2alpha1 converts a version 1 'admin_credentials' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AdminCredentialsToV2alpha1(object *cmv1.AdminCredentials) (*cmv2alpha1.AdminCredentials, error) {
+	return convert(object, cmv1.MarshalAdminCredentials, cmv2alpha1.UnmarshalAdminCredentials)
+}
+
+// AdminCredentialsToV1 converts a version 2alpha1 'admin_credentials' object of the clusters
+// management service into the equivalent version 1 object.
+func AdminCredentialsToV1(object *cmv2alpha1.AdminCredentials) (*cmv1.AdminCredentials, error) {
+	return convert(object, cmv2alpha1.MarshalAdminCredentials, cmv1.UnmarshalAdminCredentials)
+}
+
+// AlertInfoToV2alpha1 converts a version 1 'alert_info' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func AlertInfoToV2alpha1(object *cmv1.AlertInfo) (*cmv2alpha1.AlertInfo, error) {
+	return convert(object, cmv1.MarshalAlertInfo, cmv2alpha1.UnmarshalAlertInfo)
+}
+
+// AlertInfoToV1 converts a version 2alpha1 'alert_info' object of the clusters management service
+// into the equivalent version 1 object.
+func AlertInfoToV1(object *cmv2alpha1.AlertInfo) (*cmv1.AlertInfo, error) {
+	return convert(object, cmv2alpha1.MarshalAlertInfo, cmv1.UnmarshalAlertInfo)
+}
+
+// AlertsInfoToV2alpha1 converts a version 1 'alerts_info' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func AlertsInfoToV2alpha1(object *cmv1.AlertsInfo) (*cmv2alpha1.AlertsInfo, error) {
+	return convert(object, cmv1.MarshalAlertsInfo, cmv2alpha1.UnmarshalAlertsInfo)
+}
+
+// AlertsInfoToV1 converts a version 2alpha1 'alerts_info' object of the clusters management service
+// into the equivalent version 1 object.
+func AlertsInfoToV1(object *cmv2alpha1.AlertsInfo) (*cmv1.AlertsInfo, error) {
+	return convert(object, cmv2alpha1.MarshalAlertsInfo, cmv1.UnmarshalAlertsInfo)
+}
+
+// AuditLogToV2alpha1 converts a version 1 'audit_log' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func AuditLogToV2alpha1(object *cmv1.AuditLog) (*cmv2alpha1.AuditLog, error) {
+	return convert(object, cmv1.MarshalAuditLog, cmv2alpha1.UnmarshalAuditLog)
+}
+
+// AuditLogToV1 converts a version 2alpha1 'audit_log' object of the clusters management service
+// into the equivalent version 1 object.
+func AuditLogToV1(object *cmv2alpha1.AuditLog) (*cmv1.AuditLog, error) {
+	return convert(object, cmv2alpha1.MarshalAuditLog, cmv1.UnmarshalAuditLog)
+}
+
+// AutoscalerResourceLimitsToV2alpha1 converts a version 1 'autoscaler_resource_limits' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func AutoscalerResourceLimitsToV2alpha1(object *cmv1.AutoscalerResourceLimits) (*cmv2alpha1.AutoscalerResourceLimits, error) {
+	return convert(object, cmv1.MarshalAutoscalerResourceLimits, cmv2alpha1.UnmarshalAutoscalerResourceLimits)
+}
+
+// AutoscalerResourceLimitsToV1 converts a version 2alpha1 'autoscaler_resource_limits' object of
+// the clusters management service into the equivalent version 1 object.
+func AutoscalerResourceLimitsToV1(object *cmv2alpha1.AutoscalerResourceLimits) (*cmv1.AutoscalerResourceLimits, error) {
+	return convert(object, cmv2alpha1.MarshalAutoscalerResourceLimits, cmv1.UnmarshalAutoscalerResourceLimits)
+}
+
+// AutoscalerResourceLimitsGPULimitToV2alpha1 converts a version 1
+// 'autoscaler_resource_limits_GPU_limit' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func AutoscalerResourceLimitsGPULimitToV2alpha1(object *cmv1.AutoscalerResourceLimitsGPULimit) (*cmv2alpha1.AutoscalerResourceLimitsGPULimit, error) {
+	return convert(object, cmv1.MarshalAutoscalerResourceLimitsGPULimit, cmv2alpha1.UnmarshalAutoscalerResourceLimitsGPULimit)
+}
+
+// AutoscalerResourceLimitsGPULimitToV1 converts a version 2alpha1
+// 'autoscaler_resource_limits_GPU_limit' object of the clusters management service into the
+// equivalent version 1 object.
+func AutoscalerResourceLimitsGPULimitToV1(object *cmv2alpha1.AutoscalerResourceLimitsGPULimit) (*cmv1.AutoscalerResourceLimitsGPULimit, error) {
+	return convert(object, cmv2alpha1.MarshalAutoscalerResourceLimitsGPULimit, cmv1.UnmarshalAutoscalerResourceLimitsGPULimit)
+}
+
+// AutoscalerScaleDownConfigToV2alpha1 converts a version 1 'autoscaler_scale_down_config' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func AutoscalerScaleDownConfigToV2alpha1(object *cmv1.AutoscalerScaleDownConfig) (*cmv2alpha1.AutoscalerScaleDownConfig, error) {
+	return convert(object, cmv1.MarshalAutoscalerScaleDownConfig, cmv2alpha1.UnmarshalAutoscalerScaleDownConfig)
+}
+
+// AutoscalerScaleDownConfigToV1 converts a version 2alpha1 'autoscaler_scale_down_config' object of
+// the clusters management service into the equivalent version 1 object.
+func AutoscalerScaleDownConfigToV1(object *cmv2alpha1.AutoscalerScaleDownConfig) (*cmv1.AutoscalerScaleDownConfig, error) {
+	return convert(object, cmv2alpha1.MarshalAutoscalerScaleDownConfig, cmv1.UnmarshalAutoscalerScaleDownConfig)
+}
+
+// AwsEtcdEncryptionToV2alpha1 converts a version 1 'aws_etcd_encryption' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func AwsEtcdEncryptionToV2alpha1(object *cmv1.AwsEtcdEncryption) (*cmv2alpha1.AwsEtcdEncryption, error) {
+	return convert(object, cmv1.MarshalAwsEtcdEncryption, cmv2alpha1.UnmarshalAwsEtcdEncryption)
+}
+
+// AwsEtcdEncryptionToV1 converts a version 2alpha1 'aws_etcd_encryption' object of the clusters
+// management service into the equivalent version 1 object.
+func AwsEtcdEncryptionToV1(object *cmv2alpha1.AwsEtcdEncryption) (*cmv1.AwsEtcdEncryption, error) {
+	return convert(object, cmv2alpha1.MarshalAwsEtcdEncryption, cmv1.UnmarshalAwsEtcdEncryption)
+}
+
+// AzureToV2alpha1 converts a version 1 'azure' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func AzureToV2alpha1(object *cmv1.Azure) (*cmv2alpha1.Azure, error) {
+	return convert(object, cmv1.MarshalAzure, cmv2alpha1.UnmarshalAzure)
+}
+
+// AzureToV1 converts a version 2alpha1 'azure' object of the clusters management service into the
+// equivalent version 1 object.
+func AzureToV1(object *cmv2alpha1.Azure) (*cmv1.Azure, error) {
+	return convert(object, cmv2alpha1.MarshalAzure, cmv1.UnmarshalAzure)
+}
+
+// AzureNodePoolToV2alpha1 converts a version 1 'azure_node_pool' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func AzureNodePoolToV2alpha1(object *cmv1.AzureNodePool) (*cmv2alpha1.AzureNodePool, error) {
+	return convert(object, cmv1.MarshalAzureNodePool, cmv2alpha1.UnmarshalAzureNodePool)
+}
+
+// AzureNodePoolToV1 converts a version 2alpha1 'azure_node_pool' object of the clusters management
+// service into the equivalent version 1 object.
+func AzureNodePoolToV1(object *cmv2alpha1.AzureNodePool) (*cmv1.AzureNodePool, error) {
+	return convert(object, cmv2alpha1.MarshalAzureNodePool, cmv1.UnmarshalAzureNodePool)
+}
+
+// BillingModelItemToV2alpha1 converts a version 1 'billing_model_item' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func BillingModelItemToV2alpha1(object *cmv1.BillingModelItem) (*cmv2alpha1.BillingModelItem, error) {
+	return convert(object, cmv1.MarshalBillingModelItem, cmv2alpha1.UnmarshalBillingModelItem)
+}
+
+// BillingModelItemToV1 converts a version 2alpha1 'billing_model_item' object of the clusters
+// management service into the equivalent version 1 object.
+func BillingModelItemToV1(object *cmv2alpha1.BillingModelItem) (*cmv1.BillingModelItem, error) {
+	return convert(object, cmv2alpha1.MarshalBillingModelItem, cmv1.UnmarshalBillingModelItem)
+}
+
+// BreakGlassCredentialToV2alpha1 converts a version 1 'break_glass_credential' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func BreakGlassCredentialToV2alpha1(object *cmv1.BreakGlassCredential) (*cmv2alpha1.BreakGlassCredential, error) {
+	return convert(object, cmv1.MarshalBreakGlassCredential, cmv2alpha1.UnmarshalBreakGlassCredential)
+}
+
+// BreakGlassCredentialToV1 converts a version 2alpha1 'break_glass_credential' object of the
+// clusters management service into the equivalent version 1 object.
+func BreakGlassCredentialToV1(object *cmv2alpha1.BreakGlassCredential) (*cmv1.BreakGlassCredential, error) {
+	return convert(object, cmv2alpha1.MarshalBreakGlassCredential, cmv1.UnmarshalBreakGlassCredential)
+}
+
+// ByoOidcToV2alpha1 converts a version 1 'byo_oidc' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func ByoOidcToV2alpha1(object *cmv1.ByoOidc) (*cmv2alpha1.ByoOidc, error) {
+	return convert(object, cmv1.MarshalByoOidc, cmv2alpha1.UnmarshalByoOidc)
+}
+
+// ByoOidcToV1 converts a version 2alpha1 'byo_oidc' object of the clusters management service into
+// the equivalent version 1 object.
+func ByoOidcToV1(object *cmv2alpha1.ByoOidc) (*cmv1.ByoOidc, error) {
+	return convert(object, cmv2alpha1.MarshalByoOidc, cmv1.UnmarshalByoOidc)
+}
+
+// CCSToV2alpha1 converts a version 1 'CCS' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func CCSToV2alpha1(object *cmv1.CCS) (*cmv2alpha1.CCS, error) {
+	return convert(object, cmv1.MarshalCCS, cmv2alpha1.UnmarshalCCS)
+}
+
+// CCSToV1 converts a version 2alpha1 'CCS' object of the clusters management service into the
+// equivalent version 1 object.
+func CCSToV1(object *cmv2alpha1.CCS) (*cmv1.CCS, error) {
+	return convert(object, cmv2alpha1.MarshalCCS, cmv1.UnmarshalCCS)
+}
+
+// CPUTotalNodeRoleOSMetricNodeToV2alpha1 converts a version 1 'CPU_total_node_role_OS_metric_node'
+// object of the clusters management service into the equivalent version 2alpha1 object.
+func CPUTotalNodeRoleOSMetricNodeToV2alpha1(object *cmv1.CPUTotalNodeRoleOSMetricNode) (*cmv2alpha1.CPUTotalNodeRoleOSMetricNode, error) {
+	return convert(object, cmv1.MarshalCPUTotalNodeRoleOSMetricNode, cmv2alpha1.UnmarshalCPUTotalNodeRoleOSMetricNode)
+}
+
+// CPUTotalNodeRoleOSMetricNodeToV1 converts a version 2alpha1 'CPU_total_node_role_OS_metric_node'
+// object of the clusters management service into the equivalent version 1 object.
+func CPUTotalNodeRoleOSMetricNodeToV1(object *cmv2alpha1.CPUTotalNodeRoleOSMetricNode) (*cmv1.CPUTotalNodeRoleOSMetricNode, error) {
+	return convert(object, cmv2alpha1.MarshalCPUTotalNodeRoleOSMetricNode, cmv1.UnmarshalCPUTotalNodeRoleOSMetricNode)
+}
+
+// CPUTotalsNodeRoleOSMetricNodeToV2alpha1 converts a version 1
+// 'CPU_totals_node_role_OS_metric_node' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func CPUTotalsNodeRoleOSMetricNodeToV2alpha1(object *cmv1.CPUTotalsNodeRoleOSMetricNode) (*cmv2alpha1.CPUTotalsNodeRoleOSMetricNode, error) {
+	return convert(object, cmv1.MarshalCPUTotalsNodeRoleOSMetricNode, cmv2alpha1.UnmarshalCPUTotalsNodeRoleOSMetricNode)
+}
+
+// CPUTotalsNodeRoleOSMetricNodeToV1 converts a version 2alpha1
+// 'CPU_totals_node_role_OS_metric_node' object of the clusters management service into the
+// equivalent version 1 object.
+func CPUTotalsNodeRoleOSMetricNodeToV1(object *cmv2alpha1.CPUTotalsNodeRoleOSMetricNode) (*cmv1.CPUTotalsNodeRoleOSMetricNode, error) {
+	return convert(object, cmv2alpha1.MarshalCPUTotalsNodeRoleOSMetricNode, cmv1.UnmarshalCPUTotalsNodeRoleOSMetricNode)
+}
+
+// ClientComponentToV2alpha1 converts a version 1 'client_component' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ClientComponentToV2alpha1(object *cmv1.ClientComponent) (*cmv2alpha1.ClientComponent, error) {
+	return convert(object, cmv1.MarshalClientComponent, cmv2alpha1.UnmarshalClientComponent)
+}
+
+// ClientComponentToV1 converts a version 2alpha1 'client_component' object of the clusters
+// management service into the equivalent version 1 object.
+func ClientComponentToV1(object *cmv2alpha1.ClientComponent) (*cmv1.ClientComponent, error) {
+	return convert(object, cmv2alpha1.MarshalClientComponent, cmv1.UnmarshalClientComponent)
+}
+
+// CloudProviderToV2alpha1 converts a version 1 'cloud_provider' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func CloudProviderToV2alpha1(object *cmv1.CloudProvider) (*cmv2alpha1.CloudProvider, error) {
+	return convert(object, cmv1.MarshalCloudProvider, cmv2alpha1.UnmarshalCloudProvider)
+}
+
+// CloudProviderToV1 converts a version 2alpha1 'cloud_provider' object of the clusters management
+// service into the equivalent version 1 object.
+func CloudProviderToV1(object *cmv2alpha1.CloudProvider) (*cmv1.CloudProvider, error) {
+	return convert(object, cmv2alpha1.MarshalCloudProvider, cmv1.UnmarshalCloudProvider)
+}
+
+// CloudProviderDataToV2alpha1 converts a version 1 'cloud_provider_data' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func CloudProviderDataToV2alpha1(object *cmv1.CloudProviderData) (*cmv2alpha1.CloudProviderData, error) {
+	return convert(object, cmv1.MarshalCloudProviderData, cmv2alpha1.UnmarshalCloudProviderData)
+}
+
+// CloudProviderDataToV1 converts a version 2alpha1 'cloud_provider_data' object of the clusters
+// management service into the equivalent version 1 object.
+func CloudProviderDataToV1(object *cmv2alpha1.CloudProviderData) (*cmv1.CloudProviderData, error) {
+	return convert(object, cmv2alpha1.MarshalCloudProviderData, cmv1.UnmarshalCloudProviderData)
+}
+
+// CloudRegionToV2alpha1 converts a version 1 'cloud_region' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func CloudRegionToV2alpha1(object *cmv1.CloudRegion) (*cmv2alpha1.CloudRegion, error) {
+	return convert(object, cmv1.MarshalCloudRegion, cmv2alpha1.UnmarshalCloudRegion)
+}
+
+// CloudRegionToV1 converts a version 2alpha1 'cloud_region' object of the clusters management
+// service into the equivalent version 1 object.
+func CloudRegionToV1(object *cmv2alpha1.CloudRegion) (*cmv1.CloudRegion, error) {
+	return convert(object, cmv2alpha1.MarshalCloudRegion, cmv1.UnmarshalCloudRegion)
+}
+
+// CloudVPCToV2alpha1 converts a version 1 'cloud_VPC' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func CloudVPCToV2alpha1(object *cmv1.CloudVPC) (*cmv2alpha1.CloudVPC, error) {
+	return convert(object, cmv1.MarshalCloudVPC, cmv2alpha1.UnmarshalCloudVPC)
+}
+
+// CloudVPCToV1 converts a version 2alpha1 'cloud_VPC' object of the clusters management service
+// into the equivalent version 1 object.
+func CloudVPCToV1(object *cmv2alpha1.CloudVPC) (*cmv1.CloudVPC, error) {
+	return convert(object, cmv2alpha1.MarshalCloudVPC, cmv1.UnmarshalCloudVPC)
+}
+
+// ClusterToV2alpha1 converts a version 1 'cluster' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func ClusterToV2alpha1(object *cmv1.Cluster) (*cmv2alpha1.Cluster, error) {
+	return convert(object, cmv1.MarshalCluster, cmv2alpha1.UnmarshalCluster)
+}
+
+// ClusterToV1 converts a version 2alpha1 'cluster' object of the clusters management service into
+// the equivalent version 1 object.
+func ClusterToV1(object *cmv2alpha1.Cluster) (*cmv1.Cluster, error) {
+	return convert(object, cmv2alpha1.MarshalCluster, cmv1.UnmarshalCluster)
+}
+
+// ClusterAPIToV2alpha1 converts a version 1 'cluster_API' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func ClusterAPIToV2alpha1(object *cmv1.ClusterAPI) (*cmv2alpha1.ClusterAPI, error) {
+	return convert(object, cmv1.MarshalClusterAPI, cmv2alpha1.UnmarshalClusterAPI)
+}
+
+// ClusterAPIToV1 converts a version 2alpha1 'cluster_API' object of the clusters management service
+// into the equivalent version 1 object.
+func ClusterAPIToV1(object *cmv2alpha1.ClusterAPI) (*cmv1.ClusterAPI, error) {
+	return convert(object, cmv2alpha1.MarshalClusterAPI, cmv1.UnmarshalClusterAPI)
+}
+
+// ClusterAutoscalerToV2alpha1 converts a version 1 'cluster_autoscaler' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ClusterAutoscalerToV2alpha1(object *cmv1.ClusterAutoscaler) (*cmv2alpha1.ClusterAutoscaler, error) {
+	return convert(object, cmv1.MarshalClusterAutoscaler, cmv2alpha1.UnmarshalClusterAutoscaler)
+}
+
+// ClusterAutoscalerToV1 converts a version 2alpha1 'cluster_autoscaler' object of the clusters
+// management service into the equivalent version 1 object.
+func ClusterAutoscalerToV1(object *cmv2alpha1.ClusterAutoscaler) (*cmv1.ClusterAutoscaler, error) {
+	return convert(object, cmv2alpha1.MarshalClusterAutoscaler, cmv1.UnmarshalClusterAutoscaler)
+}
+
+// ClusterConsoleToV2alpha1 converts a version 1 'cluster_console' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ClusterConsoleToV2alpha1(object *cmv1.ClusterConsole) (*cmv2alpha1.ClusterConsole, error) {
+	return convert(object, cmv1.MarshalClusterConsole, cmv2alpha1.UnmarshalClusterConsole)
+}
+
+// ClusterConsoleToV1 converts a version 2alpha1 'cluster_console' object of the clusters management
+// service into the equivalent version 1 object.
+func ClusterConsoleToV1(object *cmv2alpha1.ClusterConsole) (*cmv1.ClusterConsole, error) {
+	return convert(object, cmv2alpha1.MarshalClusterConsole, cmv1.UnmarshalClusterConsole)
+}
+
+// ClusterCredentialsToV2alpha1 converts a version 1 'cluster_credentials' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ClusterCredentialsToV2alpha1(object *cmv1.ClusterCredentials) (*cmv2alpha1.ClusterCredentials, error) {
+	return convert(object, cmv1.MarshalClusterCredentials, cmv2alpha1.UnmarshalClusterCredentials)
+}
+
+// ClusterCredentialsToV1 converts a version 2alpha1 'cluster_credentials' object of the clusters
+// management service into the equivalent version 1 object.
+func ClusterCredentialsToV1(object *cmv2alpha1.ClusterCredentials) (*cmv1.ClusterCredentials, error) {
+	return convert(object, cmv2alpha1.MarshalClusterCredentials, cmv1.UnmarshalClusterCredentials)
+}
+
+// ClusterDeploymentToV2alpha1 converts a version 1 'cluster_deployment' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ClusterDeploymentToV2alpha1(object *cmv1.ClusterDeployment) (*cmv2alpha1.ClusterDeployment, error) {
+	return convert(object, cmv1.MarshalClusterDeployment, cmv2alpha1.UnmarshalClusterDeployment)
+}
+
+// ClusterDeploymentToV1 converts a version 2alpha1 'cluster_deployment' object of the clusters
+// management service into the equivalent version 1 object.
+func ClusterDeploymentToV1(object *cmv2alpha1.ClusterDeployment) (*cmv1.ClusterDeployment, error) {
+	return convert(object, cmv2alpha1.MarshalClusterDeployment, cmv1.UnmarshalClusterDeployment)
+}
+
+// ClusterLinkToV2alpha1 converts a version 1 'cluster_link' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ClusterLinkToV2alpha1(object *cmv1.ClusterLink) (*cmv2alpha1.ClusterLink, error) {
+	return convert(object, cmv1.MarshalClusterLink, cmv2alpha1.UnmarshalClusterLink)
+}
+
+// ClusterLinkToV1 converts a version 2alpha1 'cluster_link' object of the clusters management
+// service into the equivalent version 1 object.
+func ClusterLinkToV1(object *cmv2alpha1.ClusterLink) (*cmv1.ClusterLink, error) {
+	return convert(object, cmv2alpha1.MarshalClusterLink, cmv1.UnmarshalClusterLink)
+}
+
+// ClusterNodesToV2alpha1 converts a version 1 'cluster_nodes' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ClusterNodesToV2alpha1(object *cmv1.ClusterNodes) (*cmv2alpha1.ClusterNodes, error) {
+	return convert(object, cmv1.MarshalClusterNodes, cmv2alpha1.UnmarshalClusterNodes)
+}
+
+// ClusterNodesToV1 converts a version 2alpha1 'cluster_nodes' object of the clusters management
+// service into the equivalent version 1 object.
+func ClusterNodesToV1(object *cmv2alpha1.ClusterNodes) (*cmv1.ClusterNodes, error) {
+	return convert(object, cmv2alpha1.MarshalClusterNodes, cmv1.UnmarshalClusterNodes)
+}
+
+// ClusterOperatorInfoToV2alpha1 converts a version 1 'cluster_operator_info' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ClusterOperatorInfoToV2alpha1(object *cmv1.ClusterOperatorInfo) (*cmv2alpha1.ClusterOperatorInfo, error) {
+	return convert(object, cmv1.MarshalClusterOperatorInfo, cmv2alpha1.UnmarshalClusterOperatorInfo)
+}
+
+// ClusterOperatorInfoToV1 converts a version 2alpha1 'cluster_operator_info' object of the clusters
+// management service into the equivalent version 1 object.
+func ClusterOperatorInfoToV1(object *cmv2alpha1.ClusterOperatorInfo) (*cmv1.ClusterOperatorInfo, error) {
+	return convert(object, cmv2alpha1.MarshalClusterOperatorInfo, cmv1.UnmarshalClusterOperatorInfo)
+}
+
+// ClusterOperatorsInfoToV2alpha1 converts a version 1 'cluster_operators_info' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func ClusterOperatorsInfoToV2alpha1(object *cmv1.ClusterOperatorsInfo) (*cmv2alpha1.ClusterOperatorsInfo, error) {
+	return convert(object, cmv1.MarshalClusterOperatorsInfo, cmv2alpha1.UnmarshalClusterOperatorsInfo)
+}
+
+// ClusterOperatorsInfoToV1 converts a version 2alpha1 'cluster_operators_info' object of the
+// clusters management service into the equivalent version 1 object.
+func ClusterOperatorsInfoToV1(object *cmv2alpha1.ClusterOperatorsInfo) (*cmv1.ClusterOperatorsInfo, error) {
+	return convert(object, cmv2alpha1.MarshalClusterOperatorsInfo, cmv1.UnmarshalClusterOperatorsInfo)
+}
+
+// ClusterRegistrationToV2alpha1 converts a version 1 'cluster_registration' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ClusterRegistrationToV2alpha1(object *cmv1.ClusterRegistration) (*cmv2alpha1.ClusterRegistration, error) {
+	return convert(object, cmv1.MarshalClusterRegistration, cmv2alpha1.UnmarshalClusterRegistration)
+}
+
+// ClusterRegistrationToV1 converts a version 2alpha1 'cluster_registration' object of the clusters
+// management service into the equivalent version 1 object.
+func ClusterRegistrationToV1(object *cmv2alpha1.ClusterRegistration) (*cmv1.ClusterRegistration, error) {
+	return convert(object, cmv2alpha1.MarshalClusterRegistration, cmv1.UnmarshalClusterRegistration)
+}
+
+// ClusterResourcesToV2alpha1 converts a version 1 'cluster_resources' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ClusterResourcesToV2alpha1(object *cmv1.ClusterResources) (*cmv2alpha1.ClusterResources, error) {
+	return convert(object, cmv1.MarshalClusterResources, cmv2alpha1.UnmarshalClusterResources)
+}
+
+// ClusterResourcesToV1 converts a version 2alpha1 'cluster_resources' object of the clusters
+// management service into the equivalent version 1 object.
+func ClusterResourcesToV1(object *cmv2alpha1.ClusterResources) (*cmv1.ClusterResources, error) {
+	return convert(object, cmv2alpha1.MarshalClusterResources, cmv1.UnmarshalClusterResources)
+}
+
+// ClusterStatusToV2alpha1 converts a version 1 'cluster_status' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ClusterStatusToV2alpha1(object *cmv1.ClusterStatus) (*cmv2alpha1.ClusterStatus, error) {
+	return convert(object, cmv1.MarshalClusterStatus, cmv2alpha1.UnmarshalClusterStatus)
+}
+
+// ClusterStatusToV1 converts a version 2alpha1 'cluster_status' object of the clusters management
+// service into the equivalent version 1 object.
+func ClusterStatusToV1(object *cmv2alpha1.ClusterStatus) (*cmv1.ClusterStatus, error) {
+	return convert(object, cmv2alpha1.MarshalClusterStatus, cmv1.UnmarshalClusterStatus)
+}
+
+// ComponentRouteToV2alpha1 converts a version 1 'component_route' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ComponentRouteToV2alpha1(object *cmv1.ComponentRoute) (*cmv2alpha1.ComponentRoute, error) {
+	return convert(object, cmv1.MarshalComponentRoute, cmv2alpha1.UnmarshalComponentRoute)
+}
+
+// ComponentRouteToV1 converts a version 2alpha1 'component_route' object of the clusters management
+// service into the equivalent version 1 object.
+func ComponentRouteToV1(object *cmv2alpha1.ComponentRoute) (*cmv1.ComponentRoute, error) {
+	return convert(object, cmv2alpha1.MarshalComponentRoute, cmv1.UnmarshalComponentRoute)
+}
+
+// ControlPlaneUpgradePolicyToV2alpha1 converts a version 1 'control_plane_upgrade_policy' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func ControlPlaneUpgradePolicyToV2alpha1(object *cmv1.ControlPlaneUpgradePolicy) (*cmv2alpha1.ControlPlaneUpgradePolicy, error) {
+	return convert(object, cmv1.MarshalControlPlaneUpgradePolicy, cmv2alpha1.UnmarshalControlPlaneUpgradePolicy)
+}
+
+// ControlPlaneUpgradePolicyToV1 converts a version 2alpha1 'control_plane_upgrade_policy' object of
+// the clusters management service into the equivalent version 1 object.
+func ControlPlaneUpgradePolicyToV1(object *cmv2alpha1.ControlPlaneUpgradePolicy) (*cmv1.ControlPlaneUpgradePolicy, error) {
+	return convert(object, cmv2alpha1.MarshalControlPlaneUpgradePolicy, cmv1.UnmarshalControlPlaneUpgradePolicy)
+}
+
+// CredentialRequestToV2alpha1 converts a version 1 'credential_request' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func CredentialRequestToV2alpha1(object *cmv1.CredentialRequest) (*cmv2alpha1.CredentialRequest, error) {
+	return convert(object, cmv1.MarshalCredentialRequest, cmv2alpha1.UnmarshalCredentialRequest)
+}
+
+// CredentialRequestToV1 converts a version 2alpha1 'credential_request' object of the clusters
+// management service into the equivalent version 1 object.
+func CredentialRequestToV1(object *cmv2alpha1.CredentialRequest) (*cmv1.CredentialRequest, error) {
+	return convert(object, cmv2alpha1.MarshalCredentialRequest, cmv1.UnmarshalCredentialRequest)
+}
+
+// DNSToV2alpha1 converts a version 1 'DNS' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func DNSToV2alpha1(object *cmv1.DNS) (*cmv2alpha1.DNS, error) {
+	return convert(object, cmv1.MarshalDNS, cmv2alpha1.UnmarshalDNS)
+}
+
+// DNSToV1 converts a version 2alpha1 'DNS' object of the clusters management service into the
+// equivalent version 1 object.
+func DNSToV1(object *cmv2alpha1.DNS) (*cmv1.DNS, error) {
+	return convert(object, cmv2alpha1.MarshalDNS, cmv1.UnmarshalDNS)
+}
+
+// DNSDomainToV2alpha1 converts a version 1 'DNS_domain' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func DNSDomainToV2alpha1(object *cmv1.DNSDomain) (*cmv2alpha1.DNSDomain, error) {
+	return convert(object, cmv1.MarshalDNSDomain, cmv2alpha1.UnmarshalDNSDomain)
+}
+
+// DNSDomainToV1 converts a version 2alpha1 'DNS_domain' object of the clusters management service
+// into the equivalent version 1 object.
+func DNSDomainToV1(object *cmv2alpha1.DNSDomain) (*cmv1.DNSDomain, error) {
+	return convert(object, cmv2alpha1.MarshalDNSDomain, cmv1.UnmarshalDNSDomain)
+}
+
+// DeleteProtectionToV2alpha1 converts a version 1 'delete_protection' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func DeleteProtectionToV2alpha1(object *cmv1.DeleteProtection) (*cmv2alpha1.DeleteProtection, error) {
+	return convert(object, cmv1.MarshalDeleteProtection, cmv2alpha1.UnmarshalDeleteProtection)
+}
+
+// DeleteProtectionToV1 converts a version 2alpha1 'delete_protection' object of the clusters
+// management service into the equivalent version 1 object.
+func DeleteProtectionToV1(object *cmv2alpha1.DeleteProtection) (*cmv1.DeleteProtection, error) {
+	return convert(object, cmv2alpha1.MarshalDeleteProtection, cmv1.UnmarshalDeleteProtection)
+}
+
+// EncryptionKeyToV2alpha1 converts a version 1 'encryption_key' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func EncryptionKeyToV2alpha1(object *cmv1.EncryptionKey) (*cmv2alpha1.EncryptionKey, error) {
+	return convert(object, cmv1.MarshalEncryptionKey, cmv2alpha1.UnmarshalEncryptionKey)
+}
+
+// EncryptionKeyToV1 converts a version 2alpha1 'encryption_key' object of the clusters management
+// service into the equivalent version 1 object.
+func EncryptionKeyToV1(object *cmv2alpha1.EncryptionKey) (*cmv1.EncryptionKey, error) {
+	return convert(object, cmv2alpha1.MarshalEncryptionKey, cmv1.UnmarshalEncryptionKey)
+}
+
+// EnvironmentToV2alpha1 converts a version 1 'environment' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func EnvironmentToV2alpha1(object *cmv1.Environment) (*cmv2alpha1.Environment, error) {
+	return convert(object, cmv1.MarshalEnvironment, cmv2alpha1.UnmarshalEnvironment)
+}
+
+// EnvironmentToV1 converts a version 2alpha1 'environment' object of the clusters management
+// service into the equivalent version 1 object.
+func EnvironmentToV1(object *cmv2alpha1.Environment) (*cmv1.Environment, error) {
+	return convert(object, cmv2alpha1.MarshalEnvironment, cmv1.UnmarshalEnvironment)
+}
+
+// EventToV2alpha1 converts a version 1 'event' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func EventToV2alpha1(object *cmv1.Event) (*cmv2alpha1.Event, error) {
+	return convert(object, cmv1.MarshalEvent, cmv2alpha1.UnmarshalEvent)
+}
+
+// EventToV1 converts a version 2alpha1 'event' object of the clusters management service into the
+// equivalent version 1 object.
+func EventToV1(object *cmv2alpha1.Event) (*cmv1.Event, error) {
+	return convert(object, cmv2alpha1.MarshalEvent, cmv1.UnmarshalEvent)
+}
+
+// ExternalAuthToV2alpha1 converts a version 1 'external_auth' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ExternalAuthToV2alpha1(object *cmv1.ExternalAuth) (*cmv2alpha1.ExternalAuth, error) {
+	return convert(object, cmv1.MarshalExternalAuth, cmv2alpha1.UnmarshalExternalAuth)
+}
+
+// ExternalAuthToV1 converts a version 2alpha1 'external_auth' object of the clusters management
+// service into the equivalent version 1 object.
+func ExternalAuthToV1(object *cmv2alpha1.ExternalAuth) (*cmv1.ExternalAuth, error) {
+	return convert(object, cmv2alpha1.MarshalExternalAuth, cmv1.UnmarshalExternalAuth)
+}
+
+// ExternalAuthClaimToV2alpha1 converts a version 1 'external_auth_claim' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ExternalAuthClaimToV2alpha1(object *cmv1.ExternalAuthClaim) (*cmv2alpha1.ExternalAuthClaim, error) {
+	return convert(object, cmv1.MarshalExternalAuthClaim, cmv2alpha1.UnmarshalExternalAuthClaim)
+}
+
+// ExternalAuthClaimToV1 converts a version 2alpha1 'external_auth_claim' object of the clusters
+// management service into the equivalent version 1 object.
+func ExternalAuthClaimToV1(object *cmv2alpha1.ExternalAuthClaim) (*cmv1.ExternalAuthClaim, error) {
+	return convert(object, cmv2alpha1.MarshalExternalAuthClaim, cmv1.UnmarshalExternalAuthClaim)
+}
+
+// ExternalAuthClientConfigToV2alpha1 converts a version 1 'external_auth_client_config' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func ExternalAuthClientConfigToV2alpha1(object *cmv1.ExternalAuthClientConfig) (*cmv2alpha1.ExternalAuthClientConfig, error) {
+	return convert(object, cmv1.MarshalExternalAuthClientConfig, cmv2alpha1.UnmarshalExternalAuthClientConfig)
+}
+
+// ExternalAuthClientConfigToV1 converts a version 2alpha1 'external_auth_client_config' object of
+// the clusters management service into the equivalent version 1 object.
+func ExternalAuthClientConfigToV1(object *cmv2alpha1.ExternalAuthClientConfig) (*cmv1.ExternalAuthClientConfig, error) {
+	return convert(object, cmv2alpha1.MarshalExternalAuthClientConfig, cmv1.UnmarshalExternalAuthClientConfig)
+}
+
+// ExternalAuthConfigToV2alpha1 converts a version 1 'external_auth_config' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ExternalAuthConfigToV2alpha1(object *cmv1.ExternalAuthConfig) (*cmv2alpha1.ExternalAuthConfig, error) {
+	return convert(object, cmv1.MarshalExternalAuthConfig, cmv2alpha1.UnmarshalExternalAuthConfig)
+}
+
+// ExternalAuthConfigToV1 converts a version 2alpha1 'external_auth_config' object of the clusters
+// management service into the equivalent version 1 object.
+func ExternalAuthConfigToV1(object *cmv2alpha1.ExternalAuthConfig) (*cmv1.ExternalAuthConfig, error) {
+	return convert(object, cmv2alpha1.MarshalExternalAuthConfig, cmv1.UnmarshalExternalAuthConfig)
+}
+
+// ExternalConfigurationToV2alpha1 converts a version 1 'external_configuration' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func ExternalConfigurationToV2alpha1(object *cmv1.ExternalConfiguration) (*cmv2alpha1.ExternalConfiguration, error) {
+	return convert(object, cmv1.MarshalExternalConfiguration, cmv2alpha1.UnmarshalExternalConfiguration)
+}
+
+// ExternalConfigurationToV1 converts a version 2alpha1 'external_configuration' object of the
+// clusters management service into the equivalent version 1 object.
+func ExternalConfigurationToV1(object *cmv2alpha1.ExternalConfiguration) (*cmv1.ExternalConfiguration, error) {
+	return convert(object, cmv2alpha1.MarshalExternalConfiguration, cmv1.UnmarshalExternalConfiguration)
+}
+
+// FlavourToV2alpha1 converts a version 1 'flavour' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func FlavourToV2alpha1(object *cmv1.Flavour) (*cmv2alpha1.Flavour, error) {
+	return convert(object, cmv1.MarshalFlavour, cmv2alpha1.UnmarshalFlavour)
+}
+
+// FlavourToV1 converts a version 2alpha1 'flavour' object of the clusters management service into
+// the equivalent version 1 object.
+func FlavourToV1(object *cmv2alpha1.Flavour) (*cmv1.Flavour, error) {
+	return convert(object, cmv2alpha1.MarshalFlavour, cmv1.UnmarshalFlavour)
+}
+
+// FlavourNodesToV2alpha1 converts a version 1 'flavour_nodes' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func FlavourNodesToV2alpha1(object *cmv1.FlavourNodes) (*cmv2alpha1.FlavourNodes, error) {
+	return convert(object, cmv1.MarshalFlavourNodes, cmv2alpha1.UnmarshalFlavourNodes)
+}
+
+// FlavourNodesToV1 converts a version 2alpha1 'flavour_nodes' object of the clusters management
+// service into the equivalent version 1 object.
+func FlavourNodesToV1(object *cmv2alpha1.FlavourNodes) (*cmv1.FlavourNodes, error) {
+	return convert(object, cmv2alpha1.MarshalFlavourNodes, cmv1.UnmarshalFlavourNodes)
+}
+
+// GCPToV2alpha1 converts a version 1 'GCP' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func GCPToV2alpha1(object *cmv1.GCP) (*cmv2alpha1.GCP, error) {
+	return convert(object, cmv1.MarshalGCP, cmv2alpha1.UnmarshalGCP)
+}
+
+// GCPToV1 converts a version 2alpha1 'GCP' object of the clusters management service into the
+// equivalent version 1 object.
+func GCPToV1(object *cmv2alpha1.GCP) (*cmv1.GCP, error) {
+	return convert(object, cmv2alpha1.MarshalGCP, cmv1.UnmarshalGCP)
+}
+
+// GCPEncryptionKeyToV2alpha1 converts a version 1 'GCP_encryption_key' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func GCPEncryptionKeyToV2alpha1(object *cmv1.GCPEncryptionKey) (*cmv2alpha1.GCPEncryptionKey, error) {
+	return convert(object, cmv1.MarshalGCPEncryptionKey, cmv2alpha1.UnmarshalGCPEncryptionKey)
+}
+
+// GCPEncryptionKeyToV1 converts a version 2alpha1 'GCP_encryption_key' object of the clusters
+// management service into the equivalent version 1 object.
+func GCPEncryptionKeyToV1(object *cmv2alpha1.GCPEncryptionKey) (*cmv1.GCPEncryptionKey, error) {
+	return convert(object, cmv2alpha1.MarshalGCPEncryptionKey, cmv1.UnmarshalGCPEncryptionKey)
+}
+
+// GCPFlavourToV2alpha1 converts a version 1 'GCP_flavour' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func GCPFlavourToV2alpha1(object *cmv1.GCPFlavour) (*cmv2alpha1.GCPFlavour, error) {
+	return convert(object, cmv1.MarshalGCPFlavour, cmv2alpha1.UnmarshalGCPFlavour)
+}
+
+// GCPFlavourToV1 converts a version 2alpha1 'GCP_flavour' object of the clusters management service
+// into the equivalent version 1 object.
+func GCPFlavourToV1(object *cmv2alpha1.GCPFlavour) (*cmv1.GCPFlavour, error) {
+	return convert(object, cmv2alpha1.MarshalGCPFlavour, cmv1.UnmarshalGCPFlavour)
+}
+
+// GCPImageOverrideToV2alpha1 converts a version 1 'GCP_image_override' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func GCPImageOverrideToV2alpha1(object *cmv1.GCPImageOverride) (*cmv2alpha1.GCPImageOverride, error) {
+	return convert(object, cmv1.MarshalGCPImageOverride, cmv2alpha1.UnmarshalGCPImageOverride)
+}
+
+// GCPImageOverrideToV1 converts a version 2alpha1 'GCP_image_override' object of the clusters
+// management service into the equivalent version 1 object.
+func GCPImageOverrideToV1(object *cmv2alpha1.GCPImageOverride) (*cmv1.GCPImageOverride, error) {
+	return convert(object, cmv2alpha1.MarshalGCPImageOverride, cmv1.UnmarshalGCPImageOverride)
+}
+
+// GCPNetworkToV2alpha1 converts a version 1 'GCP_network' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func GCPNetworkToV2alpha1(object *cmv1.GCPNetwork) (*cmv2alpha1.GCPNetwork, error) {
+	return convert(object, cmv1.MarshalGCPNetwork, cmv2alpha1.UnmarshalGCPNetwork)
+}
+
+// GCPNetworkToV1 converts a version 2alpha1 'GCP_network' object of the clusters management service
+// into the equivalent version 1 object.
+func GCPNetworkToV1(object *cmv2alpha1.GCPNetwork) (*cmv1.GCPNetwork, error) {
+	return convert(object, cmv2alpha1.MarshalGCPNetwork, cmv1.UnmarshalGCPNetwork)
+}
+
+// GCPVolumeToV2alpha1 converts a version 1 'GCP_volume' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func GCPVolumeToV2alpha1(object *cmv1.GCPVolume) (*cmv2alpha1.GCPVolume, error) {
+	return convert(object, cmv1.MarshalGCPVolume, cmv2alpha1.UnmarshalGCPVolume)
+}
+
+// GCPVolumeToV1 converts a version 2alpha1 'GCP_volume' object of the clusters management service
+// into the equivalent version 1 object.
+func GCPVolumeToV1(object *cmv2alpha1.GCPVolume) (*cmv1.GCPVolume, error) {
+	return convert(object, cmv2alpha1.MarshalGCPVolume, cmv1.UnmarshalGCPVolume)
+}
+
+// GcpAuthenticationToV2alpha1 converts a version 1 'gcp_authentication' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func GcpAuthenticationToV2alpha1(object *cmv1.GcpAuthentication) (*cmv2alpha1.GcpAuthentication, error) {
+	return convert(object, cmv1.MarshalGcpAuthentication, cmv2alpha1.UnmarshalGcpAuthentication)
+}
+
+// GcpAuthenticationToV1 converts a version 2alpha1 'gcp_authentication' object of the clusters
+// management service into the equivalent version 1 object.
+func GcpAuthenticationToV1(object *cmv2alpha1.GcpAuthentication) (*cmv1.GcpAuthentication, error) {
+	return convert(object, cmv2alpha1.MarshalGcpAuthentication, cmv1.UnmarshalGcpAuthentication)
+}
+
+// GcpSecurityToV2alpha1 converts a version 1 'gcp_security' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func GcpSecurityToV2alpha1(object *cmv1.GcpSecurity) (*cmv2alpha1.GcpSecurity, error) {
+	return convert(object, cmv1.MarshalGcpSecurity, cmv2alpha1.UnmarshalGcpSecurity)
+}
+
+// GcpSecurityToV1 converts a version 2alpha1 'gcp_security' object of the clusters management
+// service into the equivalent version 1 object.
+func GcpSecurityToV1(object *cmv2alpha1.GcpSecurity) (*cmv1.GcpSecurity, error) {
+	return convert(object, cmv2alpha1.MarshalGcpSecurity, cmv1.UnmarshalGcpSecurity)
+}
+
+// GithubIdentityProviderToV2alpha1 converts a version 1 'github_identity_provider' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func GithubIdentityProviderToV2alpha1(object *cmv1.GithubIdentityProvider) (*cmv2alpha1.GithubIdentityProvider, error) {
+	return convert(object, cmv1.MarshalGithubIdentityProvider, cmv2alpha1.UnmarshalGithubIdentityProvider)
+}
+
+// GithubIdentityProviderToV1 converts a version 2alpha1 'github_identity_provider' object of the
+// clusters management service into the equivalent version 1 object.
+func GithubIdentityProviderToV1(object *cmv2alpha1.GithubIdentityProvider) (*cmv1.GithubIdentityProvider, error) {
+	return convert(object, cmv2alpha1.MarshalGithubIdentityProvider, cmv1.UnmarshalGithubIdentityProvider)
+}
+
+// GitlabIdentityProviderToV2alpha1 converts a version 1 'gitlab_identity_provider' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func GitlabIdentityProviderToV2alpha1(object *cmv1.GitlabIdentityProvider) (*cmv2alpha1.GitlabIdentityProvider, error) {
+	return convert(object, cmv1.MarshalGitlabIdentityProvider, cmv2alpha1.UnmarshalGitlabIdentityProvider)
+}
+
+// GitlabIdentityProviderToV1 converts a version 2alpha1 'gitlab_identity_provider' object of the
+// clusters management service into the equivalent version 1 object.
+func GitlabIdentityProviderToV1(object *cmv2alpha1.GitlabIdentityProvider) (*cmv1.GitlabIdentityProvider, error) {
+	return convert(object, cmv2alpha1.MarshalGitlabIdentityProvider, cmv1.UnmarshalGitlabIdentityProvider)
+}
+
+// GoogleIdentityProviderToV2alpha1 converts a version 1 'google_identity_provider' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func GoogleIdentityProviderToV2alpha1(object *cmv1.GoogleIdentityProvider) (*cmv2alpha1.GoogleIdentityProvider, error) {
+	return convert(object, cmv1.MarshalGoogleIdentityProvider, cmv2alpha1.UnmarshalGoogleIdentityProvider)
+}
+
+// GoogleIdentityProviderToV1 converts a version 2alpha1 'google_identity_provider' object of the
+// clusters management service into the equivalent version 1 object.
+func GoogleIdentityProviderToV1(object *cmv2alpha1.GoogleIdentityProvider) (*cmv1.GoogleIdentityProvider, error) {
+	return convert(object, cmv2alpha1.MarshalGoogleIdentityProvider, cmv1.UnmarshalGoogleIdentityProvider)
+}
+
+// GroupToV2alpha1 converts a version 1 'group' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func GroupToV2alpha1(object *cmv1.Group) (*cmv2alpha1.Group, error) {
+	return convert(object, cmv1.MarshalGroup, cmv2alpha1.UnmarshalGroup)
+}
+
+// GroupToV1 converts a version 2alpha1 'group' object of the clusters management service into the
+// equivalent version 1 object.
+func GroupToV1(object *cmv2alpha1.Group) (*cmv1.Group, error) {
+	return convert(object, cmv2alpha1.MarshalGroup, cmv1.UnmarshalGroup)
+}
+
+// GroupsClaimToV2alpha1 converts a version 1 'groups_claim' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func GroupsClaimToV2alpha1(object *cmv1.GroupsClaim) (*cmv2alpha1.GroupsClaim, error) {
+	return convert(object, cmv1.MarshalGroupsClaim, cmv2alpha1.UnmarshalGroupsClaim)
+}
+
+// GroupsClaimToV1 converts a version 2alpha1 'groups_claim' object of the clusters management
+// service into the equivalent version 1 object.
+func GroupsClaimToV1(object *cmv2alpha1.GroupsClaim) (*cmv1.GroupsClaim, error) {
+	return convert(object, cmv2alpha1.MarshalGroupsClaim, cmv1.UnmarshalGroupsClaim)
+}
+
+// HTPasswdIdentityProviderToV2alpha1 converts a version 1 'HT_passwd_identity_provider' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func HTPasswdIdentityProviderToV2alpha1(object *cmv1.HTPasswdIdentityProvider) (*cmv2alpha1.HTPasswdIdentityProvider, error) {
+	return convert(object, cmv1.MarshalHTPasswdIdentityProvider, cmv2alpha1.UnmarshalHTPasswdIdentityProvider)
+}
+
+// HTPasswdIdentityProviderToV1 converts a version 2alpha1 'HT_passwd_identity_provider' object of
+// the clusters management service into the equivalent version 1 object.
+func HTPasswdIdentityProviderToV1(object *cmv2alpha1.HTPasswdIdentityProvider) (*cmv1.HTPasswdIdentityProvider, error) {
+	return convert(object, cmv2alpha1.MarshalHTPasswdIdentityProvider, cmv1.UnmarshalHTPasswdIdentityProvider)
+}
+
+// HTPasswdUserToV2alpha1 converts a version 1 'HT_passwd_user' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func HTPasswdUserToV2alpha1(object *cmv1.HTPasswdUser) (*cmv2alpha1.HTPasswdUser, error) {
+	return convert(object, cmv1.MarshalHTPasswdUser, cmv2alpha1.UnmarshalHTPasswdUser)
+}
+
+// HTPasswdUserToV1 converts a version 2alpha1 'HT_passwd_user' object of the clusters management
+// service into the equivalent version 1 object.
+func HTPasswdUserToV1(object *cmv2alpha1.HTPasswdUser) (*cmv1.HTPasswdUser, error) {
+	return convert(object, cmv2alpha1.MarshalHTPasswdUser, cmv1.UnmarshalHTPasswdUser)
+}
+
+// HypershiftToV2alpha1 converts a version 1 'hypershift' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func HypershiftToV2alpha1(object *cmv1.Hypershift) (*cmv2alpha1.Hypershift, error) {
+	return convert(object, cmv1.MarshalHypershift, cmv2alpha1.UnmarshalHypershift)
+}
+
+// HypershiftToV1 converts a version 2alpha1 'hypershift' object of the clusters management service
+// into the equivalent version 1 object.
+func HypershiftToV1(object *cmv2alpha1.Hypershift) (*cmv1.Hypershift, error) {
+	return convert(object, cmv2alpha1.MarshalHypershift, cmv1.UnmarshalHypershift)
+}
+
+// HypershiftConfigToV2alpha1 converts a version 1 'hypershift_config' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func HypershiftConfigToV2alpha1(object *cmv1.HypershiftConfig) (*cmv2alpha1.HypershiftConfig, error) {
+	return convert(object, cmv1.MarshalHypershiftConfig, cmv2alpha1.UnmarshalHypershiftConfig)
+}
+
+// HypershiftConfigToV1 converts a version 2alpha1 'hypershift_config' object of the clusters
+// management service into the equivalent version 1 object.
+func HypershiftConfigToV1(object *cmv2alpha1.HypershiftConfig) (*cmv1.HypershiftConfig, error) {
+	return convert(object, cmv2alpha1.MarshalHypershiftConfig, cmv1.UnmarshalHypershiftConfig)
+}
+
+// IdentityProviderToV2alpha1 converts a version 1 'identity_provider' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func IdentityProviderToV2alpha1(object *cmv1.IdentityProvider) (*cmv2alpha1.IdentityProvider, error) {
+	return convert(object, cmv1.MarshalIdentityProvider, cmv2alpha1.UnmarshalIdentityProvider)
+}
+
+// IdentityProviderToV1 converts a version 2alpha1 'identity_provider' object of the clusters
+// management service into the equivalent version 1 object.
+func IdentityProviderToV1(object *cmv2alpha1.IdentityProvider) (*cmv1.IdentityProvider, error) {
+	return convert(object, cmv2alpha1.MarshalIdentityProvider, cmv1.UnmarshalIdentityProvider)
+}
+
+// ImageOverridesToV2alpha1 converts a version 1 'image_overrides' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ImageOverridesToV2alpha1(object *cmv1.ImageOverrides) (*cmv2alpha1.ImageOverrides, error) {
+	return convert(object, cmv1.MarshalImageOverrides, cmv2alpha1.UnmarshalImageOverrides)
+}
+
+// ImageOverridesToV1 converts a version 2alpha1 'image_overrides' object of the clusters management
+// service into the equivalent version 1 object.
+func ImageOverridesToV1(object *cmv2alpha1.ImageOverrides) (*cmv1.ImageOverrides, error) {
+	return convert(object, cmv2alpha1.MarshalImageOverrides, cmv1.UnmarshalImageOverrides)
+}
+
+// InflightCheckToV2alpha1 converts a version 1 'inflight_check' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func InflightCheckToV2alpha1(object *cmv1.InflightCheck) (*cmv2alpha1.InflightCheck, error) {
+	return convert(object, cmv1.MarshalInflightCheck, cmv2alpha1.UnmarshalInflightCheck)
+}
+
+// InflightCheckToV1 converts a version 2alpha1 'inflight_check' object of the clusters management
+// service into the equivalent version 1 object.
+func InflightCheckToV1(object *cmv2alpha1.InflightCheck) (*cmv1.InflightCheck, error) {
+	return convert(object, cmv2alpha1.MarshalInflightCheck, cmv1.UnmarshalInflightCheck)
+}
+
+// IngressToV2alpha1 converts a version 1 'ingress' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func IngressToV2alpha1(object *cmv1.Ingress) (*cmv2alpha1.Ingress, error) {
+	return convert(object, cmv1.MarshalIngress, cmv2alpha1.UnmarshalIngress)
+}
+
+// IngressToV1 converts a version 2alpha1 'ingress' object of the clusters management service into
+// the equivalent version 1 object.
+func IngressToV1(object *cmv2alpha1.Ingress) (*cmv1.Ingress, error) {
+	return convert(object, cmv2alpha1.MarshalIngress, cmv1.UnmarshalIngress)
+}
+
+// InstanceIAMRolesToV2alpha1 converts a version 1 'instance_IAM_roles' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func InstanceIAMRolesToV2alpha1(object *cmv1.InstanceIAMRoles) (*cmv2alpha1.InstanceIAMRoles, error) {
+	return convert(object, cmv1.MarshalInstanceIAMRoles, cmv2alpha1.UnmarshalInstanceIAMRoles)
+}
+
+// InstanceIAMRolesToV1 converts a version 2alpha1 'instance_IAM_roles' object of the clusters
+// management service into the equivalent version 1 object.
+func InstanceIAMRolesToV1(object *cmv2alpha1.InstanceIAMRoles) (*cmv1.InstanceIAMRoles, error) {
+	return convert(object, cmv2alpha1.MarshalInstanceIAMRoles, cmv1.UnmarshalInstanceIAMRoles)
+}
+
+// KeyRingToV2alpha1 converts a version 1 'key_ring' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func KeyRingToV2alpha1(object *cmv1.KeyRing) (*cmv2alpha1.KeyRing, error) {
+	return convert(object, cmv1.MarshalKeyRing, cmv2alpha1.UnmarshalKeyRing)
+}
+
+// KeyRingToV1 converts a version 2alpha1 'key_ring' object of the clusters management service into
+// the equivalent version 1 object.
+func KeyRingToV1(object *cmv2alpha1.KeyRing) (*cmv1.KeyRing, error) {
+	return convert(object, cmv2alpha1.MarshalKeyRing, cmv1.UnmarshalKeyRing)
+}
+
+// KubeletConfigToV2alpha1 converts a version 1 'kubelet_config' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func KubeletConfigToV2alpha1(object *cmv1.KubeletConfig) (*cmv2alpha1.KubeletConfig, error) {
+	return convert(object, cmv1.MarshalKubeletConfig, cmv2alpha1.UnmarshalKubeletConfig)
+}
+
+// KubeletConfigToV1 converts a version 2alpha1 'kubelet_config' object of the clusters management
+// service into the equivalent version 1 object.
+func KubeletConfigToV1(object *cmv2alpha1.KubeletConfig) (*cmv1.KubeletConfig, error) {
+	return convert(object, cmv2alpha1.MarshalKubeletConfig, cmv1.UnmarshalKubeletConfig)
+}
+
+// LDAPAttributesToV2alpha1 converts a version 1 'LDAP_attributes' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func LDAPAttributesToV2alpha1(object *cmv1.LDAPAttributes) (*cmv2alpha1.LDAPAttributes, error) {
+	return convert(object, cmv1.MarshalLDAPAttributes, cmv2alpha1.UnmarshalLDAPAttributes)
+}
+
+// LDAPAttributesToV1 converts a version 2alpha1 'LDAP_attributes' object of the clusters management
+// service into the equivalent version 1 object.
+func LDAPAttributesToV1(object *cmv2alpha1.LDAPAttributes) (*cmv1.LDAPAttributes, error) {
+	return convert(object, cmv2alpha1.MarshalLDAPAttributes, cmv1.UnmarshalLDAPAttributes)
+}
+
+// LDAPIdentityProviderToV2alpha1 converts a version 1 'LDAP_identity_provider' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func LDAPIdentityProviderToV2alpha1(object *cmv1.LDAPIdentityProvider) (*cmv2alpha1.LDAPIdentityProvider, error) {
+	return convert(object, cmv1.MarshalLDAPIdentityProvider, cmv2alpha1.UnmarshalLDAPIdentityProvider)
+}
+
+// LDAPIdentityProviderToV1 converts a version 2alpha1 'LDAP_identity_provider' object of the
+// clusters management service into the equivalent version 1 object.
+func LDAPIdentityProviderToV1(object *cmv2alpha1.LDAPIdentityProvider) (*cmv1.LDAPIdentityProvider, error) {
+	return convert(object, cmv2alpha1.MarshalLDAPIdentityProvider, cmv1.UnmarshalLDAPIdentityProvider)
+}
+
+// LabelToV2alpha1 converts a version 1 'label' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func LabelToV2alpha1(object *cmv1.Label) (*cmv2alpha1.Label, error) {
+	return convert(object, cmv1.MarshalLabel, cmv2alpha1.UnmarshalLabel)
+}
+
+// LabelToV1 converts a version 2alpha1 'label' object of the clusters management service into the
+// equivalent version 1 object.
+func LabelToV1(object *cmv2alpha1.Label) (*cmv1.Label, error) {
+	return convert(object, cmv2alpha1.MarshalLabel, cmv1.UnmarshalLabel)
+}
+
+// LimitedSupportReasonToV2alpha1 converts a version 1 'limited_support_reason' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func LimitedSupportReasonToV2alpha1(object *cmv1.LimitedSupportReason) (*cmv2alpha1.LimitedSupportReason, error) {
+	return convert(object, cmv1.MarshalLimitedSupportReason, cmv2alpha1.UnmarshalLimitedSupportReason)
+}
+
+// LimitedSupportReasonToV1 converts a version 2alpha1 'limited_support_reason' object of the
+// clusters management service into the equivalent version 1 object.
+func LimitedSupportReasonToV1(object *cmv2alpha1.LimitedSupportReason) (*cmv1.LimitedSupportReason, error) {
+	return convert(object, cmv2alpha1.MarshalLimitedSupportReason, cmv1.UnmarshalLimitedSupportReason)
+}
+
+// LimitedSupportReasonTemplateToV2alpha1 converts a version 1 'limited_support_reason_template'
+// object of the clusters management service into the equivalent version 2alpha1 object.
+func LimitedSupportReasonTemplateToV2alpha1(object *cmv1.LimitedSupportReasonTemplate) (*cmv2alpha1.LimitedSupportReasonTemplate, error) {
+	return convert(object, cmv1.MarshalLimitedSupportReasonTemplate, cmv2alpha1.UnmarshalLimitedSupportReasonTemplate)
+}
+
+// LimitedSupportReasonTemplateToV1 converts a version 2alpha1 'limited_support_reason_template'
+// object of the clusters management service into the equivalent version 1 object.
+func LimitedSupportReasonTemplateToV1(object *cmv2alpha1.LimitedSupportReasonTemplate) (*cmv1.LimitedSupportReasonTemplate, error) {
+	return convert(object, cmv2alpha1.MarshalLimitedSupportReasonTemplate, cmv1.UnmarshalLimitedSupportReasonTemplate)
+}
+
+// LogToV2alpha1 converts a version 1 'log' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func LogToV2alpha1(object *cmv1.Log) (*cmv2alpha1.Log, error) {
+	return convert(object, cmv1.MarshalLog, cmv2alpha1.UnmarshalLog)
+}
+
+// LogToV1 converts a version 2alpha1 'log' object of the clusters management service into the
+// equivalent version 1 object.
+func LogToV1(object *cmv2alpha1.Log) (*cmv1.Log, error) {
+	return convert(object, cmv2alpha1.MarshalLog, cmv1.UnmarshalLog)
+}
+
+// MachinePoolToV2alpha1 converts a version 1 'machine_pool' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func MachinePoolToV2alpha1(object *cmv1.MachinePool) (*cmv2alpha1.MachinePool, error) {
+	return convert(object, cmv1.MarshalMachinePool, cmv2alpha1.UnmarshalMachinePool)
+}
+
+// MachinePoolToV1 converts a version 2alpha1 'machine_pool' object of the clusters management
+// service into the equivalent version 1 object.
+func MachinePoolToV1(object *cmv2alpha1.MachinePool) (*cmv1.MachinePool, error) {
+	return convert(object, cmv2alpha1.MarshalMachinePool, cmv1.UnmarshalMachinePool)
+}
+
+// MachinePoolAutoscalingToV2alpha1 converts a version 1 'machine_pool_autoscaling' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func MachinePoolAutoscalingToV2alpha1(object *cmv1.MachinePoolAutoscaling) (*cmv2alpha1.MachinePoolAutoscaling, error) {
+	return convert(object, cmv1.MarshalMachinePoolAutoscaling, cmv2alpha1.UnmarshalMachinePoolAutoscaling)
+}
+
+// MachinePoolAutoscalingToV1 converts a version 2alpha1 'machine_pool_autoscaling' object of the
+// clusters management service into the equivalent version 1 object.
+func MachinePoolAutoscalingToV1(object *cmv2alpha1.MachinePoolAutoscaling) (*cmv1.MachinePoolAutoscaling, error) {
+	return convert(object, cmv2alpha1.MarshalMachinePoolAutoscaling, cmv1.UnmarshalMachinePoolAutoscaling)
+}
+
+// MachinePoolSecurityGroupFilterToV2alpha1 converts a version 1
+// 'machine_pool_security_group_filter' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func MachinePoolSecurityGroupFilterToV2alpha1(object *cmv1.MachinePoolSecurityGroupFilter) (*cmv2alpha1.MachinePoolSecurityGroupFilter, error) {
+	return convert(object, cmv1.MarshalMachinePoolSecurityGroupFilter, cmv2alpha1.UnmarshalMachinePoolSecurityGroupFilter)
+}
+
+// MachinePoolSecurityGroupFilterToV1 converts a version 2alpha1
+// 'machine_pool_security_group_filter' object of the clusters management service into the
+// equivalent version 1 object.
+func MachinePoolSecurityGroupFilterToV1(object *cmv2alpha1.MachinePoolSecurityGroupFilter) (*cmv1.MachinePoolSecurityGroupFilter, error) {
+	return convert(object, cmv2alpha1.MarshalMachinePoolSecurityGroupFilter, cmv1.UnmarshalMachinePoolSecurityGroupFilter)
+}
+
+// MachineTypeToV2alpha1 converts a version 1 'machine_type' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func MachineTypeToV2alpha1(object *cmv1.MachineType) (*cmv2alpha1.MachineType, error) {
+	return convert(object, cmv1.MarshalMachineType, cmv2alpha1.UnmarshalMachineType)
+}
+
+// MachineTypeToV1 converts a version 2alpha1 'machine_type' object of the clusters management
+// service into the equivalent version 1 object.
+func MachineTypeToV1(object *cmv2alpha1.MachineType) (*cmv1.MachineType, error) {
+	return convert(object, cmv2alpha1.MarshalMachineType, cmv1.UnmarshalMachineType)
+}
+
+// ManagedServiceToV2alpha1 converts a version 1 'managed_service' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ManagedServiceToV2alpha1(object *cmv1.ManagedService) (*cmv2alpha1.ManagedService, error) {
+	return convert(object, cmv1.MarshalManagedService, cmv2alpha1.UnmarshalManagedService)
+}
+
+// ManagedServiceToV1 converts a version 2alpha1 'managed_service' object of the clusters management
+// service into the equivalent version 1 object.
+func ManagedServiceToV1(object *cmv2alpha1.ManagedService) (*cmv1.ManagedService, error) {
+	return convert(object, cmv2alpha1.MarshalManagedService, cmv1.UnmarshalManagedService)
+}
+
+// ManifestToV2alpha1 converts a version 1 'manifest' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func ManifestToV2alpha1(object *cmv1.Manifest) (*cmv2alpha1.Manifest, error) {
+	return convert(object, cmv1.MarshalManifest, cmv2alpha1.UnmarshalManifest)
+}
+
+// ManifestToV1 converts a version 2alpha1 'manifest' object of the clusters management service into
+// the equivalent version 1 object.
+func ManifestToV1(object *cmv2alpha1.Manifest) (*cmv1.Manifest, error) {
+	return convert(object, cmv2alpha1.MarshalManifest, cmv1.UnmarshalManifest)
+}
+
+// NetworkToV2alpha1 converts a version 1 'network' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func NetworkToV2alpha1(object *cmv1.Network) (*cmv2alpha1.Network, error) {
+	return convert(object, cmv1.MarshalNetwork, cmv2alpha1.UnmarshalNetwork)
+}
+
+// NetworkToV1 converts a version 2alpha1 'network' object of the clusters management service into
+// the equivalent version 1 object.
+func NetworkToV1(object *cmv2alpha1.Network) (*cmv1.Network, error) {
+	return convert(object, cmv2alpha1.MarshalNetwork, cmv1.UnmarshalNetwork)
+}
+
+// NetworkVerificationToV2alpha1 converts a version 1 'network_verification' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func NetworkVerificationToV2alpha1(object *cmv1.NetworkVerification) (*cmv2alpha1.NetworkVerification, error) {
+	return convert(object, cmv1.MarshalNetworkVerification, cmv2alpha1.UnmarshalNetworkVerification)
+}
+
+// NetworkVerificationToV1 converts a version 2alpha1 'network_verification' object of the clusters
+// management service into the equivalent version 1 object.
+func NetworkVerificationToV1(object *cmv2alpha1.NetworkVerification) (*cmv1.NetworkVerification, error) {
+	return convert(object, cmv2alpha1.MarshalNetworkVerification, cmv1.UnmarshalNetworkVerification)
+}
+
+// NodeInfoToV2alpha1 converts a version 1 'node_info' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func NodeInfoToV2alpha1(object *cmv1.NodeInfo) (*cmv2alpha1.NodeInfo, error) {
+	return convert(object, cmv1.MarshalNodeInfo, cmv2alpha1.UnmarshalNodeInfo)
+}
+
+// NodeInfoToV1 converts a version 2alpha1 'node_info' object of the clusters management service
+// into the equivalent version 1 object.
+func NodeInfoToV1(object *cmv2alpha1.NodeInfo) (*cmv1.NodeInfo, error) {
+	return convert(object, cmv2alpha1.MarshalNodeInfo, cmv1.UnmarshalNodeInfo)
+}
+
+// NodePoolToV2alpha1 converts a version 1 'node_pool' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func NodePoolToV2alpha1(object *cmv1.NodePool) (*cmv2alpha1.NodePool, error) {
+	return convert(object, cmv1.MarshalNodePool, cmv2alpha1.UnmarshalNodePool)
+}
+
+// NodePoolToV1 converts a version 2alpha1 'node_pool' object of the clusters management service
+// into the equivalent version 1 object.
+func NodePoolToV1(object *cmv2alpha1.NodePool) (*cmv1.NodePool, error) {
+	return convert(object, cmv2alpha1.MarshalNodePool, cmv1.UnmarshalNodePool)
+}
+
+// NodePoolAutoscalingToV2alpha1 converts a version 1 'node_pool_autoscaling' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func NodePoolAutoscalingToV2alpha1(object *cmv1.NodePoolAutoscaling) (*cmv2alpha1.NodePoolAutoscaling, error) {
+	return convert(object, cmv1.MarshalNodePoolAutoscaling, cmv2alpha1.UnmarshalNodePoolAutoscaling)
+}
+
+// NodePoolAutoscalingToV1 converts a version 2alpha1 'node_pool_autoscaling' object of the clusters
+// management service into the equivalent version 1 object.
+func NodePoolAutoscalingToV1(object *cmv2alpha1.NodePoolAutoscaling) (*cmv1.NodePoolAutoscaling, error) {
+	return convert(object, cmv2alpha1.MarshalNodePoolAutoscaling, cmv1.UnmarshalNodePoolAutoscaling)
+}
+
+// NodePoolManagementUpgradeToV2alpha1 converts a version 1 'node_pool_management_upgrade' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func NodePoolManagementUpgradeToV2alpha1(object *cmv1.NodePoolManagementUpgrade) (*cmv2alpha1.NodePoolManagementUpgrade, error) {
+	return convert(object, cmv1.MarshalNodePoolManagementUpgrade, cmv2alpha1.UnmarshalNodePoolManagementUpgrade)
+}
+
+// NodePoolManagementUpgradeToV1 converts a version 2alpha1 'node_pool_management_upgrade' object of
+// the clusters management service into the equivalent version 1 object.
+func NodePoolManagementUpgradeToV1(object *cmv2alpha1.NodePoolManagementUpgrade) (*cmv1.NodePoolManagementUpgrade, error) {
+	return convert(object, cmv2alpha1.MarshalNodePoolManagementUpgrade, cmv1.UnmarshalNodePoolManagementUpgrade)
+}
+
+// NodePoolStatusToV2alpha1 converts a version 1 'node_pool_status' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func NodePoolStatusToV2alpha1(object *cmv1.NodePoolStatus) (*cmv2alpha1.NodePoolStatus, error) {
+	return convert(object, cmv1.MarshalNodePoolStatus, cmv2alpha1.UnmarshalNodePoolStatus)
+}
+
+// NodePoolStatusToV1 converts a version 2alpha1 'node_pool_status' object of the clusters
+// management service into the equivalent version 1 object.
+func NodePoolStatusToV1(object *cmv2alpha1.NodePoolStatus) (*cmv1.NodePoolStatus, error) {
+	return convert(object, cmv2alpha1.MarshalNodePoolStatus, cmv1.UnmarshalNodePoolStatus)
+}
+
+// NodePoolUpgradePolicyToV2alpha1 converts a version 1 'node_pool_upgrade_policy' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func NodePoolUpgradePolicyToV2alpha1(object *cmv1.NodePoolUpgradePolicy) (*cmv2alpha1.NodePoolUpgradePolicy, error) {
+	return convert(object, cmv1.MarshalNodePoolUpgradePolicy, cmv2alpha1.UnmarshalNodePoolUpgradePolicy)
+}
+
+// NodePoolUpgradePolicyToV1 converts a version 2alpha1 'node_pool_upgrade_policy' object of the
+// clusters management service into the equivalent version 1 object.
+func NodePoolUpgradePolicyToV1(object *cmv2alpha1.NodePoolUpgradePolicy) (*cmv1.NodePoolUpgradePolicy, error) {
+	return convert(object, cmv2alpha1.MarshalNodePoolUpgradePolicy, cmv1.UnmarshalNodePoolUpgradePolicy)
+}
+
+// NodesInfoToV2alpha1 converts a version 1 'nodes_info' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func NodesInfoToV2alpha1(object *cmv1.NodesInfo) (*cmv2alpha1.NodesInfo, error) {
+	return convert(object, cmv1.MarshalNodesInfo, cmv2alpha1.UnmarshalNodesInfo)
+}
+
+// NodesInfoToV1 converts a version 2alpha1 'nodes_info' object of the clusters management service
+// into the equivalent version 1 object.
+func NodesInfoToV1(object *cmv2alpha1.NodesInfo) (*cmv1.NodesInfo, error) {
+	return convert(object, cmv2alpha1.MarshalNodesInfo, cmv1.UnmarshalNodesInfo)
+}
+
+// OidcConfigToV2alpha1 converts a version 1 'oidc_config' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func OidcConfigToV2alpha1(object *cmv1.OidcConfig) (*cmv2alpha1.OidcConfig, error) {
+	return convert(object, cmv1.MarshalOidcConfig, cmv2alpha1.UnmarshalOidcConfig)
+}
+
+// OidcConfigToV1 converts a version 2alpha1 'oidc_config' object of the clusters management service
+// into the equivalent version 1 object.
+func OidcConfigToV1(object *cmv2alpha1.OidcConfig) (*cmv1.OidcConfig, error) {
+	return convert(object, cmv2alpha1.MarshalOidcConfig, cmv1.UnmarshalOidcConfig)
+}
+
+// OidcThumbprintToV2alpha1 converts a version 1 'oidc_thumbprint' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func OidcThumbprintToV2alpha1(object *cmv1.OidcThumbprint) (*cmv2alpha1.OidcThumbprint, error) {
+	return convert(object, cmv1.MarshalOidcThumbprint, cmv2alpha1.UnmarshalOidcThumbprint)
+}
+
+// OidcThumbprintToV1 converts a version 2alpha1 'oidc_thumbprint' object of the clusters management
+// service into the equivalent version 1 object.
+func OidcThumbprintToV1(object *cmv2alpha1.OidcThumbprint) (*cmv1.OidcThumbprint, error) {
+	return convert(object, cmv2alpha1.MarshalOidcThumbprint, cmv1.UnmarshalOidcThumbprint)
+}
+
+// OidcThumbprintInputToV2alpha1 converts a version 1 'oidc_thumbprint_input' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func OidcThumbprintInputToV2alpha1(object *cmv1.OidcThumbprintInput) (*cmv2alpha1.OidcThumbprintInput, error) {
+	return convert(object, cmv1.MarshalOidcThumbprintInput, cmv2alpha1.UnmarshalOidcThumbprintInput)
+}
+
+// OidcThumbprintInputToV1 converts a version 2alpha1 'oidc_thumbprint_input' object of the clusters
+// management service into the equivalent version 1 object.
+func OidcThumbprintInputToV1(object *cmv2alpha1.OidcThumbprintInput) (*cmv1.OidcThumbprintInput, error) {
+	return convert(object, cmv2alpha1.MarshalOidcThumbprintInput, cmv1.UnmarshalOidcThumbprintInput)
+}
+
+// OpenIDClaimsToV2alpha1 converts a version 1 'open_ID_claims' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func OpenIDClaimsToV2alpha1(object *cmv1.OpenIDClaims) (*cmv2alpha1.OpenIDClaims, error) {
+	return convert(object, cmv1.MarshalOpenIDClaims, cmv2alpha1.UnmarshalOpenIDClaims)
+}
+
+// OpenIDClaimsToV1 converts a version 2alpha1 'open_ID_claims' object of the clusters management
+// service into the equivalent version 1 object.
+func OpenIDClaimsToV1(object *cmv2alpha1.OpenIDClaims) (*cmv1.OpenIDClaims, error) {
+	return convert(object, cmv2alpha1.MarshalOpenIDClaims, cmv1.UnmarshalOpenIDClaims)
+}
+
+// OpenIDIdentityProviderToV2alpha1 converts a version 1 'open_ID_identity_provider' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func OpenIDIdentityProviderToV2alpha1(object *cmv1.OpenIDIdentityProvider) (*cmv2alpha1.OpenIDIdentityProvider, error) {
+	return convert(object, cmv1.MarshalOpenIDIdentityProvider, cmv2alpha1.UnmarshalOpenIDIdentityProvider)
+}
+
+// OpenIDIdentityProviderToV1 converts a version 2alpha1 'open_ID_identity_provider' object of the
+// clusters management service into the equivalent version 1 object.
+func OpenIDIdentityProviderToV1(object *cmv2alpha1.OpenIDIdentityProvider) (*cmv1.OpenIDIdentityProvider, error) {
+	return convert(object, cmv2alpha1.MarshalOpenIDIdentityProvider, cmv1.UnmarshalOpenIDIdentityProvider)
+}
+
+// OperatorIAMRoleToV2alpha1 converts a version 1 'operator_IAM_role' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func OperatorIAMRoleToV2alpha1(object *cmv1.OperatorIAMRole) (*cmv2alpha1.OperatorIAMRole, error) {
+	return convert(object, cmv1.MarshalOperatorIAMRole, cmv2alpha1.UnmarshalOperatorIAMRole)
+}
+
+// OperatorIAMRoleToV1 converts a version 2alpha1 'operator_IAM_role' object of the clusters
+// management service into the equivalent version 1 object.
+func OperatorIAMRoleToV1(object *cmv2alpha1.OperatorIAMRole) (*cmv1.OperatorIAMRole, error) {
+	return convert(object, cmv2alpha1.MarshalOperatorIAMRole, cmv1.UnmarshalOperatorIAMRole)
+}
+
+// OrganizationLinkToV2alpha1 converts a version 1 'organization_link' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func OrganizationLinkToV2alpha1(object *cmv1.OrganizationLink) (*cmv2alpha1.OrganizationLink, error) {
+	return convert(object, cmv1.MarshalOrganizationLink, cmv2alpha1.UnmarshalOrganizationLink)
+}
+
+// OrganizationLinkToV1 converts a version 2alpha1 'organization_link' object of the clusters
+// management service into the equivalent version 1 object.
+func OrganizationLinkToV1(object *cmv2alpha1.OrganizationLink) (*cmv1.OrganizationLink, error) {
+	return convert(object, cmv2alpha1.MarshalOrganizationLink, cmv1.UnmarshalOrganizationLink)
+}
+
+// PendingDeleteClusterToV2alpha1 converts a version 1 'pending_delete_cluster' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func PendingDeleteClusterToV2alpha1(object *cmv1.PendingDeleteCluster) (*cmv2alpha1.PendingDeleteCluster, error) {
+	return convert(object, cmv1.MarshalPendingDeleteCluster, cmv2alpha1.UnmarshalPendingDeleteCluster)
+}
+
+// PendingDeleteClusterToV1 converts a version 2alpha1 'pending_delete_cluster' object of the
+// clusters management service into the equivalent version 1 object.
+func PendingDeleteClusterToV1(object *cmv2alpha1.PendingDeleteCluster) (*cmv1.PendingDeleteCluster, error) {
+	return convert(object, cmv2alpha1.MarshalPendingDeleteCluster, cmv1.UnmarshalPendingDeleteCluster)
+}
+
+// PrivateLinkClusterConfigurationToV2alpha1 converts a version 1
+// 'private_link_cluster_configuration' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func PrivateLinkClusterConfigurationToV2alpha1(object *cmv1.PrivateLinkClusterConfiguration) (*cmv2alpha1.PrivateLinkClusterConfiguration, error) {
+	return convert(object, cmv1.MarshalPrivateLinkClusterConfiguration, cmv2alpha1.UnmarshalPrivateLinkClusterConfiguration)
+}
+
+// PrivateLinkClusterConfigurationToV1 converts a version 2alpha1
+// 'private_link_cluster_configuration' object of the clusters management service into the
+// equivalent version 1 object.
+func PrivateLinkClusterConfigurationToV1(object *cmv2alpha1.PrivateLinkClusterConfiguration) (*cmv1.PrivateLinkClusterConfiguration, error) {
+	return convert(object, cmv2alpha1.MarshalPrivateLinkClusterConfiguration, cmv1.UnmarshalPrivateLinkClusterConfiguration)
+}
+
+// PrivateLinkConfigurationToV2alpha1 converts a version 1 'private_link_configuration' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func PrivateLinkConfigurationToV2alpha1(object *cmv1.PrivateLinkConfiguration) (*cmv2alpha1.PrivateLinkConfiguration, error) {
+	return convert(object, cmv1.MarshalPrivateLinkConfiguration, cmv2alpha1.UnmarshalPrivateLinkConfiguration)
+}
+
+// PrivateLinkConfigurationToV1 converts a version 2alpha1 'private_link_configuration' object of
+// the clusters management service into the equivalent version 1 object.
+func PrivateLinkConfigurationToV1(object *cmv2alpha1.PrivateLinkConfiguration) (*cmv1.PrivateLinkConfiguration, error) {
+	return convert(object, cmv2alpha1.MarshalPrivateLinkConfiguration, cmv1.UnmarshalPrivateLinkConfiguration)
+}
+
+// PrivateLinkPrincipalToV2alpha1 converts a version 1 'private_link_principal' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func PrivateLinkPrincipalToV2alpha1(object *cmv1.PrivateLinkPrincipal) (*cmv2alpha1.PrivateLinkPrincipal, error) {
+	return convert(object, cmv1.MarshalPrivateLinkPrincipal, cmv2alpha1.UnmarshalPrivateLinkPrincipal)
+}
+
+// PrivateLinkPrincipalToV1 converts a version 2alpha1 'private_link_principal' object of the
+// clusters management service into the equivalent version 1 object.
+func PrivateLinkPrincipalToV1(object *cmv2alpha1.PrivateLinkPrincipal) (*cmv1.PrivateLinkPrincipal, error) {
+	return convert(object, cmv2alpha1.MarshalPrivateLinkPrincipal, cmv1.UnmarshalPrivateLinkPrincipal)
+}
+
+// PrivateLinkPrincipalsToV2alpha1 converts a version 1 'private_link_principals' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func PrivateLinkPrincipalsToV2alpha1(object *cmv1.PrivateLinkPrincipals) (*cmv2alpha1.PrivateLinkPrincipals, error) {
+	return convert(object, cmv1.MarshalPrivateLinkPrincipals, cmv2alpha1.UnmarshalPrivateLinkPrincipals)
+}
+
+// PrivateLinkPrincipalsToV1 converts a version 2alpha1 'private_link_principals' object of the
+// clusters management service into the equivalent version 1 object.
+func PrivateLinkPrincipalsToV1(object *cmv2alpha1.PrivateLinkPrincipals) (*cmv1.PrivateLinkPrincipals, error) {
+	return convert(object, cmv2alpha1.MarshalPrivateLinkPrincipals, cmv1.UnmarshalPrivateLinkPrincipals)
+}
+
+// ProductToV2alpha1 converts a version 1 'product' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func ProductToV2alpha1(object *cmv1.Product) (*cmv2alpha1.Product, error) {
+	return convert(object, cmv1.MarshalProduct, cmv2alpha1.UnmarshalProduct)
+}
+
+// ProductToV1 converts a version 2alpha1 'product' object of the clusters management service into
+// the equivalent version 1 object.
+func ProductToV1(object *cmv2alpha1.Product) (*cmv1.Product, error) {
+	return convert(object, cmv2alpha1.MarshalProduct, cmv1.UnmarshalProduct)
+}
+
+// ProductMinimalVersionToV2alpha1 converts a version 1 'product_minimal_version' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func ProductMinimalVersionToV2alpha1(object *cmv1.ProductMinimalVersion) (*cmv2alpha1.ProductMinimalVersion, error) {
+	return convert(object, cmv1.MarshalProductMinimalVersion, cmv2alpha1.UnmarshalProductMinimalVersion)
+}
+
+// ProductMinimalVersionToV1 converts a version 2alpha1 'product_minimal_version' object of the
+// clusters management service into the equivalent version 1 object.
+func ProductMinimalVersionToV1(object *cmv2alpha1.ProductMinimalVersion) (*cmv1.ProductMinimalVersion, error) {
+	return convert(object, cmv2alpha1.MarshalProductMinimalVersion, cmv1.UnmarshalProductMinimalVersion)
+}
+
+// ProductTechnologyPreviewToV2alpha1 converts a version 1 'product_technology_preview' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func ProductTechnologyPreviewToV2alpha1(object *cmv1.ProductTechnologyPreview) (*cmv2alpha1.ProductTechnologyPreview, error) {
+	return convert(object, cmv1.MarshalProductTechnologyPreview, cmv2alpha1.UnmarshalProductTechnologyPreview)
+}
+
+// ProductTechnologyPreviewToV1 converts a version 2alpha1 'product_technology_preview' object of
+// the clusters management service into the equivalent version 1 object.
+func ProductTechnologyPreviewToV1(object *cmv2alpha1.ProductTechnologyPreview) (*cmv1.ProductTechnologyPreview, error) {
+	return convert(object, cmv2alpha1.MarshalProductTechnologyPreview, cmv1.UnmarshalProductTechnologyPreview)
+}
+
+// ProvisionShardToV2alpha1 converts a version 1 'provision_shard' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ProvisionShardToV2alpha1(object *cmv1.ProvisionShard) (*cmv2alpha1.ProvisionShard, error) {
+	return convert(object, cmv1.MarshalProvisionShard, cmv2alpha1.UnmarshalProvisionShard)
+}
+
+// ProvisionShardToV1 converts a version 2alpha1 'provision_shard' object of the clusters management
+// service into the equivalent version 1 object.
+func ProvisionShardToV1(object *cmv2alpha1.ProvisionShard) (*cmv1.ProvisionShard, error) {
+	return convert(object, cmv2alpha1.MarshalProvisionShard, cmv1.UnmarshalProvisionShard)
+}
+
+// ProxyToV2alpha1 converts a version 1 'proxy' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func ProxyToV2alpha1(object *cmv1.Proxy) (*cmv2alpha1.Proxy, error) {
+	return convert(object, cmv1.MarshalProxy, cmv2alpha1.UnmarshalProxy)
+}
+
+// ProxyToV1 converts a version 2alpha1 'proxy' object of the clusters management service into the
+// equivalent version 1 object.
+func ProxyToV1(object *cmv2alpha1.Proxy) (*cmv1.Proxy, error) {
+	return convert(object, cmv2alpha1.MarshalProxy, cmv1.UnmarshalProxy)
+}
+
+// ReleaseImageDetailsToV2alpha1 converts a version 1 'release_image_details' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func ReleaseImageDetailsToV2alpha1(object *cmv1.ReleaseImageDetails) (*cmv2alpha1.ReleaseImageDetails, error) {
+	return convert(object, cmv1.MarshalReleaseImageDetails, cmv2alpha1.UnmarshalReleaseImageDetails)
+}
+
+// ReleaseImageDetailsToV1 converts a version 2alpha1 'release_image_details' object of the clusters
+// management service into the equivalent version 1 object.
+func ReleaseImageDetailsToV1(object *cmv2alpha1.ReleaseImageDetails) (*cmv1.ReleaseImageDetails, error) {
+	return convert(object, cmv2alpha1.MarshalReleaseImageDetails, cmv1.UnmarshalReleaseImageDetails)
+}
+
+// ReleaseImagesToV2alpha1 converts a version 1 'release_images' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ReleaseImagesToV2alpha1(object *cmv1.ReleaseImages) (*cmv2alpha1.ReleaseImages, error) {
+	return convert(object, cmv1.MarshalReleaseImages, cmv2alpha1.UnmarshalReleaseImages)
+}
+
+// ReleaseImagesToV1 converts a version 2alpha1 'release_images' object of the clusters management
+// service into the equivalent version 1 object.
+func ReleaseImagesToV1(object *cmv2alpha1.ReleaseImages) (*cmv1.ReleaseImages, error) {
+	return convert(object, cmv2alpha1.MarshalReleaseImages, cmv1.UnmarshalReleaseImages)
+}
+
+// ResourceRangeToV2alpha1 converts a version 1 'resource_range' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ResourceRangeToV2alpha1(object *cmv1.ResourceRange) (*cmv2alpha1.ResourceRange, error) {
+	return convert(object, cmv1.MarshalResourceRange, cmv2alpha1.UnmarshalResourceRange)
+}
+
+// ResourceRangeToV1 converts a version 2alpha1 'resource_range' object of the clusters management
+// service into the equivalent version 1 object.
+func ResourceRangeToV1(object *cmv2alpha1.ResourceRange) (*cmv1.ResourceRange, error) {
+	return convert(object, cmv2alpha1.MarshalResourceRange, cmv1.UnmarshalResourceRange)
+}
+
+// RolePolicyToV2alpha1 converts a version 1 'role_policy' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func RolePolicyToV2alpha1(object *cmv1.RolePolicy) (*cmv2alpha1.RolePolicy, error) {
+	return convert(object, cmv1.MarshalRolePolicy, cmv2alpha1.UnmarshalRolePolicy)
+}
+
+// RolePolicyToV1 converts a version 2alpha1 'role_policy' object of the clusters management service
+// into the equivalent version 1 object.
+func RolePolicyToV1(object *cmv2alpha1.RolePolicy) (*cmv1.RolePolicy, error) {
+	return convert(object, cmv2alpha1.MarshalRolePolicy, cmv1.UnmarshalRolePolicy)
+}
+
+// RolePolicyBindingToV2alpha1 converts a version 1 'role_policy_binding' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func RolePolicyBindingToV2alpha1(object *cmv1.RolePolicyBinding) (*cmv2alpha1.RolePolicyBinding, error) {
+	return convert(object, cmv1.MarshalRolePolicyBinding, cmv2alpha1.UnmarshalRolePolicyBinding)
+}
+
+// RolePolicyBindingToV1 converts a version 2alpha1 'role_policy_binding' object of the clusters
+// management service into the equivalent version 1 object.
+func RolePolicyBindingToV1(object *cmv2alpha1.RolePolicyBinding) (*cmv1.RolePolicyBinding, error) {
+	return convert(object, cmv2alpha1.MarshalRolePolicyBinding, cmv1.UnmarshalRolePolicyBinding)
+}
+
+// RolePolicyBindingStatusToV2alpha1 converts a version 1 'role_policy_binding_status' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func RolePolicyBindingStatusToV2alpha1(object *cmv1.RolePolicyBindingStatus) (*cmv2alpha1.RolePolicyBindingStatus, error) {
+	return convert(object, cmv1.MarshalRolePolicyBindingStatus, cmv2alpha1.UnmarshalRolePolicyBindingStatus)
+}
+
+// RolePolicyBindingStatusToV1 converts a version 2alpha1 'role_policy_binding_status' object of the
+// clusters management service into the equivalent version 1 object.
+func RolePolicyBindingStatusToV1(object *cmv2alpha1.RolePolicyBindingStatus) (*cmv1.RolePolicyBindingStatus, error) {
+	return convert(object, cmv2alpha1.MarshalRolePolicyBindingStatus, cmv1.UnmarshalRolePolicyBindingStatus)
+}
+
+// RootVolumeToV2alpha1 converts a version 1 'root_volume' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func RootVolumeToV2alpha1(object *cmv1.RootVolume) (*cmv2alpha1.RootVolume, error) {
+	return convert(object, cmv1.MarshalRootVolume, cmv2alpha1.UnmarshalRootVolume)
+}
+
+// RootVolumeToV1 converts a version 2alpha1 'root_volume' object of the clusters management service
+// into the equivalent version 1 object.
+func RootVolumeToV1(object *cmv2alpha1.RootVolume) (*cmv1.RootVolume, error) {
+	return convert(object, cmv2alpha1.MarshalRootVolume, cmv1.UnmarshalRootVolume)
+}
+
+// STSToV2alpha1 converts a version 1 'STS' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func STSToV2alpha1(object *cmv1.STS) (*cmv2alpha1.STS, error) {
+	return convert(object, cmv1.MarshalSTS, cmv2alpha1.UnmarshalSTS)
+}
+
+// STSToV1 converts a version 2alpha1 'STS' object of the clusters management service into the
+// equivalent version 1 object.
+func STSToV1(object *cmv2alpha1.STS) (*cmv1.STS, error) {
+	return convert(object, cmv2alpha1.MarshalSTS, cmv1.UnmarshalSTS)
+}
+
+// STSCredentialRequestToV2alpha1 converts a version 1 'STS_credential_request' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func STSCredentialRequestToV2alpha1(object *cmv1.STSCredentialRequest) (*cmv2alpha1.STSCredentialRequest, error) {
+	return convert(object, cmv1.MarshalSTSCredentialRequest, cmv2alpha1.UnmarshalSTSCredentialRequest)
+}
+
+// STSCredentialRequestToV1 converts a version 2alpha1 'STS_credential_request' object of the
+// clusters management service into the equivalent version 1 object.
+func STSCredentialRequestToV1(object *cmv2alpha1.STSCredentialRequest) (*cmv1.STSCredentialRequest, error) {
+	return convert(object, cmv2alpha1.MarshalSTSCredentialRequest, cmv1.UnmarshalSTSCredentialRequest)
+}
+
+// STSOperatorToV2alpha1 converts a version 1 'STS_operator' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func STSOperatorToV2alpha1(object *cmv1.STSOperator) (*cmv2alpha1.STSOperator, error) {
+	return convert(object, cmv1.MarshalSTSOperator, cmv2alpha1.UnmarshalSTSOperator)
+}
+
+// STSOperatorToV1 converts a version 2alpha1 'STS_operator' object of the clusters management
+// service into the equivalent version 1 object.
+func STSOperatorToV1(object *cmv2alpha1.STSOperator) (*cmv1.STSOperator, error) {
+	return convert(object, cmv2alpha1.MarshalSTSOperator, cmv1.UnmarshalSTSOperator)
+}
+
+// SecurityGroupToV2alpha1 converts a version 1 'security_group' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func SecurityGroupToV2alpha1(object *cmv1.SecurityGroup) (*cmv2alpha1.SecurityGroup, error) {
+	return convert(object, cmv1.MarshalSecurityGroup, cmv2alpha1.UnmarshalSecurityGroup)
+}
+
+// SecurityGroupToV1 converts a version 2alpha1 'security_group' object of the clusters management
+// service into the equivalent version 1 object.
+func SecurityGroupToV1(object *cmv2alpha1.SecurityGroup) (*cmv1.SecurityGroup, error) {
+	return convert(object, cmv2alpha1.MarshalSecurityGroup, cmv1.UnmarshalSecurityGroup)
+}
+
+// ServerConfigToV2alpha1 converts a version 1 'server_config' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func ServerConfigToV2alpha1(object *cmv1.ServerConfig) (*cmv2alpha1.ServerConfig, error) {
+	return convert(object, cmv1.MarshalServerConfig, cmv2alpha1.UnmarshalServerConfig)
+}
+
+// ServerConfigToV1 converts a version 2alpha1 'server_config' object of the clusters management
+// service into the equivalent version 1 object.
+func ServerConfigToV1(object *cmv2alpha1.ServerConfig) (*cmv1.ServerConfig, error) {
+	return convert(object, cmv2alpha1.MarshalServerConfig, cmv1.UnmarshalServerConfig)
+}
+
+// SocketTotalNodeRoleOSMetricNodeToV2alpha1 converts a version 1
+// 'socket_total_node_role_OS_metric_node' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func SocketTotalNodeRoleOSMetricNodeToV2alpha1(object *cmv1.SocketTotalNodeRoleOSMetricNode) (*cmv2alpha1.SocketTotalNodeRoleOSMetricNode, error) {
+	return convert(object, cmv1.MarshalSocketTotalNodeRoleOSMetricNode, cmv2alpha1.UnmarshalSocketTotalNodeRoleOSMetricNode)
+}
+
+// SocketTotalNodeRoleOSMetricNodeToV1 converts a version 2alpha1
+// 'socket_total_node_role_OS_metric_node' object of the clusters management service into the
+// equivalent version 1 object.
+func SocketTotalNodeRoleOSMetricNodeToV1(object *cmv2alpha1.SocketTotalNodeRoleOSMetricNode) (*cmv1.SocketTotalNodeRoleOSMetricNode, error) {
+	return convert(object, cmv2alpha1.MarshalSocketTotalNodeRoleOSMetricNode, cmv1.UnmarshalSocketTotalNodeRoleOSMetricNode)
+}
+
+// SocketTotalsNodeRoleOSMetricNodeToV2alpha1 converts a version 1
+// 'socket_totals_node_role_OS_metric_node' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func SocketTotalsNodeRoleOSMetricNodeToV2alpha1(object *cmv1.SocketTotalsNodeRoleOSMetricNode) (*cmv2alpha1.SocketTotalsNodeRoleOSMetricNode, error) {
+	return convert(object, cmv1.MarshalSocketTotalsNodeRoleOSMetricNode, cmv2alpha1.UnmarshalSocketTotalsNodeRoleOSMetricNode)
+}
+
+// SocketTotalsNodeRoleOSMetricNodeToV1 converts a version 2alpha1
+// 'socket_totals_node_role_OS_metric_node' object of the clusters management service into the
+// equivalent version 1 object.
+func SocketTotalsNodeRoleOSMetricNodeToV1(object *cmv2alpha1.SocketTotalsNodeRoleOSMetricNode) (*cmv1.SocketTotalsNodeRoleOSMetricNode, error) {
+	return convert(object, cmv2alpha1.MarshalSocketTotalsNodeRoleOSMetricNode, cmv1.UnmarshalSocketTotalsNodeRoleOSMetricNode)
+}
+
+// StorageQuotaToV2alpha1 converts a version 1 'storage_quota' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func StorageQuotaToV2alpha1(object *cmv1.StorageQuota) (*cmv2alpha1.StorageQuota, error) {
+	return convert(object, cmv1.MarshalStorageQuota, cmv2alpha1.UnmarshalStorageQuota)
+}
+
+// StorageQuotaToV1 converts a version 2alpha1 'storage_quota' object of the clusters management
+// service into the equivalent version 1 object.
+func StorageQuotaToV1(object *cmv2alpha1.StorageQuota) (*cmv1.StorageQuota, error) {
+	return convert(object, cmv2alpha1.MarshalStorageQuota, cmv1.UnmarshalStorageQuota)
+}
+
+// StsSupportJumpRoleToV2alpha1 converts a version 1 'sts_support_jump_role' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func StsSupportJumpRoleToV2alpha1(object *cmv1.StsSupportJumpRole) (*cmv2alpha1.StsSupportJumpRole, error) {
+	return convert(object, cmv1.MarshalStsSupportJumpRole, cmv2alpha1.UnmarshalStsSupportJumpRole)
+}
+
+// StsSupportJumpRoleToV1 converts a version 2alpha1 'sts_support_jump_role' object of the clusters
+// management service into the equivalent version 1 object.
+func StsSupportJumpRoleToV1(object *cmv2alpha1.StsSupportJumpRole) (*cmv1.StsSupportJumpRole, error) {
+	return convert(object, cmv2alpha1.MarshalStsSupportJumpRole, cmv1.UnmarshalStsSupportJumpRole)
+}
+
+// SubnetNetworkVerificationToV2alpha1 converts a version 1 'subnet_network_verification' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func SubnetNetworkVerificationToV2alpha1(object *cmv1.SubnetNetworkVerification) (*cmv2alpha1.SubnetNetworkVerification, error) {
+	return convert(object, cmv1.MarshalSubnetNetworkVerification, cmv2alpha1.UnmarshalSubnetNetworkVerification)
+}
+
+// SubnetNetworkVerificationToV1 converts a version 2alpha1 'subnet_network_verification' object of
+// the clusters management service into the equivalent version 1 object.
+func SubnetNetworkVerificationToV1(object *cmv2alpha1.SubnetNetworkVerification) (*cmv1.SubnetNetworkVerification, error) {
+	return convert(object, cmv2alpha1.MarshalSubnetNetworkVerification, cmv1.UnmarshalSubnetNetworkVerification)
+}
+
+// SubnetworkToV2alpha1 converts a version 1 'subnetwork' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func SubnetworkToV2alpha1(object *cmv1.Subnetwork) (*cmv2alpha1.Subnetwork, error) {
+	return convert(object, cmv1.MarshalSubnetwork, cmv2alpha1.UnmarshalSubnetwork)
+}
+
+// SubnetworkToV1 converts a version 2alpha1 'subnetwork' object of the clusters management service
+// into the equivalent version 1 object.
+func SubnetworkToV1(object *cmv2alpha1.Subnetwork) (*cmv1.Subnetwork, error) {
+	return convert(object, cmv2alpha1.MarshalSubnetwork, cmv1.UnmarshalSubnetwork)
+}
+
+// SubscriptionToV2alpha1 converts a version 1 'subscription' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func SubscriptionToV2alpha1(object *cmv1.Subscription) (*cmv2alpha1.Subscription, error) {
+	return convert(object, cmv1.MarshalSubscription, cmv2alpha1.UnmarshalSubscription)
+}
+
+// SubscriptionToV1 converts a version 2alpha1 'subscription' object of the clusters management
+// service into the equivalent version 1 object.
+func SubscriptionToV1(object *cmv2alpha1.Subscription) (*cmv1.Subscription, error) {
+	return convert(object, cmv2alpha1.MarshalSubscription, cmv1.UnmarshalSubscription)
+}
+
+// SyncsetToV2alpha1 converts a version 1 'syncset' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func SyncsetToV2alpha1(object *cmv1.Syncset) (*cmv2alpha1.Syncset, error) {
+	return convert(object, cmv1.MarshalSyncset, cmv2alpha1.UnmarshalSyncset)
+}
+
+// SyncsetToV1 converts a version 2alpha1 'syncset' object of the clusters management service into
+// the equivalent version 1 object.
+func SyncsetToV1(object *cmv2alpha1.Syncset) (*cmv1.Syncset, error) {
+	return convert(object, cmv2alpha1.MarshalSyncset, cmv1.UnmarshalSyncset)
+}
+
+// TaintToV2alpha1 converts a version 1 'taint' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func TaintToV2alpha1(object *cmv1.Taint) (*cmv2alpha1.Taint, error) {
+	return convert(object, cmv1.MarshalTaint, cmv2alpha1.UnmarshalTaint)
+}
+
+// TaintToV1 converts a version 2alpha1 'taint' object of the clusters management service into the
+// equivalent version 1 object.
+func TaintToV1(object *cmv2alpha1.Taint) (*cmv1.Taint, error) {
+	return convert(object, cmv2alpha1.MarshalTaint, cmv1.UnmarshalTaint)
+}
+
+// TokenClaimMappingsToV2alpha1 converts a version 1 'token_claim_mappings' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func TokenClaimMappingsToV2alpha1(object *cmv1.TokenClaimMappings) (*cmv2alpha1.TokenClaimMappings, error) {
+	return convert(object, cmv1.MarshalTokenClaimMappings, cmv2alpha1.UnmarshalTokenClaimMappings)
+}
+
+// TokenClaimMappingsToV1 converts a version 2alpha1 'token_claim_mappings' object of the clusters
+// management service into the equivalent version 1 object.
+func TokenClaimMappingsToV1(object *cmv2alpha1.TokenClaimMappings) (*cmv1.TokenClaimMappings, error) {
+	return convert(object, cmv2alpha1.MarshalTokenClaimMappings, cmv1.UnmarshalTokenClaimMappings)
+}
+
+// TokenClaimValidationRuleToV2alpha1 converts a version 1 'token_claim_validation_rule' object of
+// the clusters management service into the equivalent version 2alpha1 object.
+func TokenClaimValidationRuleToV2alpha1(object *cmv1.TokenClaimValidationRule) (*cmv2alpha1.TokenClaimValidationRule, error) {
+	return convert(object, cmv1.MarshalTokenClaimValidationRule, cmv2alpha1.UnmarshalTokenClaimValidationRule)
+}
+
+// TokenClaimValidationRuleToV1 converts a version 2alpha1 'token_claim_validation_rule' object of
+// the clusters management service into the equivalent version 1 object.
+func TokenClaimValidationRuleToV1(object *cmv2alpha1.TokenClaimValidationRule) (*cmv1.TokenClaimValidationRule, error) {
+	return convert(object, cmv2alpha1.MarshalTokenClaimValidationRule, cmv1.UnmarshalTokenClaimValidationRule)
+}
+
+// TokenIssuerToV2alpha1 converts a version 1 'token_issuer' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func TokenIssuerToV2alpha1(object *cmv1.TokenIssuer) (*cmv2alpha1.TokenIssuer, error) {
+	return convert(object, cmv1.MarshalTokenIssuer, cmv2alpha1.UnmarshalTokenIssuer)
+}
+
+// TokenIssuerToV1 converts a version 2alpha1 'token_issuer' object of the clusters management
+// service into the equivalent version 1 object.
+func TokenIssuerToV1(object *cmv2alpha1.TokenIssuer) (*cmv1.TokenIssuer, error) {
+	return convert(object, cmv2alpha1.MarshalTokenIssuer, cmv1.UnmarshalTokenIssuer)
+}
+
+// TrustedIpToV2alpha1 converts a version 1 'trusted_ip' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func TrustedIpToV2alpha1(object *cmv1.TrustedIp) (*cmv2alpha1.TrustedIp, error) {
+	return convert(object, cmv1.MarshalTrustedIp, cmv2alpha1.UnmarshalTrustedIp)
+}
+
+// TrustedIpToV1 converts a version 2alpha1 'trusted_ip' object of the clusters management service
+// into the equivalent version 1 object.
+func TrustedIpToV1(object *cmv2alpha1.TrustedIp) (*cmv1.TrustedIp, error) {
+	return convert(object, cmv2alpha1.MarshalTrustedIp, cmv1.UnmarshalTrustedIp)
+}
+
+// TuningConfigToV2alpha1 converts a version 1 'tuning_config' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func TuningConfigToV2alpha1(object *cmv1.TuningConfig) (*cmv2alpha1.TuningConfig, error) {
+	return convert(object, cmv1.MarshalTuningConfig, cmv2alpha1.UnmarshalTuningConfig)
+}
+
+// TuningConfigToV1 converts a version 2alpha1 'tuning_config' object of the clusters management
+// service into the equivalent version 1 object.
+func TuningConfigToV1(object *cmv2alpha1.TuningConfig) (*cmv1.TuningConfig, error) {
+	return convert(object, cmv2alpha1.MarshalTuningConfig, cmv1.UnmarshalTuningConfig)
+}
+
+// UpgradePolicyToV2alpha1 converts a version 1 'upgrade_policy' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func UpgradePolicyToV2alpha1(object *cmv1.UpgradePolicy) (*cmv2alpha1.UpgradePolicy, error) {
+	return convert(object, cmv1.MarshalUpgradePolicy, cmv2alpha1.UnmarshalUpgradePolicy)
+}
+
+// UpgradePolicyToV1 converts a version 2alpha1 'upgrade_policy' object of the clusters management
+// service into the equivalent version 1 object.
+func UpgradePolicyToV1(object *cmv2alpha1.UpgradePolicy) (*cmv1.UpgradePolicy, error) {
+	return convert(object, cmv2alpha1.MarshalUpgradePolicy, cmv1.UnmarshalUpgradePolicy)
+}
+
+// UpgradePolicyStateToV2alpha1 converts a version 1 'upgrade_policy_state' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func UpgradePolicyStateToV2alpha1(object *cmv1.UpgradePolicyState) (*cmv2alpha1.UpgradePolicyState, error) {
+	return convert(object, cmv1.MarshalUpgradePolicyState, cmv2alpha1.UnmarshalUpgradePolicyState)
+}
+
+// UpgradePolicyStateToV1 converts a version 2alpha1 'upgrade_policy_state' object of the clusters
+// management service into the equivalent version 1 object.
+func UpgradePolicyStateToV1(object *cmv2alpha1.UpgradePolicyState) (*cmv1.UpgradePolicyState, error) {
+	return convert(object, cmv2alpha1.MarshalUpgradePolicyState, cmv1.UnmarshalUpgradePolicyState)
+}
+
+// UserToV2alpha1 converts a version 1 'user' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func UserToV2alpha1(object *cmv1.User) (*cmv2alpha1.User, error) {
+	return convert(object, cmv1.MarshalUser, cmv2alpha1.UnmarshalUser)
+}
+
+// UserToV1 converts a version 2alpha1 'user' object of the clusters management service into the
+// equivalent version 1 object.
+func UserToV1(object *cmv2alpha1.User) (*cmv1.User, error) {
+	return convert(object, cmv2alpha1.MarshalUser, cmv1.UnmarshalUser)
+}
+
+// UsernameClaimToV2alpha1 converts a version 1 'username_claim' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func UsernameClaimToV2alpha1(object *cmv1.UsernameClaim) (*cmv2alpha1.UsernameClaim, error) {
+	return convert(object, cmv1.MarshalUsernameClaim, cmv2alpha1.UnmarshalUsernameClaim)
+}
+
+// UsernameClaimToV1 converts a version 2alpha1 'username_claim' object of the clusters management
+// service into the equivalent version 1 object.
+func UsernameClaimToV1(object *cmv2alpha1.UsernameClaim) (*cmv1.UsernameClaim, error) {
+	return convert(object, cmv2alpha1.MarshalUsernameClaim, cmv1.UnmarshalUsernameClaim)
+}
+
+// ValueToV2alpha1 converts a version 1 'value' object of the clusters management service into the
+// equivalent version 2alpha1 object.
+func ValueToV2alpha1(object *cmv1.Value) (*cmv2alpha1.Value, error) {
+	return convert(object, cmv1.MarshalValue, cmv2alpha1.UnmarshalValue)
+}
+
+// ValueToV1 converts a version 2alpha1 'value' object of the clusters management service into the
+// equivalent version 1 object.
+func ValueToV1(object *cmv2alpha1.Value) (*cmv1.Value, error) {
+	return convert(object, cmv2alpha1.MarshalValue, cmv1.UnmarshalValue)
+}
+
+// VersionToV2alpha1 converts a version 1 'version' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func VersionToV2alpha1(object *cmv1.Version) (*cmv2alpha1.Version, error) {
+	return convert(object, cmv1.MarshalVersion, cmv2alpha1.UnmarshalVersion)
+}
+
+// VersionToV1 converts a version 2alpha1 'version' object of the clusters management service into
+// the equivalent version 1 object.
+func VersionToV1(object *cmv2alpha1.Version) (*cmv1.Version, error) {
+	return convert(object, cmv2alpha1.MarshalVersion, cmv1.UnmarshalVersion)
+}
+
+// VersionGateToV2alpha1 converts a version 1 'version_gate' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func VersionGateToV2alpha1(object *cmv1.VersionGate) (*cmv2alpha1.VersionGate, error) {
+	return convert(object, cmv1.MarshalVersionGate, cmv2alpha1.UnmarshalVersionGate)
+}
+
+// VersionGateToV1 converts a version 2alpha1 'version_gate' object of the clusters management
+// service into the equivalent version 1 object.
+func VersionGateToV1(object *cmv2alpha1.VersionGate) (*cmv1.VersionGate, error) {
+	return convert(object, cmv2alpha1.MarshalVersionGate, cmv1.UnmarshalVersionGate)
+}
+
+// VersionGateAgreementToV2alpha1 converts a version 1 'version_gate_agreement' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func VersionGateAgreementToV2alpha1(object *cmv1.VersionGateAgreement) (*cmv2alpha1.VersionGateAgreement, error) {
+	return convert(object, cmv1.MarshalVersionGateAgreement, cmv2alpha1.UnmarshalVersionGateAgreement)
+}
+
+// VersionGateAgreementToV1 converts a version 2alpha1 'version_gate_agreement' object of the
+// clusters management service into the equivalent version 1 object.
+func VersionGateAgreementToV1(object *cmv2alpha1.VersionGateAgreement) (*cmv1.VersionGateAgreement, error) {
+	return convert(object, cmv2alpha1.MarshalVersionGateAgreement, cmv1.UnmarshalVersionGateAgreement)
+}
+
+// WifConfigToV2alpha1 converts a version 1 'wif_config' object of the clusters management service
+// into the equivalent version 2alpha1 object.
+func WifConfigToV2alpha1(object *cmv1.WifConfig) (*cmv2alpha1.WifConfig, error) {
+	return convert(object, cmv1.MarshalWifConfig, cmv2alpha1.UnmarshalWifConfig)
+}
+
+// WifConfigToV1 converts a version 2alpha1 'wif_config' object of the clusters management service
+// into the equivalent version 1 object.
+func WifConfigToV1(object *cmv2alpha1.WifConfig) (*cmv1.WifConfig, error) {
+	return convert(object, cmv2alpha1.MarshalWifConfig, cmv1.UnmarshalWifConfig)
+}
+
+// WifCredentialRequestToV2alpha1 converts a version 1 'wif_credential_request' object of the
+// clusters management service into the equivalent version 2alpha1 object.
+func WifCredentialRequestToV2alpha1(object *cmv1.WifCredentialRequest) (*cmv2alpha1.WifCredentialRequest, error) {
+	return convert(object, cmv1.MarshalWifCredentialRequest, cmv2alpha1.UnmarshalWifCredentialRequest)
+}
+
+// WifCredentialRequestToV1 converts a version 2alpha1 'wif_credential_request' object of the
+// clusters management service into the equivalent version 1 object.
+func WifCredentialRequestToV1(object *cmv2alpha1.WifCredentialRequest) (*cmv1.WifCredentialRequest, error) {
+	return convert(object, cmv2alpha1.MarshalWifCredentialRequest, cmv1.UnmarshalWifCredentialRequest)
+}
+
+// WifGcpToV2alpha1 converts a version 1 'wif_gcp' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func WifGcpToV2alpha1(object *cmv1.WifGcp) (*cmv2alpha1.WifGcp, error) {
+	return convert(object, cmv1.MarshalWifGcp, cmv2alpha1.UnmarshalWifGcp)
+}
+
+// WifGcpToV1 converts a version 2alpha1 'wif_gcp' object of the clusters management service into
+// the equivalent version 1 object.
+func WifGcpToV1(object *cmv2alpha1.WifGcp) (*cmv1.WifGcp, error) {
+	return convert(object, cmv2alpha1.MarshalWifGcp, cmv1.UnmarshalWifGcp)
+}
+
+// WifIdentityProviderToV2alpha1 converts a version 1 'wif_identity_provider' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func WifIdentityProviderToV2alpha1(object *cmv1.WifIdentityProvider) (*cmv2alpha1.WifIdentityProvider, error) {
+	return convert(object, cmv1.MarshalWifIdentityProvider, cmv2alpha1.UnmarshalWifIdentityProvider)
+}
+
+// WifIdentityProviderToV1 converts a version 2alpha1 'wif_identity_provider' object of the clusters
+// management service into the equivalent version 1 object.
+func WifIdentityProviderToV1(object *cmv2alpha1.WifIdentityProvider) (*cmv1.WifIdentityProvider, error) {
+	return convert(object, cmv2alpha1.MarshalWifIdentityProvider, cmv1.UnmarshalWifIdentityProvider)
+}
+
+// WifPoolToV2alpha1 converts a version 1 'wif_pool' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func WifPoolToV2alpha1(object *cmv1.WifPool) (*cmv2alpha1.WifPool, error) {
+	return convert(object, cmv1.MarshalWifPool, cmv2alpha1.UnmarshalWifPool)
+}
+
+// WifPoolToV1 converts a version 2alpha1 'wif_pool' object of the clusters management service into
+// the equivalent version 1 object.
+func WifPoolToV1(object *cmv2alpha1.WifPool) (*cmv1.WifPool, error) {
+	return convert(object, cmv2alpha1.MarshalWifPool, cmv1.UnmarshalWifPool)
+}
+
+// WifRoleToV2alpha1 converts a version 1 'wif_role' object of the clusters management service into
+// the equivalent version 2alpha1 object.
+func WifRoleToV2alpha1(object *cmv1.WifRole) (*cmv2alpha1.WifRole, error) {
+	return convert(object, cmv1.MarshalWifRole, cmv2alpha1.UnmarshalWifRole)
+}
+
+// WifRoleToV1 converts a version 2alpha1 'wif_role' object of the clusters management service into
+// the equivalent version 1 object.
+func WifRoleToV1(object *cmv2alpha1.WifRole) (*cmv1.WifRole, error) {
+	return convert(object, cmv2alpha1.MarshalWifRole, cmv1.UnmarshalWifRole)
+}
+
+// WifSecretRefToV2alpha1 converts a version 1 'wif_secret_ref' object of the clusters management
+// service into the equivalent version 2alpha1 object.
+func WifSecretRefToV2alpha1(object *cmv1.WifSecretRef) (*cmv2alpha1.WifSecretRef, error) {
+	return convert(object, cmv1.MarshalWifSecretRef, cmv2alpha1.UnmarshalWifSecretRef)
+}
+
+// WifSecretRefToV1 converts a version 2alpha1 'wif_secret_ref' object of the clusters management
+// service into the equivalent version 1 object.
+func WifSecretRefToV1(object *cmv2alpha1.WifSecretRef) (*cmv1.WifSecretRef, error) {
+	return convert(object, cmv2alpha1.MarshalWifSecretRef, cmv1.UnmarshalWifSecretRef)
+}
+
+// WifServiceAccountToV2alpha1 converts a version 1 'wif_service_account' object of the clusters
+// management service into the equivalent version 2alpha1 object.
+func WifServiceAccountToV2alpha1(object *cmv1.WifServiceAccount) (*cmv2alpha1.WifServiceAccount, error) {
+	return convert(object, cmv1.MarshalWifServiceAccount, cmv2alpha1.UnmarshalWifServiceAccount)
+}
+
+// WifServiceAccountToV1 converts a version 2alpha1 'wif_service_account' object of the clusters
+// management service into the equivalent version 1 object.
+func WifServiceAccountToV1(object *cmv2alpha1.WifServiceAccount) (*cmv1.WifServiceAccount, error) {
+	return convert(object, cmv2alpha1.MarshalWifServiceAccount, cmv1.UnmarshalWifServiceAccount)
+}
+
+// AWSInfrastructureAccessRoleGrantStateToV2alpha1 converts a version 1 value of the
+// 'AWS_infrastructure_access_role_grant_state' enumerated type of the clusters management service
+// into the equivalent version 2alpha1 value.
+func AWSInfrastructureAccessRoleGrantStateToV2alpha1(value cmv1.AWSInfrastructureAccessRoleGrantState) cmv2alpha1.AWSInfrastructureAccessRoleGrantState {
+	return cmv2alpha1.AWSInfrastructureAccessRoleGrantState(value)
+}
+
+// AWSInfrastructureAccessRoleGrantStateToV1 converts a version 2alpha1 value of the
+// 'AWS_infrastructure_access_role_grant_state' enumerated type of the clusters management service
+// into the equivalent version 1 value.
+func AWSInfrastructureAccessRoleGrantStateToV1(value cmv2alpha1.AWSInfrastructureAccessRoleGrantState) cmv1.AWSInfrastructureAccessRoleGrantState {
+	return cmv1.AWSInfrastructureAccessRoleGrantState(value)
+}
+
+// AWSInfrastructureAccessRoleStateToV2alpha1 converts a version 1 value of the
+// 'AWS_infrastructure_access_role_state' enumerated type of the clusters management service into
+// the equivalent version 2alpha1 value.
+func AWSInfrastructureAccessRoleStateToV2alpha1(value cmv1.AWSInfrastructureAccessRoleState) cmv2alpha1.AWSInfrastructureAccessRoleState {
+	return cmv2alpha1.AWSInfrastructureAccessRoleState(value)
+}
+
+// AWSInfrastructureAccessRoleStateToV1 converts a version 2alpha1 value of the
+// 'AWS_infrastructure_access_role_state' enumerated type of the clusters management service into
+// the equivalent version 1 value.
+func AWSInfrastructureAccessRoleStateToV1(value cmv2alpha1.AWSInfrastructureAccessRoleState) cmv1.AWSInfrastructureAccessRoleState {
+	return cmv1.AWSInfrastructureAccessRoleState(value)
+}
+
+// AddOnInstallModeToV2alpha1 converts a version 1 value of the 'add_on_install_mode' enumerated
+// type of the clusters management service into the equivalent version 2alpha1 value.
+func AddOnInstallModeToV2alpha1(value cmv1.AddOnInstallMode) cmv2alpha1.AddOnInstallMode {
+	return cmv2alpha1.AddOnInstallMode(value)
+}
+
+// AddOnInstallModeToV1 converts a version 2alpha1 value of the 'add_on_install_mode' enumerated
+// type of the clusters management service into the equivalent version 1 value.
+func AddOnInstallModeToV1(value cmv2alpha1.AddOnInstallMode) cmv1.AddOnInstallMode {
+	return cmv1.AddOnInstallMode(value)
+}
+
+// AddOnInstallationStateToV2alpha1 converts a version 1 value of the 'add_on_installation_state'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func AddOnInstallationStateToV2alpha1(value cmv1.AddOnInstallationState) cmv2alpha1.AddOnInstallationState {
+	return cmv2alpha1.AddOnInstallationState(value)
+}
+
+// AddOnInstallationStateToV1 converts a version 2alpha1 value of the 'add_on_installation_state'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func AddOnInstallationStateToV1(value cmv2alpha1.AddOnInstallationState) cmv1.AddOnInstallationState {
+	return cmv1.AddOnInstallationState(value)
+}
+
+// AlertSeverityToV2alpha1 converts a version 1 value of the 'alert_severity' enumerated type of the
+// clusters management service into the equivalent version 2alpha1 value.
+func AlertSeverityToV2alpha1(value cmv1.AlertSeverity) cmv2alpha1.AlertSeverity {
+	return cmv2alpha1.AlertSeverity(value)
+}
+
+// AlertSeverityToV1 converts a version 2alpha1 value of the 'alert_severity' enumerated type of the
+// clusters management service into the equivalent version 1 value.
+func AlertSeverityToV1(value cmv2alpha1.AlertSeverity) cmv1.AlertSeverity {
+	return cmv1.AlertSeverity(value)
+}
+
+// BillingModelToV2alpha1 converts a version 1 value of the 'billing_model' enumerated type of the
+// clusters management service into the equivalent version 2alpha1 value.
+func BillingModelToV2alpha1(value cmv1.BillingModel) cmv2alpha1.BillingModel {
+	return cmv2alpha1.BillingModel(value)
+}
+
+// BillingModelToV1 converts a version 2alpha1 value of the 'billing_model' enumerated type of the
+// clusters management service into the equivalent version 1 value.
+func BillingModelToV1(value cmv2alpha1.BillingModel) cmv1.BillingModel {
+	return cmv1.BillingModel(value)
+}
+
+// BreakGlassCredentialStatusToV2alpha1 converts a version 1 value of the
+// 'break_glass_credential_status' enumerated type of the clusters management service into the
+// equivalent version 2alpha1 value.
+func BreakGlassCredentialStatusToV2alpha1(value cmv1.BreakGlassCredentialStatus) cmv2alpha1.BreakGlassCredentialStatus {
+	return cmv2alpha1.BreakGlassCredentialStatus(value)
+}
+
+// BreakGlassCredentialStatusToV1 converts a version 2alpha1 value of the
+// 'break_glass_credential_status' enumerated type of the clusters management service into the
+// equivalent version 1 value.
+func BreakGlassCredentialStatusToV1(value cmv2alpha1.BreakGlassCredentialStatus) cmv1.BreakGlassCredentialStatus {
+	return cmv1.BreakGlassCredentialStatus(value)
+}
+
+// ClusterConfigurationModeToV2alpha1 converts a version 1 value of the 'cluster_configuration_mode'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func ClusterConfigurationModeToV2alpha1(value cmv1.ClusterConfigurationMode) cmv2alpha1.ClusterConfigurationMode {
+	return cmv2alpha1.ClusterConfigurationMode(value)
+}
+
+// ClusterConfigurationModeToV1 converts a version 2alpha1 value of the 'cluster_configuration_mode'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func ClusterConfigurationModeToV1(value cmv2alpha1.ClusterConfigurationMode) cmv1.ClusterConfigurationMode {
+	return cmv1.ClusterConfigurationMode(value)
+}
+
+// ClusterHealthStateToV2alpha1 converts a version 1 value of the 'cluster_health_state' enumerated
+// type of the clusters management service into the equivalent version 2alpha1 value.
+func ClusterHealthStateToV2alpha1(value cmv1.ClusterHealthState) cmv2alpha1.ClusterHealthState {
+	return cmv2alpha1.ClusterHealthState(value)
+}
+
+// ClusterHealthStateToV1 converts a version 2alpha1 value of the 'cluster_health_state' enumerated
+// type of the clusters management service into the equivalent version 1 value.
+func ClusterHealthStateToV1(value cmv2alpha1.ClusterHealthState) cmv1.ClusterHealthState {
+	return cmv1.ClusterHealthState(value)
+}
+
+// ClusterOperatorStateToV2alpha1 converts a version 1 value of the 'cluster_operator_state'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func ClusterOperatorStateToV2alpha1(value cmv1.ClusterOperatorState) cmv2alpha1.ClusterOperatorState {
+	return cmv2alpha1.ClusterOperatorState(value)
+}
+
+// ClusterOperatorStateToV1 converts a version 2alpha1 value of the 'cluster_operator_state'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func ClusterOperatorStateToV1(value cmv2alpha1.ClusterOperatorState) cmv1.ClusterOperatorState {
+	return cmv1.ClusterOperatorState(value)
+}
+
+// ClusterStateToV2alpha1 converts a version 1 value of the 'cluster_state' enumerated type of the
+// clusters management service into the equivalent version 2alpha1 value.
+func ClusterStateToV2alpha1(value cmv1.ClusterState) cmv2alpha1.ClusterState {
+	return cmv2alpha1.ClusterState(value)
+}
+
+// ClusterStateToV1 converts a version 2alpha1 value of the 'cluster_state' enumerated type of the
+// clusters management service into the equivalent version 1 value.
+func ClusterStateToV1(value cmv2alpha1.ClusterState) cmv1.ClusterState {
+	return cmv1.ClusterState(value)
+}
+
+// ComponentRouteTypeToV2alpha1 converts a version 1 value of the 'component_route_type' enumerated
+// type of the clusters management service into the equivalent version 2alpha1 value.
+func ComponentRouteTypeToV2alpha1(value cmv1.ComponentRouteType) cmv2alpha1.ComponentRouteType {
+	return cmv2alpha1.ComponentRouteType(value)
+}
+
+// ComponentRouteTypeToV1 converts a version 2alpha1 value of the 'component_route_type' enumerated
+// type of the clusters management service into the equivalent version 1 value.
+func ComponentRouteTypeToV1(value cmv2alpha1.ComponentRouteType) cmv1.ComponentRouteType {
+	return cmv1.ComponentRouteType(value)
+}
+
+// DetectionTypeToV2alpha1 converts a version 1 value of the 'detection_type' enumerated type of the
+// clusters management service into the equivalent version 2alpha1 value.
+func DetectionTypeToV2alpha1(value cmv1.DetectionType) cmv2alpha1.DetectionType {
+	return cmv2alpha1.DetectionType(value)
+}
+
+// DetectionTypeToV1 converts a version 2alpha1 value of the 'detection_type' enumerated type of the
+// clusters management service into the equivalent version 1 value.
+func DetectionTypeToV1(value cmv2alpha1.DetectionType) cmv1.DetectionType {
+	return cmv1.DetectionType(value)
+}
+
+// Ec2MetadataHttpTokensToV2alpha1 converts a version 1 value of the 'ec_2_metadata_http_tokens'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func Ec2MetadataHttpTokensToV2alpha1(value cmv1.Ec2MetadataHttpTokens) cmv2alpha1.Ec2MetadataHttpTokens {
+	return cmv2alpha1.Ec2MetadataHttpTokens(value)
+}
+
+// Ec2MetadataHttpTokensToV1 converts a version 2alpha1 value of the 'ec_2_metadata_http_tokens'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func Ec2MetadataHttpTokensToV1(value cmv2alpha1.Ec2MetadataHttpTokens) cmv1.Ec2MetadataHttpTokens {
+	return cmv1.Ec2MetadataHttpTokens(value)
+}
+
+// IdentityProviderMappingMethodToV2alpha1 converts a version 1 value of the
+// 'identity_provider_mapping_method' enumerated type of the clusters management service into the
+// equivalent version 2alpha1 value.
+func IdentityProviderMappingMethodToV2alpha1(value cmv1.IdentityProviderMappingMethod) cmv2alpha1.IdentityProviderMappingMethod {
+	return cmv2alpha1.IdentityProviderMappingMethod(value)
+}
+
+// IdentityProviderMappingMethodToV1 converts a version 2alpha1 value of the
+// 'identity_provider_mapping_method' enumerated type of the clusters management service into the
+// equivalent version 1 value.
+func IdentityProviderMappingMethodToV1(value cmv2alpha1.IdentityProviderMappingMethod) cmv1.IdentityProviderMappingMethod {
+	return cmv1.IdentityProviderMappingMethod(value)
+}
+
+// IdentityProviderTypeToV2alpha1 converts a version 1 value of the 'identity_provider_type'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func IdentityProviderTypeToV2alpha1(value cmv1.IdentityProviderType) cmv2alpha1.IdentityProviderType {
+	return cmv2alpha1.IdentityProviderType(value)
+}
+
+// IdentityProviderTypeToV1 converts a version 2alpha1 value of the 'identity_provider_type'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func IdentityProviderTypeToV1(value cmv2alpha1.IdentityProviderType) cmv1.IdentityProviderType {
+	return cmv1.IdentityProviderType(value)
+}
+
+// InflightCheckStateToV2alpha1 converts a version 1 value of the 'inflight_check_state' enumerated
+// type of the clusters management service into the equivalent version 2alpha1 value.
+func InflightCheckStateToV2alpha1(value cmv1.InflightCheckState) cmv2alpha1.InflightCheckState {
+	return cmv2alpha1.InflightCheckState(value)
+}
+
+// InflightCheckStateToV1 converts a version 2alpha1 value of the 'inflight_check_state' enumerated
+// type of the clusters management service into the equivalent version 1 value.
+func InflightCheckStateToV1(value cmv2alpha1.InflightCheckState) cmv1.InflightCheckState {
+	return cmv1.InflightCheckState(value)
+}
+
+// ListeningMethodToV2alpha1 converts a version 1 value of the 'listening_method' enumerated type of
+// the clusters management service into the equivalent version 2alpha1 value.
+func ListeningMethodToV2alpha1(value cmv1.ListeningMethod) cmv2alpha1.ListeningMethod {
+	return cmv2alpha1.ListeningMethod(value)
+}
+
+// ListeningMethodToV1 converts a version 2alpha1 value of the 'listening_method' enumerated type of
+// the clusters management service into the equivalent version 1 value.
+func ListeningMethodToV1(value cmv2alpha1.ListeningMethod) cmv1.ListeningMethod {
+	return cmv1.ListeningMethod(value)
+}
+
+// LoadBalancerFlavorToV2alpha1 converts a version 1 value of the 'load_balancer_flavor' enumerated
+// type of the clusters management service into the equivalent version 2alpha1 value.
+func LoadBalancerFlavorToV2alpha1(value cmv1.LoadBalancerFlavor) cmv2alpha1.LoadBalancerFlavor {
+	return cmv2alpha1.LoadBalancerFlavor(value)
+}
+
+// LoadBalancerFlavorToV1 converts a version 2alpha1 value of the 'load_balancer_flavor' enumerated
+// type of the clusters management service into the equivalent version 1 value.
+func LoadBalancerFlavorToV1(value cmv2alpha1.LoadBalancerFlavor) cmv1.LoadBalancerFlavor {
+	return cmv1.LoadBalancerFlavor(value)
+}
+
+// MachineTypeCategoryToV2alpha1 converts a version 1 value of the 'machine_type_category'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func MachineTypeCategoryToV2alpha1(value cmv1.MachineTypeCategory) cmv2alpha1.MachineTypeCategory {
+	return cmv2alpha1.MachineTypeCategory(value)
+}
+
+// MachineTypeCategoryToV1 converts a version 2alpha1 value of the 'machine_type_category'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func MachineTypeCategoryToV1(value cmv2alpha1.MachineTypeCategory) cmv1.MachineTypeCategory {
+	return cmv1.MachineTypeCategory(value)
+}
+
+// MachineTypeSizeToV2alpha1 converts a version 1 value of the 'machine_type_size' enumerated type
+// of the clusters management service into the equivalent version 2alpha1 value.
+func MachineTypeSizeToV2alpha1(value cmv1.MachineTypeSize) cmv2alpha1.MachineTypeSize {
+	return cmv2alpha1.MachineTypeSize(value)
+}
+
+// MachineTypeSizeToV1 converts a version 2alpha1 value of the 'machine_type_size' enumerated type
+// of the clusters management service into the equivalent version 1 value.
+func MachineTypeSizeToV1(value cmv2alpha1.MachineTypeSize) cmv1.MachineTypeSize {
+	return cmv1.MachineTypeSize(value)
+}
+
+// NamespaceOwnershipPolicyToV2alpha1 converts a version 1 value of the 'namespace_ownership_policy'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func NamespaceOwnershipPolicyToV2alpha1(value cmv1.NamespaceOwnershipPolicy) cmv2alpha1.NamespaceOwnershipPolicy {
+	return cmv2alpha1.NamespaceOwnershipPolicy(value)
+}
+
+// NamespaceOwnershipPolicyToV1 converts a version 2alpha1 value of the 'namespace_ownership_policy'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func NamespaceOwnershipPolicyToV1(value cmv2alpha1.NamespaceOwnershipPolicy) cmv1.NamespaceOwnershipPolicy {
+	return cmv1.NamespaceOwnershipPolicy(value)
+}
+
+// NodeTypeToV2alpha1 converts a version 1 value of the 'node_type' enumerated type of the clusters
+// management service into the equivalent version 2alpha1 value.
+func NodeTypeToV2alpha1(value cmv1.NodeType) cmv2alpha1.NodeType {
+	return cmv2alpha1.NodeType(value)
+}
+
+// NodeTypeToV1 converts a version 2alpha1 value of the 'node_type' enumerated type of the clusters
+// management service into the equivalent version 1 value.
+func NodeTypeToV1(value cmv2alpha1.NodeType) cmv1.NodeType {
+	return cmv1.NodeType(value)
+}
+
+// PlatformToV2alpha1 converts a version 1 value of the 'platform' enumerated type of the clusters
+// management service into the equivalent version 2alpha1 value.
+func PlatformToV2alpha1(value cmv1.Platform) cmv2alpha1.Platform {
+	return cmv2alpha1.Platform(value)
+}
+
+// PlatformToV1 converts a version 2alpha1 value of the 'platform' enumerated type of the clusters
+// management service into the equivalent version 1 value.
+func PlatformToV1(value cmv2alpha1.Platform) cmv1.Platform {
+	return cmv1.Platform(value)
+}
+
+// ProcessorTypeToV2alpha1 converts a version 1 value of the 'processor_type' enumerated type of the
+// clusters management service into the equivalent version 2alpha1 value.
+func ProcessorTypeToV2alpha1(value cmv1.ProcessorType) cmv2alpha1.ProcessorType {
+	return cmv2alpha1.ProcessorType(value)
+}
+
+// ProcessorTypeToV1 converts a version 2alpha1 value of the 'processor_type' enumerated type of the
+// clusters management service into the equivalent version 1 value.
+func ProcessorTypeToV1(value cmv2alpha1.ProcessorType) cmv1.ProcessorType {
+	return cmv1.ProcessorType(value)
+}
+
+// ProvisionShardTopologyToV2alpha1 converts a version 1 value of the 'provision_shard_topology'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func ProvisionShardTopologyToV2alpha1(value cmv1.ProvisionShardTopology) cmv2alpha1.ProvisionShardTopology {
+	return cmv2alpha1.ProvisionShardTopology(value)
+}
+
+// ProvisionShardTopologyToV1 converts a version 2alpha1 value of the 'provision_shard_topology'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func ProvisionShardTopologyToV1(value cmv2alpha1.ProvisionShardTopology) cmv1.ProvisionShardTopology {
+	return cmv1.ProvisionShardTopology(value)
+}
+
+// ScheduleTypeToV2alpha1 converts a version 1 value of the 'schedule_type' enumerated type of the
+// clusters management service into the equivalent version 2alpha1 value.
+func ScheduleTypeToV2alpha1(value cmv1.ScheduleType) cmv2alpha1.ScheduleType {
+	return cmv2alpha1.ScheduleType(value)
+}
+
+// ScheduleTypeToV1 converts a version 2alpha1 value of the 'schedule_type' enumerated type of the
+// clusters management service into the equivalent version 1 value.
+func ScheduleTypeToV1(value cmv2alpha1.ScheduleType) cmv1.ScheduleType {
+	return cmv1.ScheduleType(value)
+}
+
+// UpgradePolicyStateValueToV2alpha1 converts a version 1 value of the 'upgrade_policy_state_value'
+// enumerated type of the clusters management service into the equivalent version 2alpha1 value.
+func UpgradePolicyStateValueToV2alpha1(value cmv1.UpgradePolicyStateValue) cmv2alpha1.UpgradePolicyStateValue {
+	return cmv2alpha1.UpgradePolicyStateValue(value)
+}
+
+// UpgradePolicyStateValueToV1 converts a version 2alpha1 value of the 'upgrade_policy_state_value'
+// enumerated type of the clusters management service into the equivalent version 1 value.
+func UpgradePolicyStateValueToV1(value cmv2alpha1.UpgradePolicyStateValue) cmv1.UpgradePolicyStateValue {
+	return cmv1.UpgradePolicyStateValue(value)
+}
+
+// UpgradeTypeToV2alpha1 converts a version 1 value of the 'upgrade_type' enumerated type of the
+// clusters management service into the equivalent version 2alpha1 value.
+func UpgradeTypeToV2alpha1(value cmv1.UpgradeType) cmv2alpha1.UpgradeType {
+	return cmv2alpha1.UpgradeType(value)
+}
+
+// UpgradeTypeToV1 converts a version 2alpha1 value of the 'upgrade_type' enumerated type of the
+// clusters management service into the equivalent version 1 value.
+func UpgradeTypeToV1(value cmv2alpha1.UpgradeType) cmv1.UpgradeType {
+	return cmv1.UpgradeType(value)
+}
+
+// WifAccessMethodToV2alpha1 converts a version 1 value of the 'wif_access_method' enumerated type
+// of the clusters management service into the equivalent version 2alpha1 value.
+func WifAccessMethodToV2alpha1(value cmv1.WifAccessMethod) cmv2alpha1.WifAccessMethod {
+	return cmv2alpha1.WifAccessMethod(value)
+}
+
+// WifAccessMethodToV1 converts a version 2alpha1 value of the 'wif_access_method' enumerated type
+// of the clusters management service into the equivalent version 1 value.
+func WifAccessMethodToV1(value cmv2alpha1.WifAccessMethod) cmv1.WifAccessMethod {
+	return cmv1.WifAccessMethod(value)
+}
+
+// WildcardPolicyToV2alpha1 converts a version 1 value of the 'wildcard_policy' enumerated type of
+// the clusters management service into the equivalent version 2alpha1 value.
+func WildcardPolicyToV2alpha1(value cmv1.WildcardPolicy) cmv2alpha1.WildcardPolicy {
+	return cmv2alpha1.WildcardPolicy(value)
+}
+
+// WildcardPolicyToV1 converts a version 2alpha1 value of the 'wildcard_policy' enumerated type of
+// the clusters management service into the equivalent version 1 value.
+func WildcardPolicyToV1(value cmv2alpha1.WildcardPolicy) cmv1.WildcardPolicy {
+	return cmv1.WildcardPolicy(value)
+}
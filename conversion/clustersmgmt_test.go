@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the conversion between versions of the clusters management API.
+
+package conversion // github.com/openshift-online/ocm-sdk-go/conversion
+
+import (
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	cmv2alpha1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v2alpha1"
+)
+
+var _ = Describe("Clusters management", func() {
+	It("Converts object from version 1", func() {
+		source, err := cmv1.NewCluster().
+			ID("123").
+			Name("my").
+			State(cmv1.ClusterStateReady).
+			Region(cmv1.NewCloudRegion().ID("us-east-1")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := ClusterToV2alpha1(source)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ID()).To(Equal("123"))
+		Expect(result.Name()).To(Equal("my"))
+		Expect(result.State()).To(Equal(cmv2alpha1.ClusterStateReady))
+		Expect(result.Region().ID()).To(Equal("us-east-1"))
+	})
+
+	It("Converts object to version 1", func() {
+		source, err := cmv2alpha1.NewCluster().
+			ID("123").
+			Name("my").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := ClusterToV1(source)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ID()).To(Equal("123"))
+		Expect(result.Name()).To(Equal("my"))
+	})
+
+	It("Converts nil object", func() {
+		result, err := ClusterToV2alpha1(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+	})
+
+	It("Converts enumerated value", func() {
+		Expect(ClusterStateToV2alpha1(cmv1.ClusterStateReady)).To(
+			Equal(cmv2alpha1.ClusterStateReady),
+		)
+		Expect(ClusterStateToV1(cmv2alpha1.ClusterStateError)).To(
+			Equal(cmv1.ClusterStateError),
+		)
+	})
+})
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the function that converts objects between versions of an API.
+
+package conversion // github.com/openshift-online/ocm-sdk-go/conversion
+
+import (
+	"bytes"
+	"io"
+)
+
+// convert converts an object into an object of a different type that has the same JSON
+// representation, usually the equivalent type of other version of the same API. It writes the
+// source object with the marshal function and reads the result with the unmarshal function.
+func convert[S, T any](source *S, marshal func(*S, io.Writer) error,
+	unmarshal func(interface{}) (*T, error)) (result *T, err error) {
+	if source == nil {
+		return
+	}
+	buffer := &bytes.Buffer{}
+	err = marshal(source, buffer)
+	if err != nil {
+		return
+	}
+	result, err = unmarshal(buffer.Bytes())
+	return
+}
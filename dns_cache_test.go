@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the DNS cache.
+
+package sdk
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("DNS cache", func() {
+	var server *ghttp.Server
+
+	BeforeEach(func() {
+		server = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Sends requests using the cached addresses", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{}`),
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+
+		// Create the connection, using the host name instead of the IP address so that the
+		// resolver is used:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(strings.Replace(server.URL(), "127.0.0.1", "localhost", 1)).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			DNSCache(time.Minute, time.Second).
+			DisableKeepAlives(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Send the requests:
+		for i := 0; i < 2; i++ {
+			response, err := connection.Get().Path("/api/clusters_mgmt/v1/clusters").Send()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Status()).To(Equal(http.StatusOK))
+		}
+	})
+
+	It("Rejects negative time to live", func() {
+		_, err := NewConnectionBuilder().
+			Logger(logger).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			DNSCache(-time.Minute, 0).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("should be zero or positive"))
+	})
+})
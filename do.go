@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the methods of the connection that send arbitrary HTTP requests.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Do sends the given HTTP request using the authentication, retry, metrics and logging mechanisms of
+// the connection, and returns the response. This is intended for endpoints that aren't yet supported
+// by the generated clients. The URL of the request must contain only the absolute path and,
+// optionally, the query, the base URL is selected by the connection in the same way that it is done
+// for the generated clients. For example:
+//
+//	request, err := http.NewRequest(http.MethodGet, "/api/my_service/v1/things", nil)
+//	if err != nil {
+//		...
+//	}
+//	response, err := connection.Do(ctx, request)
+//	if err != nil {
+//		...
+//	}
+//	defer response.Body.Close()
+//
+// Like the RoundTrip method any HTTP status code returned by the server is considered a valid
+// response and will not be translated into an error. The caller is responsible for closing the body
+// of the response. The given request isn't modified.
+func (c *Connection) Do(ctx context.Context, request *http.Request) (response *http.Response,
+	err error) {
+	if request == nil {
+		err = fmt.Errorf("request is mandatory")
+		return
+	}
+	if ctx == nil {
+		ctx = request.Context()
+	}
+	response, err = c.RoundTrip(request.Clone(ctx))
+	return
+}
+
+// DoGet sends a GET request for the given path using the Do method. The path can contain a query,
+// for example `/api/clusters_mgmt/v1/clusters?search=name+like+'my%25'`.
+func (c *Connection) DoGet(ctx context.Context, path string) (response *http.Response,
+	err error) {
+	return c.doPath(ctx, http.MethodGet, path, nil)
+}
+
+// DoPost sends a POST request with the given body for the given path using the Do method. The body
+// can be nil.
+func (c *Connection) DoPost(ctx context.Context, path string, body io.Reader) (
+	response *http.Response, err error) {
+	return c.doPath(ctx, http.MethodPost, path, body)
+}
+
+// DoDelete sends a DELETE request for the given path using the Do method.
+func (c *Connection) DoDelete(ctx context.Context, path string) (response *http.Response,
+	err error) {
+	return c.doPath(ctx, http.MethodDelete, path, nil)
+}
+
+func (c *Connection) doPath(ctx context.Context, method, path string, body io.Reader) (
+	response *http.Response, err error) {
+	uri, err := url.Parse(path)
+	if err != nil {
+		err = fmt.Errorf("can't parse path '%s': %w", path, err)
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request, err := http.NewRequestWithContext(ctx, method, "", body)
+	if err != nil {
+		return
+	}
+	request.URL = uri
+	response, err = c.Do(ctx, request)
+	return
+}
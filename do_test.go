@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the methods that send arbitrary HTTP requests.
+
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Do", func() {
+	var (
+		ctx        context.Context
+		token      string
+		server     *ghttp.Server
+		connection *Connection
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the context:
+		ctx = context.Background()
+
+		// Create the tokens:
+		token = MakeTokenString("Bearer", 5*time.Minute)
+
+		// Create the server:
+		server = MakeTCPServer()
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			RetryInterval(10 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the server:
+		server.Close()
+	})
+
+	It("Sends an arbitrary request with authentication", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPut, "/api/my_service/v1/things/123"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer "+token),
+				ghttp.VerifyBody([]byte(`{"name":"mything"}`)),
+				RespondWithJSON(http.StatusOK, `{"id":"123"}`),
+			),
+		)
+
+		// Send the request:
+		request, err := http.NewRequest(
+			http.MethodPut,
+			"/api/my_service/v1/things/123",
+			strings.NewReader(`{"name":"mything"}`),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := connection.Do(ctx, request)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"id":"123"}`))
+
+		// Check that the original request wasn't modified:
+		Expect(request.URL.Host).To(BeEmpty())
+	})
+
+	It("Retries the request", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusServiceUnavailable, `{}`),
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+
+		// Send the request:
+		response, err := connection.DoGet(ctx, "/api/my_service/v1/things")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Sends the query of the path", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/my_service/v1/things", "page=2"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Send the request:
+		response, err := connection.DoGet(ctx, "/api/my_service/v1/things?page=2")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("Sends post request", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/my_service/v1/things"),
+				ghttp.VerifyContentType("application/json"),
+				ghttp.VerifyBody([]byte(`{}`)),
+				RespondWithJSON(http.StatusCreated, `{}`),
+			),
+		)
+
+		// Send the request:
+		response, err := connection.DoPost(ctx, "/api/my_service/v1/things", strings.NewReader(`{}`))
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusCreated))
+	})
+
+	It("Sends delete request", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, "/api/my_service/v1/things/123"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+
+		// Send the request:
+		response, err := connection.DoDelete(ctx, "/api/my_service/v1/things/123")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+	})
+
+	It("Rejects absolute URLs", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = connection.Do(ctx, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("isn't absolute"))
+	})
+})
@@ -21,6 +21,7 @@ package sdk
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"io"
 	"mime"
@@ -180,8 +181,29 @@ func (d *dumpRoundTripper) dumpResponse(ctx context.Context, response *http.Resp
 	}
 	if body != nil {
 		d.logger.Debug(ctx, "Response body follows")
-		d.dumpBody(ctx, header, body)
+		d.dumpBody(ctx, header, d.decompressBody(ctx, header, body))
+	}
+}
+
+// decompressBody decompresses the given body if the header indicates that it is compressed with
+// gzip. Note that the connection explicitly requests compressed responses, so they aren't
+// transparently decompressed by the HTTP transport before reaching this round tripper.
+func (d *dumpRoundTripper) decompressBody(ctx context.Context, header http.Header,
+	body []byte) []byte {
+	if !strings.EqualFold(header.Get("Content-Encoding"), "gzip") {
+		return body
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		d.logger.Error(ctx, "Can't decompress response body: %v", err)
+		return body
+	}
+	result, err := io.ReadAll(reader)
+	if err != nil {
+		d.logger.Error(ctx, "Can't decompress response body: %v", err)
+		return body
 	}
+	return result
 }
 
 // dumpBody checks the content type used in the given header and then it dumps the given body in a
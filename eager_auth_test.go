@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the eager authentication support.
+
+package sdk
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Eager authentication", func() {
+	var (
+		oidServer *ghttp.Server
+		apiServer *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		oidServer = MakeTCPServer()
+		apiServer = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		oidServer.Close()
+		apiServer.Close()
+	})
+
+	It("Requests the tokens when the connection is created", func() {
+		// Prepare the server:
+		accessToken := MakeTokenString("Bearer", 5*time.Minute)
+		oidServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				VerifyClientCredentialsGrant("myclient", "mysecret"),
+				RespondWithAccessToken(accessToken),
+			),
+		)
+
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			URL(apiServer.URL()).
+			Client("myclient", "mysecret").
+			EagerAuth(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Check that the tokens were requested:
+		Expect(oidServer.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Doesn't request the tokens by default", func() {
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			URL(apiServer.URL()).
+			Client("myclient", "mysecret").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(oidServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Fails if the credentials are wrong", func() {
+		// Prepare the server:
+		oidServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				VerifyClientCredentialsGrant("myclient", "badsecret"),
+				RespondWithTokenError("invalid_client", "Invalid client credentials"),
+			),
+		)
+
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			URL(apiServer.URL()).
+			Client("myclient", "badsecret").
+			EagerAuth(true).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't obtain initial tokens"))
+		Expect(err.Error()).To(ContainSubstring("Invalid client credentials"))
+		Expect(connection).To(BeNil())
+	})
+
+	It("Uses the context of the build method", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			URL(apiServer.URL()).
+			Client("myclient", "mysecret").
+			EagerAuth(true).
+			BuildContext(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(connection).To(BeNil())
+	})
+
+	It("Can be enabled from the configuration", func() {
+		// Prepare the server:
+		accessToken := MakeTokenString("Bearer", 5*time.Minute)
+		oidServer.AppendHandlers(
+			RespondWithAccessToken(accessToken),
+		)
+
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			URL(apiServer.URL()).
+			Client("myclient", "mysecret").
+			Load("eager_auth: true").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(oidServer.ReceivedRequests()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the presets for the well known environments of the API.
+
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Environment contains the endpoints and the OpenID client identifier used to connect to one of
+// the well known environments of the API. The predefined values are available as the Production,
+// Staging and Integration variables. To use a modified version of one of them copy it and change
+// the fields that need to be different. For example:
+//
+//	environment := *sdk.Staging
+//	environment.TokenURL = "https://sso.example.com/token"
+//	connection, err := sdk.NewConnectionBuilder().
+//		Environment(&environment).
+//		Build()
+type Environment struct {
+	// Name is the name of the environment, for example `production`.
+	Name string
+
+	// URL is the base URL of the API gateway.
+	URL string
+
+	// TokenURL is the URL used to request OpenID access tokens.
+	TokenURL string
+
+	// ClientID is the OpenID client identifier.
+	ClientID string
+}
+
+// Presets for the well known environments:
+var (
+	// Production is the environment available at `https://api.openshift.com`.
+	Production = &Environment{
+		Name:     "production",
+		URL:      DefaultURL,
+		TokenURL: DefaultTokenURL,
+		ClientID: DefaultClientID,
+	}
+
+	// Staging is the environment available at `https://api.stage.openshift.com`.
+	Staging = &Environment{
+		Name:     "staging",
+		URL:      "https://api.stage.openshift.com",
+		TokenURL: DefaultTokenURL,
+		ClientID: DefaultClientID,
+	}
+
+	// Integration is the environment available at `https://api.integration.openshift.com`.
+	Integration = &Environment{
+		Name:     "integration",
+		URL:      "https://api.integration.openshift.com",
+		TokenURL: DefaultTokenURL,
+		ClientID: DefaultClientID,
+	}
+)
+
+// environmentAliases contains the names and the aliases that are accepted by the LookupEnvironment
+// function.
+var environmentAliases = map[string]*Environment{
+	"production":  Production,
+	"prod":        Production,
+	"staging":     Staging,
+	"stage":       Staging,
+	"integration": Integration,
+	"int":         Integration,
+}
+
+// LookupEnvironment returns the predefined environment that corresponds to the given name. Besides
+// the complete names it also accepts the `prod`, `stage` and `int` aliases. The comparison isn't
+// case sensitive. It returns an error if there is no environment with that name.
+func LookupEnvironment(name string) (result *Environment, err error) {
+	result, ok := environmentAliases[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		err = fmt.Errorf(
+			"environment '%s' doesn't exist, valid values are 'production', "+
+				"'staging' and 'integration'",
+			name,
+		)
+	}
+	return
+}
+
+// Environment sets the base URL, the token URL and the client identifier to the values of the
+// given environment. For example, to connect to the staging environment:
+//
+//	connection, err := sdk.NewConnectionBuilder().
+//		Environment(sdk.Staging).
+//		Tokens(token).
+//		Build()
+//
+// The values are copied to the builder when this method is called, so calling the URL, TokenURL or
+// Client methods after this one overrides them.
+func (b *ConnectionBuilder) Environment(value *Environment) *ConnectionBuilder {
+	if b.err != nil {
+		return b
+	}
+	if value == nil {
+		return b
+	}
+	if value.URL != "" {
+		b.URL(value.URL)
+	}
+	if value.TokenURL != "" {
+		b.TokenURL(value.TokenURL)
+	}
+	if value.ClientID != "" {
+		b.Client(value.ClientID, "")
+	}
+	return b
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the environment presets.
+
+package sdk
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Environments", func() {
+	var token string
+
+	BeforeEach(func() {
+		token = MakeTokenString("Bearer", 5*time.Minute)
+	})
+
+	DescribeTable(
+		"Lookup",
+		func(name string, expected *Environment) {
+			environment, err := LookupEnvironment(name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(environment).To(BeIdenticalTo(expected))
+		},
+		Entry("Production", "production", Production),
+		Entry("Production alias", "prod", Production),
+		Entry("Staging", "staging", Staging),
+		Entry("Staging alias", "stage", Staging),
+		Entry("Integration", "integration", Integration),
+		Entry("Integration alias", "int", Integration),
+		Entry("Upper case", "STAGING", Staging),
+	)
+
+	It("Fails to lookup unknown environment", func() {
+		_, err := LookupEnvironment("junk")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("junk"))
+	})
+
+	It("Uses the endpoints of the environment", func() {
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			Environment(Staging).
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(connection.URL()).To(Equal(Staging.URL))
+		Expect(connection.TokenURL()).To(Equal(Staging.TokenURL))
+		id, _ := connection.Client()
+		Expect(id).To(Equal(Staging.ClientID))
+	})
+
+	It("Lets explicit settings override the environment", func() {
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			Environment(Integration).
+			URL("https://api.example.com").
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(connection.URL()).To(Equal("https://api.example.com"))
+		Expect(connection.TokenURL()).To(Equal(Integration.TokenURL))
+	})
+
+	It("Loads the environment from the configuration", func() {
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			Load("environment: integration").
+			Tokens(token).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(connection.URL()).To(Equal(Integration.URL))
+	})
+
+	It("Fails to load unknown environment from the configuration", func() {
+		_, err := NewConnectionBuilder().
+			Logger(logger).
+			Load("environment: junk").
+			Tokens(token).
+			Build()
+		Expect(err).To(HaveOccurred())
+	})
+})
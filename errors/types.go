@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the typed errors that can be extracted from API errors using the standard
+// errors.Is and errors.As functions.
+
+package errors // github.com/openshift-online/ocm-sdk-go/errors
+
+import (
+	goerrors "errors"
+	"net/http"
+)
+
+// Sentinel errors that can be used with the standard errors.Is function to check the kind of an API
+// error without comparing status codes or error codes. For example:
+//
+//	_, err := collection.Cluster(id).Get().SendContext(ctx)
+//	if errors.Is(err, ocmerrors.ErrNotFound) {
+//		...
+//	}
+var (
+	ErrNotFound    = goerrors.New("not found")
+	ErrConflict    = goerrors.New("conflict")
+	ErrRateLimited = goerrors.New("rate limited")
+	ErrValidation  = goerrors.New("validation failed")
+)
+
+// NotFoundError is the typed error corresponding to API errors with the 404 status code.
+type NotFoundError struct {
+	// Err is the underlying API error.
+	Err *Error
+}
+
+// ConflictError is the typed error corresponding to API errors with the 409 status code.
+type ConflictError struct {
+	// Err is the underlying API error.
+	Err *Error
+}
+
+// RateLimitError is the typed error corresponding to API errors with the 429 status code.
+type RateLimitError struct {
+	// Err is the underlying API error.
+	Err *Error
+}
+
+// ValidationError is the typed error corresponding to API errors with the 400 or 422 status codes.
+type ValidationError struct {
+	// Err is the underlying API error.
+	Err *Error
+
+	// Fields contains the details of the fields that failed validation, when the server
+	// provides them in the details of the error.
+	Fields []*FieldError
+}
+
+// FieldError describes the validation failure of a single field.
+type FieldError struct {
+	// Path is the path of the field, for example `aws.subnet_ids`.
+	Path string
+
+	// Reason is the description of the failure.
+	Reason string
+}
+
+// Error is the implementation of the error interface.
+func (e *NotFoundError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying API error.
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if the target is the ErrNotFound sentinel.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// Error is the implementation of the error interface.
+func (e *ConflictError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying API error.
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if the target is the ErrConflict sentinel.
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// Error is the implementation of the error interface.
+func (e *RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying API error.
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if the target is the ErrRateLimited sentinel.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// Error is the implementation of the error interface.
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying API error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if the target is the ErrValidation sentinel.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// Is checks if the error corresponds to the given sentinel error. This is intended to be used
+// by the errors.Is function of the standard library and there is usually no need to call it
+// directly.
+func (e *Error) Is(target error) bool {
+	if e == nil {
+		return false
+	}
+	switch target {
+	case ErrNotFound:
+		return e.isNotFound()
+	case ErrConflict:
+		return e.isConflict()
+	case ErrRateLimited:
+		return e.isRateLimited()
+	case ErrValidation:
+		return e.isValidation()
+	}
+	return false
+}
+
+// As converts the error into one of the typed errors, if the status code matches. This is intended
+// to be used by the errors.As function of the standard library and there is usually no need to
+// call it directly. For example:
+//
+//	var validationErr *ocmerrors.ValidationError
+//	if errors.As(err, &validationErr) {
+//		for _, field := range validationErr.Fields {
+//			...
+//		}
+//	}
+func (e *Error) As(target interface{}) bool {
+	if e == nil {
+		return false
+	}
+	switch typed := target.(type) {
+	case **NotFoundError:
+		if e.isNotFound() {
+			*typed = &NotFoundError{
+				Err: e,
+			}
+			return true
+		}
+	case **ConflictError:
+		if e.isConflict() {
+			*typed = &ConflictError{
+				Err: e,
+			}
+			return true
+		}
+	case **RateLimitError:
+		if e.isRateLimited() {
+			*typed = &RateLimitError{
+				Err: e,
+			}
+			return true
+		}
+	case **ValidationError:
+		if e.isValidation() {
+			*typed = &ValidationError{
+				Err:    e,
+				Fields: e.fieldErrors(),
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Error) isNotFound() bool {
+	return e.Status() == http.StatusNotFound
+}
+
+func (e *Error) isConflict() bool {
+	return e.Status() == http.StatusConflict
+}
+
+func (e *Error) isRateLimited() bool {
+	return e.Status() == http.StatusTooManyRequests
+}
+
+func (e *Error) isValidation() bool {
+	status := e.Status()
+	return status == http.StatusBadRequest || status == http.StatusUnprocessableEntity
+}
+
+// fieldErrors extracts the field errors from the details of the error. The details are expected
+// to be a list of objects containing the path of the field in the `field` or `path` attributes
+// and the description in the `reason`, `description` or `message` attributes. Items that don't
+// have that shape are ignored.
+func (e *Error) fieldErrors() []*FieldError {
+	items, ok := e.Details().([]interface{})
+	if !ok {
+		return nil
+	}
+	var result []*FieldError
+	for _, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path := firstString(object, "field", "path")
+		if path == "" {
+			continue
+		}
+		result = append(result, &FieldError{
+			Path:   path,
+			Reason: firstString(object, "reason", "description", "message"),
+		})
+	}
+	return result
+}
+
+// firstString returns the value of the first of the given attributes that is a non empty string.
+func firstString(object map[string]interface{}, names ...string) string {
+	for _, name := range names {
+		value, ok := object[name].(string)
+		if ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
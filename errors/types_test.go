@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors // github.com/openshift-online/ocm-sdk-go/errors
+
+import (
+	goerrors "errors"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+var _ = Describe("Typed errors", func() {
+	It("Matches sentinel for not found", func() {
+		object, err := UnmarshalErrorStatus(`{
+			"kind": "Error",
+			"id": "404",
+			"reason": "Cluster not found"
+		}`, http.StatusNotFound)
+		Expect(err).ToNot(HaveOccurred())
+		wrapped := fmt.Errorf("can't get cluster: %w", object)
+		Expect(goerrors.Is(wrapped, ErrNotFound)).To(BeTrue())
+		Expect(goerrors.Is(wrapped, ErrConflict)).To(BeFalse())
+		var notFound *NotFoundError
+		Expect(goerrors.As(wrapped, &notFound)).To(BeTrue())
+		Expect(notFound.Err.Reason()).To(Equal("Cluster not found"))
+		Expect(goerrors.Is(notFound, ErrNotFound)).To(BeTrue())
+	})
+
+	It("Doesn't convert to a type that doesn't match the status", func() {
+		object, err := UnmarshalErrorStatus(`{}`, http.StatusInternalServerError)
+		Expect(err).ToNot(HaveOccurred())
+		var notFound *NotFoundError
+		Expect(goerrors.As(object, &notFound)).To(BeFalse())
+		var conflict *ConflictError
+		Expect(goerrors.As(object, &conflict)).To(BeFalse())
+	})
+
+	It("Extracts conflict error", func() {
+		object, err := UnmarshalErrorStatus(`{}`, http.StatusConflict)
+		Expect(err).ToNot(HaveOccurred())
+		var conflict *ConflictError
+		Expect(goerrors.As(object, &conflict)).To(BeTrue())
+		Expect(conflict.Err.Status()).To(Equal(http.StatusConflict))
+		Expect(goerrors.Is(object, ErrConflict)).To(BeTrue())
+	})
+
+	It("Extracts rate limit error", func() {
+		object, err := UnmarshalErrorStatus(`{}`, http.StatusTooManyRequests)
+		Expect(err).ToNot(HaveOccurred())
+		var rateLimit *RateLimitError
+		Expect(goerrors.As(object, &rateLimit)).To(BeTrue())
+		Expect(rateLimit.Err.Status()).To(Equal(http.StatusTooManyRequests))
+		Expect(goerrors.Is(object, ErrRateLimited)).To(BeTrue())
+	})
+
+	It("Extracts field paths from validation error", func() {
+		object, err := UnmarshalErrorStatus(`{
+			"kind": "Error",
+			"id": "400",
+			"reason": "Invalid cluster",
+			"details": [
+				{
+					"field": "name",
+					"reason": "Name is too long"
+				},
+				{
+					"path": "aws.subnet_ids",
+					"description": "Subnet doesn't exist"
+				},
+				"junk"
+			]
+		}`, http.StatusBadRequest)
+		Expect(err).ToNot(HaveOccurred())
+		var validation *ValidationError
+		Expect(goerrors.As(object, &validation)).To(BeTrue())
+		Expect(validation.Fields).To(HaveLen(2))
+		Expect(validation.Fields[0].Path).To(Equal("name"))
+		Expect(validation.Fields[0].Reason).To(Equal("Name is too long"))
+		Expect(validation.Fields[1].Path).To(Equal("aws.subnet_ids"))
+		Expect(validation.Fields[1].Reason).To(Equal("Subnet doesn't exist"))
+		Expect(goerrors.Is(object, ErrValidation)).To(BeTrue())
+	})
+
+	It("Unwraps typed error to the API error", func() {
+		object, err := UnmarshalErrorStatus(`{}`, http.StatusNotFound)
+		Expect(err).ToNot(HaveOccurred())
+		var notFound *NotFoundError
+		Expect(goerrors.As(object, &notFound)).To(BeTrue())
+		var apiErr *Error
+		Expect(goerrors.As(notFound, &apiErr)).To(BeTrue())
+		Expect(apiErr).To(BeIdenticalTo(object))
+	})
+})
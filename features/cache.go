@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in memory cache for the results of the feature checks.
+
+package features
+
+import (
+	"sync"
+	"time"
+)
+
+// cacheKey identifies a feature check. The owner is used to separate the results obtained with
+// different clients when the cache is shared.
+type cacheKey struct {
+	owner   interface{}
+	kind    string
+	subject string
+	feature string
+}
+
+// cacheEntry contains a cached result and the time when it expires.
+type cacheEntry struct {
+	result bool
+	expiry time.Time
+}
+
+// cache stores the results of the feature checks for a limited time. Expired entries are removed
+// when new results are added.
+type cache struct {
+	ttl     time.Duration
+	lock    *sync.Mutex
+	entries map[cacheKey]cacheEntry
+	swept   time.Time
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		lock:    &sync.Mutex{},
+		entries: map[cacheKey]cacheEntry{},
+	}
+}
+
+func (c *cache) get(key cacheKey) (result bool, ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	if time.Now().After(entry.expiry) {
+		delete(c.entries, key)
+		ok = false
+		return
+	}
+	result = entry.result
+	return
+}
+
+func (c *cache) put(key cacheKey, result bool) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now()
+	if now.Sub(c.swept) > c.ttl {
+		for candidate, entry := range c.entries {
+			if now.After(entry.expiry) {
+				delete(c.entries, candidate)
+			}
+		}
+		c.swept = now
+	}
+	c.entries[key] = cacheEntry{
+		result: result,
+		expiry: now.Add(c.ttl),
+	}
+}
+
+func (c *cache) flush() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries = map[cacheKey]cacheEntry{}
+}
@@ -0,0 +1,326 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the checker that decides if features are enabled, using the feature toggles
+// of the accounts management service and the feature reviews of the authorizations service.
+
+package features
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/accountsmgmt"
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	azv1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// CheckerBuilder contains the data and logic needed to build a feature checker.
+type CheckerBuilder struct {
+	logger         logging.Logger
+	accountsMgmt   *amv1.Client
+	authorizations *azv1.Client
+	ttl            time.Duration
+}
+
+// Checker checks if features are enabled. The results are cached in memory for the configured
+// time to live, so that repeated checks don't send requests to the server. Errors aren't cached.
+// It is safe to use it from multiple goroutines simultaneously.
+type Checker struct {
+	logger         logging.Logger
+	accountsMgmt   *amv1.Client
+	authorizations *azv1.Client
+	cache          *cache
+}
+
+// clients identifies the pair of clients used by the Enabled function, so that the results
+// obtained with different clients are kept separate in the shared cache.
+type clients struct {
+	accountsMgmt   *amv1.Client
+	authorizations *azv1.Client
+}
+
+// NewChecker creates a builder that can then be used to configure and create a feature checker.
+func NewChecker() *CheckerBuilder {
+	return &CheckerBuilder{
+		ttl: defaultTTL,
+	}
+}
+
+// Logger sets the logger that the checker will use to write to the log. This is mandatory.
+func (b *CheckerBuilder) Logger(value logging.Logger) *CheckerBuilder {
+	b.logger = value
+	return b
+}
+
+// AccountsMgmt sets the accounts management client that the checker will use to find the
+// organizations of clusters and to query the feature toggles. This is mandatory.
+func (b *CheckerBuilder) AccountsMgmt(value *amv1.Client) *CheckerBuilder {
+	b.accountsMgmt = value
+	return b
+}
+
+// Authorizations sets the authorizations client that the checker will use to send the feature
+// reviews for users. This is mandatory.
+func (b *CheckerBuilder) Authorizations(value *azv1.Client) *CheckerBuilder {
+	b.authorizations = value
+	return b
+}
+
+// TTL sets the time that the results of the checks will be kept in memory. A zero value disables
+// caching. The default value is five minutes.
+func (b *CheckerBuilder) TTL(value time.Duration) *CheckerBuilder {
+	b.ttl = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new feature checker.
+func (b *CheckerBuilder) Build() (result *Checker, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.accountsMgmt == nil {
+		err = errors.New("accounts management client is mandatory")
+		return
+	}
+	if b.authorizations == nil {
+		err = errors.New("authorizations client is mandatory")
+		return
+	}
+	if b.ttl < 0 {
+		err = fmt.Errorf("time to live should be zero or positive, but it is %s", b.ttl)
+		return
+	}
+
+	// Create and populate the object:
+	result = &Checker{
+		logger:         b.logger,
+		accountsMgmt:   b.accountsMgmt,
+		authorizations: b.authorizations,
+		cache:          newCache(b.ttl),
+	}
+	return
+}
+
+// Enabled checks if the given feature is enabled for the given cluster. The cluster identifier
+// can be the one assigned by the clusters management service or the external one. The feature
+// toggles are evaluated for organizations, so the check uses the organization that owns the
+// subscription of the cluster. If the cluster identifier is empty the check is done for the user
+// that is authenticated to the server, using the self feature review endpoint.
+func (c *Checker) Enabled(ctx context.Context, feature, clusterID string) (result bool,
+	err error) {
+	return c.check(ctx, nil, kindCluster, clusterID, feature, "")
+}
+
+// OrganizationEnabled checks if the given feature toggle is enabled for the given organization.
+func (c *Checker) OrganizationEnabled(ctx context.Context, feature,
+	organizationID string) (result bool, err error) {
+	if organizationID == "" {
+		err = errors.New("organization identifier is mandatory")
+		return
+	}
+	return c.check(ctx, nil, kindOrganization, organizationID, feature, "")
+}
+
+// UserEnabled checks if the given feature is enabled for the given user in the given
+// organization, using the feature review endpoint. If the user is empty the check is done for the
+// user that is authenticated to the server, using the self feature review endpoint, and the
+// organization is ignored.
+func (c *Checker) UserEnabled(ctx context.Context, user, feature,
+	organizationID string) (result bool, err error) {
+	return c.check(ctx, nil, kindUser, user, feature, organizationID)
+}
+
+// Flush removes all the results from the cache.
+func (c *Checker) Flush() {
+	c.cache.flush()
+}
+
+// Enabled checks if the given feature is enabled for the given cluster, using the given clients.
+// See the Enabled method of the Checker type for details. The results are cached in a cache shared
+// by all the callers of this function that use the same clients, with a time to live of five
+// minutes. Use a Checker if you need to control the cache or to write the results to the log. For
+// example:
+//
+//	enabled, err := features.Enabled(
+//		ctx,
+//		connection.AccountsMgmt().V1(),
+//		connection.Authorizations().V1(),
+//		"hypershift",
+//		clusterID,
+//	)
+func Enabled(ctx context.Context, accountsMgmt *amv1.Client, authorizations *azv1.Client,
+	feature, clusterID string) (result bool, err error) {
+	if accountsMgmt == nil {
+		err = errors.New("accounts management client is mandatory")
+		return
+	}
+	if authorizations == nil {
+		err = errors.New("authorizations client is mandatory")
+		return
+	}
+	checker := &Checker{
+		accountsMgmt:   accountsMgmt,
+		authorizations: authorizations,
+		cache:          defaultCache,
+	}
+	owner := clients{
+		accountsMgmt:   accountsMgmt,
+		authorizations: authorizations,
+	}
+	return checker.check(ctx, owner, kindCluster, clusterID, feature, "")
+}
+
+func (c *Checker) check(ctx context.Context, owner interface{}, kind, subject, feature,
+	organizationID string) (result bool, err error) {
+	if feature == "" {
+		err = errors.New("feature is mandatory")
+		return
+	}
+	key := cacheKey{
+		owner:   owner,
+		kind:    kind,
+		subject: subject + "/" + organizationID,
+		feature: feature,
+	}
+	result, ok := c.cache.get(key)
+	if ok {
+		return
+	}
+	switch {
+	case kind == kindCluster && subject != "":
+		result, err = c.queryCluster(ctx, subject, feature)
+	case kind == kindOrganization:
+		result, err = c.queryOrganization(ctx, subject, feature)
+	case subject != "":
+		result, err = c.sendUserReview(ctx, subject, feature, organizationID)
+	default:
+		result, err = c.sendSelfReview(ctx, feature)
+	}
+	if err != nil {
+		return
+	}
+	if c.logger != nil {
+		c.logger.Debug(
+			ctx,
+			"Check of feature '%s' for %s '%s' returned %t",
+			feature, kind, subject, result,
+		)
+	}
+	c.cache.put(key, result)
+	return
+}
+
+func (c *Checker) queryCluster(ctx context.Context, clusterID, feature string) (result bool,
+	err error) {
+	match, err := accountsmgmt.FindSubscriptionForCluster(ctx, c.accountsMgmt, clusterID)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't find organization of cluster '%s' to check feature '%s': %w",
+			clusterID, feature, err,
+		)
+		return
+	}
+	result, err = c.queryOrganization(ctx, match.Subscription.OrganizationID(), feature)
+	return
+}
+
+func (c *Checker) queryOrganization(ctx context.Context, organizationID,
+	feature string) (result bool, err error) {
+	request, err := amv1.NewFeatureToggleQueryRequest().
+		OrganizationID(organizationID).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := c.accountsMgmt.FeatureToggles().
+		FeatureToggle(feature).
+		Query().
+		Post().
+		Request(request).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't query feature toggle '%s' for organization '%s': %w",
+			feature, organizationID, err,
+		)
+		return
+	}
+	result = response.Response().Enabled()
+	return
+}
+
+func (c *Checker) sendUserReview(ctx context.Context, user, feature,
+	organizationID string) (result bool, err error) {
+	request, err := azv1.NewFeatureReviewRequest().
+		AccountUsername(user).
+		Feature(feature).
+		OrganizationId(organizationID).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := c.authorizations.FeatureReview().Post().
+		Request(request).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't review feature '%s' for user '%s': %w",
+			feature, user, err,
+		)
+		return
+	}
+	result = response.Request().Enabled()
+	return
+}
+
+func (c *Checker) sendSelfReview(ctx context.Context, feature string) (result bool, err error) {
+	request, err := azv1.NewSelfFeatureReviewRequest().
+		Feature(feature).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := c.authorizations.SelfFeatureReview().Post().
+		Request(request).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't review feature '%s': %w", feature, err)
+		return
+	}
+	result = response.Request().Enabled()
+	return
+}
+
+// defaultCache is the cache used by the Enabled function.
+var defaultCache = newCache(defaultTTL)
+
+// Kinds of checks:
+const (
+	kindCluster      = "cluster"
+	kindOrganization = "organization"
+	kindUser         = "user"
+)
+
+// Default values:
+const (
+	defaultTTL = 5 * time.Minute
+)
@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	azv1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
+)
+
+var _ = Describe("Checker", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var accountsMgmt *amv1.Client
+	var authorizations *azv1.Client
+	var checker *Checker
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = MakeTCPServer()
+		accountsMgmt = amv1.NewClient(ServerTransport(server), "/api/accounts_mgmt/v1")
+		authorizations = azv1.NewClient(ServerTransport(server), "/api/authorizations/v1")
+		checker, err = NewChecker().
+			Logger(logger).
+			AccountsMgmt(accountsMgmt).
+			Authorizations(authorizations).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Can't be created without an accounts management client", func() {
+		_, err := NewChecker().
+			Logger(logger).
+			Authorizations(authorizations).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("accounts management client"))
+	})
+
+	It("Can't be created without an authorizations client", func() {
+		_, err := NewChecker().
+			Logger(logger).
+			AccountsMgmt(accountsMgmt).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("authorizations client"))
+	})
+
+	It("Queries the toggle for the organization of the cluster", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/subscriptions"),
+				ghttp.VerifyFormKV(
+					"search",
+					"cluster_id = '123' or external_cluster_id = '123'",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"items": [
+						{
+							"id": "456",
+							"cluster_id": "123",
+							"organization_id": "789",
+							"status": "Active"
+						}
+					]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/accounts_mgmt/v1/feature_toggles/my-feature/query",
+				),
+				VerifyJQ(".organization_id", "789"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "my-feature",
+					"enabled": true
+				}`),
+			),
+		)
+		enabled, err := checker.Enabled(ctx, "my-feature", "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+	})
+
+	It("Sends self review when there is no cluster", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/authorizations/v1/self_feature_review",
+				),
+				VerifyJQ(".feature", "my-feature"),
+				RespondWithJSON(http.StatusOK, `{
+					"feature_id": "my-feature",
+					"enabled": true
+				}`),
+			),
+		)
+		enabled, err := checker.Enabled(ctx, "my-feature", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+	})
+
+	It("Sends review for other user", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/authorizations/v1/feature_review"),
+				VerifyJQ(".account_username", "joe"),
+				VerifyJQ(".feature", "my-feature"),
+				VerifyJQ(".organization_id", "789"),
+				RespondWithJSON(http.StatusOK, `{
+					"feature_id": "my-feature",
+					"enabled": false
+				}`),
+			),
+		)
+		enabled, err := checker.UserEnabled(ctx, "joe", "my-feature", "789")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+	})
+
+	It("Caches the result", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"id": "my-feature",
+				"enabled": true
+			}`),
+		)
+		for i := 0; i < 2; i++ {
+			enabled, err := checker.OrganizationEnabled(ctx, "my-feature", "789")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Separates the results of different organizations", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"id": "my-feature",
+				"enabled": true
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"id": "my-feature",
+				"enabled": false
+			}`),
+		)
+		enabled, err := checker.OrganizationEnabled(ctx, "my-feature", "789")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+		enabled, err = checker.OrganizationEnabled(ctx, "my-feature", "790")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+	})
+
+	It("Doesn't cache errors", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusInternalServerError, `{
+				"kind": "Error",
+				"reason": "Boom"
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"id": "my-feature",
+				"enabled": true
+			}`),
+		)
+		_, err := checker.OrganizationEnabled(ctx, "my-feature", "789")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(
+			"can't query feature toggle 'my-feature' for organization '789'",
+		))
+		enabled, err := checker.OrganizationEnabled(ctx, "my-feature", "789")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+	})
+
+	It("Fails if the cluster doesn't have a subscription", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"items": []
+			}`),
+		)
+		_, err := checker.Enabled(ctx, "my-feature", "123")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't find organization of cluster '123'"))
+	})
+
+	It("Flushes the cache", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"id": "my-feature",
+				"enabled": true
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"id": "my-feature",
+				"enabled": false
+			}`),
+		)
+		enabled, err := checker.OrganizationEnabled(ctx, "my-feature", "789")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+		checker.Flush()
+		enabled, err = checker.OrganizationEnabled(ctx, "my-feature", "789")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+	})
+
+	It("Shares the cache between calls of the function with the same clients", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"feature_id": "my-feature",
+				"enabled": true
+			}`),
+		)
+		for i := 0; i < 2; i++ {
+			enabled, err := Enabled(ctx, accountsMgmt, authorizations, "my-feature", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,26 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fips contains the functions that enforce the constraints of FIPS 140 on the connections
+// created by the SDK: TLS is restricted to approved versions, cipher suites, curves and
+// certificates, and tokens must be signed with approved algorithms. Violations are reported with
+// errors of type *Error.
+//
+// The constraints are enforced by default when the SDK is built with the `fips` build tag, and
+// can be enabled explicitly with the FIPS method of the connection builder. Note that this only
+// restricts what the SDK accepts; using a FIPS validated cryptographic module is still the
+// responsibility of the Go toolchain used to build the application.
+package fips
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types and functions used to report violations of the FIPS constraints.
+
+package fips
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotCompliant is the sentinel that matches, using errors.Is, all the errors that report
+// violations of the FIPS constraints.
+var ErrNotCompliant = errors.New("not FIPS compliant")
+
+// Violation describes the kind of violation of the FIPS constraints.
+type Violation string
+
+const (
+	// ViolationInsecure indicates that the verification of TLS certificates has been disabled.
+	ViolationInsecure Violation = "Insecure"
+
+	// ViolationEndpoint indicates that a server doesn't use TLS.
+	ViolationEndpoint Violation = "Endpoint"
+
+	// ViolationTLSVersion indicates that the TLS version negotiated with a server isn't
+	// approved.
+	ViolationTLSVersion Violation = "TLSVersion"
+
+	// ViolationCipherSuite indicates that the TLS cipher suite negotiated with a server isn't
+	// approved.
+	ViolationCipherSuite Violation = "CipherSuite"
+
+	// ViolationCertificate indicates that a certificate uses a key or a signature algorithm
+	// that isn't approved.
+	ViolationCertificate Violation = "Certificate"
+
+	// ViolationKey indicates that a key used to verify tokens isn't approved.
+	ViolationKey Violation = "Key"
+
+	// ViolationTokenAlgorithm indicates that a token is signed with an algorithm that isn't
+	// approved.
+	ViolationTokenAlgorithm Violation = "TokenAlgorithm"
+)
+
+// Error is the error returned when something violates the FIPS constraints. Use errors.As to
+// extract it, or errors.Is with ErrNotCompliant to check for it.
+type Error struct {
+	// Violation is the kind of violation.
+	Violation Violation
+
+	// Subject is the thing that violates the constraints, for example the URL of a server, the
+	// subject of a certificate or the signing algorithm of a token.
+	Subject string
+
+	// Reason is the description of the violation.
+	Reason string
+}
+
+// Error is the implementation of the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s '%s' isn't FIPS compliant: %s", e.Violation, e.Subject, e.Reason)
+}
+
+// Is returns true if the target is the ErrNotCompliant sentinel.
+func (e *Error) Is(target error) bool {
+	return target == ErrNotCompliant
+}
+
+// CheckInsecure returns an error if the insecure flag is set, as disabling the verification of
+// TLS certificates is never allowed when the FIPS constraints are enforced.
+func CheckInsecure(insecure bool) error {
+	if !insecure {
+		return nil
+	}
+	return &Error{
+		Violation: ViolationInsecure,
+		Subject:   "insecure",
+		Reason:    "verification of TLS certificates can't be disabled",
+	}
+}
+
+// CheckProtocol returns an error if the given application protocol, as extracted from the given
+// server address, isn't `https`.
+func CheckProtocol(address, protocol string) error {
+	if protocol == "https" {
+		return nil
+	}
+	return &Error{
+		Violation: ViolationEndpoint,
+		Subject:   address,
+		Reason:    fmt.Sprintf("protocol '%s' doesn't use TLS", protocol),
+	}
+}
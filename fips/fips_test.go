@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fips
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
+)
+
+// makeCertificate creates a certificate for the given key. It is self signed if the parent is nil,
+// otherwise it is signed by the parent.
+func makeCertificate(name string, key crypto.Signer, parent *x509.Certificate,
+	parentKey crypto.Signer) *x509.Certificate {
+	now := time.Now()
+	spec := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName: name,
+		},
+		NotBefore: now,
+		NotAfter:  now.Add(time.Hour),
+	}
+	if parent == nil {
+		parent = spec
+		parentKey = key
+	}
+	data, err := x509.CreateCertificate(rand.Reader, spec, parent, key.Public(), parentKey)
+	Expect(err).ToNot(HaveOccurred())
+	result, err := x509.ParseCertificate(data)
+	Expect(err).ToNot(HaveOccurred())
+	return result
+}
+
+var _ = Describe("Errors", func() {
+	It("Matches the sentinel", func() {
+		err := CheckInsecure(true)
+		Expect(errors.Is(err, ErrNotCompliant)).To(BeTrue())
+		var fipsErr *Error
+		Expect(errors.As(err, &fipsErr)).To(BeTrue())
+		Expect(fipsErr.Violation).To(Equal(ViolationInsecure))
+	})
+
+	It("Accepts HTTPS", func() {
+		err := CheckProtocol("https://api.example.com", "https")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable(
+		"Rejects protocols without TLS",
+		func(protocol string) {
+			err := CheckProtocol("my://api.example.com", protocol)
+			var fipsErr *Error
+			Expect(errors.As(err, &fipsErr)).To(BeTrue())
+			Expect(fipsErr.Violation).To(Equal(ViolationEndpoint))
+			Expect(fipsErr.Subject).To(Equal("my://api.example.com"))
+		},
+		Entry("HTTP", "http"),
+		Entry("H2C", "h2c"),
+	)
+})
+
+var _ = Describe("TLS", func() {
+	It("Restricts the configuration", func() {
+		config := &tls.Config{}
+		err := ConfigureTLS(config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+		Expect(config.CipherSuites).To(ConsistOf(cipherSuites))
+		Expect(config.CurvePreferences).To(ConsistOf(curves))
+		Expect(config.VerifyConnection).ToNot(BeNil())
+	})
+
+	It("Rejects insecure configuration", func() {
+		config := &tls.Config{
+			InsecureSkipVerify: true, // nolint
+		}
+		err := ConfigureTLS(config)
+		Expect(errors.Is(err, ErrNotCompliant)).To(BeTrue())
+	})
+
+	It("Calls the original verification function", func() {
+		called := false
+		config := &tls.Config{
+			VerifyConnection: func(tls.ConnectionState) error {
+				called = true
+				return nil
+			},
+		}
+		err := ConfigureTLS(config)
+		Expect(err).ToNot(HaveOccurred())
+		err = config.VerifyConnection(tls.ConnectionState{
+			Version:     tls.VersionTLS13,
+			CipherSuite: tls.TLS_AES_128_GCM_SHA256,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(called).To(BeTrue())
+	})
+
+	It("Rejects old TLS versions", func() {
+		err := CheckConnection(tls.ConnectionState{
+			Version:     tls.VersionTLS11,
+			CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		})
+		var fipsErr *Error
+		Expect(errors.As(err, &fipsErr)).To(BeTrue())
+		Expect(fipsErr.Violation).To(Equal(ViolationTLSVersion))
+	})
+
+	It("Rejects cipher suites that aren't approved", func() {
+		err := CheckConnection(tls.ConnectionState{
+			Version:     tls.VersionTLS13,
+			CipherSuite: tls.TLS_CHACHA20_POLY1305_SHA256,
+		})
+		var fipsErr *Error
+		Expect(errors.As(err, &fipsErr)).To(BeTrue())
+		Expect(fipsErr.Violation).To(Equal(ViolationCipherSuite))
+		Expect(fipsErr.Subject).To(Equal("TLS_CHACHA20_POLY1305_SHA256"))
+	})
+
+	It("Accepts certificates with approved keys", func() {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		ca := makeCertificate("ca", ecKey, nil, nil)
+		leaf := makeCertificate("leaf", rsaKey, ca, ecKey)
+		err = CheckConnection(tls.ConnectionState{
+			Version:          tls.VersionTLS12,
+			CipherSuite:      tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			PeerCertificates: []*x509.Certificate{leaf, ca},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Rejects small RSA keys", func() {
+		key, err := rsa.GenerateKey(rand.Reader, 1024) // nolint
+		Expect(err).ToNot(HaveOccurred())
+		certificate := makeCertificate("weak", key, nil, nil)
+		err = CheckCertificate(certificate)
+		var fipsErr *Error
+		Expect(errors.As(err, &fipsErr)).To(BeTrue())
+		Expect(fipsErr.Violation).To(Equal(ViolationCertificate))
+		Expect(fipsErr.Subject).To(Equal("CN=weak"))
+		Expect(fipsErr.Reason).To(ContainSubstring("1024"))
+	})
+
+	It("Rejects curves that aren't approved", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		err = CheckKey("my-key", &key.PublicKey)
+		var fipsErr *Error
+		Expect(errors.As(err, &fipsErr)).To(BeTrue())
+		Expect(fipsErr.Violation).To(Equal(ViolationKey))
+		Expect(fipsErr.Subject).To(Equal("my-key"))
+	})
+})
+
+var _ = Describe("Tokens", func() {
+	DescribeTable(
+		"Accepts approved algorithms",
+		func(method jwt.SigningMethod) {
+			token := jwt.New(method)
+			err := CheckToken(token)
+			Expect(err).ToNot(HaveOccurred())
+		},
+		Entry("RS256", jwt.SigningMethodRS256),
+		Entry("PS384", jwt.SigningMethodPS384),
+		Entry("ES512", jwt.SigningMethodES512),
+	)
+
+	DescribeTable(
+		"Rejects algorithms that aren't approved",
+		func(method jwt.SigningMethod) {
+			token := jwt.New(method)
+			err := CheckToken(token)
+			var fipsErr *Error
+			Expect(errors.As(err, &fipsErr)).To(BeTrue())
+			Expect(fipsErr.Violation).To(Equal(ViolationTokenAlgorithm))
+			Expect(fipsErr.Subject).To(Equal(method.Alg()))
+		},
+		Entry("HS256", jwt.SigningMethodHS256),
+		Entry("EdDSA", jwt.SigningMethodEdDSA),
+		Entry("None", jwt.SigningMethodNone),
+	)
+
+	It("Returns a copy of the approved algorithms", func() {
+		algorithms := TokenAlgorithms()
+		algorithms[0] = "none"
+		Expect(TokenAlgorithms()[0]).To(Equal("RS256"))
+	})
+})
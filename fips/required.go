@@ -0,0 +1,27 @@
+//go:build !fips
+// +build !fips
+
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the default value of the FIPS mode when the SDK is built without the `fips`
+// build tag.
+
+package fips
+
+// Required indicates if the FIPS constraints are enforced by default. It is true when the SDK is
+// built with the `fips` build tag, and false otherwise.
+const Required = false
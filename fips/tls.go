@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that restrict TLS to the versions, cipher suites, curves and
+// certificates approved by FIPS.
+
+package fips
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+)
+
+// MinRSAKeySize is the minimum size, in bits, of the RSA keys accepted in certificates and for
+// verification of token signatures.
+const MinRSAKeySize = 2048
+
+// cipherSuites are the approved cipher suites. For TLS 1.3 the Go library doesn't allow to
+// configure them, so they are checked after the handshake.
+var cipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_AES_128_GCM_SHA256,
+	tls.TLS_AES_256_GCM_SHA384,
+}
+
+// curves are the approved elliptic curves for key exchange.
+var curves = []tls.CurveID{
+	tls.CurveP256,
+	tls.CurveP384,
+	tls.CurveP521,
+}
+
+// signatureAlgorithms are the approved certificate signature algorithms.
+var signatureAlgorithms = map[x509.SignatureAlgorithm]bool{
+	x509.SHA256WithRSA:    true,
+	x509.SHA384WithRSA:    true,
+	x509.SHA512WithRSA:    true,
+	x509.SHA256WithRSAPSS: true,
+	x509.SHA384WithRSAPSS: true,
+	x509.SHA512WithRSAPSS: true,
+	x509.ECDSAWithSHA256:  true,
+	x509.ECDSAWithSHA384:  true,
+	x509.ECDSAWithSHA512:  true,
+}
+
+// ConfigureTLS changes the given TLS configuration so that it only offers the approved versions,
+// cipher suites and curves, and so that the handshake fails with an error of type *Error if the
+// server negotiates anything else or presents a certificate that isn't approved. It also fails if
+// the configuration disables the verification of certificates.
+func ConfigureTLS(config *tls.Config) error {
+	err := CheckInsecure(config.InsecureSkipVerify)
+	if err != nil {
+		return err
+	}
+	config.MinVersion = tls.VersionTLS12
+	config.CipherSuites = make([]uint16, len(cipherSuites))
+	copy(config.CipherSuites, cipherSuites)
+	config.CurvePreferences = make([]tls.CurveID, len(curves))
+	copy(config.CurvePreferences, curves)
+	next := config.VerifyConnection
+	config.VerifyConnection = func(state tls.ConnectionState) error {
+		err := CheckConnection(state)
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			return next(state)
+		}
+		return nil
+	}
+	return nil
+}
+
+// CheckConnection checks that the version, cipher suite and certificates of the given TLS
+// connection are approved.
+func CheckConnection(state tls.ConnectionState) error {
+	if state.Version < tls.VersionTLS12 {
+		return &Error{
+			Violation: ViolationTLSVersion,
+			Subject:   tls.VersionName(state.Version),
+			Reason:    "minimum version is TLS 1.2",
+		}
+	}
+	approved := false
+	for _, cipherSuite := range cipherSuites {
+		if state.CipherSuite == cipherSuite {
+			approved = true
+			break
+		}
+	}
+	if !approved {
+		return &Error{
+			Violation: ViolationCipherSuite,
+			Subject:   tls.CipherSuiteName(state.CipherSuite),
+			Reason:    "only AES-GCM cipher suites are approved",
+		}
+	}
+	for _, certificate := range state.PeerCertificates {
+		err := CheckCertificate(certificate)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckCertificate checks that the key and the signature algorithm of the given certificate are
+// approved. The signature algorithm of self signed certificates isn't checked, because those are
+// trust anchors and their signatures aren't used to verify anything.
+func CheckCertificate(certificate *x509.Certificate) error {
+	subject := certificate.Subject.String()
+	reason := checkPublicKey(certificate.PublicKey)
+	if reason == "" && !bytes.Equal(certificate.RawIssuer, certificate.RawSubject) &&
+		!signatureAlgorithms[certificate.SignatureAlgorithm] {
+		reason = fmt.Sprintf(
+			"signature algorithm '%s' isn't approved",
+			certificate.SignatureAlgorithm,
+		)
+	}
+	if reason != "" {
+		return &Error{
+			Violation: ViolationCertificate,
+			Subject:   subject,
+			Reason:    reason,
+		}
+	}
+	return nil
+}
+
+// CheckKey checks that the given public key, used to verify the signatures of tokens, is
+// approved.
+func CheckKey(id string, key interface{}) error {
+	reason := checkPublicKey(key)
+	if reason != "" {
+		return &Error{
+			Violation: ViolationKey,
+			Subject:   id,
+			Reason:    reason,
+		}
+	}
+	return nil
+}
+
+// checkPublicKey returns the reason why the given public key isn't approved, or an empty string
+// if it is approved.
+func checkPublicKey(key interface{}) string {
+	switch typed := key.(type) {
+	case *rsa.PublicKey:
+		size := typed.N.BitLen()
+		if size < MinRSAKeySize {
+			return fmt.Sprintf(
+				"RSA key size %d is less than the minimum %d",
+				size, MinRSAKeySize,
+			)
+		}
+	case *ecdsa.PublicKey:
+		switch typed.Curve {
+		case elliptic.P256(), elliptic.P384(), elliptic.P521():
+		default:
+			return fmt.Sprintf("curve '%s' isn't approved", typed.Curve.Params().Name)
+		}
+	default:
+		return fmt.Sprintf("key type '%T' isn't approved", key)
+	}
+	return ""
+}
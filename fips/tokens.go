@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that check that tokens are signed with approved algorithms.
+
+package fips
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// tokenAlgorithms are the approved token signing algorithms. Only digital signature algorithms
+// are included, because the tokens used by the SDK are always signed with asymmetric keys.
+var tokenAlgorithms = []string{
+	"RS256",
+	"RS384",
+	"RS512",
+	"PS256",
+	"PS384",
+	"PS512",
+	"ES256",
+	"ES384",
+	"ES512",
+}
+
+// TokenAlgorithms returns the names of the approved token signing algorithms. The result can be
+// used as the list of valid methods of a token parser.
+func TokenAlgorithms() []string {
+	result := make([]string, len(tokenAlgorithms))
+	copy(result, tokenAlgorithms)
+	return result
+}
+
+// CheckTokenAlgorithm checks that the given token signing algorithm is approved.
+func CheckTokenAlgorithm(alg string) error {
+	for _, approved := range tokenAlgorithms {
+		if alg == approved {
+			return nil
+		}
+	}
+	return &Error{
+		Violation: ViolationTokenAlgorithm,
+		Subject:   alg,
+		Reason:    "only RSA and ECDSA signatures with SHA-2 are approved",
+	}
+}
+
+// CheckToken checks that the given token is signed with an approved algorithm. The token doesn't
+// need to be verified, as the algorithm is taken from the header.
+func CheckToken(token *jwt.Token) error {
+	alg, _ := token.Header["alg"].(string)
+	return CheckTokenAlgorithm(alg)
+}
@@ -0,0 +1,316 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the enforcement of the FIPS constraints.
+
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	"github.com/openshift-online/ocm-sdk-go/fips"
+)
+
+var _ = Describe("FIPS", func() {
+	// Tokens used during the tests:
+	var accessToken string
+	var refreshToken string
+
+	// Servers used during the tests:
+	var oidServer *ghttp.Server
+	var apiServer *ghttp.Server
+
+	// Names of the temporary files containing the CAs for the servers:
+	var oidCA string
+	var apiCA string
+
+	BeforeEach(func() {
+		// Create the tokens:
+		accessToken = MakeTokenString("Bearer", 5*time.Minute)
+		refreshToken = MakeTokenString("Refresh", 10*time.Hour)
+
+		// Create the servers:
+		oidServer, oidCA = MakeTCPTLSServer()
+		apiServer, apiCA = MakeTCPTLSServer()
+	})
+
+	AfterEach(func() {
+		// Stop the servers:
+		oidServer.Close()
+		apiServer.Close()
+
+		// Remove the temporary CA files:
+		err := os.Remove(oidCA)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.Remove(apiCA)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// expectViolation checks that the given error reports the given violation of the FIPS
+	// constraints.
+	expectViolation := func(err error, violation fips.Violation) {
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, fips.ErrNotCompliant)).To(BeTrue())
+		var fipsErr *fips.Error
+		Expect(errors.As(err, &fipsErr)).To(BeTrue())
+		Expect(fipsErr.Violation).To(Equal(violation))
+	}
+
+	It("Is disabled by default", func() {
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			Tokens(accessToken).
+			URL("http://localhost").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(connection.FIPS()).To(Equal(fips.Required))
+	})
+
+	It("Sends requests to servers that use approved TLS", func() {
+		// Prepare the server:
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt"),
+				RespondWithJSON(http.StatusOK, "{}"),
+			),
+		)
+
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			Tokens(accessToken, refreshToken).
+			URL(apiServer.URL()).
+			TrustedCAFile(oidCA).
+			TrustedCAFile(apiCA).
+			FIPS(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(connection.FIPS()).To(BeTrue())
+
+		// Send the request:
+		response, err := connection.Get().
+			Path("/api/clusters_mgmt").
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusOK))
+	})
+
+	It("Rejects insecure connections", func() {
+		_, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			Tokens(accessToken).
+			URL(apiServer.URL()).
+			Insecure(true).
+			FIPS(true).
+			Build()
+		expectViolation(err, fips.ViolationInsecure)
+	})
+
+	It("Rejects API server without TLS", func() {
+		_, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			Tokens(accessToken).
+			URL("http://localhost").
+			TrustedCAFile(oidCA).
+			FIPS(true).
+			Build()
+		expectViolation(err, fips.ViolationEndpoint)
+	})
+
+	It("Rejects alternative API server without TLS", func() {
+		_, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			Tokens(accessToken).
+			URL(apiServer.URL()).
+			AlternativeURL("/api/clusters_mgmt", "h2c://localhost").
+			TrustedCAFile(oidCA).
+			TrustedCAFile(apiCA).
+			FIPS(true).
+			Build()
+		expectViolation(err, fips.ViolationEndpoint)
+	})
+
+	It("Rejects OpenID server without TLS", func() {
+		_, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL("http://localhost/token").
+			Tokens(accessToken).
+			URL(apiServer.URL()).
+			TrustedCAFile(apiCA).
+			FIPS(true).
+			Build()
+		expectViolation(err, fips.ViolationEndpoint)
+	})
+
+	It("Rejects token signed with algorithm that isn't approved", func() {
+		// Create a token signed with HMAC:
+		claims := MakeClaims()
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+			SignedString([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// Try to create the connection:
+		_, err = NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			Tokens(token).
+			URL(apiServer.URL()).
+			TrustedCAFile(oidCA).
+			TrustedCAFile(apiCA).
+			FIPS(true).
+			Build()
+		expectViolation(err, fips.ViolationTokenAlgorithm)
+	})
+
+	It("Rejects access token returned by the server with algorithm that isn't approved", func() {
+		// Prepare the OpenID server to return a token signed with HMAC:
+		claims := MakeClaims()
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+			SignedString([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		oidServer.AppendHandlers(
+			RespondWithAccessToken(token),
+		)
+
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			Client("myclient", "mysecret").
+			URL(apiServer.URL()).
+			TrustedCAFile(oidCA).
+			TrustedCAFile(apiCA).
+			FIPS(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Try to get the tokens:
+		_, _, err = connection.TokensContext(context.Background())
+		expectViolation(err, fips.ViolationTokenAlgorithm)
+	})
+
+	It("Rejects server certificate with key that isn't approved", func() {
+		// Create a certificate with a small RSA key:
+		key, err := rsa.GenerateKey(rand.Reader, 1024) // nolint
+		Expect(err).ToNot(HaveOccurred())
+		now := time.Now()
+		spec := x509.Certificate{
+			SerialNumber: big.NewInt(0),
+			Subject: pkix.Name{
+				CommonName: "localhost",
+			},
+			IPAddresses: []net.IP{
+				net.ParseIP("127.0.0.1"),
+			},
+			NotBefore: now,
+			NotAfter:  now.Add(time.Hour),
+			KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+			ExtKeyUsage: []x509.ExtKeyUsage{
+				x509.ExtKeyUsageServerAuth,
+			},
+		}
+		data, err := x509.CreateCertificate(rand.Reader, &spec, &spec, &key.PublicKey, key)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Save the certificate so that the client trusts it:
+		file, err := os.CreateTemp("", "*.test.ca")
+		Expect(err).ToNot(HaveOccurred())
+		weakCA := file.Name()
+		defer func() {
+			err := os.Remove(weakCA)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		err = pem.Encode(file, &pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: data,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		err = file.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create a server that uses that certificate:
+		weakServer := ghttp.NewUnstartedServer()
+		weakServer.Writer = GinkgoWriter
+		weakServer.HTTPTestServer.Config.ErrorLog = log.New(GinkgoWriter, "", log.LstdFlags)
+		weakServer.HTTPTestServer.TLS = &tls.Config{
+			Certificates: []tls.Certificate{{
+				Certificate: [][]byte{data},
+				PrivateKey:  key,
+			}},
+		}
+		weakServer.HTTPTestServer.StartTLS()
+		defer weakServer.Close()
+
+		// Create the connection:
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			Tokens(accessToken).
+			URL(weakServer.URL()).
+			TrustedCAFile(oidCA).
+			TrustedCAFile(weakCA).
+			RetryLimit(0).
+			FIPS(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Send the request:
+		_, err = connection.Get().
+			Path("/api/clusters_mgmt").
+			Send()
+		expectViolation(err, fips.ViolationCertificate)
+	})
+})
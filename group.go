@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of groups of requests that are cancelled together.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Group is a collection of goroutines that send requests using a connection scoped to the group.
+// It behaves like the group of the `golang.org/x/sync/errgroup` package: the first goroutine that
+// fails cancels the context of the group, and that cancels all the requests sent with the scoped
+// connection, even if they were sent with a different context. Don't create instances of this type
+// directly, use the WithGroup method of the connection instead.
+type Group struct {
+	connection *Connection
+	cancel     context.CancelCauseFunc
+	stop       func() bool
+	wait       sync.WaitGroup
+	errOnce    sync.Once
+	err        error
+}
+
+// WithGroup creates a group whose context is derived from the given one. It returns the group and
+// that context. For example, to retrieve a set of clusters in parallel and stop as soon as one of
+// them fails:
+//
+//	group, ctx := connection.WithGroup(ctx)
+//	for _, id := range ids {
+//		id := id
+//		group.Go(func() error {
+//			_, err := group.Connection().ClustersMgmt().V1().Clusters().Cluster(id).Get().
+//				SendContext(ctx)
+//			return err
+//		})
+//	}
+//	err := group.Wait()
+//
+// The given context can also be the one returned by errgroup.WithContext, so that the requests
+// sent with the scoped connection are cancelled when that other group fails.
+func (c *Connection) WithGroup(ctx context.Context) (group *Group, groupCtx context.Context) {
+	groupCtx, cancel := context.WithCancelCause(ctx)
+	group = &Group{
+		connection: c.scoped(groupCtx),
+		cancel:     cancel,
+		stop:       func() bool { return false },
+	}
+
+	// If this connection is itself scoped to a group then the new group is also cancelled when
+	// that group is cancelled:
+	if c.scope != nil {
+		group.stop = context.AfterFunc(c.scope, func() {
+			cancel(context.Cause(c.scope))
+		})
+	}
+	return
+}
+
+// Connection returns the connection scoped to the group. It shares the tokens and HTTP clients of
+// the original connection, but the requests sent with it are cancelled when the context of the
+// group is cancelled.
+func (g *Group) Connection() *Connection {
+	return g.connection
+}
+
+// Go runs the given function in a new goroutine. The first call that returns an error cancels the
+// context of the group, and that error will be returned by the Wait method.
+func (g *Group) Go(f func() error) {
+	g.wait.Add(1)
+	go func() {
+		defer g.wait.Done()
+		err := f()
+		if err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				g.cancel(err)
+			})
+		}
+	}()
+}
+
+// Wait waits till all the goroutines started with the Go method finish, then cancels the context
+// of the group and returns the first error, if any. Requests sent with the scoped connection after
+// this will fail.
+func (g *Group) Wait() error {
+	g.wait.Wait()
+	g.stop()
+	g.cancel(nil)
+	return g.err
+}
+
+// InFlight returns the number of requests sent with the scoped connection that haven't finished
+// yet. A request is finished when its response has been completely read, or when it fails.
+func (g *Group) InFlight() int {
+	return g.connection.inFlight()
+}
+
+// WaitInFlight waits till all the requests sent with the scoped connection finish, or till the
+// given context is done. This is useful when the goroutines of the group don't own the requests
+// they start, for example when they return response bodies that are consumed later. It doesn't
+// start any goroutine, so nothing is left waiting in the background when the context is done first.
+func (g *Group) WaitInFlight(ctx context.Context) error {
+	err := g.connection.waitInFlight(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"can't wait for %d requests in progress: %w",
+			g.InFlight(), err,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for groups of requests.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"runtime"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Group", func() {
+	var (
+		ctx        context.Context
+		server     *ghttp.Server
+		connection *Connection
+		release    chan struct{}
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the context:
+		ctx = context.Background()
+
+		// Create the server. The slow path doesn't respond till the test releases it or the
+		// client cancels the request.
+		release = make(chan struct{})
+		server = MakeTCPServer()
+		server.RouteToHandler(
+			http.MethodGet,
+			"/api/clusters_mgmt/v1/fast",
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+		server.RouteToHandler(
+			http.MethodGet,
+			"/api/clusters_mgmt/v1/slow",
+			func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-release:
+					RespondWithJSON(http.StatusOK, `{}`)(w, r)
+				case <-r.Context().Done():
+				}
+			},
+		)
+
+		// Create the connection:
+		token := MakeTokenString("Bearer", 5*time.Minute)
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(token).
+			RetryLimit(0).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the server:
+		server.Close()
+	})
+
+	It("Returns nil when all the goroutines succeed", func() {
+		group, groupCtx := connection.WithGroup(ctx)
+		for i := 0; i < 3; i++ {
+			group.Go(func() error {
+				_, err := group.Connection().Get().
+					Path("/api/clusters_mgmt/v1/fast").
+					SendContext(groupCtx)
+				return err
+			})
+		}
+		err := group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(group.InFlight()).To(BeZero())
+	})
+
+	It("Cancels requests in progress when a goroutine fails", func() {
+		failure := errors.New("my failure")
+		group, _ := connection.WithGroup(ctx)
+		slowErr := make(chan error, 1)
+		group.Go(func() error {
+			// Note that this doesn't use the context of the group, the request should be
+			// cancelled anyhow because it is sent with the scoped connection.
+			_, err := group.Connection().Get().
+				Path("/api/clusters_mgmt/v1/slow").
+				SendContext(ctx)
+			slowErr <- err
+			return nil
+		})
+		group.Go(func() error {
+			Eventually(group.InFlight).Should(Equal(1))
+			return failure
+		})
+		err := group.Wait()
+		Expect(err).To(MatchError(failure))
+		Expect(<-slowErr).To(HaveOccurred())
+		Expect(group.InFlight()).To(BeZero())
+	})
+
+	It("Waits for requests in progress", func() {
+		group, groupCtx := connection.WithGroup(ctx)
+		group.Go(func() error {
+			_, err := group.Connection().Get().
+				Path("/api/clusters_mgmt/v1/slow").
+				SendContext(groupCtx)
+			return err
+		})
+		Eventually(group.InFlight).Should(Equal(1))
+
+		// Check that waiting fails while the request is blocked:
+		shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		err := group.WaitInFlight(shortCtx)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		// Release the request and wait again:
+		close(release)
+		err = group.WaitInFlight(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(group.InFlight()).To(BeZero())
+		err = group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Doesn't leave goroutines behind when waiting times out", func() {
+		group, groupCtx := connection.WithGroup(ctx)
+		group.Go(func() error {
+			_, err := group.Connection().Get().
+				Path("/api/clusters_mgmt/v1/slow").
+				SendContext(groupCtx)
+			return err
+		})
+		Eventually(group.InFlight).Should(Equal(1))
+
+		// Wait several times with a context that expires:
+		before := runtime.NumGoroutine()
+		for i := 0; i < 10; i++ {
+			shortCtx, cancel := context.WithTimeout(ctx, time.Millisecond)
+			err := group.WaitInFlight(shortCtx)
+			cancel()
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+		}
+		Expect(runtime.NumGoroutine()).To(BeNumerically("<=", before))
+
+		// Release the request:
+		close(release)
+		err := group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Uses the close timeout of the connection", func() {
+		clone, err := connection.Clone().
+			CloseTimeout(time.Minute).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err := clone.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		group, _ := clone.WithGroup(ctx)
+		Expect(group.Connection().CloseTimeout()).To(Equal(time.Minute))
+		err = group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Rejects requests after waiting", func() {
+		group, _ := connection.WithGroup(ctx)
+		err := group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = group.Connection().Get().
+			Path("/api/clusters_mgmt/v1/fast").
+			Send()
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(group.InFlight()).To(BeZero())
+	})
+
+	It("Is cancelled when the parent context is cancelled", func() {
+		// This simulates a group created from the context of an errgroup that fails:
+		parentCtx, cancel := context.WithCancel(ctx)
+		group, _ := connection.WithGroup(parentCtx)
+		result := make(chan error, 1)
+		go func() {
+			_, err := group.Connection().Get().
+				Path("/api/clusters_mgmt/v1/slow").
+				Send()
+			result <- err
+		}()
+		Eventually(group.InFlight).Should(Equal(1))
+		cancel()
+		Expect(<-result).To(HaveOccurred())
+		Eventually(group.InFlight).Should(BeZero())
+	})
+
+	It("Is cancelled when the group of the connection is cancelled", func() {
+		outer, _ := connection.WithGroup(ctx)
+		inner, innerCtx := outer.Connection().WithGroup(ctx)
+		err := outer.Wait()
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(innerCtx.Done()).Should(BeClosed())
+		err = inner.Wait()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Keeps the scope in clones", func() {
+		group, _ := connection.WithGroup(ctx)
+		clone, err := group.Connection().Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = clone.Get().
+			Path("/api/clusters_mgmt/v1/fast").
+			Send()
+		Expect(err).To(MatchError(context.Canceled))
+	})
+
+	It("Waits for requests sent with clones of clones", func() {
+		group, groupCtx := connection.WithGroup(ctx)
+		clone, err := group.Connection().Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+		cloneOfClone, err := clone.Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+		group.Go(func() error {
+			_, err := cloneOfClone.Get().
+				Path("/api/clusters_mgmt/v1/slow").
+				SendContext(groupCtx)
+			return err
+		})
+		Eventually(group.InFlight).Should(Equal(1))
+		close(release)
+		err = group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(group.InFlight()).To(BeZero())
+	})
+
+	It("Shares the region discovery with the connection", func() {
+		discovery, err := connection.RegionDiscovery()
+		Expect(err).ToNot(HaveOccurred())
+		group, _ := connection.WithGroup(ctx)
+		Expect(group.Connection().regionDiscovery).To(BeIdenticalTo(discovery))
+		err = group.Wait()
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the health check of the connection.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultHealthCheckPath is the path used by the HealthCheck method when no other path is given. It
+// is the metadata endpoint of the clusters management service, which is cheap to serve.
+const DefaultHealthCheckPath = "/api/clusters_mgmt/v1"
+
+// HealthCheckResult contains the result of a health check of a connection. It can be serialized to
+// JSON, for example to return it from the readiness endpoint of a service.
+type HealthCheckResult struct {
+	// Healthy is true if all the checks succeeded.
+	Healthy bool `json:"healthy"`
+
+	// Time is the time when the check started.
+	Time time.Time `json:"time"`
+
+	// Tokens is the result of checking that valid tokens are available. It is nil if the
+	// connection doesn't use authentication.
+	Tokens *HealthCheckStatus `json:"tokens,omitempty"`
+
+	// API is the result of sending a request to the API. It is nil if that request wasn't sent
+	// because the tokens aren't available.
+	API *HealthCheckStatus `json:"api,omitempty"`
+}
+
+// HealthCheckStatus contains the result of one of the checks performed by the HealthCheck method.
+type HealthCheckStatus struct {
+	// Healthy is true if the check succeeded.
+	Healthy bool `json:"healthy"`
+
+	// Duration is the time that the check took.
+	Duration time.Duration `json:"duration"`
+
+	// Code is the HTTP status code returned by the server, if a response was received.
+	Code int `json:"code,omitempty"`
+
+	// Message is the description of the error, if the check failed.
+	Message string `json:"message,omitempty"`
+
+	// Error is the error that caused the check to fail, if any.
+	Error error `json:"-"`
+}
+
+// HealthCheck checks that the connection can be used: that valid tokens are available, requesting
+// new ones if needed, and that the API answers a request for a lightweight endpoint with a
+// successful status code. The path of that endpoint is DefaultHealthCheckPath unless a different
+// one is given. Failures of the checks are reported in the result and not as errors, so this is
+// suitable for the readiness probes of services that embed the SDK. For example:
+//
+//	result := connection.HealthCheck(ctx)
+//	if !result.Healthy {
+//		w.WriteHeader(http.StatusServiceUnavailable)
+//	}
+//	json.NewEncoder(w).Encode(result)
+//
+// Consider using a context with a deadline, as the requests are retried like any other request sent
+// by the connection.
+func (c *Connection) HealthCheck(ctx context.Context, path ...string) *HealthCheckResult {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	target := DefaultHealthCheckPath
+	if len(path) == 1 {
+		target = path[0]
+	}
+	result := &HealthCheckResult{
+		Time: time.Now(),
+	}
+
+	// Check the tokens:
+	if c.authnWrapper != nil {
+		start := time.Now()
+		_, _, err := c.authnWrapper.Tokens(ctx)
+		result.Tokens = newHealthCheckStatus(start, 0, err)
+		if err != nil {
+			return result
+		}
+	}
+
+	// Send the request to the API:
+	start := time.Now()
+	code, err := c.healthCheckPing(ctx, target)
+	result.API = newHealthCheckStatus(start, code, err)
+	result.Healthy = result.API.Healthy
+	return result
+}
+
+// healthCheckPing sends a GET request for the given path and returns the status code, or an error
+// if the request fails or the status code isn't successful.
+func (c *Connection) healthCheckPing(ctx context.Context, path string) (code int, err error) {
+	response, err := c.DoGet(ctx, path)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+	_, err = io.Copy(io.Discard, response.Body)
+	code = response.StatusCode
+	if err != nil {
+		err = fmt.Errorf("can't read response: %w", err)
+		return
+	}
+	if code < http.StatusOK || code >= http.StatusMultipleChoices {
+		err = fmt.Errorf("request for '%s' returned status code %d", path, code)
+	}
+	return
+}
+
+// newHealthCheckStatus creates the status of a check that started at the given time.
+func newHealthCheckStatus(start time.Time, code int, err error) *HealthCheckStatus {
+	status := &HealthCheckStatus{
+		Healthy:  err == nil,
+		Duration: time.Since(start),
+		Code:     code,
+		Error:    err,
+	}
+	if err != nil {
+		status.Message = err.Error()
+	}
+	return status
+}
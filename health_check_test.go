@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the health check of the connection.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Health check", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Reports healthy when tokens are valid and the API answers", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, DefaultHealthCheckPath),
+				RespondWithJSON(http.StatusOK, `{"server_version": "123"}`),
+			),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+
+		result := connection.HealthCheck(ctx)
+		Expect(result.Healthy).To(BeTrue())
+		Expect(result.Tokens).ToNot(BeNil())
+		Expect(result.Tokens.Healthy).To(BeTrue())
+		Expect(result.API).ToNot(BeNil())
+		Expect(result.API.Healthy).To(BeTrue())
+		Expect(result.API.Code).To(Equal(http.StatusOK))
+		Expect(result.API.Message).To(BeEmpty())
+	})
+
+	It("Uses the given path", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+
+		result := connection.HealthCheck(ctx, "/api/accounts_mgmt/v1")
+		Expect(result.Healthy).To(BeTrue())
+	})
+
+	It("Reports unhealthy when the API returns an error", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusBadRequest, `{}`),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+
+		result := connection.HealthCheck(ctx)
+		Expect(result.Healthy).To(BeFalse())
+		Expect(result.Tokens.Healthy).To(BeTrue())
+		Expect(result.API.Healthy).To(BeFalse())
+		Expect(result.API.Code).To(Equal(http.StatusBadRequest))
+		Expect(result.API.Error).To(HaveOccurred())
+		Expect(result.API.Message).To(ContainSubstring("400"))
+	})
+
+	It("Doesn't send the request when tokens aren't available", func() {
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", -5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+
+		result := connection.HealthCheck(ctx)
+		Expect(result.Healthy).To(BeFalse())
+		Expect(result.Tokens.Healthy).To(BeFalse())
+		Expect(result.Tokens.Error).To(HaveOccurred())
+		Expect(result.API).To(BeNil())
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Can be serialized to JSON", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+
+		result := connection.HealthCheck(ctx)
+		data, err := json.Marshal(result)
+		Expect(err).ToNot(HaveOccurred())
+		var object map[string]interface{}
+		err = json.Unmarshal(data, &object)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(object).To(HaveKeyWithValue("healthy", true))
+		Expect(object).To(HaveKey("tokens"))
+		Expect(object).To(HaveKey("api"))
+	})
+})
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the shared pool of buffers used to marshal and unmarshal messages.
+
+package helpers // github.com/openshift-online/ocm-sdk-go/helpers
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// BufferPoolStats contains the counters of the shared buffer pool.
+type BufferPoolStats struct {
+	// Gets is the number of buffers obtained from the pool.
+	Gets uint64
+
+	// Puts is the number of buffers returned to the pool.
+	Puts uint64
+
+	// Allocations is the number of buffers that had to be allocated because the pool was empty.
+	Allocations uint64
+
+	// Discards is the number of buffers that weren't returned to the pool because they were too
+	// large.
+	Discards uint64
+}
+
+// bufferPool is the pool of buffers shared by all the connections.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		bufferPoolAllocations.Add(1)
+		return &bytes.Buffer{}
+	},
+}
+
+// Counters of the shared buffer pool:
+var (
+	bufferPoolGets        atomic.Uint64
+	bufferPoolPuts        atomic.Uint64
+	bufferPoolAllocations atomic.Uint64
+	bufferPoolDiscards    atomic.Uint64
+)
+
+// maxPooledBufferSize is the capacity above which buffers aren't returned to the pool, so that an
+// occasional very large message doesn't keep a large amount of memory in use.
+const maxPooledBufferSize = 1 << 20
+
+// GetBuffer returns an empty buffer from the shared pool. The buffer should be returned to the pool
+// with the PutBuffer function once it is no longer needed.
+func GetBuffer() *bytes.Buffer {
+	bufferPoolGets.Add(1)
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	return buffer
+}
+
+// PutBuffer returns a buffer to the shared pool. The buffer, or any slice obtained from it, must not
+// be used after calling this.
+func PutBuffer(buffer *bytes.Buffer) {
+	if buffer == nil {
+		return
+	}
+	if buffer.Cap() > maxPooledBufferSize {
+		bufferPoolDiscards.Add(1)
+		return
+	}
+	bufferPoolPuts.Add(1)
+	bufferPool.Put(buffer)
+}
+
+// BufferBody returns a request body that reads from the given buffer and returns it to the shared
+// pool when it is closed. Note that HTTP transports are required to close the request body, but
+// they may do it after the round trip finishes.
+func BufferBody(buffer *bytes.Buffer) io.ReadCloser {
+	return &bufferBody{
+		buffer: buffer,
+	}
+}
+
+// bufferBody is a request body that returns the buffer to the pool when it is closed.
+type bufferBody struct {
+	lock   sync.Mutex
+	buffer *bytes.Buffer
+}
+
+// Read is the implementation of the io.Reader interface.
+func (b *bufferBody) Read(p []byte) (n int, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.buffer == nil {
+		err = io.EOF
+		return
+	}
+	return b.buffer.Read(p)
+}
+
+// Close is the implementation of the io.Closer interface.
+func (b *bufferBody) Close() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	PutBuffer(b.buffer)
+	b.buffer = nil
+	return nil
+}
+
+// GetBufferPoolStats returns the current values of the counters of the shared buffer pool.
+func GetBufferPoolStats() BufferPoolStats {
+	return BufferPoolStats{
+		Gets:        bufferPoolGets.Load(),
+		Puts:        bufferPoolPuts.Load(),
+		Allocations: bufferPoolAllocations.Load(),
+		Discards:    bufferPoolDiscards.Load(),
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains a function that waits till an asynchronous operation accepted by the server
+// completes.
+
+package helpers // github.com/openshift-online/ocm-sdk-go/helpers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// CompletionProgress contains the details of one of the requests sent to check the status of an
+// asynchronous operation. It is passed to the progress function.
+type CompletionProgress struct {
+	// Location is the path of the resource that is polled, taken from the `Location` header of
+	// the response that accepted the operation.
+	Location string
+
+	// Attempt is the number of the polling request, starting with one.
+	Attempt int
+
+	// Status is the HTTP status code returned by the server.
+	Status int
+
+	// Header contains the HTTP headers returned by the server.
+	Header http.Header
+
+	// Done is true when the operation has completed.
+	Done bool
+}
+
+// CompletionProgressFunc is the type of the functions that receive the progress of asynchronous
+// operations.
+type CompletionProgressFunc func(progress *CompletionProgress)
+
+// CompletionOptions contains the data that the WaitForCompletion function needs to poll the status
+// of an asynchronous operation.
+type CompletionOptions struct {
+	// Transport is the transport used to send the polling requests. This is mandatory.
+	Transport http.RoundTripper
+
+	// Path is the path of the request that started the operation. It is used to resolve the
+	// location when it is relative.
+	Path string
+
+	// Method is the HTTP method of the request that started the operation. When it is `DELETE`
+	// the 404 and 410 status codes mean that the operation completed.
+	Method string
+
+	// Status is the HTTP status code of the response to the request that started the operation.
+	// Nothing is polled unless it is 202.
+	Status int
+
+	// Header contains the HTTP headers of the response to the request that started the operation.
+	Header http.Header
+
+	// Interval is the time to wait between polling requests when the server doesn't send the
+	// `Retry-After` header. The default value is five seconds.
+	Interval time.Duration
+
+	// Progress is an optional function that will be called after each polling request.
+	Progress CompletionProgressFunc
+
+	// DecodeError is the function used to convert the responses with error status codes into
+	// errors. When it isn't set a generic error containing the status code is returned.
+	DecodeError func(source io.Reader, response *http.Response) error
+}
+
+// WaitForCompletion checks if the server accepted an operation for asynchronous processing, with
+// the 202 status code and a `Location` header, and in that case polls the referenced location till
+// the server returns a status code different to 202. Responses with status codes 400 or higher are
+// reported as errors, except 404 and 410 when the operation is a deletion. Nothing is done if the
+// status code isn't 202 or the `Location` header is missing.
+func WaitForCompletion(ctx context.Context, opts *CompletionOptions) error {
+	// Check the parameters:
+	if opts == nil {
+		return fmt.Errorf("options are mandatory")
+	}
+	if opts.Transport == nil {
+		return fmt.Errorf("transport is mandatory")
+	}
+	if opts.Interval < 0 {
+		return fmt.Errorf("interval %s isn't valid, it should be positive", opts.Interval)
+	}
+	if opts.Status != http.StatusAccepted {
+		return nil
+	}
+	location := opts.Header.Get("Location")
+	if location == "" {
+		return nil
+	}
+	parsed, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("can't parse location '%s': %w", location, err)
+	}
+	base := &url.URL{
+		Path: opts.Path,
+	}
+	parsed = base.ResolveReference(parsed)
+	uri := &url.URL{
+		Path:     parsed.Path,
+		RawQuery: parsed.RawQuery,
+	}
+	interval := opts.Interval
+	if interval == 0 {
+		interval = 5 * time.Second
+	}
+
+	header := opts.Header
+	for attempt := 1; ; attempt++ {
+		// Wait before checking, honouring the delay requested by the server:
+		delay := interval
+		if value := header.Get("Retry-After"); value != "" {
+			seconds, err := strconv.Atoi(value)
+			if err == nil && seconds >= 0 {
+				delay = time.Duration(seconds) * time.Second
+			}
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("operation hasn't completed: %w", ctx.Err())
+		case <-timer.C:
+		}
+
+		// Check the status of the operation:
+		request := &http.Request{
+			Method: http.MethodGet,
+			URL:    uri,
+			Header: http.Header{},
+		}
+		request = request.WithContext(ctx)
+		response, err := opts.Transport.RoundTrip(request)
+		if err != nil {
+			return fmt.Errorf("can't check status of operation at '%s': %w", uri.Path, err)
+		}
+		body, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return fmt.Errorf("can't read status of operation at '%s': %w", uri.Path, err)
+		}
+		header = response.Header
+		status := response.StatusCode
+		done := status != http.StatusAccepted
+		if opts.Progress != nil {
+			opts.Progress(&CompletionProgress{
+				Location: uri.Path,
+				Attempt:  attempt,
+				Status:   status,
+				Header:   header,
+				Done:     done,
+			})
+		}
+		switch {
+		case !done:
+			continue
+		case opts.Method == http.MethodDelete &&
+			(status == http.StatusNotFound || status == http.StatusGone):
+			return nil
+		case status >= 400 && opts.DecodeError != nil && len(body) > 0:
+			return opts.DecodeError(bytes.NewReader(body), response)
+		case status >= 400:
+			return fmt.Errorf(
+				"operation at '%s' failed with status code %d",
+				uri.Path, status,
+			)
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that extract well known values from the headers of responses.
+
+package helpers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Names of the response headers:
+const (
+	ETagHeader               = "ETag"
+	OperationIDHeader        = "X-Operation-ID"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	SunsetHeader             = "Sunset"
+)
+
+// legacyRateLimitRemainingHeader is the name of the header that some servers use instead of the
+// standard `RateLimit-Remaining`.
+const legacyRateLimitRemainingHeader = "X-RateLimit-Remaining"
+
+// ETag returns the value of the `ETag` header, or an empty string if it isn't present.
+func ETag(header http.Header) string {
+	if header == nil {
+		return ""
+	}
+	return header.Get(ETagHeader)
+}
+
+// OperationID returns the value of the `X-Operation-ID` header, or an empty string if it isn't
+// present. This is the identifier that the server assigns to the request, useful to correlate
+// with the server logs when reporting problems.
+func OperationID(header http.Header) string {
+	if header == nil {
+		return ""
+	}
+	return header.Get(OperationIDHeader)
+}
+
+// RateLimitRemaining returns the number of requests remaining in the current rate limit window, as
+// reported by the `RateLimit-Remaining` header, or by `X-RateLimit-Remaining` if the standard
+// header isn't present. The flag will be false if none of the headers is present or if the value
+// isn't a valid non negative integer.
+func RateLimitRemaining(header http.Header) (value int, ok bool) {
+	if header == nil {
+		return
+	}
+	text := header.Get(RateLimitRemainingHeader)
+	if text == "" {
+		text = header.Get(legacyRateLimitRemainingHeader)
+	}
+	if text == "" {
+		return
+	}
+	value, err := strconv.Atoi(strings.TrimSpace(text))
+	if err != nil || value < 0 {
+		value = 0
+		return
+	}
+	ok = true
+	return
+}
+
+// Sunset returns the time when the resource will stop being available, as reported by the `Sunset`
+// header described in RFC 8594. It returns the zero time if the header isn't present or if its
+// value isn't a valid HTTP date.
+func Sunset(header http.Header) time.Time {
+	if header == nil {
+		return time.Time{}
+	}
+	text := header.Get(SunsetHeader)
+	if text == "" {
+		return time.Time{}
+	}
+	value, err := http.ParseTime(text)
+	if err != nil {
+		return time.Time{}
+	}
+	return value
+}
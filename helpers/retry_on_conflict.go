@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains a function that retries read-modify-write updates when they fail because of
+// conflicts.
+
+package helpers // github.com/openshift-online/ocm-sdk-go/helpers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy describes how many times and how often the RetryOnConflict function retries an update.
+type RetryPolicy struct {
+	// Steps is the maximum number of times that the function will be called.
+	Steps int
+
+	// Duration is the time to wait before the first retry.
+	Duration time.Duration
+
+	// Factor is the number that the wait time is multiplied by after each retry. Values lower
+	// than one are ignored.
+	Factor float64
+
+	// Jitter is the maximum fraction of the wait time that will be randomly added to it, so that
+	// multiple clients updating the same object don't retry at the same time.
+	Jitter float64
+
+	// Cap is the maximum time to wait between retries. Zero means no limit.
+	Cap time.Duration
+}
+
+// DefaultRetryPolicy is the policy used by the RetryOnConflict function when no other policy is
+// given. It is intended for objects like clusters and machine pools that are updated concurrently
+// by the service itself, so it waits a bit longer between retries than what would be needed for
+// objects updated only by clients.
+var DefaultRetryPolicy = RetryPolicy{
+	Steps:    5,
+	Duration: 100 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+	Cap:      5 * time.Second,
+}
+
+// RetryOnConflict calls the given function till it succeeds or returns an error other than a
+// conflict. Conflicts are errors with the 409 status code, including the typed ConflictError of
+// the errors package. The function should fetch the latest version of the object, apply the changes
+// and send the update, so that each attempt works with fresh data. For example:
+//
+//	err := helpers.RetryOnConflict(ctx, func(ctx context.Context) error {
+//		get, err := client.Get().SendContext(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		patch, err := cmv1.NewMachinePool().
+//			Replicas(get.Body().Replicas() + 1).
+//			Build()
+//		if err != nil {
+//			return err
+//		}
+//		_, err = client.Update().Body(patch).SendContext(ctx)
+//		return err
+//	}, nil)
+//
+// The policy can be nil, and then DefaultRetryPolicy will be used. If the function still returns a
+// conflict after all the steps the last error is returned.
+func RetryOnConflict(ctx context.Context, fn func(context.Context) error, policy *RetryPolicy) error {
+	// Check the parameters:
+	if fn == nil {
+		return fmt.Errorf("function is mandatory")
+	}
+	if policy == nil {
+		policy = &DefaultRetryPolicy
+	}
+	if policy.Steps < 1 {
+		return fmt.Errorf("steps %d isn't valid, it should be at least one", policy.Steps)
+	}
+	if policy.Duration < 0 {
+		return fmt.Errorf("duration %s isn't valid, it should be positive", policy.Duration)
+	}
+	if policy.Jitter < 0 {
+		return fmt.Errorf("jitter %f isn't valid, it should be positive", policy.Jitter)
+	}
+
+	delay := policy.Duration
+	for step := 1; ; step++ {
+		err := fn(ctx)
+		if err == nil || !hasStatus(err, http.StatusConflict) || step >= policy.Steps {
+			return err
+		}
+
+		// Wait before trying again:
+		wait := delay
+		if policy.Jitter > 0 {
+			wait += time.Duration(rand.Float64() * policy.Jitter * float64(delay))
+		}
+		if policy.Cap > 0 && wait > policy.Cap {
+			wait = policy.Cap
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("can't retry after conflict: %w", ctx.Err())
+		case <-timer.C:
+		}
+		if policy.Factor > 1 {
+			delay = time.Duration(float64(delay) * policy.Factor)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used by the generated clients to validate requests before sending
+// them to the server.
+
+package helpers // github.com/openshift-online/ocm-sdk-go/helpers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrMissingParameter is the error returned, possibly wrapped, when a request is sent without a
+// value for a mandatory path parameter or body. It can be checked with the errors.Is function.
+//
+// Note that the specification of the API only marks path parameters as required, it doesn't say
+// which attributes of the request bodies are mandatory. Because of that the bodies are only checked
+// to be present, and the validation of their content is left to the server.
+var ErrMissingParameter = errors.New("missing mandatory parameter")
+
+// PathParameter returns the segment that should be added to the path of a client for the given
+// value of the path parameter with the given name. Empty values are replaced by a placeholder that
+// contains the name of the parameter, like the path templates used in the specification of the API.
+// That makes the request fail with a descriptive error when it is sent, instead of silently sending
+// it to the parent collection.
+func PathParameter(name, value string) string {
+	if value == "" {
+		return "{" + name + "}"
+	}
+	return value
+}
+
+// ValidatePath checks that all the path parameters of the given path have a value.
+func ValidatePath(path string) error {
+	for _, segment := range strings.Split(path, "/") {
+		if len(segment) > 2 && strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			return MissingParameter(segment[1:len(segment)-1], path)
+		}
+	}
+	return nil
+}
+
+// MissingParameter returns the error that indicates that the given mandatory parameter of the
+// request for the given path doesn't have a value.
+func MissingParameter(name, path string) error {
+	return fmt.Errorf("%w '%s' in request for '%s'", ErrMissingParameter, name, path)
+}
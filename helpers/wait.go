@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains a function that waits till an object has been deleted.
+
+package helpers // github.com/openshift-online/ocm-sdk-go/helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WaitForDeletionOptions contains the options that control how the WaitForDeletion function polls
+// the server.
+type WaitForDeletionOptions struct {
+	// Interval is the time to wait before the first retry. The default value is five seconds.
+	Interval time.Duration
+
+	// MaxInterval is the maximum time to wait between retries. The interval is doubled after each
+	// retry till it reaches this value. The default value is one minute.
+	MaxInterval time.Duration
+
+	// Timeout is the maximum total time to wait. The default is to wait till the context is
+	// cancelled.
+	Timeout time.Duration
+}
+
+// WaitForDeletion repeatedly calls the given function till it returns an error with the 404 status
+// code, which is considered success. Any other error is returned immediately. The function will
+// usually send a get request for the object. For example, to wait till a cluster has been deleted:
+//
+//	err := helpers.WaitForDeletion(
+//		ctx,
+//		func(ctx context.Context) error {
+//			_, err := client.Get().SendContext(ctx)
+//			return err
+//		},
+//		&helpers.WaitForDeletionOptions{
+//			Timeout: 30 * time.Minute,
+//		},
+//	)
+//
+// The options can be nil, and then the default values will be used. If the context is cancelled or
+// the timeout expires before the object is deleted the returned error will wrap the error of the
+// context.
+func WaitForDeletion(ctx context.Context, getFn func(context.Context) error,
+	opts *WaitForDeletionOptions) error {
+	// Check the parameters:
+	if getFn == nil {
+		return fmt.Errorf("get function is mandatory")
+	}
+	interval := 5 * time.Second
+	maxInterval := time.Minute
+	if opts != nil {
+		if opts.Interval < 0 {
+			return fmt.Errorf("interval %s isn't valid, it should be positive", opts.Interval)
+		}
+		if opts.MaxInterval < 0 {
+			return fmt.Errorf(
+				"maximum interval %s isn't valid, it should be positive",
+				opts.MaxInterval,
+			)
+		}
+		if opts.Timeout < 0 {
+			return fmt.Errorf("timeout %s isn't valid, it should be positive", opts.Timeout)
+		}
+		if opts.Interval > 0 {
+			interval = opts.Interval
+		}
+		if opts.MaxInterval > 0 {
+			maxInterval = opts.MaxInterval
+		}
+		if opts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			defer cancel()
+		}
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	for {
+		// Check if the object still exists:
+		err := getFn(ctx)
+		switch {
+		case err == nil:
+		case hasStatus(err, http.StatusNotFound):
+			return nil
+		case ctx.Err() != nil:
+			return fmt.Errorf("object hasn't been deleted: %w", ctx.Err())
+		default:
+			return fmt.Errorf("can't check if object has been deleted: %w", err)
+		}
+
+		// Wait before trying again:
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("object hasn't been deleted: %w", ctx.Err())
+		case <-timer.C:
+		}
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// hasStatus checks if the given error, or any of the errors that it wraps, has the given status
+// code. The errors package can't be used directly here because it depends on this package.
+func hasStatus(err error, code int) bool {
+	var status interface {
+		Status() int
+	}
+	return errors.As(err, &status) && status.Status() == code
+}
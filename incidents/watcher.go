@@ -0,0 +1,300 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the watcher that delivers notifications when incidents are created or
+// updated.
+
+package incidents
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	webrcav1 "github.com/openshift-online/ocm-sdk-go/webrca/v1"
+)
+
+// NotificationType is the type of a notification.
+type NotificationType string
+
+// Types of notifications:
+const (
+	// NotificationCreated is used for incidents that haven't been seen before.
+	NotificationCreated NotificationType = "created"
+
+	// NotificationUpdated is used for incidents that have been updated but whose status hasn't
+	// changed.
+	NotificationUpdated NotificationType = "updated"
+
+	// NotificationStatusChanged is used for incidents whose status has changed.
+	NotificationStatusChanged NotificationType = "status_changed"
+)
+
+// Notification describes a change in an incident.
+type Notification struct {
+	// Type is the type of the notification.
+	Type NotificationType
+
+	// Incident is the incident as returned by the server.
+	Incident *webrcav1.Incident
+
+	// PreviousStatus is the status that the incident had before the change. It is only set for
+	// notifications of type NotificationStatusChanged.
+	PreviousStatus string
+}
+
+// WatcherBuilder contains the data and logic needed to build a watcher.
+type WatcherBuilder struct {
+	logger          logging.Logger
+	client          *webrcav1.Client
+	interval        time.Duration
+	status          string
+	includeExisting bool
+	bufferSize      int
+}
+
+// Watcher periodically polls the incidents and delivers notifications for the ones that have been
+// created or updated since the previous poll. Each version of an incident is notified only once.
+type Watcher struct {
+	logger          logging.Logger
+	client          *webrcav1.Client
+	interval        time.Duration
+	status          string
+	includeExisting bool
+	bufferSize      int
+}
+
+// incidentState is the information that the watcher remembers about each incident in order to
+// avoid duplicated notifications.
+type incidentState struct {
+	updatedAt time.Time
+	status    string
+}
+
+// NewWatcher creates a builder that can then be used to configure and create a watcher.
+func NewWatcher() *WatcherBuilder {
+	return &WatcherBuilder{
+		interval:   defaultInterval,
+		bufferSize: defaultBufferSize,
+	}
+}
+
+// Logger sets the logger that the watcher will use to write to the log. This is mandatory.
+func (b *WatcherBuilder) Logger(value logging.Logger) *WatcherBuilder {
+	b.logger = value
+	return b
+}
+
+// Client sets the web RCA client that will be used to list the incidents. This is mandatory.
+func (b *WatcherBuilder) Client(value *webrcav1.Client) *WatcherBuilder {
+	b.client = value
+	return b
+}
+
+// Interval sets the time between polls. The default value is thirty seconds.
+func (b *WatcherBuilder) Interval(value time.Duration) *WatcherBuilder {
+	b.interval = value
+	return b
+}
+
+// Status sets the status of the incidents that will be watched, for example `ongoing`. The
+// default is to watch all the incidents.
+func (b *WatcherBuilder) Status(value string) *WatcherBuilder {
+	b.status = value
+	return b
+}
+
+// IncludeExisting sets the flag that indicates if notifications should be sent for the incidents
+// that already exist when the watcher starts. The default value is false, so that only changes
+// that happen after the watcher starts are notified.
+func (b *WatcherBuilder) IncludeExisting(value bool) *WatcherBuilder {
+	b.includeExisting = value
+	return b
+}
+
+// BufferSize sets the size of the buffer of the channel used to deliver the notifications. The
+// default value is one hundred.
+func (b *WatcherBuilder) BufferSize(value int) *WatcherBuilder {
+	b.bufferSize = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new watcher.
+func (b *WatcherBuilder) Build() (result *Watcher, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	if b.interval <= 0 {
+		err = errors.New("interval should be greater than zero")
+		return
+	}
+	if b.bufferSize < 0 {
+		err = errors.New("buffer size should be zero or positive")
+		return
+	}
+
+	// Create and populate the object:
+	result = &Watcher{
+		logger:          b.logger,
+		client:          b.client,
+		interval:        b.interval,
+		status:          b.status,
+		includeExisting: b.includeExisting,
+		bufferSize:      b.bufferSize,
+	}
+	return
+}
+
+// Watch starts polling the incidents in a new goroutine and returns the channel where the
+// notifications will be delivered. The channel is closed when the context is cancelled. Errors
+// sending requests to the server are written to the log and the request is retried in the next
+// poll, so temporary failures don't interrupt the notifications. For example:
+//
+//	notifications := watcher.Watch(ctx)
+//	for notification := range notifications {
+//		...
+//	}
+func (w *Watcher) Watch(ctx context.Context) <-chan *Notification {
+	channel := make(chan *Notification, w.bufferSize)
+	go w.run(ctx, channel)
+	return channel
+}
+
+func (w *Watcher) run(ctx context.Context, channel chan<- *Notification) {
+	defer close(channel)
+	states := map[string]*incidentState{}
+	var since time.Time
+	first := true
+	for {
+		latest, err := w.poll(ctx, states, since, !first || w.includeExisting, channel)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			w.logger.Error(ctx, "Can't poll incidents, will retry: %v", err)
+		} else {
+			since = latest
+			first = false
+		}
+		timer := time.NewTimer(w.interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// poll fetches the incidents that have been updated since the given time and sends the
+// notifications. It returns the time of the most recent update seen.
+func (w *Watcher) poll(ctx context.Context, states map[string]*incidentState, since time.Time,
+	notify bool, channel chan<- *Notification) (latest time.Time, err error) {
+	latest = since
+	var notifications []*Notification
+	page := 1
+	for {
+		request := w.client.Incidents().List().
+			OrderBy(orderBy).
+			Page(page).
+			Size(listSize)
+		if w.status != "" {
+			request.Status(w.status)
+		}
+		var response *webrcav1.IncidentsListResponse
+		response, err = request.SendContext(ctx)
+		if err != nil {
+			return
+		}
+		done := response.Items().Len() < listSize
+		response.Items().Each(func(incident *webrcav1.Incident) bool {
+			updatedAt := incident.UpdatedAt()
+			if updatedAt.Before(since) {
+				done = true
+				return false
+			}
+			if updatedAt.After(latest) {
+				latest = updatedAt
+			}
+			notification := w.compare(states, incident)
+			if notification != nil && notify {
+				notifications = append(notifications, notification)
+			}
+			return true
+		})
+		if done {
+			break
+		}
+		page++
+	}
+
+	// Send the notifications in chronological order:
+	for i := len(notifications) - 1; i >= 0; i-- {
+		select {
+		case channel <- notifications[i]:
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+	}
+	return
+}
+
+// compare updates the remembered state of the incident and returns the notification that should be
+// sent, or nil if the incident hasn't changed.
+func (w *Watcher) compare(states map[string]*incidentState,
+	incident *webrcav1.Incident) *Notification {
+	state, ok := states[incident.ID()]
+	if !ok {
+		states[incident.ID()] = &incidentState{
+			updatedAt: incident.UpdatedAt(),
+			status:    incident.Status(),
+		}
+		return &Notification{
+			Type:     NotificationCreated,
+			Incident: incident,
+		}
+	}
+	if !incident.UpdatedAt().After(state.updatedAt) {
+		return nil
+	}
+	notification := &Notification{
+		Type:     NotificationUpdated,
+		Incident: incident,
+	}
+	if incident.Status() != state.status {
+		notification.Type = NotificationStatusChanged
+		notification.PreviousStatus = state.status
+	}
+	state.updatedAt = incident.UpdatedAt()
+	state.status = incident.Status()
+	return notification
+}
+
+// Default values:
+const (
+	defaultInterval   = 30 * time.Second
+	defaultBufferSize = 100
+	listSize          = 100
+	orderBy           = "updated_at desc"
+)
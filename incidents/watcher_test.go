@@ -0,0 +1,190 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package incidents
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Watcher", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var server *ghttp.Server
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		server = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	It("Can't be created without a client", func() {
+		_, err := NewWatcher().Logger(logger).Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("client"))
+	})
+
+	It("Notifies changes without duplicates", func() {
+		path := "/api/web-rca/v1/incidents"
+		server.AppendHandlers(
+			// The initial poll establishes the baseline:
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, path),
+				ghttp.VerifyFormKV("order_by", "updated_at desc"),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 1,
+					"items": [
+						{
+							"id": "1",
+							"status": "ongoing",
+							"updated_at": "2026-01-01T10:00:00Z"
+						}
+					]
+				}`),
+			),
+
+			// The second poll finds a new incident and a status change:
+			RespondWithJSON(http.StatusOK, `{
+				"page": 1,
+				"size": 2,
+				"items": [
+					{
+						"id": "2",
+						"status": "ongoing",
+						"updated_at": "2026-01-01T10:10:00Z"
+					},
+					{
+						"id": "1",
+						"status": "resolved",
+						"updated_at": "2026-01-01T10:05:00Z"
+					}
+				]
+			}`),
+
+			// The third poll returns the same data, so nothing should be notified, then
+			// the fourth updates one of the incidents:
+			RespondWithJSON(http.StatusOK, `{
+				"page": 1,
+				"size": 1,
+				"items": [
+					{
+						"id": "2",
+						"status": "ongoing",
+						"updated_at": "2026-01-01T10:10:00Z"
+					}
+				]
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"page": 1,
+				"size": 2,
+				"items": [
+					{
+						"id": "2",
+						"status": "ongoing",
+						"updated_at": "2026-01-01T10:20:00Z"
+					},
+					{
+						"id": "1",
+						"status": "resolved",
+						"updated_at": "2026-01-01T10:05:00Z"
+					}
+				]
+			}`),
+		)
+		server.SetAllowUnhandledRequests(true)
+		server.SetUnhandledRequestStatusCode(http.StatusServiceUnavailable)
+		watcher, err := NewWatcher().
+			Logger(logger).
+			Client(MakeClient(server)).
+			Interval(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		notifications := watcher.Watch(ctx)
+
+		var notification *Notification
+		Eventually(notifications).Should(Receive(&notification))
+		Expect(notification.Type).To(Equal(NotificationStatusChanged))
+		Expect(notification.Incident.ID()).To(Equal("1"))
+		Expect(notification.PreviousStatus).To(Equal("ongoing"))
+
+		Eventually(notifications).Should(Receive(&notification))
+		Expect(notification.Type).To(Equal(NotificationCreated))
+		Expect(notification.Incident.ID()).To(Equal("2"))
+
+		Eventually(notifications).Should(Receive(&notification))
+		Expect(notification.Type).To(Equal(NotificationUpdated))
+		Expect(notification.Incident.ID()).To(Equal("2"))
+
+		Consistently(notifications, 50*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("Includes existing incidents if requested", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"page": 1,
+				"size": 1,
+				"items": [
+					{
+						"id": "1",
+						"status": "ongoing",
+						"updated_at": "2026-01-01T10:00:00Z"
+					}
+				]
+			}`),
+		)
+		server.SetAllowUnhandledRequests(true)
+		server.SetUnhandledRequestStatusCode(http.StatusServiceUnavailable)
+		watcher, err := NewWatcher().
+			Logger(logger).
+			Client(MakeClient(server)).
+			Interval(time.Millisecond).
+			IncludeExisting(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		notifications := watcher.Watch(ctx)
+		var notification *Notification
+		Eventually(notifications).Should(Receive(&notification))
+		Expect(notification.Type).To(Equal(NotificationCreated))
+		Expect(notification.Incident.ID()).To(Equal("1"))
+	})
+
+	It("Closes the channel when the context is cancelled", func() {
+		server.SetAllowUnhandledRequests(true)
+		server.SetUnhandledRequestStatusCode(http.StatusServiceUnavailable)
+		watcher, err := NewWatcher().
+			Logger(logger).
+			Client(MakeClient(server)).
+			Interval(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		notifications := watcher.Watch(ctx)
+		cancel()
+		Eventually(notifications).Should(BeClosed())
+	})
+})
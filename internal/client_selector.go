@@ -27,11 +27,14 @@ import (
 	"net"
 	"net/http"
 	"net/http/cookiejar"
+	"net/url"
 	"os"
 	"sync"
+	"time"
 
 	"golang.org/x/net/http2"
 
+	"github.com/openshift-online/ocm-sdk-go/fips"
 	"github.com/openshift-online/ocm-sdk-go/logging"
 )
 
@@ -41,8 +44,14 @@ type ClientSelectorBuilder struct {
 	logger            logging.Logger
 	trustedCAs        []interface{}
 	insecure          bool
+	fips              bool
 	disableKeepAlives bool
+	proxy             *url.URL
+	ipFamily          IPFamily
+	fallbackDelay     time.Duration
+	resolver          *Resolver
 	transportWrappers []func(http.RoundTripper) http.RoundTripper
+	sharedTransports  *SharedTransports
 }
 
 // ClientSelector contains the information needed to create select the HTTP client to use to connect
@@ -51,8 +60,14 @@ type ClientSelector struct {
 	logger            logging.Logger
 	trustedCAs        *x509.CertPool
 	insecure          bool
+	fips              bool
 	disableKeepAlives bool
+	proxy             *url.URL
+	ipFamily          IPFamily
+	fallbackDelay     time.Duration
+	resolver          *Resolver
 	transportWrappers []func(http.RoundTripper) http.RoundTripper
+	sharedTransports  *SharedTransports
 	cookieJar         http.CookieJar
 	clientsMutex      *sync.Mutex
 	clientsTable      map[string]*http.Client
@@ -101,6 +116,14 @@ func (b *ClientSelectorBuilder) Insecure(flag bool) *ClientSelectorBuilder {
 	return b
 }
 
+// FIPS enables the enforcement of the FIPS constraints. When enabled the HTTP clients will only
+// connect to servers using TLS with the approved versions, cipher suites and certificates, and
+// insecure communication isn't allowed.
+func (b *ClientSelectorBuilder) FIPS(flag bool) *ClientSelectorBuilder {
+	b.fips = flag
+	return b
+}
+
 // DisableKeepAlives disables HTTP keep-alives with the serviers. This is unrelated to similarly
 // named TCP keep-alives.
 func (b *ClientSelectorBuilder) DisableKeepAlives(flag bool) *ClientSelectorBuilder {
@@ -108,6 +131,37 @@ func (b *ClientSelectorBuilder) DisableKeepAlives(flag bool) *ClientSelectorBuil
 	return b
 }
 
+// Proxy sets the URL of the proxy server that the HTTP clients will use. The supported schemes are
+// `http`, `https`, `socks5` and `socks5h`, and the URL can contain the user name and password used
+// to authenticate to the proxy. If this isn't explicitly specified then the proxy will be taken from
+// the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables, which also accept `socks5`
+// URLs.
+func (b *ClientSelectorBuilder) Proxy(value *url.URL) *ClientSelectorBuilder {
+	b.proxy = value
+	return b
+}
+
+// IPFamily sets the IP address family that will be used first when connecting to servers that have
+// both IPv4 and IPv6 addresses. The default is dual stack, using the Happy Eyeballs algorithm.
+func (b *ClientSelectorBuilder) IPFamily(value IPFamily) *ClientSelectorBuilder {
+	b.ipFamily = value
+	return b
+}
+
+// FallbackDelay sets the time to wait before trying the other IP family when using dual stack.
+// Zero means the default of the Go library, and a negative value disables the fallback.
+func (b *ClientSelectorBuilder) FallbackDelay(value time.Duration) *ClientSelectorBuilder {
+	b.fallbackDelay = value
+	return b
+}
+
+// Resolver sets the resolver that will be used to cache the IP addresses of the servers. The
+// default is to not cache them.
+func (b *ClientSelectorBuilder) Resolver(value *Resolver) *ClientSelectorBuilder {
+	b.resolver = value
+	return b
+}
+
 // TransportWrapper adds a function that will be used to wrap the transports of the HTTP clients. If
 // used multiple times the transport wrappers will be called in the same order that they are added.
 func (b *ClientSelectorBuilder) TransportWrapper(
@@ -127,6 +181,15 @@ func (b *ClientSelectorBuilder) TransportWrappers(
 	return b
 }
 
+// SharedTransports sets the table of transports that will be shared with other selectors. When this
+// is set the selector doesn't create its own transports, it uses the ones from the table instead, so
+// that the network connections are reused. All the selectors that use the same table must have the
+// same TLS, proxy and dialer settings.
+func (b *ClientSelectorBuilder) SharedTransports(value *SharedTransports) *ClientSelectorBuilder {
+	b.sharedTransports = value
+	return b
+}
+
 // Build uses the information stored in the builder to create a new HTTP client selector.
 func (b *ClientSelectorBuilder) Build(ctx context.Context) (result *ClientSelector, err error) {
 	// Check parameters:
@@ -134,6 +197,32 @@ func (b *ClientSelectorBuilder) Build(ctx context.Context) (result *ClientSelect
 		err = fmt.Errorf("logger is mandatory")
 		return
 	}
+	if b.proxy != nil {
+		switch b.proxy.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			err = fmt.Errorf(
+				"proxy scheme '%s' isn't supported, should be 'http', 'https', "+
+					"'socks5' or 'socks5h'",
+				b.proxy.Scheme,
+			)
+			return
+		}
+	}
+
+	if b.fips {
+		err = fips.CheckInsecure(b.insecure)
+		if err != nil {
+			return
+		}
+	}
+
+	switch b.ipFamily {
+	case IPFamilyDualStack, IPFamilyPreferIPv4, IPFamilyPreferIPv6:
+	default:
+		err = fmt.Errorf("IP family %s isn't supported", b.ipFamily)
+		return
+	}
 
 	// Create the cookie jar:
 	cookieJar, err := b.createCookieJar()
@@ -152,8 +241,14 @@ func (b *ClientSelectorBuilder) Build(ctx context.Context) (result *ClientSelect
 		logger:            b.logger,
 		trustedCAs:        trustedCAs,
 		insecure:          b.insecure,
+		fips:              b.fips,
 		disableKeepAlives: b.disableKeepAlives,
+		proxy:             b.proxy,
+		ipFamily:          b.ipFamily,
+		fallbackDelay:     b.fallbackDelay,
+		resolver:          b.resolver,
 		transportWrappers: b.transportWrappers,
+		sharedTransports:  b.sharedTransports,
 		cookieJar:         cookieJar,
 		clientsMutex:      &sync.Mutex{},
 		clientsTable:      map[string]*http.Client{},
@@ -319,6 +414,31 @@ func (s *ClientSelector) create(ctx context.Context, address *ServerAddress) (re
 
 // createTransport creates a new HTTP transport to use to connect to the given server address.
 func (s *ClientSelector) createTransport(ctx context.Context,
+	address *ServerAddress) (result http.RoundTripper, err error) {
+	// Get the base transport from the shared table, or create a new one:
+	if s.sharedTransports != nil {
+		result, err = s.sharedTransports.get(s.key(address), func() (http.RoundTripper, error) {
+			return s.createBaseTransport(ctx, address)
+		})
+	} else {
+		result, err = s.createBaseTransport(ctx, address)
+	}
+	if err != nil {
+		return
+	}
+
+	// Transport wrappers are stored in the order that the round trippers that they create
+	// should be called. That means that we need to call them in reverse order.
+	for i := len(s.transportWrappers) - 1; i >= 0; i-- {
+		result = s.transportWrappers[i](result)
+	}
+
+	return
+}
+
+// createBaseTransport creates the HTTP transport that connects to the given server address, without
+// the transport wrappers.
+func (s *ClientSelector) createBaseTransport(ctx context.Context,
 	address *ServerAddress) (result http.RoundTripper, err error) {
 	// Prepare the TLS configuration:
 	// #nosec 402
@@ -329,13 +449,34 @@ func (s *ClientSelector) createTransport(ctx context.Context,
 		RootCAs:            s.trustedCAs,
 	}
 
+	// Restrict the TLS configuration and reject servers that don't use TLS, if needed:
+	if s.fips {
+		err = fips.CheckProtocol(address.Text, address.Protocol)
+		if err != nil {
+			return
+		}
+		err = fips.ConfigureTLS(config)
+		if err != nil {
+			return
+		}
+	}
+
 	// Create the transport:
 	if address.Protocol != H2CProtocol {
+		// Use the explicitly configured proxy, if any. Note that the transport supports
+		// `socks5` proxies directly, including authentication with the user name and password
+		// from the URL:
+		proxy := http.ProxyFromEnvironment
+		if s.proxy != nil && address.Network != UnixNetwork {
+			proxy = http.ProxyURL(s.proxy)
+		}
+
 		// Create a regular transport. Note that this does support HTTP/2 with TLS, but
 		// not h2c:
 		transport := &http.Transport{
 			TLSClientConfig:    config,
-			Proxy:              http.ProxyFromEnvironment,
+			Proxy:              proxy,
+			DialContext:        s.dialer().DialContext,
 			DisableKeepAlives:  s.disableKeepAlives,
 			DisableCompression: false,
 			ForceAttemptHTTP2:  true,
@@ -379,8 +520,7 @@ func (s *ClientSelector) createTransport(ctx context.Context,
 			}
 		} else {
 			transport.DialTLSContext = func(ctx context.Context, network, addr string, cfg *tls.Config) (net.Conn, error) {
-				var d net.Dialer
-				return d.DialContext(ctx, network, addr)
+				return s.dialer().DialContext(ctx, network, addr)
 			}
 		}
 
@@ -388,15 +528,19 @@ func (s *ClientSelector) createTransport(ctx context.Context,
 		result = transport
 	}
 
-	// Transport wrappers are stored in the order that the round trippers that they create
-	// should be called. That means that we need to call them in reverse order.
-	for i := len(s.transportWrappers) - 1; i >= 0; i-- {
-		result = s.transportWrappers[i](result)
-	}
-
 	return
 }
 
+// dialer creates the dialer used to open TCP connections.
+func (s *ClientSelector) dialer() *Dialer {
+	return &Dialer{
+		Family:        s.ipFamily,
+		Timeout:       defaultDialTimeout,
+		FallbackDelay: s.fallbackDelay,
+		Resolver:      s.resolver,
+	}
+}
+
 // TrustedCAs sets returns the certificate pool that contains the certificate authorities that are
 // trusted by the HTTP clients.
 func (s *ClientSelector) TrustedCAs() *x509.CertPool {
@@ -408,6 +552,11 @@ func (s *ClientSelector) Insecure() bool {
 	return s.insecure
 }
 
+// FIPS returns the flag that indicates if the FIPS constraints are enforced.
+func (s *ClientSelector) FIPS() bool {
+	return s.fips
+}
+
 // DisableKeepAlives retursnt the flag that indicates if HTTP keep alive is disabled.
 func (s *ClientSelector) DisableKeepAlives() bool {
 	return s.disableKeepAlives
@@ -112,6 +112,32 @@ var _ = Describe("Select client", func() {
 	})
 })
 
+var _ = Describe("Shared transports", func() {
+	It("Shares the transports between selectors", func() {
+		ctx := context.Background()
+		shared := NewSharedTransports()
+		defer shared.Close()
+		first, err := NewClientSelector().
+			Logger(logger).
+			SharedTransports(shared).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := NewClientSelector().
+			Logger(logger).
+			SharedTransports(shared).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		address, err := ParseServerAddress(ctx, "https://my.server.com")
+		Expect(err).ToNot(HaveOccurred())
+		firstClient, err := first.Select(ctx, address)
+		Expect(err).ToNot(HaveOccurred())
+		secondClient, err := second.Select(ctx, address)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secondClient == firstClient).To(BeFalse())
+		Expect(secondClient.Transport).To(BeIdenticalTo(firstClient.Transport))
+	})
+})
+
 var _ = Describe("Redirect Behavior", func() {
 	var (
 		ctx                  context.Context
@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the dialer that implements the preferences for IP address families.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// IPFamily indicates which IP address family should be used first when connecting to servers that
+// have both IPv4 and IPv6 addresses.
+type IPFamily int
+
+// Supported IP family preferences:
+const (
+	IPFamilyDualStack  IPFamily = 0
+	IPFamilyPreferIPv4 IPFamily = 1
+	IPFamilyPreferIPv6 IPFamily = 2
+)
+
+// String returns the name of the IP family preference.
+func (f IPFamily) String() string {
+	switch f {
+	case IPFamilyDualStack:
+		return "dual_stack"
+	case IPFamilyPreferIPv4:
+		return "prefer_ipv4"
+	case IPFamilyPreferIPv6:
+		return "prefer_ipv6"
+	default:
+		return fmt.Sprintf("ip_family(%d)", int(f))
+	}
+}
+
+// Dialer opens TCP connections honouring the IP family preference.
+type Dialer struct {
+	// Family is the IP family preference.
+	Family IPFamily
+
+	// Timeout is the maximum time to wait for a connection, including the resolution of the
+	// addresses. When there are multiple addresses it is shared among them, like in the Go
+	// library. Zero means no timeout other than the one of the context.
+	Timeout time.Duration
+
+	// FallbackDelay is the time to wait before starting a connection using the other IP family
+	// when using dual stack. Zero means the default of the Go library, currently 300
+	// milliseconds, and a negative value disables the fallback.
+	FallbackDelay time.Duration
+
+	// Resolver is the optional resolver used to cache the IP addresses of the servers.
+	Resolver *Resolver
+}
+
+// DialContext opens a connection to the given address. When the IP family preference is dual stack
+// it uses the Happy Eyeballs algorithm described in RFC 6555. Otherwise it first resolves and
+// connects only using the preferred family, and tries the other family only if that fails. This
+// way broken resolution of addresses of the other family doesn't delay or prevent connections. If
+// there is a resolver it is used to obtain the addresses.
+func (d *Dialer) DialContext(ctx context.Context, network, address string) (result net.Conn,
+	err error) {
+	dialer := &net.Dialer{
+		Timeout:       d.Timeout,
+		FallbackDelay: d.FallbackDelay,
+	}
+	var first, second string
+	switch d.Family {
+	case IPFamilyPreferIPv4:
+		first, second = "tcp4", "tcp6"
+	case IPFamilyPreferIPv6:
+		first, second = "tcp6", "tcp4"
+	}
+	if network != "tcp" {
+		return dialer.DialContext(ctx, network, address)
+	}
+	if first == "" {
+		return d.dial(ctx, dialer, network, address)
+	}
+	result, err = d.dial(ctx, dialer, first, address)
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+	var fallbackErr error
+	result, fallbackErr = d.dial(ctx, dialer, second, address)
+	if fallbackErr != nil {
+		err = errors.Join(err, fallbackErr)
+		return
+	}
+	err = nil
+	return
+}
+
+// dial opens a connection using the given network, which must be `tcp`, `tcp4` or `tcp6`. If there
+// is a resolver the addresses are obtained from it. When they contain both families the addresses
+// of the second family are tried in parallel after the fallback delay, using the Happy Eyeballs
+// algorithm like the Go library does. Otherwise they are tried one after the other.
+func (d *Dialer) dial(ctx context.Context, dialer *net.Dialer, network, address string) (
+	result net.Conn, err error) {
+	if d.Resolver == nil {
+		return dialer.DialContext(ctx, network, address)
+	}
+	if d.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.Timeout)
+		defer cancel()
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return
+	}
+	addrs, err := d.Resolver.Lookup(ctx, "ip"+strings.TrimPrefix(network, "tcp"), host)
+	if err != nil {
+		return
+	}
+	primary, secondary := splitFamilies(addrs)
+	if len(secondary) == 0 || d.FallbackDelay < 0 {
+		return d.dialSerial(ctx, network, port, interleaveFamilies(addrs))
+	}
+	return d.dialParallel(ctx, network, port, primary, secondary)
+}
+
+// dialAttempt contains the result of one of the attempts started by the dialParallel method.
+type dialAttempt struct {
+	conn net.Conn
+	err  error
+}
+
+// dialParallel tries the primary addresses, and after the fallback delay, or as soon as they fail,
+// the secondary addresses. It returns the first connection that succeeds and closes the others.
+func (d *Dialer) dialParallel(ctx context.Context, network, port string, primary,
+	secondary []netip.Addr) (result net.Conn, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	attempts := make(chan dialAttempt, 2)
+	start := func(addrs []netip.Addr) {
+		go func() {
+			conn, err := d.dialSerial(ctx, network, port, addrs)
+			attempts <- dialAttempt{conn: conn, err: err}
+		}()
+	}
+	delay := d.FallbackDelay
+	if delay == 0 {
+		delay = defaultFallbackDelay
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	start(primary)
+	pending := 1
+	fallback := secondary
+	var errs []error
+	for {
+		select {
+		case <-timer.C:
+			if fallback != nil {
+				start(fallback)
+				fallback = nil
+				pending++
+			}
+		case next := <-attempts:
+			pending--
+			if next.err == nil {
+				result = next.conn
+				go closeRemaining(attempts, pending)
+				return
+			}
+			errs = append(errs, next.err)
+			if fallback != nil {
+				start(fallback)
+				fallback = nil
+				pending++
+			}
+			if pending == 0 {
+				err = errors.Join(errs...)
+				return
+			}
+		}
+	}
+}
+
+// closeRemaining waits for the given number of pending attempts and closes the connections that
+// they may have opened after another attempt won.
+func closeRemaining(attempts chan dialAttempt, pending int) {
+	for ; pending > 0; pending-- {
+		next := <-attempts
+		if next.conn != nil {
+			_ = next.conn.Close()
+		}
+	}
+}
+
+// dialSerial tries the given addresses one after the other. Each attempt gets an equal part of the
+// time left till the deadline of the context, but never less than the minimum attempt time, so that
+// an address that doesn't answer doesn't consume the time of the rest.
+func (d *Dialer) dialSerial(ctx context.Context, network, port string,
+	addrs []netip.Addr) (result net.Conn, err error) {
+	dialer := &net.Dialer{}
+	var errs []error
+	for i, addr := range addrs {
+		attemptCtx := ctx
+		cancel := func() {}
+		deadline, ok := ctx.Deadline()
+		if ok {
+			timeout := time.Until(deadline) / time.Duration(len(addrs)-i)
+			if timeout < minAttemptTimeout {
+				timeout = minAttemptTimeout
+			}
+			attemptCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		result, err = dialer.DialContext(
+			attemptCtx, network, net.JoinHostPort(addr.String(), port),
+		)
+		cancel()
+		if err == nil {
+			return
+		}
+		errs = append(errs, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	err = errors.Join(errs...)
+	return
+}
+
+// splitFamilies splits the given addresses into the ones that have the same family as the first
+// one and the rest.
+func splitFamilies(addrs []netip.Addr) (primary, secondary []netip.Addr) {
+	for _, addr := range addrs {
+		if addr.Unmap().Is4() == addrs[0].Unmap().Is4() {
+			primary = append(primary, addr)
+		} else {
+			secondary = append(secondary, addr)
+		}
+	}
+	return
+}
+
+// interleaveFamilies reorders the given addresses so that IPv4 and IPv6 addresses alternate,
+// starting with the family of the first address, as recommended by RFC 8305.
+func interleaveFamilies(addrs []netip.Addr) []netip.Addr {
+	if len(addrs) == 0 {
+		return addrs
+	}
+	primary, secondary := splitFamilies(addrs)
+	result := make([]netip.Addr, 0, len(addrs))
+	for i := 0; i < len(primary) || i < len(secondary); i++ {
+		if i < len(primary) {
+			result = append(result, primary[i])
+		}
+		if i < len(secondary) {
+			result = append(result, secondary[i])
+		}
+	}
+	return result
+}
+
+// Default values:
+const (
+	// defaultDialTimeout is the maximum time to wait for a connection, the same used by the default
+	// transport of the Go library.
+	defaultDialTimeout = 30 * time.Second
+
+	// defaultFallbackDelay is the delay used by the Go library before starting the connection using
+	// the other family.
+	defaultFallbackDelay = 300 * time.Millisecond
+
+	// minAttemptTimeout is the minimum time given to each address when trying them one after the
+	// other, the same used by the Go library.
+	minAttemptTimeout = 2 * time.Second
+)
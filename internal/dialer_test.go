@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+var _ = Describe("Dialer", func() {
+	var (
+		ctx      context.Context
+		listener net.Listener
+		port     string
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create a listener that only accepts IPv4 connections:
+		listener, err = net.Listen("tcp4", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		port = strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+		go func(listener net.Listener) {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				_ = conn.Close()
+			}
+		}(listener)
+	})
+
+	AfterEach(func() {
+		err := listener.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Connects using IPv4 when preferred", func() {
+		dialer := &Dialer{
+			Family: IPFamilyPreferIPv4,
+		}
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort("localhost", port))
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		Expect(conn.RemoteAddr().(*net.TCPAddr).IP.To4()).ToNot(BeNil())
+	})
+
+	It("Falls back to IPv4 when IPv6 fails", func() {
+		dialer := &Dialer{
+			Family: IPFamilyPreferIPv6,
+		}
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort("127.0.0.1", port))
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		Expect(conn.RemoteAddr().(*net.TCPAddr).IP.To4()).ToNot(BeNil())
+	})
+
+	It("Returns both errors when all families fail", func() {
+		dialer := &Dialer{
+			Family: IPFamilyPreferIPv4,
+		}
+		_, err := dialer.DialContext(ctx, "tcp", "[::1]:"+port)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("tcp4"))
+		Expect(err.Error()).To(ContainSubstring("tcp6"))
+	})
+
+	It("Uses dual stack by default", func() {
+		dialer := &Dialer{}
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort("127.0.0.1", port))
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+	})
+
+	It("Tries the other family as soon as the first fails", func() {
+		resolver := NewResolver(time.Minute, time.Minute)
+		resolver.lookup = func(ctx context.Context, network, host string) ([]netip.Addr,
+			error) {
+			return []netip.Addr{
+				netip.MustParseAddr("::1"),
+				netip.MustParseAddr("127.0.0.1"),
+			}, nil
+		}
+		dialer := &Dialer{
+			Timeout:       time.Minute,
+			FallbackDelay: time.Minute,
+			Resolver:      resolver,
+		}
+		start := time.Now()
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort("localhost", port))
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		Expect(time.Since(start)).To(BeNumerically("<", time.Minute))
+		Expect(conn.RemoteAddr().(*net.TCPAddr).IP.To4()).ToNot(BeNil())
+	})
+
+	It("Tries the addresses one after the other when the fallback is disabled", func() {
+		resolver := NewResolver(time.Minute, time.Minute)
+		resolver.lookup = func(ctx context.Context, network, host string) ([]netip.Addr,
+			error) {
+			return []netip.Addr{
+				netip.MustParseAddr("::1"),
+				netip.MustParseAddr("127.0.0.1"),
+			}, nil
+		}
+		dialer := &Dialer{
+			FallbackDelay: -1,
+			Resolver:      resolver,
+		}
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort("localhost", port))
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		Expect(conn.RemoteAddr().(*net.TCPAddr).IP.To4()).ToNot(BeNil())
+	})
+
+	It("Returns the errors of both families when they fail", func() {
+		resolver := NewResolver(time.Minute, time.Minute)
+		resolver.lookup = func(ctx context.Context, network, host string) ([]netip.Addr,
+			error) {
+			return []netip.Addr{
+				netip.MustParseAddr("::1"),
+				netip.MustParseAddr("127.0.0.1"),
+			}, nil
+		}
+		dialer := &Dialer{
+			Resolver: resolver,
+		}
+		closed, err := net.Listen("tcp4", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		address := net.JoinHostPort("localhost", strconv.Itoa(closed.Addr().(*net.TCPAddr).Port))
+		err = closed.Close()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = dialer.DialContext(ctx, "tcp", address)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("[::1]"))
+		Expect(err.Error()).To(ContainSubstring("127.0.0.1"))
+	})
+
+	It("Can't create client selector with unknown family", func() {
+		_, err := NewClientSelector().
+			Logger(logger).
+			IPFamily(IPFamily(42)).
+			Build(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("IP family ip_family(42) isn't supported"))
+	})
+})
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types used to store the priority of requests in the context.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+)
+
+// Priority is the priority of a request. Requests with higher priority are sent before requests
+// with lower priority when the connection is rate limited, and requests with low priority wait
+// longer between retries.
+type Priority int
+
+// Supported priorities:
+const (
+	PriorityLow    Priority = -1
+	PriorityNormal Priority = 0
+	PriorityHigh   Priority = 1
+)
+
+// String returns the name of the priority.
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("priority(%d)", int(p))
+	}
+}
+
+// priorityKey is the type of the key used to store the priority in the context.
+type priorityKey struct{}
+
+// ContextWithPriority returns a new context that contains the given priority.
+func ContextWithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the priority stored in the context, or the normal priority if the
+// context doesn't contain one.
+func PriorityFromContext(ctx context.Context) Priority {
+	if ctx == nil {
+		return PriorityNormal
+	}
+	priority, ok := ctx.Value(priorityKey{}).(Priority)
+	if !ok {
+		return PriorityNormal
+	}
+	switch {
+	case priority < PriorityLow:
+		return PriorityLow
+	case priority > PriorityHigh:
+		return PriorityHigh
+	default:
+		return priority
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the resolver that caches the IP addresses of servers.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// resolverKey identifies a cached lookup.
+type resolverKey struct {
+	network string
+	host    string
+}
+
+// resolverEntry contains the result of a lookup and the time when it expires.
+type resolverEntry struct {
+	addrs  []netip.Addr
+	err    error
+	expiry time.Time
+}
+
+// Resolver resolves host names to IP addresses and caches the results, so that creating many
+// connections to the same servers doesn't send many queries to the DNS server. Note that the Go
+// resolver doesn't report the TTL of the DNS records, so the results are kept for the time to
+// live given when the resolver is created. Lookups that fail because the host doesn't exist are
+// also cached, using the negative time to live. Other errors aren't cached. It is safe to use it
+// from multiple goroutines simultaneously.
+type Resolver struct {
+	ttl         time.Duration
+	negativeTTL time.Duration
+	lookup      func(ctx context.Context, network, host string) ([]netip.Addr, error)
+	lock        *sync.Mutex
+	entries     map[resolverKey]resolverEntry
+	swept       time.Time
+}
+
+// NewResolver creates a new resolver that keeps successful lookups for the given time to live,
+// and lookups for hosts that don't exist for the given negative time to live. A zero negative time
+// to live disables negative caching.
+func NewResolver(ttl, negativeTTL time.Duration) *Resolver {
+	return &Resolver{
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		lookup:      net.DefaultResolver.LookupNetIP,
+		lock:        &sync.Mutex{},
+		entries:     map[resolverKey]resolverEntry{},
+	}
+}
+
+// Lookup returns the IP addresses of the given host. The network must be `ip`, `ip4` or `ip6`.
+func (r *Resolver) Lookup(ctx context.Context, network, host string) (result []netip.Addr,
+	err error) {
+	// Don't cache literal addresses:
+	literal, err := netip.ParseAddr(host)
+	if err == nil {
+		if network == "ip4" && !literal.Unmap().Is4() || network == "ip6" && !literal.Is6() {
+			err = &net.AddrError{
+				Err:  "no suitable address",
+				Addr: host,
+			}
+			return
+		}
+		result = []netip.Addr{literal}
+		return
+	}
+	err = nil
+
+	// Check the cache:
+	key := resolverKey{
+		network: network,
+		host:    host,
+	}
+	now := time.Now()
+	r.lock.Lock()
+	entry, ok := r.entries[key]
+	r.lock.Unlock()
+	if ok && now.Before(entry.expiry) {
+		result, err = entry.addrs, entry.err
+		return
+	}
+
+	// Do the lookup and save the result:
+	result, err = r.lookup(ctx, network, host)
+	var ttl time.Duration
+	var dnsErr *net.DNSError
+	switch {
+	case err == nil:
+		ttl = r.ttl
+	case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+		ttl = r.negativeTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if now.Sub(r.swept) > r.ttl {
+		for candidate, entry := range r.entries {
+			if now.After(entry.expiry) {
+				delete(r.entries, candidate)
+			}
+		}
+		r.swept = now
+	}
+	r.entries[key] = resolverEntry{
+		addrs:  result,
+		err:    err,
+		expiry: now.Add(ttl),
+	}
+	return
+}
+
+// Flush removes all the results from the cache.
+func (r *Resolver) Flush() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.entries = map[resolverKey]resolverEntry{}
+}
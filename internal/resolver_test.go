@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+var _ = Describe("Resolver", func() {
+	var (
+		ctx      context.Context
+		resolver *Resolver
+		lookups  int
+		lookupFn func(ctx context.Context, network, host string) ([]netip.Addr, error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		lookups = 0
+		lookupFn = func(ctx context.Context, network, host string) ([]netip.Addr, error) {
+			return []netip.Addr{
+				netip.MustParseAddr("192.0.2.1"),
+			}, nil
+		}
+		resolver = NewResolver(time.Minute, time.Minute)
+		resolver.lookup = func(ctx context.Context, network, host string) ([]netip.Addr,
+			error) {
+			lookups++
+			return lookupFn(ctx, network, host)
+		}
+	})
+
+	It("Caches successful lookups", func() {
+		first, err := resolver.Lookup(ctx, "ip", "my.server.com")
+		Expect(err).ToNot(HaveOccurred())
+		second, err := resolver.Lookup(ctx, "ip", "my.server.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second).To(Equal(first))
+		Expect(lookups).To(Equal(1))
+	})
+
+	It("Separates networks", func() {
+		_, err := resolver.Lookup(ctx, "ip4", "my.server.com")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = resolver.Lookup(ctx, "ip6", "my.server.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lookups).To(Equal(2))
+	})
+
+	It("Looks up again when the entry expires", func() {
+		resolver.ttl = 10 * time.Millisecond
+		_, err := resolver.Lookup(ctx, "ip", "my.server.com")
+		Expect(err).ToNot(HaveOccurred())
+		time.Sleep(20 * time.Millisecond)
+		_, err = resolver.Lookup(ctx, "ip", "my.server.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lookups).To(Equal(2))
+	})
+
+	It("Caches hosts that don't exist", func() {
+		lookupFn = func(ctx context.Context, network, host string) ([]netip.Addr, error) {
+			return nil, &net.DNSError{
+				Err:        "no such host",
+				Name:       host,
+				IsNotFound: true,
+			}
+		}
+		_, err := resolver.Lookup(ctx, "ip", "my.server.com")
+		Expect(err).To(HaveOccurred())
+		_, err = resolver.Lookup(ctx, "ip", "my.server.com")
+		var dnsErr *net.DNSError
+		Expect(errors.As(err, &dnsErr)).To(BeTrue())
+		Expect(dnsErr.IsNotFound).To(BeTrue())
+		Expect(lookups).To(Equal(1))
+	})
+
+	It("Doesn't cache temporary errors", func() {
+		lookupFn = func(ctx context.Context, network, host string) ([]netip.Addr, error) {
+			return nil, &net.DNSError{
+				Err:         "timeout",
+				Name:        host,
+				IsTemporary: true,
+			}
+		}
+		_, err := resolver.Lookup(ctx, "ip", "my.server.com")
+		Expect(err).To(HaveOccurred())
+		_, err = resolver.Lookup(ctx, "ip", "my.server.com")
+		Expect(err).To(HaveOccurred())
+		Expect(lookups).To(Equal(2))
+	})
+
+	It("Doesn't look up literal addresses", func() {
+		addrs, err := resolver.Lookup(ctx, "ip", "127.0.0.1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(addrs).To(Equal([]netip.Addr{netip.MustParseAddr("127.0.0.1")}))
+		_, err = resolver.Lookup(ctx, "ip6", "127.0.0.1")
+		Expect(err).To(HaveOccurred())
+		Expect(lookups).To(BeZero())
+	})
+
+	It("Is used by the dialer", func() {
+		listener, err := net.Listen("tcp4", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+		lookupFn = func(ctx context.Context, network, host string) ([]netip.Addr, error) {
+			return []netip.Addr{
+				netip.MustParseAddr("127.0.0.1"),
+			}, nil
+		}
+		dialer := &Dialer{
+			Resolver: resolver,
+		}
+		_, port, err := net.SplitHostPort(listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		for i := 0; i < 3; i++ {
+			conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort("my.server.com", port))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conn.Close()).To(Succeed())
+		}
+		Expect(lookups).To(Equal(1))
+	})
+
+	It("Interleaves address families", func() {
+		addrs := interleaveFamilies([]netip.Addr{
+			netip.MustParseAddr("2001:db8::1"),
+			netip.MustParseAddr("2001:db8::2"),
+			netip.MustParseAddr("192.0.2.1"),
+		})
+		Expect(addrs).To(Equal([]netip.Addr{
+			netip.MustParseAddr("2001:db8::1"),
+			netip.MustParseAddr("192.0.2.1"),
+			netip.MustParseAddr("2001:db8::2"),
+		}))
+	})
+})
@@ -55,6 +55,12 @@ type ServerAddress struct {
 	// URL is the regular URL calculated from this server address. The scheme will be `http` if
 	// the protocol is `http` or `h2c` and will be `https` if the protocol is https.
 	URL *neturl.URL
+
+	// Path is the path of the server address, without the trailing slash. It is empty when the
+	// path is used as the name of the Unix socket. Clients should add it as a prefix to the
+	// paths of their requests, so that servers mounted under a sub-path, for example behind a
+	// reverse proxy, are supported.
+	Path string
 }
 
 // ParseServerAddress parses the given text as a server address. Server addresses should be URLs
@@ -86,7 +92,10 @@ type ServerAddress struct {
 //	unix://my.sso.com/my/token/path?socket=/sockets/my.socket
 //
 // When the Unix socket is specified in the `socket` query parameter as in the above example
-// the URL path will be ignored.
+// the URL path isn't used as the name of the socket.
+//
+// Except when it is used as the name of the socket, the path is stored in the Path field of the
+// result, so that clients can use it as a prefix for the paths of their requests.
 //
 // Some examples of valid server addresses:
 //
@@ -224,6 +233,13 @@ func ParseServerAddress(ctx context.Context, text string) (result *ServerAddress
 		}
 	}
 
+	// The path is the name of the socket when using Unix sockets and it isn't specified in the
+	// query parameter, otherwise it is a prefix for the request paths:
+	var path string
+	if network != UnixNetwork || socket != parsed.Path {
+		path = strings.TrimRight(parsed.Path, "/")
+	}
+
 	// Calculate the URL:
 	url := &neturl.URL{
 		Host: host,
@@ -250,6 +266,7 @@ func ParseServerAddress(ctx context.Context, text string) (result *ServerAddress
 		Port:     port,
 		Socket:   socket,
 		URL:      url,
+		Path:     path,
 	}
 
 	return
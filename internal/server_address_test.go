@@ -36,6 +36,7 @@ var _ = DescribeTable(
 		Expect(actual.Port).To(Equal(expected.Port))
 		Expect(actual.Socket).To(Equal(expected.Socket))
 		Expect(actual.URL.String()).To(Equal(expected.URL.String()))
+		Expect(actual.Path).To(Equal(expected.Path))
 	},
 	Entry(
 		"tcp",
@@ -338,6 +339,37 @@ var _ = DescribeTable(
 				Scheme: "http",
 				Host:   "my.server.com",
 			},
+			Path: "/my/path",
+		},
+	),
+	Entry(
+		"Path",
+		"https://my.server.com/my/path",
+		&ServerAddress{
+			Network:  TCPNetwork,
+			Protocol: HTTPSProtocol,
+			Host:     "my.server.com",
+			Port:     "443",
+			URL: &url.URL{
+				Scheme: "https",
+				Host:   "my.server.com",
+			},
+			Path: "/my/path",
+		},
+	),
+	Entry(
+		"Path with trailing slash",
+		"https://my.server.com/my/path/",
+		&ServerAddress{
+			Network:  TCPNetwork,
+			Protocol: HTTPSProtocol,
+			Host:     "my.server.com",
+			Port:     "443",
+			URL: &url.URL{
+				Scheme: "https",
+				Host:   "my.server.com",
+			},
+			Path: "/my/path",
 		},
 	),
 	Entry(
@@ -367,6 +399,7 @@ var _ = DescribeTable(
 				Scheme: "http",
 				Host:   "my.server.com",
 			},
+			Path: "/my/path",
 		},
 	),
 	Entry(
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the table of HTTP transports that can be shared by multiple client selectors.
+
+package internal
+
+import (
+	"net/http"
+	"sync"
+)
+
+// SharedTransports is a table of HTTP transports that is shared by multiple client selectors, so
+// that they reuse the same network connections. This is intended for applications that create many
+// connections with the same settings but different credentials. Don't create instances of this type
+// directly, use the NewSharedTransports function instead.
+type SharedTransports struct {
+	lock  *sync.Mutex
+	table map[string]http.RoundTripper
+}
+
+// NewSharedTransports creates a new empty table of shared transports.
+func NewSharedTransports() *SharedTransports {
+	return &SharedTransports{
+		lock:  &sync.Mutex{},
+		table: map[string]http.RoundTripper{},
+	}
+}
+
+// get returns the transport for the given key, calling the create function to create it if it
+// doesn't exist yet.
+func (t *SharedTransports) get(key string,
+	create func() (http.RoundTripper, error)) (result http.RoundTripper, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	result, ok := t.table[key]
+	if ok {
+		return
+	}
+	result, err = create()
+	if err != nil {
+		return
+	}
+	t.table[key] = result
+	return
+}
+
+// Close closes the idle network connections of all the transports of the table. Connections that
+// are in use aren't affected.
+func (t *SharedTransports) Close() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, transport := range t.table {
+		closer, ok := transport.(interface{ CloseIdleConnections() })
+		if ok {
+			closer.CloseIdleConnections()
+		}
+	}
+}
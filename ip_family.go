@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types used to select the IP address family used to connect to servers.
+
+package sdk
+
+import (
+	"github.com/openshift-online/ocm-sdk-go/internal"
+)
+
+// IPFamily indicates which IP address family is used first when connecting to servers that have
+// both IPv4 and IPv6 addresses. Use the IPFamily method of the connection builder to set it.
+type IPFamily = internal.IPFamily
+
+// Supported IP family preferences:
+const (
+	// IPFamilyDualStack uses the Happy Eyeballs algorithm: connections using both families are
+	// started, the second one after a short delay, and the first to succeed is used. The delay
+	// can be changed with the DialFallbackDelay method of the connection builder. This is the
+	// default.
+	IPFamilyDualStack = internal.IPFamilyDualStack
+
+	// IPFamilyPreferIPv4 resolves and connects using only IPv4 addresses, and tries IPv6 only if
+	// that fails. This is useful in networks where resolution of IPv6 addresses is broken.
+	IPFamilyPreferIPv4 = internal.IPFamilyPreferIPv4
+
+	// IPFamilyPreferIPv6 resolves and connects using only IPv6 addresses, and tries IPv4 only if
+	// that fails.
+	IPFamilyPreferIPv6 = internal.IPFamilyPreferIPv6
+)
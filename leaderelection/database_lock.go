@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the lock that uses the leadership flags database table.
+
+package leaderelection
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// DatabaseLockBuilder contains the data and logic needed to build a database lock.
+type DatabaseLockBuilder struct {
+	logger   logging.Logger
+	handle   *sql.DB
+	name     string
+	process  string
+	duration time.Duration
+}
+
+// DatabaseLock is a lock stored in the `leadership_flags` table, the same table used by the flags
+// of the leadership package. It uses the time of the database server, so that it doesn't depend on
+// the synchronization of the clocks of the machines that compete for the lock.
+type DatabaseLock struct {
+	logger   logging.Logger
+	handle   *sql.DB
+	name     string
+	process  string
+	duration time.Duration
+}
+
+// NewDatabaseLock creates a builder that can then be used to configure and create a database lock.
+func NewDatabaseLock() *DatabaseLockBuilder {
+	return &DatabaseLockBuilder{
+		duration: defaultLeaseDuration,
+	}
+}
+
+// Logger sets the logger that the lock will use to write to the log. This is mandatory.
+func (b *DatabaseLockBuilder) Logger(value logging.Logger) *DatabaseLockBuilder {
+	b.logger = value
+	return b
+}
+
+// Handle sets the database handle that the lock will use to store its state. This is mandatory.
+func (b *DatabaseLockBuilder) Handle(value *sql.DB) *DatabaseLockBuilder {
+	b.handle = value
+	return b
+}
+
+// Name sets the name of the lock. This is mandatory.
+func (b *DatabaseLockBuilder) Name(value string) *DatabaseLockBuilder {
+	b.name = value
+	return b
+}
+
+// Process sets the name of the process. This should be unique amongst the set of processes using
+// the same lock name, for example the name of the Kubernetes pod. This is mandatory.
+func (b *DatabaseLockBuilder) Process(value string) *DatabaseLockBuilder {
+	b.process = value
+	return b
+}
+
+// LeaseDuration sets the time after which a lock that hasn't been renewed can be acquired by other
+// process. It should be longer than the renew interval of the elector. The default value is thirty
+// seconds, the same as the default interval of the flags of the leadership package.
+func (b *DatabaseLockBuilder) LeaseDuration(value time.Duration) *DatabaseLockBuilder {
+	b.duration = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new database lock. It creates the table if
+// it doesn't exist yet.
+func (b *DatabaseLockBuilder) Build(ctx context.Context) (result *DatabaseLock, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.handle == nil {
+		err = errors.New("database handle is mandatory")
+		return
+	}
+	if b.name == "" {
+		err = errors.New("name is mandatory")
+		return
+	}
+	if b.process == "" {
+		err = errors.New("process is mandatory")
+		return
+	}
+	if b.duration <= 0 {
+		err = errors.New("lease duration should be greater than zero")
+		return
+	}
+
+	// Make sure that the table exists, creating it if needed:
+	_, err = b.handle.ExecContext(
+		ctx,
+		`
+		create table if not exists leadership_flags (
+			name text not null primary key,
+			holder text not null,
+			version bigint not null,
+			timestamp timestamp with time zone not null
+		)
+		`,
+	)
+	if err != nil {
+		return
+	}
+
+	// Create and populate the object:
+	result = &DatabaseLock{
+		logger:   b.logger,
+		handle:   b.handle,
+		name:     b.name,
+		process:  b.process,
+		duration: b.duration,
+	}
+	return
+}
+
+// Identity is the implementation of the Lock interface.
+func (l *DatabaseLock) Identity() string {
+	return l.process
+}
+
+// LeaseDuration is the implementation of the Lock interface.
+func (l *DatabaseLock) LeaseDuration() time.Duration {
+	return l.duration
+}
+
+// TryAcquire is the implementation of the Lock interface. It creates the row of the lock if it
+// doesn't exist, and updates it if it is held by this process or if it has expired.
+func (l *DatabaseLock) TryAcquire(ctx context.Context) (result bool, err error) {
+	row := l.handle.QueryRowContext(
+		ctx,
+		`
+		insert into leadership_flags as f (
+			name,
+			holder,
+			version,
+			timestamp
+		) values (
+			$1,
+			$2,
+			0,
+			now()
+		)
+		on conflict (name) do update set
+			holder = excluded.holder,
+			version = f.version + 1,
+			timestamp = now()
+		where
+			f.holder = excluded.holder or
+			f.timestamp < now() - $3 * interval '1 millisecond'
+		returning
+			holder
+		`,
+		l.name,
+		l.process,
+		l.duration.Milliseconds(),
+	)
+	var holder string
+	err = row.Scan(&holder)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	result = holder == l.process
+	return
+}
+
+// Release is the implementation of the Lock interface. It moves the timestamp of the lock to the
+// past, so that other processes can acquire it immediately.
+func (l *DatabaseLock) Release(ctx context.Context) error {
+	_, err := l.handle.ExecContext(
+		ctx,
+		`
+		update leadership_flags set
+			version = version + 1,
+			timestamp = 'epoch'
+		where
+			name = $1 and
+			holder = $2
+		`,
+		l.name,
+		l.process,
+	)
+	return err
+}
+
+// Default values:
+const (
+	defaultLeaseDuration = 30 * time.Second
+)
@@ -0,0 +1,360 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the elector that runs code only while the process is the leader of a group of
+// processes.
+
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Lock is the interface of the objects that the elector uses to obtain and keep the leadership.
+// Only one process can hold a lock with a given name at any point in time.
+type Lock interface {
+	// Identity returns the identity of the process that uses the lock, for use in messages.
+	Identity() string
+
+	// TryAcquire tries to acquire the lock, or to renew it if it is already held by this
+	// process. It returns true if the lock is held by this process after the call.
+	TryAcquire(ctx context.Context) (bool, error)
+
+	// Release releases the lock if it is held by this process, so that other processes can
+	// acquire it without waiting for it to expire.
+	Release(ctx context.Context) error
+
+	// LeaseDuration returns the time after which the lock expires if it isn't renewed.
+	LeaseDuration() time.Duration
+}
+
+// Callbacks contains the functions that the elector calls when the leadership changes.
+type Callbacks struct {
+	// OnStartedLeading is called in a new goroutine when the process acquires the leadership.
+	// The context passed is cancelled when the leadership is lost or when the elector stops.
+	// This is mandatory.
+	OnStartedLeading func(ctx context.Context)
+
+	// OnStoppedLeading is called when the process loses the leadership or when the elector stops
+	// after having acquired it. It is optional.
+	OnStoppedLeading func()
+}
+
+// ElectorBuilder contains the data and logic needed to build an elector.
+type ElectorBuilder struct {
+	logger        logging.Logger
+	lock          Lock
+	callbacks     Callbacks
+	renewInterval time.Duration
+	renewDeadline time.Duration
+	retryInterval time.Duration
+	release       bool
+}
+
+// Elector acquires a lock, renews it periodically while it is held and calls the callbacks when the
+// leadership changes. It is modeled after the leader election package of the Kubernetes client.
+type Elector struct {
+	logger        logging.Logger
+	lock          Lock
+	callbacks     Callbacks
+	renewInterval time.Duration
+	renewDeadline time.Duration
+	retryInterval time.Duration
+	release       bool
+}
+
+// NewElector creates a builder that can then be used to configure and create an elector.
+func NewElector() *ElectorBuilder {
+	return &ElectorBuilder{
+		renewInterval: defaultRenewInterval,
+		renewDeadline: defaultRenewDeadline,
+		retryInterval: defaultRetryInterval,
+		release:       true,
+	}
+}
+
+// Logger sets the logger that the elector will use to write to the log. This is mandatory.
+func (b *ElectorBuilder) Logger(value logging.Logger) *ElectorBuilder {
+	b.logger = value
+	return b
+}
+
+// Lock sets the lock that the elector will use. This is mandatory.
+func (b *ElectorBuilder) Lock(value Lock) *ElectorBuilder {
+	b.lock = value
+	return b
+}
+
+// Callbacks sets the functions that will be called when the leadership changes. The
+// OnStartedLeading function is mandatory.
+func (b *ElectorBuilder) Callbacks(value Callbacks) *ElectorBuilder {
+	b.callbacks = value
+	return b
+}
+
+// RenewInterval sets the interval for renewing the lock while it is held. It should be shorter
+// than the time that the lock takes to expire. The default value is ten seconds.
+func (b *ElectorBuilder) RenewInterval(value time.Duration) *ElectorBuilder {
+	b.renewInterval = value
+	return b
+}
+
+// RenewDeadline sets the maximum time that the elector will keep the leadership without being able
+// to renew the lock because of errors. It should be shorter than the lease duration of the lock, so
+// that the leader code is stopped before other process can acquire the lock. The default value is
+// twenty seconds.
+func (b *ElectorBuilder) RenewDeadline(value time.Duration) *ElectorBuilder {
+	b.renewDeadline = value
+	return b
+}
+
+// RetryInterval sets the interval for trying to acquire the lock when it isn't held, and for
+// retrying failed renewals. The default value is two seconds.
+func (b *ElectorBuilder) RetryInterval(value time.Duration) *ElectorBuilder {
+	b.retryInterval = value
+	return b
+}
+
+// ReleaseOnCancel sets the flag that indicates if the lock should be released when the context
+// passed to the Run method is cancelled. The default value is true.
+func (b *ElectorBuilder) ReleaseOnCancel(value bool) *ElectorBuilder {
+	b.release = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new elector.
+func (b *ElectorBuilder) Build() (result *Elector, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.lock == nil {
+		err = errors.New("lock is mandatory")
+		return
+	}
+	if b.callbacks.OnStartedLeading == nil {
+		err = errors.New("started leading callback is mandatory")
+		return
+	}
+	if b.renewInterval <= 0 {
+		err = errors.New("renew interval should be greater than zero")
+		return
+	}
+	if b.retryInterval <= 0 {
+		err = errors.New("retry interval should be greater than zero")
+		return
+	}
+	if b.renewDeadline < b.renewInterval {
+		err = fmt.Errorf(
+			"renew deadline %s should be greater or equal than renew interval %s",
+			b.renewDeadline, b.renewInterval,
+		)
+		return
+	}
+	if b.renewDeadline >= b.lock.LeaseDuration() {
+		err = fmt.Errorf(
+			"renew deadline %s should be less than lease duration %s",
+			b.renewDeadline, b.lock.LeaseDuration(),
+		)
+		return
+	}
+
+	// Create and populate the object:
+	result = &Elector{
+		logger:        b.logger,
+		lock:          b.lock,
+		callbacks:     b.callbacks,
+		renewInterval: b.renewInterval,
+		renewDeadline: b.renewDeadline,
+		retryInterval: b.retryInterval,
+		release:       b.release,
+	}
+	return
+}
+
+// Run waits till the lock is acquired, calls the OnStartedLeading callback and then renews the lock
+// till it is lost or the context is cancelled. In both cases it calls the OnStoppedLeading callback
+// and waits for the OnStartedLeading callback to return before returning. It returns nil if the
+// context was cancelled, and an error if the leadership was lost. Like in the Kubernetes client,
+// once the leadership is lost the elector doesn't try to acquire it again, usually the process
+// should exit and be restarted.
+func (e *Elector) Run(ctx context.Context) error {
+	// Wait till we acquire the lock:
+	if !e.acquire(ctx) {
+		return nil
+	}
+
+	// Start the leader code:
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		e.callbacks.OnStartedLeading(leaderCtx)
+	}()
+
+	// Renew the lock till it is lost or the context is cancelled:
+	err := e.renew(ctx)
+
+	// Stop the leader code:
+	cancel()
+	wg.Wait()
+	if e.callbacks.OnStoppedLeading != nil {
+		e.callbacks.OnStoppedLeading()
+	}
+	if err == nil && e.release {
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), e.retryInterval)
+		defer releaseCancel()
+		releaseErr := e.lock.Release(releaseCtx)
+		if releaseErr != nil {
+			e.logger.Error(
+				ctx,
+				"Process '%s' can't release lock: %v",
+				e.lock.Identity(), releaseErr,
+			)
+		}
+	}
+	return err
+}
+
+// acquire tries to acquire the lock till it succeeds or the context is cancelled. It returns true
+// if the lock was acquired.
+func (e *Elector) acquire(ctx context.Context) bool {
+	for {
+		acquired, err := e.lock.TryAcquire(ctx)
+		if err != nil {
+			e.logger.Error(
+				ctx,
+				"Process '%s' can't acquire lock: %v",
+				e.lock.Identity(), err,
+			)
+		}
+		if acquired {
+			e.logger.Info(ctx, "Process '%s' acquired lock", e.lock.Identity())
+			return true
+		}
+		if !e.sleep(ctx, e.retryInterval) {
+			return false
+		}
+	}
+}
+
+// renew renews the lock till it is lost or the context is cancelled. It returns nil if the context
+// was cancelled and an error explaining why the lock was lost otherwise. Each renewal attempt is
+// limited to the time left till the renew deadline, so that a blocked attempt can't keep the leader
+// code running after the lock has expired.
+func (e *Elector) renew(ctx context.Context) error {
+	renewed := time.Now()
+	delay := e.renewInterval
+	for {
+		if !e.sleep(ctx, delay) {
+			return nil
+		}
+		acquired, err := e.tryRenew(ctx, e.renewDeadline-time.Since(renewed))
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return nil
+			}
+			if time.Since(renewed) >= e.renewDeadline {
+				return fmt.Errorf(
+					"process '%s' failed to renew lock for more than %s: %w",
+					e.lock.Identity(), e.renewDeadline, err,
+				)
+			}
+			e.logger.Warn(
+				ctx,
+				"Process '%s' can't renew lock, will retry: %v",
+				e.lock.Identity(), err,
+			)
+			delay = e.retryInterval
+		case !acquired:
+			e.logger.Info(ctx, "Process '%s' lost lock", e.lock.Identity())
+			return fmt.Errorf("process '%s' lost lock", e.lock.Identity())
+		default:
+			renewed = time.Now()
+			delay = e.renewInterval
+		}
+	}
+}
+
+// tryRenew tries to renew the lock, giving up when the given timeout expires.
+func (e *Elector) tryRenew(ctx context.Context, timeout time.Duration) (bool, error) {
+	renewCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return e.lock.TryAcquire(renewCtx)
+}
+
+// sleep waits for the given time or till the context is cancelled. It returns false if the context
+// was cancelled.
+func (e *Elector) sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Run creates an elector with the default configuration and runs it. It returns an error if the
+// elector can't be created, for example if the lock or the started leading callback are missing.
+// For example:
+//
+//	lock, err := leaderelection.NewDatabaseLock().
+//		Logger(logger).
+//		Handle(db).
+//		Name("my_controller").
+//		Process(podName).
+//		Build(ctx)
+//	if err != nil {
+//		...
+//	}
+//	err = leaderelection.Run(ctx, logger, lock, leaderelection.Callbacks{
+//		OnStartedLeading: func(ctx context.Context) {
+//			...
+//		},
+//	})
+//	if err != nil {
+//		...
+//	}
+func Run(ctx context.Context, logger logging.Logger, lock Lock, callbacks Callbacks) error {
+	elector, err := NewElector().
+		Logger(logger).
+		Lock(lock).
+		Callbacks(callbacks).
+		Build()
+	if err != nil {
+		return fmt.Errorf("can't create elector: %w", err)
+	}
+	return elector.Run(ctx)
+}
+
+// Default values:
+const (
+	defaultRenewInterval = 10 * time.Second
+	defaultRenewDeadline = 20 * time.Second
+	defaultRetryInterval = 2 * time.Second
+)
@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+// fakeLock is a lock that returns the results configured by the test.
+type fakeLock struct {
+	lock     sync.Mutex
+	results  []bool
+	errors   []error
+	blocks   []bool
+	calls    int
+	released bool
+}
+
+func (l *fakeLock) Identity() string {
+	return "my_process"
+}
+
+func (l *fakeLock) TryAcquire(ctx context.Context) (result bool, err error) {
+	l.lock.Lock()
+	i := l.calls
+	l.calls++
+	l.lock.Unlock()
+	if i < len(l.blocks) && l.blocks[i] {
+		<-ctx.Done()
+		err = ctx.Err()
+		return
+	}
+	if i < len(l.errors) {
+		err = l.errors[i]
+	}
+	if i < len(l.results) {
+		result = l.results[i]
+	} else if len(l.results) > 0 {
+		result = l.results[len(l.results)-1]
+	}
+	return
+}
+
+func (l *fakeLock) LeaseDuration() time.Duration {
+	return 2 * time.Minute
+}
+
+func (l *fakeLock) Release(ctx context.Context) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.released = true
+	return nil
+}
+
+func (l *fakeLock) Released() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.released
+}
+
+var _ = Describe("Elector", func() {
+	It("Can't be created without started leading callback", func() {
+		_, err := NewElector().
+			Logger(logger).
+			Lock(&fakeLock{}).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("callback"))
+	})
+
+	It("Can't be created with deadline shorter than renew interval", func() {
+		_, err := NewElector().
+			Logger(logger).
+			Lock(&fakeLock{}).
+			Callbacks(Callbacks{
+				OnStartedLeading: func(ctx context.Context) {},
+			}).
+			RenewInterval(10 * time.Second).
+			RenewDeadline(5 * time.Second).
+			Build()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Can't be created with deadline longer than lease duration", func() {
+		_, err := NewElector().
+			Logger(logger).
+			Lock(&fakeLock{}).
+			Callbacks(Callbacks{
+				OnStartedLeading: func(ctx context.Context) {},
+			}).
+			RenewDeadline(time.Hour).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("lease duration"))
+	})
+
+	It("Returns the error if the elector can't be created", func() {
+		err := Run(context.Background(), logger, &fakeLock{}, Callbacks{})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("callback"))
+	})
+
+	It("Runs the leader code and releases the lock when cancelled", func() {
+		lock := &fakeLock{
+			results: []bool{false, false, true},
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		started := make(chan struct{})
+		stopped := false
+		elector, err := NewElector().
+			Logger(logger).
+			Lock(lock).
+			Callbacks(Callbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					close(started)
+					<-ctx.Done()
+				},
+				OnStoppedLeading: func() {
+					stopped = true
+				},
+			}).
+			RenewInterval(time.Millisecond).
+			RenewDeadline(10 * time.Millisecond).
+			RetryInterval(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			defer GinkgoRecover()
+			<-started
+			cancel()
+		}()
+		err = elector.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stopped).To(BeTrue())
+		Expect(lock.Released()).To(BeTrue())
+	})
+
+	It("Returns error when the lock is lost", func() {
+		lock := &fakeLock{
+			results: []bool{true, true, false},
+		}
+		leaderCancelled := false
+		elector, err := NewElector().
+			Logger(logger).
+			Lock(lock).
+			Callbacks(Callbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					<-ctx.Done()
+					leaderCancelled = true
+				},
+			}).
+			RenewInterval(time.Millisecond).
+			RenewDeadline(10 * time.Millisecond).
+			RetryInterval(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = elector.Run(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("lost"))
+		Expect(leaderCancelled).To(BeTrue())
+		Expect(lock.Released()).To(BeFalse())
+	})
+
+	It("Tolerates renewal errors till the deadline", func() {
+		failure := errors.New("database is down")
+		lock := &fakeLock{
+			results: []bool{true},
+			errors:  []error{nil, failure, failure, nil},
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		elector, err := NewElector().
+			Logger(logger).
+			Lock(lock).
+			Callbacks(Callbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					<-ctx.Done()
+				},
+			}).
+			RenewInterval(time.Millisecond).
+			RenewDeadline(time.Minute).
+			RetryInterval(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			defer GinkgoRecover()
+			Eventually(func() int {
+				lock.lock.Lock()
+				defer lock.lock.Unlock()
+				return lock.calls
+			}).Should(BeNumerically(">", 5))
+			cancel()
+		}()
+		err = elector.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Gives up when renewal errors exceed the deadline", func() {
+		failure := errors.New("database is down")
+		lock := &fakeLock{
+			results: []bool{true, false},
+			errors:  []error{nil},
+		}
+		for i := 0; i < 1000; i++ {
+			lock.errors = append(lock.errors, failure)
+		}
+		elector, err := NewElector().
+			Logger(logger).
+			Lock(lock).
+			Callbacks(Callbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					<-ctx.Done()
+				},
+			}).
+			RenewInterval(time.Millisecond).
+			RenewDeadline(5 * time.Millisecond).
+			RetryInterval(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = elector.Run(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, failure)).To(BeTrue())
+	})
+
+	It("Gives up when a renewal blocks beyond the deadline", func() {
+		lock := &fakeLock{
+			results: []bool{true},
+			blocks:  []bool{false, true},
+		}
+		elector, err := NewElector().
+			Logger(logger).
+			Lock(lock).
+			Callbacks(Callbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					<-ctx.Done()
+				},
+			}).
+			RenewInterval(time.Millisecond).
+			RenewDeadline(10 * time.Millisecond).
+			RetryInterval(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = elector.Run(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+
+	It("Returns without calling the callbacks if cancelled before acquiring", func() {
+		lock := &fakeLock{
+			results: []bool{false},
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		called := false
+		elector, err := NewElector().
+			Logger(logger).
+			Lock(lock).
+			Callbacks(Callbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					called = true
+				},
+			}).
+			RetryInterval(time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = elector.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(called).To(BeFalse())
+	})
+})
@@ -0,0 +1,280 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that convert objects to and from YAML.
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML writes the given object as a YAML document. The marshal function is the one that
+// writes the object as JSON, usually one of the generated functions of the model. For example, to
+// write a cluster:
+//
+//	data, err := manifest.MarshalYAML(cluster, cmv1.MarshalCluster)
+//
+// The order of the attributes is the same that is used by the JSON marshal function.
+func MarshalYAML[T any](object T, marshal func(T, io.Writer) error) (result []byte, err error) {
+	buffer := &bytes.Buffer{}
+	err = marshal(object, buffer)
+	if err != nil {
+		return
+	}
+	result, err = JSONToYAML(buffer.Bytes())
+	return
+}
+
+// UnmarshalYAML reads an object from the given YAML document. The unmarshal function is the one
+// that reads the object from JSON, usually one of the generated functions of the model. For
+// example, to read a cluster:
+//
+//	cluster, err := manifest.UnmarshalYAML(data, cmv1.UnmarshalCluster)
+func UnmarshalYAML[T any](data []byte, unmarshal func(interface{}) (T, error)) (result T,
+	err error) {
+	text, err := YAMLToJSON(data)
+	if err != nil {
+		return
+	}
+	result, err = unmarshal(text)
+	return
+}
+
+// JSONToYAML converts the given JSON document to YAML, preserving the order of the attributes of
+// the objects.
+func JSONToYAML(data []byte) (result []byte, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	node, err := readNode(decoder)
+	if err != nil {
+		err = fmt.Errorf("can't parse JSON document: %w", err)
+		return
+	}
+	buffer := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buffer)
+	encoder.SetIndent(2)
+	err = encoder.Encode(node)
+	if err != nil {
+		return
+	}
+	err = encoder.Close()
+	if err != nil {
+		return
+	}
+	result = buffer.Bytes()
+	return
+}
+
+// YAMLToJSON converts the given YAML document to JSON, preserving the order of the attributes of
+// the objects.
+func YAMLToJSON(data []byte) (result []byte, err error) {
+	var document yaml.Node
+	err = yaml.Unmarshal(data, &document)
+	if err != nil {
+		err = fmt.Errorf("can't parse YAML document: %w", err)
+		return
+	}
+	buffer := &bytes.Buffer{}
+	if document.Kind == 0 {
+		buffer.WriteString("null")
+	} else {
+		err = writeNode(buffer, &document)
+		if err != nil {
+			return
+		}
+	}
+	result = buffer.Bytes()
+	return
+}
+
+// YAMLStreamToJSON converts the given YAML stream, that may contain multiple documents separated by
+// `---`, to a list of JSON documents. Empty documents are skipped.
+func YAMLStreamToJSON(data []byte) (result [][]byte, err error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for index := 0; ; index++ {
+		var document yaml.Node
+		err = decoder.Decode(&document)
+		if err == io.EOF {
+			err = nil
+			return
+		}
+		if err != nil {
+			err = fmt.Errorf("can't parse YAML document %d: %w", index, err)
+			result = nil
+			return
+		}
+		if len(document.Content) == 0 || document.Content[0].ShortTag() == "!!null" {
+			continue
+		}
+		buffer := &bytes.Buffer{}
+		err = writeNode(buffer, &document)
+		if err != nil {
+			result = nil
+			return
+		}
+		result = append(result, buffer.Bytes())
+	}
+}
+
+// readNode reads the next JSON value from the decoder and converts it into a YAML node.
+func readNode(decoder *json.Decoder) (result *yaml.Node, err error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return
+	}
+	switch typed := token.(type) {
+	case json.Delim:
+		switch typed {
+		case '{':
+			result = &yaml.Node{
+				Kind: yaml.MappingNode,
+				Tag:  "!!map",
+			}
+			for decoder.More() {
+				var key json.Token
+				key, err = decoder.Token()
+				if err != nil {
+					return
+				}
+				var value *yaml.Node
+				value, err = readNode(decoder)
+				if err != nil {
+					return
+				}
+				result.Content = append(result.Content, &yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!str",
+					Value: key.(string),
+				}, value)
+			}
+		case '[':
+			result = &yaml.Node{
+				Kind: yaml.SequenceNode,
+				Tag:  "!!seq",
+			}
+			for decoder.More() {
+				var value *yaml.Node
+				value, err = readNode(decoder)
+				if err != nil {
+					return
+				}
+				result.Content = append(result.Content, value)
+			}
+		}
+		// Consume the closing delimiter:
+		_, err = decoder.Token()
+	case string:
+		result = &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!str",
+			Value: typed,
+		}
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(typed.String(), ".eE") {
+			tag = "!!float"
+		}
+		result = &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   tag,
+			Value: typed.String(),
+		}
+	case bool:
+		result = &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!bool",
+			Value: fmt.Sprintf("%t", typed),
+		}
+	case nil:
+		result = &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!null",
+			Value: "null",
+		}
+	}
+	return
+}
+
+// writeNode writes the given YAML node as JSON.
+func writeNode(buffer *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			buffer.WriteString("null")
+			return nil
+		}
+		return writeNode(buffer, node.Content[0])
+	case yaml.AliasNode:
+		return writeNode(buffer, node.Alias)
+	case yaml.MappingNode:
+		buffer.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			key, err := json.Marshal(node.Content[i].Value)
+			if err != nil {
+				return err
+			}
+			buffer.Write(key)
+			buffer.WriteByte(':')
+			err = writeNode(buffer, node.Content[i+1])
+			if err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	case yaml.SequenceNode:
+		buffer.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			err := writeNode(buffer, item)
+			if err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	case yaml.ScalarNode:
+		var value interface{}
+		err := node.Decode(&value)
+		if err != nil {
+			return err
+		}
+		if node.ShortTag() == "!!timestamp" {
+			value = node.Value
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf(
+				"can't convert value '%s' in line %d to JSON: %w",
+				node.Value, node.Line, err,
+			)
+		}
+		buffer.Write(data)
+	default:
+		return fmt.Errorf("unsupported YAML node kind %d in line %d", node.Kind, node.Line)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("YAML", func() {
+	It("Writes cluster preserving the order of attributes", func() {
+		cluster, err := cmv1.NewCluster().
+			ID("123").
+			Name("my-cluster").
+			MultiAZ(true).
+			Nodes(cmv1.NewClusterNodes().Compute(3)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		data, err := MarshalYAML(cluster, cmv1.MarshalCluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(RemoveLeadingTabs(
+			`kind: Cluster
+			id: "123"
+			multi_az: true
+			name: my-cluster
+			nodes:
+			  compute: 3
+			`,
+		)))
+	})
+
+	It("Reads cluster", func() {
+		cluster, err := UnmarshalYAML([]byte(RemoveLeadingTabs(
+			`kind: Cluster
+			id: "123"
+			name: my-cluster
+			creation_timestamp: 2024-01-02T03:04:05Z
+			properties:
+			  owner: me
+			nodes:
+			  compute: 3
+			`,
+		)), cmv1.UnmarshalCluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.ID()).To(Equal("123"))
+		Expect(cluster.Name()).To(Equal("my-cluster"))
+		Expect(cluster.CreationTimestamp().Year()).To(Equal(2024))
+		Expect(cluster.Properties()).To(HaveKeyWithValue("owner", "me"))
+		Expect(cluster.Nodes().Compute()).To(Equal(3))
+	})
+
+	It("Round trips arbitrary JSON", func() {
+		original := `{"b":1,"a":[1.5,"x",true,null,{"c":"true"}],"e":{}}`
+		data, err := JSONToYAML([]byte(original))
+		Expect(err).ToNot(HaveOccurred())
+		result, err := YAMLToJSON(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(result)).To(Equal(original))
+	})
+
+	It("Rejects invalid YAML", func() {
+		_, err := YAMLToJSON([]byte("a: [1"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Splits a stream of documents skipping empty ones", func() {
+		result, err := YAMLStreamToJSON([]byte(RemoveLeadingTabs(
+			`a: 1
+			---
+			---
+			b: [x, y]
+			...
+			`,
+		)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(2))
+		Expect(string(result[0])).To(Equal(`{"a":1}`))
+		Expect(string(result[1])).To(Equal(`{"b":["x","y"]}`))
+	})
+
+	It("Reports the index of the invalid document", func() {
+		_, err := YAMLStreamToJSON([]byte("a: 1\n---\nb: [1\n"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("document 1"))
+	})
+})
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the metadata client.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/openshift-online/ocm-sdk-go/errors"
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+)
+
+// API describes the top level metadata endpoint of the API.
+type API struct {
+	// HREF is the path of the endpoint, usually `/api`.
+	HREF string `json:"href,omitempty"`
+
+	// Services is the list of services available in the API.
+	Services []*Service `json:"services,omitempty"`
+}
+
+// Service describes one of the services available in the API.
+type Service struct {
+	// ID is the identifier of the service, for example `clusters_mgmt`.
+	ID string `json:"id,omitempty"`
+
+	// HREF is the path of the service, for example `/api/clusters_mgmt`.
+	HREF string `json:"href,omitempty"`
+
+	// Versions is the list of versions of the service. Note that the top level endpoint may not
+	// include the versions, use the Service method of the client to retrieve them.
+	Versions []*Version `json:"versions,omitempty"`
+}
+
+// Version describes one of the versions of a service.
+type Version struct {
+	// ID is the identifier of the version, for example `v1`.
+	ID string `json:"id,omitempty"`
+
+	// HREF is the path of the version, for example `/api/clusters_mgmt/v1`.
+	HREF string `json:"href,omitempty"`
+
+	// ServerVersion is the version of the server that implements this version of the service.
+	// It is only populated by the Version method of the client.
+	ServerVersion string `json:"server_version,omitempty"`
+}
+
+// Service returns the service with the given identifier, or nil if there is no such service.
+func (a *API) Service(id string) *Service {
+	if a == nil {
+		return nil
+	}
+	for _, service := range a.Services {
+		if service != nil && service.ID == id {
+			return service
+		}
+	}
+	return nil
+}
+
+// HasService returns true if the API contains the service with the given identifier.
+func (a *API) HasService(id string) bool {
+	return a.Service(id) != nil
+}
+
+// Version returns the version with the given identifier, or nil if there is no such version.
+func (s *Service) Version(id string) *Version {
+	if s == nil {
+		return nil
+	}
+	for _, version := range s.Versions {
+		if version != nil && version.ID == id {
+			return version
+		}
+	}
+	return nil
+}
+
+// HasVersion returns true if the service contains the version with the given identifier.
+func (s *Service) HasVersion(id string) bool {
+	return s.Version(id) != nil
+}
+
+// Client is the client for the metadata endpoints of the API. Don't create instances of this type
+// directly, use the Metadata method of the connection instead.
+type Client struct {
+	transport http.RoundTripper
+	path      string
+}
+
+// NewClient creates a new client for the metadata endpoints using the given transport to send the
+// requests and receive the responses. The path is the path of the top level endpoint, usually
+// `/api`.
+func NewClient(transport http.RoundTripper, path string) *Client {
+	return &Client{
+		transport: transport,
+		path:      path,
+	}
+}
+
+// Get retrieves the list of services available in the API.
+func (c *Client) Get(ctx context.Context) (result *API, err error) {
+	result = &API{}
+	err = c.get(ctx, c.path, result)
+	if err != nil {
+		result = nil
+	}
+	return
+}
+
+// Service retrieves the description of the service with the given identifier, including the list of
+// its versions. If the service doesn't exist the returned error will be an *errors.Error with status
+// code 404.
+func (c *Client) Service(ctx context.Context, id string) (result *Service, err error) {
+	result = &Service{}
+	err = c.get(ctx, path.Join(c.path, id), result)
+	if err != nil {
+		result = nil
+		return
+	}
+	if result.ID == "" {
+		result.ID = id
+	}
+	return
+}
+
+// Version retrieves the description of the given version of the given service, including the
+// version of the server that implements it.
+func (c *Client) Version(ctx context.Context, service, version string) (result *Version,
+	err error) {
+	result = &Version{}
+	err = c.get(ctx, path.Join(c.path, service, version), result)
+	if err != nil {
+		result = nil
+		return
+	}
+	if result.ID == "" {
+		result.ID = version
+	}
+	return
+}
+
+// get sends a GET request for the given path and decodes the response into the given object.
+func (c *Client) get(ctx context.Context, target string, object interface{}) error {
+	err := helpers.ValidatePath(target)
+	if err != nil {
+		return err
+	}
+	request := &http.Request{
+		Method: http.MethodGet,
+		URL: &url.URL{
+			Path: target,
+		},
+		Header: http.Header{},
+	}
+	if ctx != nil {
+		request = request.WithContext(ctx)
+	}
+	response, err := c.transport.RoundTrip(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= http.StatusBadRequest {
+		apiErr, err := errors.UnmarshalErrorStatus(response.Body, response.StatusCode)
+		if err != nil {
+			return fmt.Errorf("can't decode error from '%s': %w", target, err)
+		}
+		return apiErr
+	}
+	err = json.NewDecoder(response.Body).Decode(object)
+	if err != nil {
+		return fmt.Errorf("can't decode metadata from '%s': %w", target, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the metadata client.
+
+package metadata
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/openshift-online/ocm-sdk-go/errors"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Client", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var client *Client
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = MakeTCPServer()
+		client = NewClient(ServerTransport(server), "/api")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Retrieves the list of services", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api"),
+				RespondWithJSON(http.StatusOK, `{
+					"href": "/api",
+					"services": [
+						{
+							"id": "accounts_mgmt",
+							"href": "/api/accounts_mgmt"
+						},
+						{
+							"id": "clusters_mgmt",
+							"href": "/api/clusters_mgmt"
+						}
+					]
+				}`),
+			),
+		)
+		api, err := client.Get(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.Services).To(HaveLen(2))
+		Expect(api.HasService("clusters_mgmt")).To(BeTrue())
+		Expect(api.HasService("service_logs")).To(BeFalse())
+		Expect(api.Service("accounts_mgmt").HREF).To(Equal("/api/accounts_mgmt"))
+	})
+
+	It("Retrieves the versions of a service", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt"),
+				RespondWithJSON(http.StatusOK, `{
+					"href": "/api/clusters_mgmt",
+					"versions": [
+						{
+							"id": "v1",
+							"href": "/api/clusters_mgmt/v1"
+						}
+					]
+				}`),
+			),
+		)
+		service, err := client.Service(ctx, "clusters_mgmt")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(service.ID).To(Equal("clusters_mgmt"))
+		Expect(service.HasVersion("v1")).To(BeTrue())
+		Expect(service.HasVersion("v2")).To(BeFalse())
+	})
+
+	It("Retrieves the metadata of a version", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1"),
+				RespondWithJSON(http.StatusOK, `{
+					"server_version": "123"
+				}`),
+			),
+		)
+		version, err := client.Version(ctx, "clusters_mgmt", "v1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version.ID).To(Equal("v1"))
+		Expect(version.ServerVersion).To(Equal("123"))
+	})
+
+	It("Returns the API error when the service doesn't exist", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Service not found"
+			}`),
+		)
+		service, err := client.Service(ctx, "junk")
+		Expect(err).To(HaveOccurred())
+		Expect(service).To(BeNil())
+		var apiErr *errors.Error
+		Expect(stderrors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.Status()).To(Equal(http.StatusNotFound))
+	})
+
+	It("Handles nil objects in the lookup methods", func() {
+		var api *API
+		Expect(api.HasService("clusters_mgmt")).To(BeFalse())
+		var service *Service
+		Expect(service.HasVersion("v1")).To(BeFalse())
+	})
+})
@@ -0,0 +1,22 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metadata contains a client for the metadata endpoints of the API: the top level `/api`
+// endpoint that lists the available services, the endpoints of the services that list their
+// versions, and the endpoints of the versions that describe the server. Applications can use it to
+// discover what is available and degrade gracefully when some services are absent, for example in
+// API gateways installed on premises.
+package metadata
@@ -354,6 +354,25 @@ var _ = Describe("Methods", func() {
 			Expect(response.Status()).To(Equal(http.StatusOK))
 		})
 
+		It("Sends dry run parameter", func() {
+			// Configure the server:
+			apiServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyFormKV("dryRun", "true"),
+					RespondWithJSON(http.StatusOK, "{}"),
+				),
+			)
+
+			// Send the request:
+			response, err := connection.Post().
+				Path("/mypath").
+				DryRun(true).
+				Send()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).ToNot(BeNil())
+			Expect(response.Status()).To(Equal(http.StatusOK))
+		})
+
 		It("Accepts 204 with empty body", func() {
 			// Configure the server:
 			apiServer.AppendHandlers(
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the function that registers the metrics of the shared buffer pool.
+
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+)
+
+// RegisterBufferPoolMetrics registers with the given Prometheus registerer the following metrics
+// that describe the usage of the buffer pool shared by all the connections:
+//
+//	<subsystem>_buffer_pool_get_count - Number of buffers obtained from the pool.
+//	<subsystem>_buffer_pool_put_count - Number of buffers returned to the pool.
+//	<subsystem>_buffer_pool_allocation_count - Number of buffers allocated because the pool was empty.
+//	<subsystem>_buffer_pool_discard_count - Number of buffers discarded because they were too large.
+//
+// If the registerer is nil the default Prometheus registerer will be used. Calling this more than
+// once with the same registerer and subsystem isn't an error, but each call should be matched by a
+// call to UnregisterBufferPoolMetrics.
+func RegisterBufferPoolMetrics(registerer prometheus.Registerer, subsystem string) error {
+	// Check parameters:
+	if subsystem == "" {
+		return fmt.Errorf("subsystem is mandatory")
+	}
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	// Register the metrics:
+	collectors := bufferPoolCollectors(subsystem)
+	for i, collector := range collectors {
+		_, err := Register(registerer, collector)
+		if err != nil {
+			for _, registered := range collectors[:i] {
+				Unregister(registerer, registered)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// UnregisterBufferPoolMetrics releases the metrics registered by the RegisterBufferPoolMetrics
+// function. The metrics are removed from the registerer when no other user is using them.
+func UnregisterBufferPoolMetrics(registerer prometheus.Registerer, subsystem string) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+	for _, collector := range bufferPoolCollectors(subsystem) {
+		Unregister(registerer, collector)
+	}
+}
+
+// bufferPoolCollectors creates the collectors for the metrics of the buffer pool.
+func bufferPoolCollectors(subsystem string) []prometheus.Collector {
+	return []prometheus.Collector{
+		prometheus.NewCounterFunc(
+			prometheus.CounterOpts{
+				Subsystem: subsystem,
+				Name:      "buffer_pool_get_count",
+				Help:      "Number of buffers obtained from the pool.",
+			},
+			func() float64 {
+				return float64(helpers.GetBufferPoolStats().Gets)
+			},
+		),
+		prometheus.NewCounterFunc(
+			prometheus.CounterOpts{
+				Subsystem: subsystem,
+				Name:      "buffer_pool_put_count",
+				Help:      "Number of buffers returned to the pool.",
+			},
+			func() float64 {
+				return float64(helpers.GetBufferPoolStats().Puts)
+			},
+		),
+		prometheus.NewCounterFunc(
+			prometheus.CounterOpts{
+				Subsystem: subsystem,
+				Name:      "buffer_pool_allocation_count",
+				Help:      "Number of buffers allocated because the pool was empty.",
+			},
+			func() float64 {
+				return float64(helpers.GetBufferPoolStats().Allocations)
+			},
+		),
+		prometheus.NewCounterFunc(
+			prometheus.CounterOpts{
+				Subsystem: subsystem,
+				Name:      "buffer_pool_discard_count",
+				Help:      "Number of buffers discarded because they were too large.",
+			},
+			func() float64 {
+				return float64(helpers.GetBufferPoolStats().Discards)
+			},
+		),
+	}
+}
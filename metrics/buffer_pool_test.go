@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the metrics of the shared buffer pool.
+
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+)
+
+var _ = Describe("Buffer pool metrics", func() {
+	var metricsServer *MetricsServer
+
+	BeforeEach(func() {
+		metricsServer = NewMetricsServer()
+	})
+
+	AfterEach(func() {
+		metricsServer.Close()
+	})
+
+	It("Can't be registered without a subsystem", func() {
+		err := RegisterBufferPoolMetrics(metricsServer.Registry(), "")
+		Expect(err).To(HaveOccurred())
+		message := err.Error()
+		Expect(message).To(ContainSubstring("subsystem"))
+		Expect(message).To(ContainSubstring("mandatory"))
+	})
+
+	It("Can be registered twice", func() {
+		err := RegisterBufferPoolMetrics(metricsServer.Registry(), "my")
+		Expect(err).ToNot(HaveOccurred())
+		err = RegisterBufferPoolMetrics(metricsServer.Registry(), "my")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Reflects the usage of the pool", func() {
+		// Register the metrics:
+		err := RegisterBufferPoolMetrics(metricsServer.Registry(), "my")
+		Expect(err).ToNot(HaveOccurred())
+
+		// Use the pool:
+		before := helpers.GetBufferPoolStats()
+		buffer := helpers.GetBuffer()
+		helpers.PutBuffer(buffer)
+		after := helpers.GetBufferPoolStats()
+		Expect(after.Gets - before.Gets).To(BeNumerically(">=", 1))
+		Expect(after.Puts - before.Puts).To(BeNumerically(">=", 1))
+
+		// Verify the metrics:
+		metrics := metricsServer.Metrics()
+		Expect(metrics).To(MatchLine(`^my_buffer_pool_get_count [1-9]\d*$`))
+		Expect(metrics).To(MatchLine(`^my_buffer_pool_put_count [1-9]\d*$`))
+		Expect(metrics).To(MatchLine(`^my_buffer_pool_allocation_count \d+$`))
+		Expect(metrics).To(MatchLine(`^my_buffer_pool_discard_count \d+$`))
+	})
+
+	It("Discards large buffers", func() {
+		before := helpers.GetBufferPoolStats()
+		buffer := helpers.GetBuffer()
+		buffer.Grow(2 << 20)
+		helpers.PutBuffer(buffer)
+		after := helpers.GetBufferPoolStats()
+		Expect(after.Discards - before.Discards).To(Equal(uint64(1)))
+	})
+})
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the metrics that describe the size of response bodies.
+
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PayloadMetricsBuilder contains the data and logic needed to create the following Prometheus
+// metrics that describe the size of the response bodies:
+//
+//	<subsystem>_response_compressed_size - Size in bytes of response bodies as received.
+//	<subsystem>_response_uncompressed_size - Size in bytes of response bodies after decompression.
+//
+// Both are histograms and have the same labels than the request metrics generated by the transport
+// wrapper. For responses that aren't compressed both sizes will be the same.
+//
+// Don't create objects of this type directly; use the NewPayloadMetrics function instead.
+type PayloadMetricsBuilder struct {
+	paths      []string
+	subsystem  string
+	registerer prometheus.Registerer
+}
+
+// PayloadMetrics contains the data and logic needed to update the payload size metrics.
+type PayloadMetrics struct {
+	paths            pathTree
+	registerer       prometheus.Registerer
+	compressedSize   *prometheus.HistogramVec
+	uncompressedSize *prometheus.HistogramVec
+}
+
+// NewPayloadMetrics creates a new builder that can then be used to configure and create the payload
+// size metrics.
+func NewPayloadMetrics() *PayloadMetricsBuilder {
+	return &PayloadMetricsBuilder{
+		registerer: prometheus.DefaultRegisterer,
+	}
+}
+
+// Path adds a path that will be accepted as a value for the `path` label. By default all the paths
+// of the API are already added.
+func (b *PayloadMetricsBuilder) Path(value string) *PayloadMetricsBuilder {
+	b.paths = append(b.paths, value)
+	return b
+}
+
+// Subsystem sets the name of the subsystem that will be used by to register the metrics with
+// Prometheus. This is mandatory.
+func (b *PayloadMetricsBuilder) Subsystem(value string) *PayloadMetricsBuilder {
+	b.subsystem = value
+	return b
+}
+
+// Registerer sets the Prometheus registerer that will be used to register the metrics. The default
+// is to use the default Prometheus registerer.
+func (b *PayloadMetricsBuilder) Registerer(value prometheus.Registerer) *PayloadMetricsBuilder {
+	if value == nil {
+		value = prometheus.DefaultRegisterer
+	}
+	b.registerer = value
+	return b
+}
+
+// Build uses the information stored in the builder to create the payload size metrics.
+func (b *PayloadMetricsBuilder) Build() (result *PayloadMetrics, err error) {
+	// Check parameters:
+	if b.subsystem == "" {
+		err = fmt.Errorf("subsystem is mandatory")
+		return
+	}
+
+	// Register the metrics:
+	compressedSize, err := b.register(
+		"response_compressed_size",
+		"Size in bytes of response bodies as received.",
+	)
+	if err != nil {
+		return
+	}
+	uncompressedSize, err := b.register(
+		"response_uncompressed_size",
+		"Size in bytes of response bodies after decompression.",
+	)
+	if err != nil {
+		Unregister(b.registerer, compressedSize)
+		return
+	}
+
+	// Create the path tree:
+	paths := pathRoot.copy()
+	for _, path := range b.paths {
+		paths.add(path)
+	}
+
+	// Create and populate the object:
+	result = &PayloadMetrics{
+		paths:            paths,
+		registerer:       b.registerer,
+		compressedSize:   compressedSize,
+		uncompressedSize: uncompressedSize,
+	}
+
+	return
+}
+
+func (b *PayloadMetricsBuilder) register(name, help string) (result *prometheus.HistogramVec,
+	err error) {
+	result = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: b.subsystem,
+			Name:      name,
+			Help:      help,
+			Buckets:   payloadSizeBuckets,
+		},
+		requestLabelNames,
+	)
+	registered, err := Register(b.registerer, result)
+	if err != nil {
+		return
+	}
+	result = registered.(*prometheus.HistogramVec)
+	return
+}
+
+// Observe updates the metrics with the sizes of the body of a response.
+func (m *PayloadMetrics) Observe(method, path string, code int, compressed, uncompressed int64) {
+	labels := prometheus.Labels{
+		serviceLabelName: serviceLabel(path),
+		methodLabelName:  methodLabel(method),
+		pathLabelName:    pathLabel(m.paths, path),
+		codeLabelName:    codeLabel(code),
+	}
+	m.compressedSize.With(labels).Observe(float64(compressed))
+	m.uncompressedSize.With(labels).Observe(float64(uncompressed))
+}
+
+// Close releases the registered metrics. The metrics are removed from the registerer when no other
+// user is using them.
+func (m *PayloadMetrics) Close() error {
+	Unregister(m.registerer, m.compressedSize)
+	Unregister(m.registerer, m.uncompressedSize)
+	return nil
+}
+
+// payloadSizeBuckets are the upper bounds of the buckets of the payload size histograms, from 1 KiB
+// to 16 MiB.
+var payloadSizeBuckets = prometheus.ExponentialBuckets(1024, 4, 8)
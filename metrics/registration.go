@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to register and unregister metrics that are shared by
+// multiple connections.
+
+package metrics
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Register registers the given collector with the given registerer. If an equivalent collector,
+// one with the same descriptors, has already been registered then that one is returned instead
+// and the given one is ignored. This allows multiple connections to share the same metrics, and
+// guarantees that they all have the same labels.
+//
+// Registrations are reference counted: each call to this function should be matched by a call to
+// the Unregister function, and the collector is removed from the registerer only when the last
+// user unregisters it. Collectors that were registered directly with the registerer, without
+// using this function, are used but never unregistered.
+//
+// The registerer is identified by its pointer, or by its value if it isn't a pointer, so it must be
+// a pointer or a comparable value. The collector is identified by the descriptions of its metrics,
+// which are obtained synchronously, so it must not describe more than 100 metrics.
+func Register(registerer prometheus.Registerer, collector prometheus.Collector) (
+	result prometheus.Collector, err error) {
+	key, err := registrationKeyFor(registerer, collector)
+	if err != nil {
+		return
+	}
+	registrationsLock.Lock()
+	defer registrationsLock.Unlock()
+	err = registerer.Register(collector)
+	if err == nil {
+		registrations[key] = 1
+		result = collector
+		return
+	}
+	registered, ok := err.(prometheus.AlreadyRegisteredError)
+	if !ok {
+		return
+	}
+	err = nil
+	_, tracked := registrations[key]
+	if tracked {
+		registrations[key]++
+	}
+	result = registered.ExistingCollector
+	return
+}
+
+// Unregister releases a registration made with the Register function. The collector is removed
+// from the registerer when this is the last registration. The given collector can be the one that
+// was passed to Register or the one that it returned.
+func Unregister(registerer prometheus.Registerer, collector prometheus.Collector) {
+	key, err := registrationKeyFor(registerer, collector)
+	if err != nil {
+		return
+	}
+	registrationsLock.Lock()
+	defer registrationsLock.Unlock()
+	count, tracked := registrations[key]
+	if !tracked {
+		return
+	}
+	count--
+	if count > 0 {
+		registrations[key] = count
+		return
+	}
+	delete(registrations, key)
+	registerer.Unregister(collector)
+}
+
+// registrationKey identifies a set of equivalent collectors registered with a registerer.
+type registrationKey struct {
+	registerer any
+	descs      string
+}
+
+// registrations contains the number of users of each registered collector.
+var (
+	registrations     = map[registrationKey]int{}
+	registrationsLock sync.Mutex
+)
+
+// registrationKeyFor calculates the registration key of a collector, using the identity of the
+// registerer and the descriptions of the metrics that the collector describes.
+func registrationKeyFor(registerer prometheus.Registerer,
+	collector prometheus.Collector) (result registrationKey, err error) {
+	identity, err := registererIdentity(registerer)
+	if err != nil {
+		return
+	}
+	channel := make(chan *prometheus.Desc, maxCollectorDescs)
+	collector.Describe(channel)
+	close(channel)
+	var descs []string
+	for desc := range channel {
+		descs = append(descs, desc.String())
+	}
+	sort.Strings(descs)
+	result = registrationKey{
+		registerer: identity,
+		descs:      strings.Join(descs, "\n"),
+	}
+	return
+}
+
+// registererIdentity returns a value that identifies the given registerer and that can safely be
+// used as part of a map key. Pointers are compared by identity. Maps, functions and slices can't be
+// compared, so their type and pointer are used instead. Other values are used only if they are
+// comparable, as otherwise using them in a map key would panic.
+func registererIdentity(registerer prometheus.Registerer) (result any, err error) {
+	value := reflect.ValueOf(registerer)
+	switch value.Kind() {
+	case reflect.Map, reflect.Func, reflect.Slice:
+		result = registererPointer{
+			kind:    value.Type(),
+			address: value.Pointer(),
+		}
+	default:
+		if !value.Comparable() {
+			err = fmt.Errorf(
+				"registerer of type %T can't be used to share metrics because it isn't "+
+					"comparable",
+				registerer,
+			)
+			return
+		}
+		result = registerer
+	}
+	return
+}
+
+// registererPointer identifies a registerer whose type isn't comparable but that has a pointer.
+type registererPointer struct {
+	kind    reflect.Type
+	address uintptr
+}
+
+// maxCollectorDescs is the maximum number of metrics that a collector registered with the Register
+// function can describe.
+const maxCollectorDescs = 100
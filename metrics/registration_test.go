@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the reference counted registration of metrics.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+// uncomparableRegisterer is a registerer that can't be used as a map key because it contains a
+// slice.
+type uncomparableRegisterer struct {
+	*prometheus.Registry
+	tags []string
+}
+
+var _ = Describe("Registration", func() {
+	var registry *prometheus.Registry
+
+	BeforeEach(func() {
+		registry = prometheus.NewRegistry()
+	})
+
+	// newCounter creates a new counter with a fixed name, so that all the counters created are
+	// equivalent.
+	newCounter := func() prometheus.Counter {
+		return prometheus.NewCounter(prometheus.CounterOpts{
+			Subsystem: "my",
+			Name:      "count",
+			Help:      "My count.",
+		})
+	}
+
+	// names returns the names of the metrics currently registered.
+	names := func() []string {
+		families, err := registry.Gather()
+		Expect(err).ToNot(HaveOccurred())
+		var result []string
+		for _, family := range families {
+			result = append(result, family.GetName())
+		}
+		return result
+	}
+
+	It("Returns the existing collector", func() {
+		first := newCounter()
+		second := newCounter()
+		registered, err := Register(registry, first)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(registered).To(BeIdenticalTo(first))
+		registered, err = Register(registry, second)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(registered).To(BeIdenticalTo(first))
+	})
+
+	It("Unregisters only when the last user releases it", func() {
+		first, err := Register(registry, newCounter())
+		Expect(err).ToNot(HaveOccurred())
+		second, err := Register(registry, newCounter())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names()).To(ConsistOf("my_count"))
+		Unregister(registry, first)
+		Expect(names()).To(ConsistOf("my_count"))
+		Unregister(registry, second)
+		Expect(names()).To(BeEmpty())
+	})
+
+	It("Can register again after unregistering", func() {
+		counter, err := Register(registry, newCounter())
+		Expect(err).ToNot(HaveOccurred())
+		Unregister(registry, counter)
+		_, err = Register(registry, newCounter())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names()).To(ConsistOf("my_count"))
+	})
+
+	It("Doesn't unregister collectors registered directly", func() {
+		err := registry.Register(newCounter())
+		Expect(err).ToNot(HaveOccurred())
+		counter, err := Register(registry, newCounter())
+		Expect(err).ToNot(HaveOccurred())
+		Unregister(registry, counter)
+		Expect(names()).To(ConsistOf("my_count"))
+	})
+
+	It("Returns other registration errors", func() {
+		_, err := Register(registry, newCounter())
+		Expect(err).ToNot(HaveOccurred())
+		_, err = Register(registry, prometheus.NewCounter(prometheus.CounterOpts{
+			Subsystem: "my",
+			Name:      "count",
+			Help:      "Different help.",
+		}))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Rejects registerers that aren't comparable instead of panicking", func() {
+		registerer := uncomparableRegisterer{
+			Registry: registry,
+		}
+		Expect(func() {
+			_, err := Register(registerer, newCounter())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("comparable"))
+			Unregister(registerer, newCounter())
+		}).ToNot(Panic())
+		Expect(names()).To(BeEmpty())
+	})
+})
@@ -81,6 +81,7 @@ type TransportWrapperBuilder struct {
 // one that generates Prometheus metrics.
 type TransportWrapper struct {
 	paths           pathTree
+	registerer      prometheus.Registerer
 	requestCount    *prometheus.CounterVec
 	requestDuration *prometheus.HistogramVec
 }
@@ -155,16 +156,11 @@ func (b *TransportWrapperBuilder) Build() (result *TransportWrapper, err error)
 		},
 		requestLabelNames,
 	)
-	err = b.registerer.Register(requestCount)
+	registered, err := Register(b.registerer, requestCount)
 	if err != nil {
-		registered, ok := err.(prometheus.AlreadyRegisteredError)
-		if ok {
-			requestCount = registered.ExistingCollector.(*prometheus.CounterVec)
-			err = nil //nolint:all
-		} else {
-			return
-		}
+		return
 	}
+	requestCount = registered.(*prometheus.CounterVec)
 
 	// Create the path tree:
 	paths := pathRoot.copy()
@@ -187,20 +183,17 @@ func (b *TransportWrapperBuilder) Build() (result *TransportWrapper, err error)
 		},
 		requestLabelNames,
 	)
-	err = b.registerer.Register(requestDuration)
+	registered, err = Register(b.registerer, requestDuration)
 	if err != nil {
-		registered, ok := err.(prometheus.AlreadyRegisteredError)
-		if ok {
-			requestDuration = registered.ExistingCollector.(*prometheus.HistogramVec)
-			err = nil
-		} else {
-			return
-		}
+		Unregister(b.registerer, requestCount)
+		return
 	}
+	requestDuration = registered.(*prometheus.HistogramVec)
 
 	// Create and populate the object:
 	result = &TransportWrapper{
 		paths:           paths,
+		registerer:      b.registerer,
 		requestCount:    requestCount,
 		requestDuration: requestDuration,
 	}
@@ -216,6 +209,14 @@ func (w *TransportWrapper) Wrap(transport http.RoundTripper) http.RoundTripper {
 	}
 }
 
+// Close releases the metrics registered by the wrapper. The metrics are removed from the registerer
+// when no other wrapper is using them.
+func (w *TransportWrapper) Close() error {
+	Unregister(w.registerer, w.requestCount)
+	Unregister(w.registerer, w.requestDuration)
+	return nil
+}
+
 // RoundTrip is the implementation of the round tripper interface.
 func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response, err error) {
 	// Measure the time that it takes to send the request and receive the response:
@@ -108,6 +108,19 @@ var _ = Describe("Metrics enabled", func() {
 		Expect(metrics).To(MatchLine(`^my_request_count\{.*path="/api/clusters_mgmt/v1/clusters/-".*\} .*$`))
 	})
 
+	It("Generates payload size metrics", func() {
+		// Send the request:
+		_, err := connection.Get().
+			Path("/api/clusters_mgmt/v1/clusters/123").
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Verify the metrics:
+		metrics := metricsServer.Metrics()
+		Expect(metrics).To(MatchLine(`^my_response_compressed_size_count\{.*path="/api/clusters_mgmt/v1/clusters/-".*\} 1$`))
+		Expect(metrics).To(MatchLine(`^my_response_uncompressed_size_count\{.*path="/api/clusters_mgmt/v1/clusters/-".*\} 1$`))
+	})
+
 	It("Generates token request count", func() {
 		// Send the request:
 		_, err := connection.ClustersMgmt().V1().Clusters().Cluster("123").Get().
@@ -223,3 +236,73 @@ var _ = Describe("Metrics disabled", func() {
 		Expect(metrics).To(ConsistOf(""))
 	})
 })
+
+var _ = Describe("Metrics shared by connections", func() {
+	var apiServer *ghttp.Server
+	var metricsServer *MetricsServer
+
+	BeforeEach(func() {
+		apiServer = MakeTCPServer()
+		metricsServer = NewMetricsServer()
+	})
+
+	AfterEach(func() {
+		apiServer.Close()
+		metricsServer.Close()
+	})
+
+	// connect creates a connection that registers the metrics in the metrics server.
+	connect := func() *Connection {
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(apiServer.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			MetricsSubsystem("my").
+			MetricsRegisterer(metricsServer.Registry()).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return connection
+	}
+
+	It("Keeps the metrics till the last connection is closed", func() {
+		// Create the connections:
+		first := connect()
+		second := connect()
+
+		// Send a request with the second connection:
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, "{}"),
+			RespondWithJSON(http.StatusOK, "{}"),
+		)
+		_, err := second.Get().Path("/api/clusters_mgmt/v1/clusters/123").Send()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Close the first connection and verify that the metrics are still there:
+		err = first.Close()
+		Expect(err).ToNot(HaveOccurred())
+		metrics := metricsServer.Metrics()
+		Expect(metrics).To(MatchLine(`^my_request_count\{.*\} 1$`))
+
+		// Check that the second connection still updates the metrics:
+		_, err = second.Get().Path("/api/clusters_mgmt/v1/clusters/123").Send()
+		Expect(err).ToNot(HaveOccurred())
+		metrics = metricsServer.Metrics()
+		Expect(metrics).To(MatchLine(`^my_request_count\{.*\} 2$`))
+
+		// Close the second connection and verify that the metrics are gone:
+		err = second.Close()
+		Expect(err).ToNot(HaveOccurred())
+		metrics = metricsServer.Metrics()
+		Expect(metrics).ToNot(MatchLine(`^my_.*$`))
+	})
+
+	It("Supports many short lived connections", func() {
+		for i := 0; i < 10; i++ {
+			connection := connect()
+			err := connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}
+		metrics := metricsServer.Metrics()
+		Expect(metrics).ToNot(MatchLine(`^my_.*$`))
+	})
+})
@@ -0,0 +1,24 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mocks contains a fake HTTP transport that returns programmed responses and records the
+// requests that it receives, so that unit tests don't need to start an HTTP server. The transport
+// can be used to create a connection, or passed to the NewClient functions of the generated
+// packages.
+//
+// Note that it doesn't contain fakes of the generated clients. Those clients are concrete types and
+// not interfaces, so tests still exercise the real clients and only the HTTP layer is replaced.
+package mocks
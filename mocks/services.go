@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that create connections and real clients of the generated
+// packages that send their requests to the fake transport.
+
+package mocks
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/openshift-online/ocm-sdk-go/accesstransparency"
+	"github.com/openshift-online/ocm-sdk-go/accountsmgmt"
+	"github.com/openshift-online/ocm-sdk-go/addonsmgmt"
+	"github.com/openshift-online/ocm-sdk-go/authorizations"
+	"github.com/openshift-online/ocm-sdk-go/clustersmgmt"
+	"github.com/openshift-online/ocm-sdk-go/jobqueue"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	"github.com/openshift-online/ocm-sdk-go/osdfleetmgmt"
+	"github.com/openshift-online/ocm-sdk-go/servicelogs"
+	"github.com/openshift-online/ocm-sdk-go/servicemgmt"
+	"github.com/openshift-online/ocm-sdk-go/statusboard"
+	"github.com/openshift-online/ocm-sdk-go/webrca"
+)
+
+// NewConnection creates a connection that sends all the requests to the given fake transport. The
+// connection doesn't authenticate and doesn't retry failed requests. The logger is optional, if it
+// is nil the default logger of the connection will be used. The connection should be closed when
+// no longer needed, like any other connection.
+func NewConnection(transport *Transport, logger logging.Logger) (result *sdk.Connection,
+	err error) {
+	builder := sdk.NewUnauthenticatedConnectionBuilder().
+		URL(fakeURL).
+		RetryLimit(0).
+		TransportWrapper(func(http.RoundTripper) http.RoundTripper {
+			return transport
+		})
+	if logger != nil {
+		builder.Logger(logger)
+	}
+	result, err = builder.Build()
+	return
+}
+
+// JSON generates the JSON representation of a model object using the given marshal function, and
+// is intended to generate bodies for the Respond method. It panics if the object can't be
+// marshalled. For example:
+//
+//	cluster, _ := cmv1.NewCluster().ID("123").Build()
+//	transport.On(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123").
+//		Respond(http.StatusOK, mocks.JSON(cmv1.MarshalCluster, cluster))
+func JSON[T any](marshal func(*T, io.Writer) error, object *T) string {
+	buffer := &bytes.Buffer{}
+	err := marshal(object, buffer)
+	if err != nil {
+		panic(err)
+	}
+	return buffer.String()
+}
+
+// AccessTransparency returns a client for the access transparency service that uses the transport.
+func (t *Transport) AccessTransparency() *accesstransparency.Client {
+	return accesstransparency.NewClient(t, "/api/access_transparency")
+}
+
+// AccountsMgmt returns a client for the accounts management service that uses the transport.
+func (t *Transport) AccountsMgmt() *accountsmgmt.Client {
+	return accountsmgmt.NewClient(t, "/api/accounts_mgmt")
+}
+
+// AddonsMgmt returns a client for the add-ons management service that uses the transport.
+func (t *Transport) AddonsMgmt() *addonsmgmt.Client {
+	return addonsmgmt.NewClient(t, "/api/addons_mgmt")
+}
+
+// Authorizations returns a client for the authorizations service that uses the transport.
+func (t *Transport) Authorizations() *authorizations.Client {
+	return authorizations.NewClient(t, "/api/authorizations")
+}
+
+// ClustersMgmt returns a client for the clusters management service that uses the transport.
+func (t *Transport) ClustersMgmt() *clustersmgmt.Client {
+	return clustersmgmt.NewClient(t, "/api/clusters_mgmt")
+}
+
+// JobQueue returns a client for the job queue service that uses the transport.
+func (t *Transport) JobQueue() *jobqueue.Client {
+	return jobqueue.NewClient(t, "/api/job_queue")
+}
+
+// OSDFleetMgmt returns a client for the OSD fleet management service that uses the transport.
+func (t *Transport) OSDFleetMgmt() *osdfleetmgmt.Client {
+	return osdfleetmgmt.NewClient(t, "/api/osd_fleet_mgmt")
+}
+
+// ServiceLogs returns a client for the service logs service that uses the transport.
+func (t *Transport) ServiceLogs() *servicelogs.Client {
+	return servicelogs.NewClient(t, "/api/service_logs")
+}
+
+// ServiceMgmt returns a client for the service management service that uses the transport.
+func (t *Transport) ServiceMgmt() *servicemgmt.Client {
+	return servicemgmt.NewClient(t, "/api/service_mgmt")
+}
+
+// StatusBoard returns a client for the status board service that uses the transport.
+func (t *Transport) StatusBoard() *statusboard.Client {
+	return statusboard.NewClient(t, "/api/status-board")
+}
+
+// WebRCA returns a client for the web RCA service that uses the transport.
+func (t *Transport) WebRCA() *webrca.Client {
+	return webrca.NewClient(t, "/api/web-rca")
+}
+
+// fakeURL is the URL used by the connections created by the NewConnection function. Requests never
+// reach it.
+const fakeURL = "http://mocks.invalid"
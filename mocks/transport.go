@@ -0,0 +1,281 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the fake transport that returns programmed responses and records the
+// requests that it receives.
+
+package mocks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sync"
+)
+
+// Call contains the details of a request received by the transport.
+type Call struct {
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Path is the path of the request, without the query.
+	Path string
+
+	// Query contains the query parameters of the request.
+	Query url.Values
+
+	// Header contains the headers of the request.
+	Header http.Header
+
+	// Body is the body of the request.
+	Body []byte
+}
+
+// Transport is an implementation of the http.RoundTripper interface that doesn't send requests to
+// any server. Instead of that it returns the responses programmed with the On method and records
+// the requests, so that they can be checked later. It can be passed to the NewClient functions of
+// the generated packages, or used to create a connection with the NewConnection function. It is
+// safe to use it from multiple goroutines simultaneously.
+type Transport struct {
+	lock  *sync.Mutex
+	stubs []*Stub
+	calls []*Call
+}
+
+// Stub is a programmed response of the transport.
+type Stub struct {
+	lock    *sync.Mutex
+	method  string
+	pattern string
+	times   int
+	used    int
+	status  int
+	header  http.Header
+	body    []byte
+	handler func(call *Call) (int, string)
+	err     error
+}
+
+// NewTransport creates a new fake transport without any programmed response. Requests that don't
+// match any programmed response receive a 404 response containing an error.
+func NewTransport() *Transport {
+	return &Transport{
+		lock: &sync.Mutex{},
+	}
+}
+
+// On programs a response for the requests with the given method and path. The path can contain
+// the wildcards supported by the path.Match function of the standard library, for example
+// `/api/clusters_mgmt/v1/clusters/*`. Responses are matched in the order that they were
+// programmed. By default the response is used for all the matching requests, use the Times or Once
+// methods of the returned stub to limit that. For example:
+//
+//	transport.On(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123").
+//		Respond(http.StatusOK, `{"id": "123", "state": "ready"}`)
+func (t *Transport) On(method, pattern string) *Stub {
+	stub := &Stub{
+		lock:    t.lock,
+		method:  method,
+		pattern: pattern,
+		status:  http.StatusOK,
+		header:  http.Header{},
+	}
+	t.lock.Lock()
+	t.stubs = append(t.stubs, stub)
+	t.lock.Unlock()
+	return stub
+}
+
+// Respond sets the status code and the body of the response.
+func (s *Stub) Respond(status int, body string) *Stub {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.status = status
+	s.body = []byte(body)
+	return s
+}
+
+// RespondFunc sets a function that will be called to calculate the status code and the body of the
+// response for each request.
+func (s *Stub) RespondFunc(handler func(call *Call) (status int, body string)) *Stub {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handler = handler
+	return s
+}
+
+// Header adds a header to the response.
+func (s *Stub) Header(name, value string) *Stub {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.header.Add(name, value)
+	return s
+}
+
+// Fail makes the transport return the given error instead of a response, simulating a network
+// failure.
+func (s *Stub) Fail(err error) *Stub {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.err = err
+	return s
+}
+
+// Times sets the number of requests that the response will be used for. After that it will be
+// ignored. Zero, the default, means no limit.
+func (s *Stub) Times(value int) *Stub {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.times = value
+	return s
+}
+
+// Once is equivalent to Times(1).
+func (s *Stub) Once() *Stub {
+	return s.Times(1)
+}
+
+// Calls returns a copy of the list of requests received by the transport, in the order they were
+// received.
+func (t *Transport) Calls() []*Call {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	result := make([]*Call, len(t.calls))
+	copy(result, t.calls)
+	return result
+}
+
+// CallsTo returns the requests received with the given method and path. The path can contain the
+// same wildcards as the On method.
+func (t *Transport) CallsTo(method, pattern string) []*Call {
+	var result []*Call
+	for _, call := range t.Calls() {
+		if matches(method, pattern, call) {
+			result = append(result, call)
+		}
+	}
+	return result
+}
+
+// Reset removes all the programmed responses and all the recorded requests.
+func (t *Transport) Reset() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.stubs = nil
+	t.calls = nil
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *Transport) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	// Record the call:
+	call := &Call{
+		Method: request.Method,
+		Path:   request.URL.Path,
+		Query:  request.URL.Query(),
+		Header: request.Header.Clone(),
+	}
+	if request.Body != nil {
+		call.Body, err = io.ReadAll(request.Body)
+		if err != nil {
+			return
+		}
+		err = request.Body.Close()
+		if err != nil {
+			return
+		}
+	}
+	t.lock.Lock()
+	t.calls = append(t.calls, call)
+	var stub *Stub
+	for _, candidate := range t.stubs {
+		if candidate.times > 0 && candidate.used >= candidate.times {
+			continue
+		}
+		if matches(candidate.method, candidate.pattern, call) {
+			stub = candidate
+			stub.used++
+			break
+		}
+	}
+	t.lock.Unlock()
+
+	// Generate the response:
+	status := http.StatusNotFound
+	header := http.Header{}
+	var body []byte
+	if stub == nil {
+		body = notFoundBody(call)
+	} else {
+		stub.lock.Lock()
+		status = stub.status
+		header = stub.header.Clone()
+		body = stub.body
+		handler := stub.handler
+		err = stub.err
+		stub.lock.Unlock()
+		if err != nil {
+			return
+		}
+		if handler != nil {
+			var text string
+			status, text = handler(call)
+			body = []byte(text)
+		}
+	}
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "application/json")
+	}
+	response = &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+	return
+}
+
+// matches checks if the call matches the given method and path pattern.
+func matches(method, pattern string, call *Call) bool {
+	if method != call.Method {
+		return false
+	}
+	matched, err := path.Match(pattern, call.Path)
+	return err == nil && matched
+}
+
+// notFoundBody generates the body of the error response returned for requests that don't match
+// any programmed response.
+func notFoundBody(call *Call) []byte {
+	body, _ := json.Marshal(map[string]interface{}{
+		"kind": "Error",
+		"id":   "404",
+		"code": "MOCKS-404",
+		"reason": fmt.Sprintf(
+			"No response has been programmed for '%s %s'",
+			call.Method, call.Path,
+		),
+	})
+	return body
+}
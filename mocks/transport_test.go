@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mocks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+)
+
+var _ = Describe("Transport", func() {
+	var ctx context.Context
+	var transport *Transport
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = NewTransport()
+	})
+
+	It("Returns programmed response", func() {
+		transport.On(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123").
+			Respond(http.StatusOK, `{
+				"id": "123",
+				"state": "ready"
+			}`)
+		response, err := transport.ClustersMgmt().V1().Clusters().Cluster("123").Get().
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().ID()).To(Equal("123"))
+		Expect(response.Body().State()).To(Equal(cmv1.ClusterStateReady))
+	})
+
+	It("Supports wildcards", func() {
+		transport.On(http.MethodGet, "/api/clusters_mgmt/v1/clusters/*").
+			Respond(http.StatusOK, `{
+				"state": "ready"
+			}`)
+		client := transport.ClustersMgmt().V1()
+		for _, id := range []string{"123", "456"} {
+			response, err := client.Clusters().Cluster(id).Get().SendContext(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Body().State()).To(Equal(cmv1.ClusterStateReady))
+		}
+	})
+
+	It("Uses responses in order", func() {
+		transport.On(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123").
+			Once().
+			Respond(http.StatusOK, `{"state": "installing"}`)
+		transport.On(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123").
+			Respond(http.StatusOK, `{"state": "ready"}`)
+		resource := transport.ClustersMgmt().V1().Clusters().Cluster("123")
+		response, err := resource.Get().SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().State()).To(Equal(cmv1.ClusterStateInstalling))
+		response, err = resource.Get().SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().State()).To(Equal(cmv1.ClusterStateReady))
+	})
+
+	It("Returns not found error for requests that weren't programmed", func() {
+		_, err := transport.ClustersMgmt().V1().Clusters().Cluster("123").Get().
+			SendContext(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, ocmerrors.ErrNotFound)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("GET /api/clusters_mgmt/v1/clusters/123"))
+	})
+
+	It("Records the calls", func() {
+		transport.On(http.MethodPost, "/api/clusters_mgmt/v1/clusters").
+			RespondFunc(func(call *Call) (int, string) {
+				return http.StatusCreated, `{"id": "123"}`
+			})
+		cluster, err := cmv1.NewCluster().Name("my").Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = transport.ClustersMgmt().V1().Clusters().Add().
+			Parameter("dryRun", true).
+			Body(cluster).
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		calls := transport.CallsTo(http.MethodPost, "/api/clusters_mgmt/v1/clusters")
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].Query.Get("dryRun")).To(Equal("true"))
+		Expect(calls[0].Body).To(MatchJSON(`{
+			"kind": "Cluster",
+			"name": "my"
+		}`))
+	})
+
+	It("Simulates network errors", func() {
+		failure := errors.New("connection refused")
+		transport.On(http.MethodGet, "/api/clusters_mgmt/v1/clusters").Fail(failure)
+		_, err := transport.ClustersMgmt().V1().Clusters().List().SendContext(ctx)
+		Expect(errors.Is(err, failure)).To(BeTrue())
+	})
+
+	It("Generates bodies from model objects", func() {
+		cluster, err := cmv1.NewCluster().ID("123").Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(JSON(cmv1.MarshalCluster, cluster)).To(MatchJSON(`{
+			"kind": "Cluster",
+			"id": "123"
+		}`))
+	})
+})
+
+var _ = Describe("Connection", func() {
+	It("Sends requests to the transport", func() {
+		transport := NewTransport()
+		transport.On(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123").
+			Respond(http.StatusOK, `{"id": "123"}`)
+		connection, err := NewConnection(transport, logger)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err := connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		response, err := connection.ClustersMgmt().V1().Clusters().Cluster("123").Get().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().ID()).To(Equal("123"))
+		Expect(transport.Calls()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that calculate the differences between objects.
+
+package objectdiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Operation describes how an attribute changed.
+type Operation string
+
+// Supported operations:
+const (
+	// OperationAdd means that the attribute doesn't exist in the old object.
+	OperationAdd Operation = "add"
+
+	// OperationRemove means that the attribute doesn't exist in the new object.
+	OperationRemove Operation = "remove"
+
+	// OperationReplace means that the attribute exists in both objects with different values.
+	OperationReplace Operation = "replace"
+)
+
+// Change describes the difference between the values of one attribute.
+type Change struct {
+	// Operation indicates if the attribute was added, removed or replaced.
+	Operation Operation
+
+	// Path contains the names of the JSON attributes from the root of the object to the
+	// changed attribute. For example, the path of the number of compute nodes of a cluster is
+	// `nodes` followed by `compute`.
+	Path []string
+
+	// Old is the value in the old object, as decoded from JSON. It will be nil for added
+	// attributes. Numbers are represented with the json.Number type.
+	Old interface{}
+
+	// New is the value in the new object, as decoded from JSON. It will be nil for removed
+	// attributes. Numbers are represented with the json.Number type.
+	New interface{}
+}
+
+// String returns a short description of the change, suitable for logs. For example:
+//
+//	nodes.compute: 3 -> 5
+func (c *Change) String() string {
+	switch c.Operation {
+	case OperationAdd:
+		return fmt.Sprintf("%s: added %s", c.PathString(), format(c.New))
+	case OperationRemove:
+		return fmt.Sprintf("%s: removed %s", c.PathString(), format(c.Old))
+	default:
+		return fmt.Sprintf("%s: %s -> %s", c.PathString(), format(c.Old), format(c.New))
+	}
+}
+
+// PathString returns the path of the changed attribute with the names separated by dots.
+func (c *Change) PathString() string {
+	return strings.Join(c.Path, ".")
+}
+
+// Diff compares two objects and returns the list of attributes that are different, sorted by path.
+// The marshal function is the one that writes the objects as JSON, usually one of the generated
+// functions of the model. For example, to compare two clusters:
+//
+//	changes, err := objectdiff.Diff(current, desired, cmv1.MarshalCluster)
+//
+// Objects are compared attribute by attribute recursively. Lists are compared as a whole, so if
+// any item is different the change will contain the complete old and new lists. Either object can
+// be nil, which is equivalent to an object without attributes.
+func Diff[T any](a, b T, marshal func(T, io.Writer) error) (result []*Change, err error) {
+	aData, err := marshalObject(a, marshal)
+	if err != nil {
+		return
+	}
+	bData, err := marshalObject(b, marshal)
+	if err != nil {
+		return
+	}
+	result, err = DiffJSON(aData, bData)
+	return
+}
+
+// DiffJSON is like Diff, but compares two JSON documents directly.
+func DiffJSON(a, b []byte) (result []*Change, err error) {
+	aValue, err := decode(a)
+	if err != nil {
+		return
+	}
+	bValue, err := decode(b)
+	if err != nil {
+		return
+	}
+	result = compare(nil, aValue, bValue, nil)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].PathString() < result[j].PathString()
+	})
+	return
+}
+
+// Patch returns a JSON merge patch, as described in RFC 7386, that contains only the changed
+// attributes. Removed attributes are set to null. The result can be used as the body of an update
+// request, for example:
+//
+//	data, err := objectdiff.Patch(changes)
+//	...
+//	patch, err := cmv1.UnmarshalCluster(data)
+//	...
+//	_, err = client.Update().Body(patch).SendContext(ctx)
+//
+// Note that the generated unmarshal functions ignore null values, so removals can only be sent
+// using the JSON document directly.
+func Patch(changes []*Change) (result []byte, err error) {
+	patch := map[string]interface{}{}
+	for _, change := range changes {
+		if len(change.Path) == 0 {
+			err = fmt.Errorf("can't create patch for change without path")
+			return
+		}
+		object := patch
+		for _, name := range change.Path[:len(change.Path)-1] {
+			next, ok := object[name].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				object[name] = next
+			}
+			object = next
+		}
+		object[change.Path[len(change.Path)-1]] = change.New
+	}
+	result, err = json.Marshal(patch)
+	return
+}
+
+// marshalObject writes the given object as JSON using the given function. Nil objects are
+// converted to an empty JSON object.
+func marshalObject[T any](object T, marshal func(T, io.Writer) error) (result []byte, err error) {
+	value := reflect.ValueOf(&object).Elem()
+	if value.Kind() == reflect.Pointer && value.IsNil() {
+		result = []byte("{}")
+		return
+	}
+	buffer := &bytes.Buffer{}
+	err = marshal(object, buffer)
+	if err != nil {
+		err = fmt.Errorf("can't marshal object: %w", err)
+		return
+	}
+	result = buffer.Bytes()
+	return
+}
+
+// decode parses the given JSON document, preserving the text of numbers.
+func decode(data []byte) (result interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err = decoder.Decode(&result)
+	if err != nil {
+		err = fmt.Errorf("can't parse object: %w", err)
+	}
+	return
+}
+
+// compare appends to the given list the changes between the two values, which are located at the
+// given path.
+func compare(path []string, a, b interface{}, changes []*Change) []*Change {
+	aObject, aOK := a.(map[string]interface{})
+	bObject, bOK := b.(map[string]interface{})
+	if aOK && bOK {
+		for name, aItem := range aObject {
+			bItem, ok := bObject[name]
+			if !ok {
+				changes = append(changes, &Change{
+					Operation: OperationRemove,
+					Path:      appendPath(path, name),
+					Old:       aItem,
+				})
+				continue
+			}
+			changes = compare(appendPath(path, name), aItem, bItem, changes)
+		}
+		for name, bItem := range bObject {
+			_, ok := aObject[name]
+			if !ok {
+				changes = append(changes, &Change{
+					Operation: OperationAdd,
+					Path:      appendPath(path, name),
+					New:       bItem,
+				})
+			}
+		}
+		return changes
+	}
+	if !reflect.DeepEqual(a, b) {
+		changes = append(changes, &Change{
+			Operation: OperationReplace,
+			Path:      path,
+			Old:       a,
+			New:       b,
+		})
+	}
+	return changes
+}
+
+// appendPath returns a new path with the given name added, without modifying the original.
+func appendPath(path []string, name string) []string {
+	result := make([]string, len(path)+1)
+	copy(result, path)
+	result[len(path)] = name
+	return result
+}
+
+// format returns the JSON representation of the given value, for use in messages.
+func format(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectdiff
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Diff", func() {
+	It("Returns nothing for equal objects", func() {
+		a, err := cmv1.NewCluster().Name("mycluster").Build()
+		Expect(err).ToNot(HaveOccurred())
+		b, err := cmv1.NewCluster().Name("mycluster").Build()
+		Expect(err).ToNot(HaveOccurred())
+		changes, err := Diff(a, b, cmv1.MarshalCluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Returns changed, added and removed attributes sorted by path", func() {
+		a, err := cmv1.NewCluster().
+			Name("mycluster").
+			MultiAZ(true).
+			Nodes(cmv1.NewClusterNodes().Compute(3)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		b, err := cmv1.NewCluster().
+			Name("mycluster").
+			Nodes(cmv1.NewClusterNodes().Compute(5)).
+			DisableUserWorkloadMonitoring(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		changes, err := Diff(a, b, cmv1.MarshalCluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(3))
+		Expect(changes[0].Operation).To(Equal(OperationAdd))
+		Expect(changes[0].Path).To(Equal([]string{"disable_user_workload_monitoring"}))
+		Expect(changes[0].New).To(Equal(true))
+		Expect(changes[1].Operation).To(Equal(OperationRemove))
+		Expect(changes[1].Path).To(Equal([]string{"multi_az"}))
+		Expect(changes[1].Old).To(Equal(true))
+		Expect(changes[2].Operation).To(Equal(OperationReplace))
+		Expect(changes[2].Path).To(Equal([]string{"nodes", "compute"}))
+		Expect(changes[2].Old).To(Equal(json.Number("3")))
+		Expect(changes[2].New).To(Equal(json.Number("5")))
+		Expect(changes[2].String()).To(Equal("nodes.compute: 3 -> 5"))
+	})
+
+	It("Compares lists as a whole", func() {
+		changes, err := DiffJSON(
+			[]byte(`{"zones": ["a", "b"]}`),
+			[]byte(`{"zones": ["a", "c"]}`),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].PathString()).To(Equal("zones"))
+		Expect(changes[0].Old).To(Equal([]interface{}{"a", "b"}))
+		Expect(changes[0].New).To(Equal([]interface{}{"a", "c"}))
+	})
+
+	It("Accepts nil objects", func() {
+		b, err := cmv1.NewCluster().Name("mycluster").Build()
+		Expect(err).ToNot(HaveOccurred())
+		changes, err := Diff(nil, b, cmv1.MarshalCluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(2))
+		Expect(changes[0].PathString()).To(Equal("kind"))
+		Expect(changes[1].PathString()).To(Equal("name"))
+		Expect(changes[1].String()).To(Equal(`name: added "mycluster"`))
+	})
+
+	It("Fails with invalid JSON", func() {
+		_, err := DiffJSON([]byte(`{}`), []byte(`junk`))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Patch", func() {
+	It("Contains only the changed attributes", func() {
+		changes, err := DiffJSON(
+			[]byte(`{
+				"name": "mycluster",
+				"multi_az": true,
+				"nodes": {
+					"compute": 3,
+					"infra": 2
+				}
+			}`),
+			[]byte(`{
+				"name": "mycluster",
+				"nodes": {
+					"compute": 5,
+					"infra": 2
+				}
+			}`),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		patch, err := Patch(changes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patch).To(MatchJSON(`{
+			"multi_az": null,
+			"nodes": {
+				"compute": 5
+			}
+		}`))
+	})
+
+	It("Can be used as the body of an update", func() {
+		a, err := cmv1.NewMachinePool().ID("mypool").Replicas(3).Build()
+		Expect(err).ToNot(HaveOccurred())
+		b, err := cmv1.NewMachinePool().ID("mypool").Replicas(4).Build()
+		Expect(err).ToNot(HaveOccurred())
+		changes, err := Diff(a, b, cmv1.MarshalMachinePool)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := Patch(changes)
+		Expect(err).ToNot(HaveOccurred())
+		patch, err := cmv1.UnmarshalMachinePool(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patch.Replicas()).To(Equal(4))
+		_, ok := patch.GetID()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Returns empty object when there are no changes", func() {
+		patch, err := Patch(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patch).To(MatchJSON(`{}`))
+	})
+})
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the typed clients of the OSD fleet management service.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	fmv1 "github.com/openshift-online/ocm-sdk-go/osdfleetmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("OSD fleet management", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var connection *Connection
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = MakeTCPServer()
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+		server.Close()
+	})
+
+	It("Waits for a service cluster to reach a state", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "ServiceCluster",
+				"id": "123",
+				"status": "cluster_provisioning"
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "ServiceCluster",
+				"id": "123",
+				"status": "ready"
+			}`),
+		)
+		pollCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		response, err := connection.OSDFleetMgmt().V1().ServiceClusters().ServiceCluster("123").
+			Poll().
+			Interval(time.Millisecond).
+			Predicate(func(response *fmv1.ServiceClusterGetResponse) bool {
+				return response.Body().Status() == "ready"
+			}).
+			StartContext(pollCtx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().Status()).To(Equal("ready"))
+	})
+})
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for connections to servers mounted under a sub-path.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("URL path prefix", func() {
+	var server *ghttp.Server
+
+	BeforeEach(func() {
+		server = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Adds the path of the URL to the request paths", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/ocm/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL() + "/ocm").
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+		_, err = connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Ignores the trailing slash of the URL", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/ocm/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL() + "/ocm/").
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+		_, err = connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Uses the path of the alternative URL", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/ocm/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/accounts/api/accounts_mgmt/v1/accounts"),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()+"/ocm").
+			AlternativeURL("/api/accounts_mgmt", server.URL()+"/accounts").
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+		_, err = connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = connection.AccountsMgmt().V1().Accounts().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Preserves escaped characters of the request path", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+					Expect(r.RequestURI).To(Equal(
+						"/ocm/api/clusters_mgmt/v1/clusters/a%2Fb",
+					))
+				},
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL() + "/ocm").
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+		response, err := connection.DoGet(
+			context.Background(),
+			"/api/clusters_mgmt/v1/clusters/a%2Fb",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+	})
+})
@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types used to decompress response bodies, limit their size and measure
+// them.
+
+package sdk
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/openshift-online/ocm-sdk-go/metrics"
+)
+
+// ResponseTooLargeError is the error returned when reading a response body that is larger than the
+// limit configured with the MaxResponseSize method of the connection builder. Use the errors.As
+// function to check for it. For example:
+//
+//	var tooLarge *sdk.ResponseTooLargeError
+//	if errors.As(err, &tooLarge) {
+//		...
+//	}
+type ResponseTooLargeError struct {
+	// Limit is the maximum size of the response body, in bytes.
+	Limit int64
+}
+
+// Error is the implementation of the error interface.
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body is larger than the limit of %d bytes", e.Limit)
+}
+
+// payloadWrapper creates the transport wrapper that asks the server to compress the response
+// bodies and replaces them with bodies that decompress them, check that they don't exceed the given
+// maximum size and update the given payload metrics when they are closed. It must be the innermost
+// wrapper, below the ones added with the TransportWrapper method of the connection builder, so that
+// all the other wrappers, for example the ones that record or dump the interactions, see the
+// uncompressed bodies.
+func payloadWrapper(limit int64,
+	observer *metrics.PayloadMetrics) func(http.RoundTripper) http.RoundTripper {
+	return func(transport http.RoundTripper) http.RoundTripper {
+		return &payloadTransport{
+			transport: transport,
+			limit:     limit,
+			metrics:   observer,
+		}
+	}
+}
+
+// payloadTransport is the round tripper created by the payload wrapper.
+type payloadTransport struct {
+	transport http.RoundTripper
+	limit     int64
+	metrics   *metrics.PayloadMetrics
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *payloadTransport) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	if request.Header.Get("Accept-Encoding") == "" {
+		// Note that setting this explicitly disables the transparent decompression of the
+		// HTTP transport, so the body is decompressed later by this round tripper, which allows
+		// to measure the size of the payload before and after decompression.
+		request = request.Clone(request.Context())
+		request.Header.Set("Accept-Encoding", "gzip")
+	}
+	response, err = t.transport.RoundTrip(request)
+	if err != nil {
+		return
+	}
+	body := &payloadBody{
+		body:  response.Body,
+		limit: t.limit,
+	}
+	body.compressed.reader = response.Body
+	body.reader = &body.compressed
+	if strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		body.gzipped = true
+		response.Header.Del("Content-Encoding")
+		response.Header.Del("Content-Length")
+		response.ContentLength = -1
+		response.Uncompressed = true
+	}
+	if t.metrics != nil {
+		method := request.Method
+		path := request.URL.Path
+		code := response.StatusCode
+		body.observe = func(compressed, uncompressed int64) {
+			t.metrics.Observe(method, path, code, compressed, uncompressed)
+		}
+	}
+	response.Body = body
+	return
+}
+
+// payloadBody is a response body that decompresses the original body, limits its size and counts
+// the bytes read before and after decompression.
+type payloadBody struct {
+	body         io.ReadCloser
+	compressed   countingReader
+	reader       io.Reader
+	gzipped      bool
+	decompressor *gzip.Reader
+	uncompressed int64
+	limit        int64
+	err          error
+	observe      func(compressed, uncompressed int64)
+	once         sync.Once
+}
+
+// Read is the implementation of the io.Reader interface.
+func (b *payloadBody) Read(p []byte) (n int, err error) {
+	if b.err != nil {
+		err = b.err
+		return
+	}
+	if b.gzipped && b.decompressor == nil {
+		b.decompressor, err = gzip.NewReader(&b.compressed)
+		if err != nil {
+			b.err = err
+			return
+		}
+		b.reader = b.decompressor
+	}
+	n, err = b.reader.Read(p)
+	b.uncompressed += int64(n)
+	if b.limit > 0 && b.uncompressed > b.limit {
+		n -= int(b.uncompressed - b.limit)
+		b.uncompressed = b.limit
+		b.err = &ResponseTooLargeError{
+			Limit: b.limit,
+		}
+		err = b.err
+	}
+	return
+}
+
+// Close is the implementation of the io.Closer interface.
+func (b *payloadBody) Close() error {
+	if b.decompressor != nil {
+		b.decompressor.Close()
+	}
+	err := b.body.Close()
+	if b.observe != nil {
+		b.once.Do(func() {
+			b.observe(b.compressed.count, b.uncompressed)
+		})
+	}
+	return err
+}
+
+// countingReader is a reader that counts the bytes read from the underlying reader.
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+// Read is the implementation of the io.Reader interface.
+func (r *countingReader) Read(p []byte) (n int, err error) {
+	n, err = r.reader.Read(p)
+	r.count += int64(n)
+	return
+}
@@ -0,0 +1,504 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the pool of connections keyed by credentials.
+
+package sdk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/internal"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Credentials contains the credentials used by one of the connections of a pool. Only the fields
+// that are needed for the selected grant need to be set.
+type Credentials struct {
+	// User and Password are used for the resource owner password grant.
+	User     string
+	Password string
+
+	// ClientID and ClientSecret are used for the client credentials grant.
+	ClientID     string
+	ClientSecret string
+
+	// Tokens contains the access and refresh tokens, for example an offline token.
+	Tokens []string
+
+	// ServiceAccountID and ServiceAccountSecret are used for the client credentials grant with a
+	// service account. When they are set the offline token, if any, is ignored. See the
+	// ServiceAccount method of the connection builder for details.
+	ServiceAccountID     string
+	ServiceAccountSecret string
+}
+
+// ConnectionPoolBuilder contains the data and logic needed to create a pool of connections. Don't
+// create instances of this type directly, use the NewConnectionPool function instead.
+type ConnectionPoolBuilder struct {
+	logger      logging.Logger
+	template    *ConnectionBuilder
+	limit       int
+	idleTimeout time.Duration
+}
+
+// ConnectionPool creates and caches one connection for each set of credentials. This is intended for
+// services that send requests on behalf of many different accounts, for example using the offline
+// token of each tenant. Connections that haven't been used for a while are closed and removed from
+// the pool. All the connections of the pool share the HTTP transports, so the network connections
+// to the servers are reused, but each one has its own tokens. The pool counts the connections that
+// are in use, including the ones that are being created, so that the shared transports are closed
+// only when the last of them is released. Don't create instances of this type
+// directly, use the NewConnectionPool function instead.
+type ConnectionPool struct {
+	logger      logging.Logger
+	template    *ConnectionBuilder
+	limit       int
+	idleTimeout time.Duration
+	transports  *internal.SharedTransports
+	lock        *sync.Mutex
+	entries     map[string]*poolEntry
+	refs        int
+	closed      bool
+}
+
+// poolEntry contains a connection of the pool, the number of callers that are using it and the time
+// when it was used for the last time. The ready channel is closed when the connection has been
+// created, or when that failed, and then the connection or the error are set.
+type poolEntry struct {
+	ready      chan struct{}
+	connection *Connection
+	err        error
+	refs       int
+	used       time.Time
+	removed    bool
+}
+
+// ErrPoolFull is returned by the Get method of the connection pool when the limit of connections
+// has been reached and none of them is idle.
+var ErrPoolFull = errors.New("connection pool is full")
+
+// NewConnectionPool creates a builder that can then be used to configure and create a pool of
+// connections. For example:
+//
+//	pool, err := sdk.NewConnectionPool().
+//		Logger(logger).
+//		Template(sdk.NewConnectionBuilder().URL("https://api.openshift.com")).
+//		Limit(500).
+//		Build()
+//	if err != nil {
+//		...
+//	}
+//	defer pool.Close()
+//	connection, release, err := pool.Get(ctx, sdk.Credentials{
+//		Tokens: []string{offlineToken},
+//	})
+//	if err != nil {
+//		...
+//	}
+//	defer release()
+func NewConnectionPool() *ConnectionPoolBuilder {
+	return &ConnectionPoolBuilder{
+		limit:       DefaultPoolLimit,
+		idleTimeout: DefaultPoolIdleTimeout,
+	}
+}
+
+// Logger sets the logger that the pool and the connections will use to write to the log. This is
+// mandatory.
+func (b *ConnectionPoolBuilder) Logger(value logging.Logger) *ConnectionPoolBuilder {
+	b.logger = value
+	return b
+}
+
+// Template sets the connection builder that contains the settings that are common to all the
+// connections, like the URLs, the trusted CAs or the retry settings. The credentials set in the
+// template are replaced by the ones passed to the Get method. The template is copied when the pool
+// is built, so changing it later has no effect. The default is a builder created with the
+// NewConnectionBuilder function.
+func (b *ConnectionPoolBuilder) Template(value *ConnectionBuilder) *ConnectionPoolBuilder {
+	b.template = value
+	return b
+}
+
+// Limit sets the maximum number of connections of the pool. The default value is 100.
+func (b *ConnectionPoolBuilder) Limit(value int) *ConnectionPoolBuilder {
+	b.limit = value
+	return b
+}
+
+// IdleTimeout sets the time after which connections that haven't been used are closed and removed
+// from the pool. The default value is 30 minutes.
+func (b *ConnectionPoolBuilder) IdleTimeout(value time.Duration) *ConnectionPoolBuilder {
+	b.idleTimeout = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new pool.
+func (b *ConnectionPoolBuilder) Build() (result *ConnectionPool, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.limit <= 0 {
+		err = fmt.Errorf("limit should be greater than zero, but it is %d", b.limit)
+		return
+	}
+	if b.idleTimeout <= 0 {
+		err = fmt.Errorf(
+			"idle timeout should be greater than zero, but it is %s",
+			b.idleTimeout,
+		)
+		return
+	}
+
+	// Copy the template:
+	template := b.template
+	if template == nil {
+		template = NewConnectionBuilder()
+	}
+	if template.err != nil {
+		err = fmt.Errorf("template is not valid: %w", template.err)
+		return
+	}
+	template = template.copy()
+	if template.logger == nil {
+		template.logger = b.logger
+	}
+	transports := internal.NewSharedTransports()
+	template.sharedTransports = transports
+
+	// Create and populate the object:
+	result = &ConnectionPool{
+		logger:      b.logger,
+		template:    template,
+		limit:       b.limit,
+		idleTimeout: b.idleTimeout,
+		transports:  transports,
+		lock:        &sync.Mutex{},
+		entries:     map[string]*poolEntry{},
+	}
+	return
+}
+
+// Get returns the connection for the given credentials, creating it if it doesn't exist yet. The
+// returned connection is owned by the pool, so the caller must not close it. Instead, it must call
+// the returned release function when it no longer needs the connection. Connections that are in use
+// are never closed by the pool. If the limit of connections has been reached the connections that
+// are idle are removed, and if that isn't enough the ErrPoolFull error is returned.
+func (p *ConnectionPool) Get(ctx context.Context, credentials Credentials) (result *Connection,
+	release func(), err error) {
+	// Find the entry for the credentials, or add a new one if it doesn't exist yet:
+	key := credentials.key()
+	entry, created, idle, err := p.acquire(key)
+	p.close(ctx, idle)
+	if err != nil {
+		return
+	}
+
+	// Create the connection if we added the entry. This is done without holding the lock, so
+	// that callers using other credentials aren't blocked while the tokens are requested.
+	if created {
+		entry.connection, entry.err = p.build(ctx, credentials)
+		if entry.err != nil {
+			p.discard(key, entry)
+		}
+		close(entry.ready)
+	}
+
+	// Wait till the connection is ready:
+	select {
+	case <-entry.ready:
+	case <-ctx.Done():
+		p.release(ctx, entry)
+		err = ctx.Err()
+		return
+	}
+	if entry.err != nil {
+		p.release(ctx, entry)
+		err = fmt.Errorf("can't create connection: %w", entry.err)
+		return
+	}
+
+	// Return the connection and the function that releases it:
+	once := &sync.Once{}
+	result = entry.connection
+	release = func() {
+		once.Do(func() {
+			p.release(ctx, entry)
+		})
+	}
+	return
+}
+
+// acquire finds the entry for the given key and increases its number of references. If the entry
+// doesn't exist it adds a new one, evicting idle connections if needed, and then the caller is
+// responsible for creating the connection. The evicted entries are returned so that the caller can
+// close them without holding the lock.
+func (p *ConnectionPool) acquire(key string) (entry *poolEntry, created bool, idle []*poolEntry,
+	err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.closed {
+		err = errors.New("connection pool is closed")
+		return
+	}
+
+	// Use the existing entry if there is one:
+	now := time.Now()
+	entry, ok := p.entries[key]
+	if ok {
+		entry.refs++
+		entry.used = now
+		p.refs++
+		return
+	}
+
+	// Remove the idle connections if the pool is full:
+	if len(p.entries) >= p.limit {
+		idle = p.evict(now)
+	}
+	if len(p.entries) >= p.limit {
+		entry = nil
+		err = fmt.Errorf("%w, it already has %d connections", ErrPoolFull, len(p.entries))
+		return
+	}
+
+	// Add the new entry:
+	entry = &poolEntry{
+		ready: make(chan struct{}),
+		refs:  1,
+		used:  now,
+	}
+	p.entries[key] = entry
+	p.refs++
+	created = true
+	return
+}
+
+// build creates the connection for the given credentials.
+func (p *ConnectionPool) build(ctx context.Context, credentials Credentials) (result *Connection,
+	err error) {
+	builder := p.template.copy()
+	builder.user = credentials.User
+	builder.password = credentials.Password
+	builder.clientID = credentials.ClientID
+	builder.clientSecret = credentials.ClientSecret
+	builder.tokens = append([]string(nil), credentials.Tokens...)
+	builder.serviceAccountID = credentials.ServiceAccountID
+	builder.serviceAccountKey = credentials.ServiceAccountSecret
+	result, err = builder.BuildContext(ctx)
+	if err != nil {
+		return
+	}
+	p.logger.Debug(ctx, "Created connection, pool now has %d connections", p.Len())
+	return
+}
+
+// discard removes from the pool the given entry, if it is still the one stored for the key. This is
+// used when the connection couldn't be created, so that the next call tries again.
+func (p *ConnectionPool) discard(key string, entry *poolEntry) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.entries[key] == entry {
+		delete(p.entries, key)
+	}
+	entry.removed = true
+}
+
+// release decreases the number of references of the given entry, and closes the connection if it
+// has been removed from the pool and it is no longer in use. If the pool has been closed and this
+// was the last connection in use it also closes the shared transports.
+func (p *ConnectionPool) release(ctx context.Context, entry *poolEntry) {
+	p.lock.Lock()
+	entry.refs--
+	entry.used = time.Now()
+	p.refs--
+	unused := entry.removed && entry.refs == 0
+	last := p.closed && p.refs == 0
+	p.lock.Unlock()
+	if unused {
+		p.close(ctx, []*poolEntry{entry})
+	}
+	if last {
+		p.transports.Close()
+	}
+}
+
+// Remove closes and removes from the pool the connection for the given credentials, if it exists.
+// This is intended for situations where the credentials are no longer valid, for example when a
+// tenant is deleted. If the connection is in use it is closed when it is released.
+func (p *ConnectionPool) Remove(ctx context.Context, credentials Credentials) error {
+	entry := p.remove(credentials.key())
+	if entry == nil {
+		return nil
+	}
+	return p.closeEntries([]*poolEntry{entry})
+}
+
+// remove removes from the pool the entry for the given key. It returns the entry if it exists and
+// it isn't in use, so that the caller can close it.
+func (p *ConnectionPool) remove(key string) *poolEntry {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	entry, ok := p.entries[key]
+	if !ok {
+		return nil
+	}
+	delete(p.entries, key)
+	entry.removed = true
+	if entry.refs > 0 {
+		return nil
+	}
+	return entry
+}
+
+// Len returns the number of connections that are currently in the pool.
+func (p *ConnectionPool) Len() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.entries)
+}
+
+// Sweep closes and removes the connections that have been idle for longer than the idle timeout.
+// This is done automatically when the pool is full, but applications that want to release resources
+// sooner can call it periodically.
+func (p *ConnectionPool) Sweep(ctx context.Context) {
+	p.lock.Lock()
+	idle := p.evict(time.Now())
+	p.lock.Unlock()
+	p.close(ctx, idle)
+}
+
+// Close closes all the connections of the pool. Connections that are in use, or that are being
+// created, are closed when they are released, and the shared transports are closed when the last of
+// them is released. The pool can't be used after this.
+func (p *ConnectionPool) Close() error {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return nil
+	}
+	p.closed = true
+	var unused []*poolEntry
+	for key, entry := range p.entries {
+		delete(p.entries, key)
+		entry.removed = true
+		if entry.refs == 0 {
+			unused = append(unused, entry)
+		}
+	}
+	last := p.refs == 0
+	p.lock.Unlock()
+	err := p.closeEntries(unused)
+	if last {
+		p.transports.Close()
+	}
+	return err
+}
+
+// evict removes the connections that are idle and returns them so that the caller can close them
+// after releasing the lock. It must be called with the lock held.
+func (p *ConnectionPool) evict(now time.Time) (idle []*poolEntry) {
+	for key, entry := range p.entries {
+		if entry.refs > 0 || now.Sub(entry.used) < p.idleTimeout {
+			continue
+		}
+		delete(p.entries, key)
+		entry.removed = true
+		idle = append(idle, entry)
+	}
+	return
+}
+
+// close closes the connections of the given entries, writing to the log the errors.
+func (p *ConnectionPool) close(ctx context.Context, entries []*poolEntry) {
+	err := p.closeEntries(entries)
+	if err != nil {
+		p.logger.Error(ctx, "Can't close idle connection: %v", err)
+	}
+}
+
+// closeEntries closes the connections of the given entries. It must be called without holding the
+// lock, as closing a connection may need to wait for requests in progress.
+func (p *ConnectionPool) closeEntries(entries []*poolEntry) error {
+	var errs []error
+	for _, entry := range entries {
+		if entry.connection == nil {
+			continue
+		}
+		err := entry.connection.Close()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// key calculates the key used to store the connection for the credentials in the pool. The values
+// are hashed so that the pool doesn't keep copies of the secrets.
+func (c Credentials) key() string {
+	tokens := append([]string(nil), c.Tokens...)
+	sort.Strings(tokens)
+	hash := sha256.New()
+	for _, value := range append(
+		[]string{
+			c.User, c.Password, c.ClientID, c.ClientSecret,
+			c.ServiceAccountID, c.ServiceAccountSecret,
+		},
+		tokens...,
+	) {
+		fmt.Fprintf(hash, "%d:%s;", len(value), value)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// copy creates a copy of the builder that can be modified without affecting the original.
+func (b *ConnectionBuilder) copy() *ConnectionBuilder {
+	result := *b
+	result.urlTable = map[string]string{}
+	for prefix, base := range b.urlTable {
+		result.urlTable[prefix] = base
+	}
+	result.headers = b.headers.Clone()
+	result.trustedCAs = append([]interface{}(nil), b.trustedCAs...)
+	result.tokens = append([]string(nil), b.tokens...)
+	result.scopes = append([]string(nil), b.scopes...)
+	result.transportWrappers = append(
+		[]func(http.RoundTripper) http.RoundTripper(nil),
+		b.transportWrappers...,
+	)
+	return &result
+}
+
+// Default values:
+const (
+	// DefaultPoolLimit is the default maximum number of connections of a pool.
+	DefaultPoolLimit = 100
+
+	// DefaultPoolIdleTimeout is the default time after which idle connections are removed from a
+	// pool.
+	DefaultPoolIdleTimeout = 30 * time.Minute
+)
@@ -0,0 +1,502 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the connection pool.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Connection pool", func() {
+	var (
+		ctx    context.Context
+		server *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Can't be created without a logger", func() {
+		_, err := NewConnectionPool().Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("logger"))
+	})
+
+	It("Can't be created with a negative limit", func() {
+		_, err := NewConnectionPool().
+			Logger(logger).
+			Limit(-1).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("limit"))
+	})
+
+	It("Uses a different connection for each set of credentials", func() {
+		// Create the tokens:
+		firstToken := MakeTokenStringWithClaims(jwt.MapClaims{
+			"username": "first",
+		})
+		secondToken := MakeTokenStringWithClaims(jwt.MapClaims{
+			"username": "second",
+		})
+
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "Bearer "+firstToken),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "Bearer "+secondToken),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Template(NewConnectionBuilder().URL(server.URL())).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get the connections and send the requests:
+		first, releaseFirst, err := pool.Get(ctx, Credentials{
+			Tokens: []string{firstToken},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer releaseFirst()
+		second, releaseSecond, err := pool.Get(ctx, Credentials{
+			Tokens: []string{secondToken},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer releaseSecond()
+		Expect(second).ToNot(BeIdenticalTo(first))
+		Expect(second.URL()).To(Equal(server.URL()))
+		_, err = first.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = second.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.Len()).To(Equal(2))
+	})
+
+	It("Reuses the connection for the same credentials", func() {
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get the connections:
+		credentials := Credentials{
+			ClientID:     "myclient",
+			ClientSecret: "mysecret",
+		}
+		first, releaseFirst, err := pool.Get(ctx, credentials)
+		Expect(err).ToNot(HaveOccurred())
+		defer releaseFirst()
+		second, releaseSecond, err := pool.Get(ctx, credentials)
+		Expect(err).ToNot(HaveOccurred())
+		defer releaseSecond()
+		Expect(second).To(BeIdenticalTo(first))
+		Expect(pool.Len()).To(Equal(1))
+		id, secret := first.Client()
+		Expect(id).To(Equal("myclient"))
+		Expect(secret).To(Equal("mysecret"))
+	})
+
+	It("Fails when the limit is reached", func() {
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Limit(1).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get the connections:
+		_, release, err := pool.Get(ctx, Credentials{
+			ClientID:     "first",
+			ClientSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer release()
+		_, _, err = pool.Get(ctx, Credentials{
+			ClientID:     "second",
+			ClientSecret: "secret",
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, ErrPoolFull)).To(BeTrue())
+	})
+
+	It("Evicts idle connections when the limit is reached", func() {
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Limit(1).
+			IdleTimeout(10 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get the connections:
+		first, release, err := pool.Get(ctx, Credentials{
+			ClientID:     "first",
+			ClientSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		release()
+		time.Sleep(20 * time.Millisecond)
+		_, release, err = pool.Get(ctx, Credentials{
+			ClientID:     "second",
+			ClientSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer release()
+		Expect(pool.Len()).To(Equal(1))
+
+		// Check that the evicted connection has been closed:
+		_, err = first.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("closed"))
+	})
+
+	It("Removes idle connections when swept", func() {
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			IdleTimeout(10 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get a connection and wait till it is idle:
+		_, release, err := pool.Get(ctx, Credentials{
+			User:     "myuser",
+			Password: "mypassword",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		release()
+		time.Sleep(20 * time.Millisecond)
+		pool.Sweep(ctx)
+		Expect(pool.Len()).To(BeZero())
+	})
+
+	It("Removes the connection for the given credentials", func() {
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get and remove the connection:
+		credentials := Credentials{
+			User:     "myuser",
+			Password: "mypassword",
+		}
+		_, release, err := pool.Get(ctx, credentials)
+		Expect(err).ToNot(HaveOccurred())
+		release()
+		err = pool.Remove(ctx, credentials)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.Len()).To(BeZero())
+	})
+
+	It("Can't be used after closing it", func() {
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = pool.Close()
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = pool.Get(ctx, Credentials{
+			User:     "myuser",
+			Password: "mypassword",
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Doesn't evict connections that are in use", func() {
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Limit(1).
+			IdleTimeout(10 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get a connection and keep it after the idle timeout:
+		first, release, err := pool.Get(ctx, Credentials{
+			ClientID:     "first",
+			ClientSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		time.Sleep(20 * time.Millisecond)
+
+		// Check that the connection isn't evicted:
+		_, _, err = pool.Get(ctx, Credentials{
+			ClientID:     "second",
+			ClientSecret: "secret",
+		})
+		Expect(errors.Is(err, ErrPoolFull)).To(BeTrue())
+		id, _ := first.Client()
+		Expect(id).To(Equal("first"))
+
+		// Check that it is evicted once released:
+		release()
+		time.Sleep(20 * time.Millisecond)
+		_, release, err = pool.Get(ctx, Credentials{
+			ClientID:     "second",
+			ClientSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		release()
+	})
+
+	It("Closes removed connections when they are released", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Template(NewConnectionBuilder().URL(server.URL())).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get the connection and remove it while it is in use:
+		credentials := Credentials{
+			Tokens: []string{MakeTokenString("Bearer", 5*time.Minute)},
+		}
+		connection, release, err := pool.Get(ctx, credentials)
+		Expect(err).ToNot(HaveOccurred())
+		err = pool.Remove(ctx, credentials)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.Len()).To(BeZero())
+
+		// Check that it can still be used:
+		_, err = connection.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check that it is closed once released:
+		release()
+		_, err = connection.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("closed"))
+	})
+
+	It("Keeps connections in use working after closing the pool", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Template(NewConnectionBuilder().URL(server.URL())).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Get the connection and close the pool while it is in use:
+		connection, release, err := pool.Get(ctx, Credentials{
+			Tokens: []string{MakeTokenString("Bearer", 5*time.Minute)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		err = pool.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check that it can still be used:
+		_, err = connection.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check that it is closed once released:
+		release()
+		_, err = connection.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("closed"))
+	})
+
+	It("Uses a different connection for each service account", func() {
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Get the connections:
+		first, releaseFirst, err := pool.Get(ctx, Credentials{
+			ServiceAccountID:     "first",
+			ServiceAccountSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer releaseFirst()
+		second, releaseSecond, err := pool.Get(ctx, Credentials{
+			ServiceAccountID:     "second",
+			ServiceAccountSecret: "secret",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer releaseSecond()
+		Expect(second).ToNot(BeIdenticalTo(first))
+		Expect(pool.Len()).To(Equal(2))
+	})
+
+	It("Shares the network connections", func() {
+		// Prepare the server so that it saves the remote addresses:
+		var addresses []string
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			addresses = append(addresses, r.RemoteAddr)
+		}
+		server.AppendHandlers(
+			ghttp.CombineHandlers(handler, RespondWithJSON(http.StatusOK, `{}`)),
+			ghttp.CombineHandlers(handler, RespondWithJSON(http.StatusOK, `{}`)),
+		)
+
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Template(NewConnectionBuilder().URL(server.URL())).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Send a request with each connection:
+		for _, username := range []string{"first", "second"} {
+			token := MakeTokenStringWithClaims(jwt.MapClaims{
+				"username": username,
+			})
+			connection, release, err := pool.Get(ctx, Credentials{
+				Tokens: []string{token},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = connection.Get().Path("/api/clusters_mgmt/v1/clusters").SendContext(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			release()
+		}
+
+		// Check that both requests used the same network connection:
+		Expect(addresses).To(HaveLen(2))
+		Expect(addresses[1]).To(Equal(addresses[0]))
+	})
+
+	It("Doesn't block other credentials while creating a connection", func() {
+		// Prepare the token server so that it blocks till the test finishes:
+		oidServer := MakeTCPServer()
+		defer oidServer.Close()
+		blocked := make(chan struct{})
+		defer close(blocked)
+		oidServer.AppendHandlers(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-blocked
+			}),
+		)
+
+		// Create the pool:
+		pool, err := NewConnectionPool().
+			Logger(logger).
+			Template(
+				NewConnectionBuilder().
+					URL(server.URL()).
+					TokenURL(oidServer.URL()).
+					RetryLimit(0).
+					EagerAuth(true),
+			).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = pool.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Start creating a connection that needs to request the tokens:
+		slowCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			defer GinkgoRecover()
+			_, _, err := pool.Get(slowCtx, Credentials{
+				ClientID:     "myclient",
+				ClientSecret: "mysecret",
+			})
+			Expect(err).To(HaveOccurred())
+		}()
+		Eventually(pool.Len).Should(Equal(1))
+
+		// Check that a connection for other credentials can be created meanwhile:
+		fastCtx, fastCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer fastCancel()
+		_, release, err := pool.Get(fastCtx, Credentials{
+			Tokens: []string{MakeTokenString("Bearer", 5*time.Minute)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		release()
+	})
+})
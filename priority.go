@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to set the priority of requests.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/openshift-online/ocm-sdk-go/internal"
+)
+
+// Priority is the priority of a request. Use the WithPriority function to set it.
+type Priority = internal.Priority
+
+// Supported priorities:
+const (
+	// PriorityLow is intended for background traffic, like periodic reconciliation. When the
+	// connection is rate limited these requests are sent only when there are no requests with
+	// higher priority waiting, and they wait longer between retries.
+	PriorityLow = internal.PriorityLow
+
+	// PriorityNormal is the priority of requests that don't explicitly set one.
+	PriorityNormal = internal.PriorityNormal
+
+	// PriorityHigh is intended for interactive requests, like the ones triggered by a user
+	// interface. When the connection is rate limited these requests are sent before any other
+	// waiting request.
+	PriorityHigh = internal.PriorityHigh
+)
+
+// WithPriority returns a new context that sets the priority of the requests sent with it. For
+// example, to send a request with low priority:
+//
+//	ctx = sdk.WithPriority(ctx, sdk.PriorityLow)
+//	response, err := connection.ClustersMgmt().V1().Clusters().List().SendContext(ctx)
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return internal.ContextWithPriority(ctx, priority)
+}
+
+// PriorityFrom returns the priority stored in the given context, or PriorityNormal if the context
+// doesn't contain one.
+func PriorityFrom(ctx context.Context) Priority {
+	return internal.PriorityFromContext(ctx)
+}
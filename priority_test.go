@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for request priorities and rate limiting.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"  // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table" // nolint
+	. "github.com/onsi/gomega"              // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Priority", func() {
+	It("Is normal by default", func() {
+		Expect(PriorityFrom(context.Background())).To(Equal(PriorityNormal))
+	})
+
+	DescribeTable(
+		"Is stored in the context",
+		func(priority Priority) {
+			ctx := WithPriority(context.Background(), priority)
+			Expect(PriorityFrom(ctx)).To(Equal(priority))
+		},
+		Entry("Low", PriorityLow),
+		Entry("Normal", PriorityNormal),
+		Entry("High", PriorityHigh),
+	)
+})
+
+var _ = Describe("Rate limit", func() {
+	var (
+		server     *ghttp.Server
+		connection *Connection
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the server:
+		server = MakeTCPServer()
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			RateLimit(10, 1).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the server:
+		server.Close()
+	})
+
+	It("Delays requests that exceed the limit", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{}`),
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+
+		// Send the requests:
+		ctx := WithPriority(context.Background(), PriorityHigh)
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			_, err := connection.Get().
+				Path("/api/clusters_mgmt/v1/clusters").
+				SendContext(ctx)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+
+	It("Fails if the context is cancelled while waiting", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+
+		// Send the first request, that consumes the only token:
+		ctx := context.Background()
+		_, err := connection.Get().
+			Path("/api/clusters_mgmt/v1/clusters").
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Send the second request with a context that expires before the next token:
+		timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err = connection.Get().
+			Path("/api/clusters_mgmt/v1/clusters").
+			SendContext(timeout)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("rate limiter"))
+	})
+})
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the rate limit state of the connection.
+
+package sdk
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Rate limit state", func() {
+	var server *ghttp.Server
+
+	BeforeEach(func() {
+		server = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Reports the budget returned by the server", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{}`, http.Header{
+				"Content-Type":        []string{"application/json"},
+				"RateLimit-Limit":     []string{"100"},
+				"RateLimit-Remaining": []string{"99"},
+				"RateLimit-Reset":     []string{"60"},
+			}),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+
+		// Initially there is no information:
+		Expect(connection.RateLimitState().Services).To(BeEmpty())
+
+		// After the request the state should be updated:
+		_, err = connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+		state := connection.RateLimitState().Service("clusters_mgmt")
+		Expect(state).ToNot(BeNil())
+		Expect(state.Limit).To(Equal(100))
+		Expect(state.Remaining).To(Equal(99))
+		Expect(state.Reset).To(BeTemporally("~", time.Now().Add(time.Minute), 5*time.Second))
+
+		// Clones share the state:
+		clone, err := connection.Clone().Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer clone.Close()
+		Expect(clone.RateLimitState().Service("clusters_mgmt")).ToNot(BeNil())
+	})
+
+	It("Accepts auto tuning of the rate limit", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{}`, http.Header{
+				"Content-Type":        []string{"application/json"},
+				"RateLimit-Remaining": []string{"0"},
+				"RateLimit-Reset":     []string{"1"},
+			}),
+			RespondWithJSON(http.StatusOK, `{}`),
+		)
+		connection, err := NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			RateLimit(100, 10).
+			RateLimitAutoTune(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+
+		// The second request has to wait because the server reported that there are no
+		// remaining requests, and the window resets in one second:
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			_, err = connection.ClustersMgmt().V1().Clusters().List().Send()
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 500*time.Millisecond))
+	})
+})
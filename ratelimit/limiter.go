@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of a token bucket rate limiter that takes into account
+// the priority of the requests.
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/internal"
+)
+
+// limiter is a token bucket rate limiter. When there are no tokens available the callers wait in
+// one queue per priority, and tokens are given first to the callers in the queue of the highest
+// priority, so that requests with low priority can't starve requests with higher priority.
+type limiter struct {
+	lock       sync.Mutex
+	configured float64
+	rate       float64
+	burst      float64
+	tokens     float64
+	last       time.Time
+	queues     [3][]*waiter
+	scheduled  bool
+	now        func() time.Time
+}
+
+// waiter is a caller waiting for a token.
+type waiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+func newLimiter(rate float64, burst int) *limiter {
+	return &limiter{
+		configured: rate,
+		rate:       rate,
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		now:        time.Now,
+	}
+}
+
+// wait blocks till a token is available for a caller with the given priority, or till the context
+// is done.
+func (l *limiter) wait(ctx context.Context, priority internal.Priority) error {
+	l.lock.Lock()
+	l.refill()
+	index := queueIndex(priority)
+	if l.tokens >= 1 && !l.waiting(index) {
+		l.tokens--
+		l.lock.Unlock()
+		return nil
+	}
+	w := &waiter{
+		ready: make(chan struct{}),
+	}
+	l.queues[index] = append(l.queues[index], w)
+	l.schedule()
+	l.lock.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		if w.granted {
+			// The token was given to us right when the context was cancelled, so we
+			// can as well use it.
+			return nil
+		}
+		l.remove(index, w)
+		return ctx.Err()
+	}
+}
+
+// refill adds the tokens accumulated since the last time it was called. Must be called with the
+// lock acquired.
+func (l *limiter) refill() {
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+}
+
+// tune adjusts the rate so that the given number of remaining requests lasts till the given reset
+// time, as long as that doesn't exceed the configured rate. It also makes sure that no more than
+// the remaining requests can be sent at once.
+func (l *limiter) tune(remaining int, reset time.Time) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.refill()
+	rate := l.configured
+	if !reset.IsZero() {
+		window := reset.Sub(l.now()).Seconds()
+		if window > 0 {
+			budget := float64(remaining)
+			if budget < 1 {
+				// Allow one request when the window finishes, so that the next response
+				// tells us the new budget:
+				budget = 1
+			}
+			if budget/window < rate {
+				rate = budget / window
+			}
+		}
+	}
+	l.rate = rate
+	if l.tokens > float64(remaining) {
+		l.tokens = float64(remaining)
+	}
+}
+
+// currentRate returns the rate currently in use.
+func (l *limiter) currentRate() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.rate
+}
+
+// waiting checks if there are callers waiting with the given or higher priority. Must be called
+// with the lock acquired.
+func (l *limiter) waiting(index int) bool {
+	for i := index; i < len(l.queues); i++ {
+		if len(l.queues[i]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatch gives the available tokens to the waiting callers, starting with the highest priority.
+func (l *limiter) dispatch() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.scheduled = false
+	l.refill()
+	for i := len(l.queues) - 1; i >= 0; i-- {
+		for len(l.queues[i]) > 0 && l.tokens >= 1 {
+			w := l.queues[i][0]
+			l.queues[i] = l.queues[i][1:]
+			l.tokens--
+			w.granted = true
+			close(w.ready)
+		}
+	}
+	l.schedule()
+}
+
+// schedule arranges for the dispatch method to be called when the next token is available, if
+// there are waiting callers. Must be called with the lock acquired.
+func (l *limiter) schedule() {
+	if l.scheduled || !l.waiting(0) {
+		return
+	}
+	delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+	if delay < 0 {
+		delay = 0
+	}
+	l.scheduled = true
+	time.AfterFunc(delay, l.dispatch)
+}
+
+// remove removes a waiter from its queue. Must be called with the lock acquired.
+func (l *limiter) remove(index int, w *waiter) {
+	queue := l.queues[index]
+	for i, item := range queue {
+		if item == w {
+			l.queues[index] = append(queue[:i:i], queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// queueIndex returns the index of the queue for the given priority.
+func queueIndex(priority internal.Priority) int {
+	return int(priority - internal.PriorityLow)
+}
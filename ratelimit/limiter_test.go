@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the rate limiter.
+
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/openshift-online/ocm-sdk-go/internal"
+)
+
+var _ = Describe("Limiter", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("Allows the burst without waiting", func() {
+		limiter := newLimiter(1, 3)
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			err := limiter.wait(ctx, internal.PriorityNormal)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(time.Since(start)).To(BeNumerically("<", 100*time.Millisecond))
+	})
+
+	It("Waits when the burst is exhausted", func() {
+		limiter := newLimiter(10, 1)
+		err := limiter.wait(ctx, internal.PriorityNormal)
+		Expect(err).ToNot(HaveOccurred())
+		start := time.Now()
+		err = limiter.wait(ctx, internal.PriorityNormal)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+
+	It("Stops waiting when the context is cancelled", func() {
+		limiter := newLimiter(0.1, 1)
+		err := limiter.wait(ctx, internal.PriorityNormal)
+		Expect(err).ToNot(HaveOccurred())
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err = limiter.wait(timeout, internal.PriorityNormal)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(limiter.waiting(0)).To(BeFalse())
+	})
+
+	It("Gives tokens to high priority callers first", func() {
+		limiter := newLimiter(20, 1)
+		err := limiter.wait(ctx, internal.PriorityNormal)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start the low priority callers first, and then the high priority one:
+		var lock sync.Mutex
+		var order []internal.Priority
+		var group sync.WaitGroup
+		start := func(priority internal.Priority) {
+			group.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer group.Done()
+				err := limiter.wait(ctx, priority)
+				Expect(err).ToNot(HaveOccurred())
+				lock.Lock()
+				order = append(order, priority)
+				lock.Unlock()
+			}()
+			Eventually(func() bool {
+				limiter.lock.Lock()
+				defer limiter.lock.Unlock()
+				for _, w := range limiter.queues[queueIndex(priority)] {
+					if !w.granted {
+						return true
+					}
+				}
+				return false
+			}).Should(BeTrue())
+		}
+		start(internal.PriorityLow)
+		start(internal.PriorityLow)
+		start(internal.PriorityHigh)
+		group.Wait()
+		Expect(order).To(Equal([]internal.Priority{
+			internal.PriorityHigh,
+			internal.PriorityLow,
+			internal.PriorityLow,
+		}))
+	})
+})
+
+var _ = Describe("Transport wrapper", func() {
+	It("Can't be created without a logger", func() {
+		wrapper, err := NewTransportWrapper().
+			Rate(1).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(wrapper).To(BeNil())
+		Expect(err.Error()).To(ContainSubstring("logger"))
+	})
+
+	It("Can't be created without a rate", func() {
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(wrapper).To(BeNil())
+		Expect(err.Error()).To(ContainSubstring("rate"))
+	})
+
+	It("Limits the rate of requests", func() {
+		// Create the server:
+		server := ghttp.NewServer()
+		defer server.Close()
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, nil),
+			ghttp.RespondWith(http.StatusOK, nil),
+		)
+
+		// Create the client:
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Rate(10).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer wrapper.Close()
+		client := &http.Client{
+			Transport: wrapper.Wrap(http.DefaultTransport),
+		}
+
+		// Send the requests:
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			response, err := client.Get(server.URL())
+			Expect(err).ToNot(HaveOccurred())
+			response.Body.Close()
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+})
@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types and functions used to track the rate limit budget reported by the
+// server in the headers of the responses.
+
+package ratelimit
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+)
+
+// Names of the headers, besides the ones defined in the helpers package:
+const (
+	limitHeader       = "RateLimit-Limit"
+	legacyLimitHeader = "X-RateLimit-Limit"
+	resetHeader       = "RateLimit-Reset"
+	legacyResetHeader = "X-RateLimit-Reset"
+)
+
+// ServiceState is the rate limit budget of one service, as reported by the server in the last
+// response that contained rate limit headers.
+type ServiceState struct {
+	// Service is the name of the service, for example `clusters_mgmt`. It is empty for paths
+	// that don't start with `/api/`.
+	Service string
+
+	// Limit is the number of requests allowed in the current window, or zero if the server
+	// didn't report it.
+	Limit int
+
+	// Remaining is the number of requests remaining in the current window.
+	Remaining int
+
+	// Reset is the time when the current window finishes, or the zero time if the server didn't
+	// report it.
+	Reset time.Time
+
+	// Updated is the time when the response was received.
+	Updated time.Time
+}
+
+// State is the rate limit budget of all the services that have returned rate limit headers.
+type State struct {
+	// Services contains the state of each service, sorted by name.
+	Services []*ServiceState
+}
+
+// Service returns the state of the service with the given name, or nil if the server hasn't
+// reported the rate limit for that service.
+func (s *State) Service(name string) *ServiceState {
+	if s == nil {
+		return nil
+	}
+	for _, service := range s.Services {
+		if service.Service == name {
+			return service
+		}
+	}
+	return nil
+}
+
+// Tracker remembers the rate limit budget reported by the server for each service. It is safe for
+// concurrent use by multiple goroutines. Usually there is no need to use it directly, as the
+// connection creates one automatically; use the RateLimitState method of the connection instead.
+type Tracker struct {
+	lock     sync.Mutex
+	services map[string]*ServiceState
+	now      func() time.Time
+}
+
+// trackerRoundTripper is a round tripper that updates a tracker with the headers of the responses.
+type trackerRoundTripper struct {
+	owner     *Tracker
+	transport http.RoundTripper
+}
+
+// Make sure that we implement the interface:
+var _ http.RoundTripper = (*trackerRoundTripper)(nil)
+
+// NewTracker creates a new rate limit tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		services: map[string]*ServiceState{},
+		now:      time.Now,
+	}
+}
+
+// Wrap creates a new round tripper that wraps the given one and updates the tracker with the rate
+// limit headers of the responses.
+func (t *Tracker) Wrap(transport http.RoundTripper) http.RoundTripper {
+	return &trackerRoundTripper{
+		owner:     t,
+		transport: transport,
+	}
+}
+
+// Observe updates the tracker with the rate limit headers of the given response to a request for
+// the given path. Responses without rate limit headers are ignored.
+func (t *Tracker) Observe(path string, header http.Header) {
+	state, ok := parseState(header, t.now())
+	if !ok {
+		return
+	}
+	state.Service = serviceName(path)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.services[state.Service] = state
+}
+
+// State returns a snapshot of the rate limit budget of all the services. The returned object can
+// be modified by the caller.
+func (t *Tracker) State() *State {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	result := &State{
+		Services: make([]*ServiceState, 0, len(t.services)),
+	}
+	for _, service := range t.services {
+		item := *service
+		result.Services = append(result.Services, &item)
+	}
+	sort.Slice(result.Services, func(i, j int) bool {
+		return result.Services[i].Service < result.Services[j].Service
+	})
+	return result
+}
+
+// RoundTrip is the implementation of the round tripper interface.
+func (r *trackerRoundTripper) RoundTrip(request *http.Request) (response *http.Response,
+	err error) {
+	response, err = r.transport.RoundTrip(request)
+	if err != nil {
+		return
+	}
+	r.owner.Observe(request.URL.Path, response.Header)
+	return
+}
+
+// parseState extracts the rate limit budget from the given headers. The flag will be false if the
+// headers don't contain the number of remaining requests.
+func parseState(header http.Header, now time.Time) (result *ServiceState, ok bool) {
+	remaining, ok := helpers.RateLimitRemaining(header)
+	if !ok {
+		return
+	}
+	result = &ServiceState{
+		Remaining: remaining,
+		Updated:   now,
+	}
+	limit, present := parseNumber(header, limitHeader, legacyLimitHeader)
+	if present {
+		result.Limit = int(limit)
+	}
+	reset, present := parseNumber(header, resetHeader, legacyResetHeader)
+	if present {
+		// The standard header contains the number of seconds till the reset, but some servers
+		// use the legacy header to send the Unix time of the reset instead. Values that large
+		// can't be a reasonable number of seconds, so we assume they are times.
+		if reset > 1_000_000_000 {
+			result.Reset = time.Unix(reset, 0)
+		} else {
+			result.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return
+}
+
+// parseNumber returns the non negative integer at the beginning of the value of the first of the
+// given headers that is present. Anything after the first comma or semicolon, like the quota policy
+// parameters of the standard headers, is ignored.
+func parseNumber(header http.Header, names ...string) (result int64, ok bool) {
+	for _, name := range names {
+		text := header.Get(name)
+		if text == "" {
+			continue
+		}
+		end := strings.IndexAny(text, ",;")
+		if end != -1 {
+			text = text[:end]
+		}
+		value, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+		if err != nil || value < 0 {
+			return
+		}
+		result = value
+		ok = true
+		return
+	}
+	return
+}
+
+// serviceName extracts the name of the service from the given path. For example, for
+// `/api/clusters_mgmt/v1/clusters` it returns `clusters_mgmt`.
+func serviceName(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "api" {
+		return ""
+	}
+	return segments[1]
+}
@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the rate limit budget tracker.
+
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/openshift-online/ocm-sdk-go/internal"
+)
+
+var _ = Describe("Rate limit state", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("Parses the standard headers", func() {
+		state, ok := parseState(http.Header{
+			"Ratelimit-Limit":     []string{"100, 100;w=60"},
+			"Ratelimit-Remaining": []string{"42"},
+			"Ratelimit-Reset":     []string{"30"},
+		}, now)
+		Expect(ok).To(BeTrue())
+		Expect(state.Limit).To(Equal(100))
+		Expect(state.Remaining).To(Equal(42))
+		Expect(state.Reset).To(Equal(now.Add(30 * time.Second)))
+		Expect(state.Updated).To(Equal(now))
+	})
+
+	It("Parses the legacy headers with an absolute reset time", func() {
+		state, ok := parseState(http.Header{
+			"X-Ratelimit-Limit":     []string{"10"},
+			"X-Ratelimit-Remaining": []string{"5"},
+			"X-Ratelimit-Reset":     []string{"1792152060"},
+		}, now)
+		Expect(ok).To(BeTrue())
+		Expect(state.Limit).To(Equal(10))
+		Expect(state.Remaining).To(Equal(5))
+		Expect(state.Reset).To(Equal(time.Unix(1792152060, 0)))
+	})
+
+	It("Ignores responses without the remaining header", func() {
+		_, ok := parseState(http.Header{
+			"Ratelimit-Limit": []string{"100"},
+		}, now)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Tracks the state of each service", func() {
+		tracker := NewTracker()
+		tracker.now = func() time.Time {
+			return now
+		}
+		tracker.Observe("/api/clusters_mgmt/v1/clusters", http.Header{
+			"Ratelimit-Remaining": []string{"1"},
+		})
+		tracker.Observe("/api/accounts_mgmt/v1/accounts", http.Header{
+			"Ratelimit-Remaining": []string{"2"},
+		})
+		tracker.Observe("/api/accounts_mgmt/v1/accounts", http.Header{})
+		tracker.Observe("/api/accounts_mgmt/v1/subscriptions", http.Header{
+			"Ratelimit-Remaining": []string{"3"},
+		})
+		state := tracker.State()
+		Expect(state.Services).To(HaveLen(2))
+		Expect(state.Services[0].Service).To(Equal("accounts_mgmt"))
+		Expect(state.Services[0].Remaining).To(Equal(3))
+		Expect(state.Services[1].Service).To(Equal("clusters_mgmt"))
+		Expect(state.Service("clusters_mgmt").Remaining).To(Equal(1))
+		Expect(state.Service("service_logs")).To(BeNil())
+	})
+
+	It("Tracks the responses sent through the round tripper", func() {
+		server := ghttp.NewServer()
+		defer server.Close()
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, nil, http.Header{
+				"RateLimit-Remaining": []string{"7"},
+			}),
+		)
+		tracker := NewTracker()
+		client := &http.Client{
+			Transport: tracker.Wrap(http.DefaultTransport),
+		}
+		response, err := client.Get(server.URL() + "/api/clusters_mgmt/v1/clusters")
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		Expect(tracker.State().Service("clusters_mgmt").Remaining).To(Equal(7))
+	})
+})
+
+var _ = Describe("Limiter tuning", func() {
+	It("Reduces the rate to make the budget last till the reset", func() {
+		limiter := newLimiter(100, 10)
+		limiter.tune(10, time.Now().Add(10*time.Second))
+		Expect(limiter.currentRate()).To(BeNumerically("~", 1, 0.01))
+	})
+
+	It("Never exceeds the configured rate", func() {
+		limiter := newLimiter(1, 1)
+		limiter.tune(1000, time.Now().Add(time.Second))
+		Expect(limiter.currentRate()).To(Equal(1.0))
+	})
+
+	It("Waits for the reset when the budget is exhausted", func() {
+		ctx := context.Background()
+		limiter := newLimiter(100, 10)
+		limiter.tune(0, time.Now().Add(100*time.Millisecond))
+		start := time.Now()
+		err := limiter.wait(ctx, internal.PriorityNormal)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+
+	It("Is adjusted by the transport wrapper when enabled", func() {
+		server := ghttp.NewServer()
+		defer server.Close()
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, nil, http.Header{
+				"RateLimit-Remaining": []string{"5"},
+				"RateLimit-Reset":     []string{"10"},
+			}),
+		)
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Rate(100).
+			Burst(10).
+			AutoTune(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		defer wrapper.Close()
+		client := &http.Client{
+			Transport: wrapper.Wrap(http.DefaultTransport),
+		}
+		response, err := client.Get(server.URL())
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		Expect(wrapper.limiter.currentRate()).To(BeNumerically("~", 0.5, 0.01))
+	})
+})
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of a transport wrapper that limits the rate of requests.
+
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/internal"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// TransportWrapperBuilder contains the data and logic needed to build a new rate limiting
+// transport wrapper. Don't create objects of this type directly; use the NewTransportWrapper
+// function instead.
+type TransportWrapperBuilder struct {
+	logger   logging.Logger
+	rate     float64
+	burst    int
+	autoTune bool
+}
+
+// TransportWrapper contains the data and logic needed to wrap an HTTP round tripper with another
+// one that limits the rate of requests.
+type TransportWrapper struct {
+	logger   logging.Logger
+	limiter  *limiter
+	autoTune bool
+}
+
+// roundTripper is a round tripper that limits the rate of requests.
+type roundTripper struct {
+	owner     *TransportWrapper
+	transport http.RoundTripper
+}
+
+// Make sure that we implement the interface:
+var _ http.RoundTripper = (*roundTripper)(nil)
+
+// NewTransportWrapper creates a new builder that can then be used to configure and create a new
+// rate limiting transport wrapper. Usually there is no need to use it directly, as the RateLimit
+// method of the connection builder does it automatically.
+func NewTransportWrapper() *TransportWrapperBuilder {
+	return &TransportWrapperBuilder{}
+}
+
+// Logger sets the logger that the wrapper will use. This is mandatory.
+func (b *TransportWrapperBuilder) Logger(value logging.Logger) *TransportWrapperBuilder {
+	b.logger = value
+	return b
+}
+
+// Rate sets the number of requests per second that will be allowed. This is mandatory.
+func (b *TransportWrapperBuilder) Rate(value float64) *TransportWrapperBuilder {
+	b.rate = value
+	return b
+}
+
+// Burst sets the maximum number of requests that can be sent at once when there has been no
+// traffic for a while. The default value is one.
+func (b *TransportWrapperBuilder) Burst(value int) *TransportWrapperBuilder {
+	b.burst = value
+	return b
+}
+
+// AutoTune enables or disables adjusting the rate according to the rate limit headers returned by
+// the server. When enabled the rate is reduced so that the remaining requests reported by the
+// server last till the end of the current window, but it is never increased above the rate set
+// with the Rate method. Note that the limit is shared by all the services, so it follows the
+// budget reported in the most recent response. The default value is false.
+func (b *TransportWrapperBuilder) AutoTune(value bool) *TransportWrapperBuilder {
+	b.autoTune = value
+	return b
+}
+
+// Build uses the information stored in the builder to create a new transport wrapper.
+func (b *TransportWrapperBuilder) Build() (result *TransportWrapper, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.rate <= 0 {
+		err = fmt.Errorf("rate %f isn't valid, it should be greater than zero", b.rate)
+		return
+	}
+	if b.burst < 0 {
+		err = fmt.Errorf("burst %d isn't valid, it should be greater or equal than zero", b.burst)
+		return
+	}
+
+	// Set default values:
+	burst := b.burst
+	if burst == 0 {
+		burst = 1
+	}
+
+	// Create and populate the object:
+	result = &TransportWrapper{
+		logger:   b.logger,
+		limiter:  newLimiter(b.rate, burst),
+		autoTune: b.autoTune,
+	}
+	return
+}
+
+// Wrap creates a new round tripper that wraps the given one and limits the rate of requests.
+func (w *TransportWrapper) Wrap(transport http.RoundTripper) http.RoundTripper {
+	return &roundTripper{
+		owner:     w,
+		transport: transport,
+	}
+}
+
+// Close releases all the resources used by the wrapper.
+func (w *TransportWrapper) Close() error {
+	return nil
+}
+
+// RoundTrip is the implementation of the round tripper interface.
+func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	ctx := request.Context()
+	priority := internal.PriorityFromContext(ctx)
+	err = t.owner.limiter.wait(ctx, priority)
+	if err != nil {
+		err = fmt.Errorf("can't wait for rate limiter: %w", err)
+		return
+	}
+	response, err = t.transport.RoundTrip(request)
+	if err != nil || !t.owner.autoTune {
+		return
+	}
+	state, ok := parseState(response.Header, time.Now())
+	if !ok {
+		return
+	}
+	t.owner.limiter.tune(state.Remaining, state.Reset)
+	if t.owner.logger.DebugEnabled() {
+		t.owner.logger.Debug(
+			ctx,
+			"Adjusted rate limit to %.2f requests per second, server reports %d "+
+				"remaining requests",
+			t.owner.limiter.currentRate(), state.Remaining,
+		)
+	}
+	return
+}
@@ -0,0 +1,458 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of a transport wrapper that records the interactions with
+// the server to files and replays them later.
+
+package recording
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Mode is the mode of operation of the transport wrapper.
+type Mode string
+
+const (
+	// ModeRecord sends the requests to the server and saves the interactions to the fixture
+	// files.
+	ModeRecord Mode = "record"
+
+	// ModeReplay doesn't send requests to the server. Instead of that it returns the responses
+	// saved in the fixture files.
+	ModeReplay Mode = "replay"
+)
+
+// TransportWrapperBuilder contains the data and logic needed to create a new recording transport
+// wrapper.
+type TransportWrapperBuilder struct {
+	logger    logging.Logger
+	mode      Mode
+	directory string
+	redacted  []string
+}
+
+// TransportWrapper contains the data and logic needed to wrap an HTTP round tripper with another
+// one that records or replays the interactions with the server.
+type TransportWrapper struct {
+	logger    logging.Logger
+	mode      Mode
+	directory string
+	redacted  map[string]bool
+	lock      *sync.Mutex
+	positions map[string]int
+}
+
+// roundTripper is a round tripper that records or replays interactions.
+type roundTripper struct {
+	owner     *TransportWrapper
+	transport http.RoundTripper
+}
+
+// Make sure that we implement the interface:
+var _ http.RoundTripper = (*roundTripper)(nil)
+
+// interaction is the representation of a request and its response stored in the fixture files.
+type interaction struct {
+	Request  *recordedRequest  `json:"request"`
+	Response *recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+}
+
+type recordedResponse struct {
+	Status int             `json:"status"`
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+}
+
+// NewTransportWrapper creates a new builder that can then be used to configure and create a new
+// recording transport wrapper.
+func NewTransportWrapper() *TransportWrapperBuilder {
+	return &TransportWrapperBuilder{
+		mode: ModeReplay,
+	}
+}
+
+// Logger sets the logger that will be used by the wrapper and by the round trippers that it
+// creates. This is mandatory.
+func (b *TransportWrapperBuilder) Logger(value logging.Logger) *TransportWrapperBuilder {
+	b.logger = value
+	return b
+}
+
+// Mode sets the mode of operation. The default value is ModeReplay.
+func (b *TransportWrapperBuilder) Mode(value Mode) *TransportWrapperBuilder {
+	b.mode = value
+	return b
+}
+
+// Directory sets the directory where the fixture files are stored. This is mandatory.
+func (b *TransportWrapperBuilder) Directory(value string) *TransportWrapperBuilder {
+	b.directory = value
+	return b
+}
+
+// Redact adds names of headers, query parameters and JSON or form attributes whose values will be
+// replaced by a placeholder before saving them. The `Authorization`, `Cookie` and `Set-Cookie`
+// headers and the attributes that usually contain tokens, passwords and secrets are always
+// redacted.
+func (b *TransportWrapperBuilder) Redact(values ...string) *TransportWrapperBuilder {
+	b.redacted = append(b.redacted, values...)
+	return b
+}
+
+// Build uses the information stored in the builder to create a new transport wrapper.
+func (b *TransportWrapperBuilder) Build() (result *TransportWrapper, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.directory == "" {
+		err = errors.New("directory is mandatory")
+		return
+	}
+	switch b.mode {
+	case ModeRecord:
+		err = os.MkdirAll(b.directory, 0700)
+		if err != nil {
+			err = fmt.Errorf("can't create directory '%s': %w", b.directory, err)
+			return
+		}
+	case ModeReplay:
+	default:
+		err = fmt.Errorf(
+			"mode '%s' isn't valid, it should be '%s' or '%s'",
+			b.mode, ModeRecord, ModeReplay,
+		)
+		return
+	}
+
+	// Calculate the set of redacted names:
+	redacted := map[string]bool{}
+	for _, name := range defaultRedacted {
+		redacted[strings.ToLower(name)] = true
+	}
+	for _, name := range b.redacted {
+		redacted[strings.ToLower(name)] = true
+	}
+
+	// Create and populate the object:
+	result = &TransportWrapper{
+		logger:    b.logger,
+		mode:      b.mode,
+		directory: b.directory,
+		redacted:  redacted,
+		lock:      &sync.Mutex{},
+		positions: map[string]int{},
+	}
+	return
+}
+
+// Wrap creates a round tripper on top of the given one that records or replays the interactions.
+// In replay mode the given round tripper is never used.
+func (w *TransportWrapper) Wrap(transport http.RoundTripper) http.RoundTripper {
+	return &roundTripper{
+		owner:     w,
+		transport: transport,
+	}
+}
+
+// Close releases all the resources used by the wrapper.
+func (w *TransportWrapper) Close() error {
+	return nil
+}
+
+// RoundTrip is the implementation of the round tripper interface.
+func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	// Read the body of the request, as we need it to calculate the key:
+	var body []byte
+	if request.Body != nil {
+		body, err = io.ReadAll(request.Body)
+		if err != nil {
+			return
+		}
+		err = request.Body.Close()
+		if err != nil {
+			return
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	recorded := t.owner.sanitizeRequest(request, body)
+	key := t.owner.key(recorded)
+
+	switch t.owner.mode {
+	case ModeRecord:
+		response, err = t.record(request, recorded, key)
+	default:
+		response, err = t.replay(request, recorded, key)
+	}
+	return
+}
+
+func (t *roundTripper) record(request *http.Request, recorded *recordedRequest,
+	key string) (response *http.Response, err error) {
+	response, err = t.transport.RoundTrip(request)
+	if err != nil {
+		return
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return
+	}
+	err = response.Body.Close()
+	if err != nil {
+		return
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	item := &interaction{
+		Request:  recorded,
+		Response: t.owner.sanitizeResponse(response, body),
+	}
+	err = t.owner.save(key, item)
+	if err != nil {
+		err = fmt.Errorf("can't save interaction: %w", err)
+	}
+	return
+}
+
+func (t *roundTripper) replay(request *http.Request, recorded *recordedRequest,
+	key string) (response *http.Response, err error) {
+	items, err := t.owner.load(key)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = fmt.Errorf(
+			"there is no recorded interaction for '%s %s'",
+			recorded.Method, recorded.Path,
+		)
+		return
+	}
+	if err != nil {
+		return
+	}
+	if len(items) == 0 {
+		err = fmt.Errorf(
+			"fixture file for '%s %s' doesn't contain interactions",
+			recorded.Method, recorded.Path,
+		)
+		return
+	}
+
+	// Return the interactions in the order that they were recorded, repeating the last one when
+	// all have been used. This is needed for requests that are repeated, like when polling.
+	t.owner.lock.Lock()
+	position := t.owner.positions[key]
+	if position < len(items)-1 {
+		t.owner.positions[key] = position + 1
+	}
+	t.owner.lock.Unlock()
+	if position >= len(items) {
+		position = len(items) - 1
+	}
+	saved := items[position].Response
+	var body []byte
+	if saved.Body != nil {
+		body = saved.Body
+	} else {
+		body = []byte(saved.Text)
+	}
+	header := saved.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	response = &http.Response{
+		Status:        fmt.Sprintf("%d %s", saved.Status, http.StatusText(saved.Status)),
+		StatusCode:    saved.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+	return
+}
+
+// key calculates the key of the interaction from the method, path, query and body of the
+// sanitized request.
+func (w *TransportWrapper) key(request *recordedRequest) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s %s?%s\n", request.Method, request.Path, request.Query)
+	hash.Write(request.Body)
+	hash.Write([]byte(request.Text))
+	sum := hex.EncodeToString(hash.Sum(nil))
+	name := strings.Trim(strings.ReplaceAll(request.Path, "/", "_"), "_")
+	return fmt.Sprintf("%s_%s_%s", strings.ToLower(request.Method), name, sum[:16])
+}
+
+func (w *TransportWrapper) file(key string) string {
+	return filepath.Join(w.directory, key+".json")
+}
+
+func (w *TransportWrapper) load(key string) (result []*interaction, err error) {
+	data, err := os.ReadFile(w.file(key))
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(data, &result)
+	return
+}
+
+func (w *TransportWrapper) save(key string, item *interaction) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	items, err := w.load(key)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	items = append(items, item)
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(w.file(key), data, 0600)
+}
+
+// sanitizeRequest creates the representation of the request that will be saved, without the values
+// of the redacted attributes.
+func (w *TransportWrapper) sanitizeRequest(request *http.Request,
+	body []byte) *recordedRequest {
+	result := &recordedRequest{
+		Method: request.Method,
+		Path:   request.URL.Path,
+	}
+	query := request.URL.Query()
+	if len(query) > 0 {
+		w.redactValues(query)
+		result.Query = query.Encode()
+	}
+	result.Body, result.Text = w.sanitizeBody(request.Header.Get("Content-Type"), body)
+	return result
+}
+
+// sanitizeResponse creates the representation of the response that will be saved, without the
+// values of the redacted attributes.
+func (w *TransportWrapper) sanitizeResponse(response *http.Response,
+	body []byte) *recordedResponse {
+	result := &recordedResponse{
+		Status: response.StatusCode,
+		Header: response.Header.Clone(),
+	}
+	for name := range result.Header {
+		if w.redacted[strings.ToLower(name)] {
+			result.Header[name] = []string{redactedValue}
+		}
+	}
+	result.Body, result.Text = w.sanitizeBody(response.Header.Get("Content-Type"), body)
+	return result
+}
+
+// sanitizeBody removes the values of redacted attributes from the body. JSON bodies are returned
+// as raw messages with the keys sorted, so that they are stable, and the rest of bodies are
+// returned as text.
+func (w *TransportWrapper) sanitizeBody(contentType string, body []byte) (raw json.RawMessage,
+	text string) {
+	if len(body) == 0 {
+		return
+	}
+	var value interface{}
+	err := json.Unmarshal(body, &value)
+	if err == nil {
+		value = w.redactJSON(value)
+		raw, err = json.Marshal(value)
+		if err == nil {
+			return
+		}
+		raw = nil
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			w.redactValues(values)
+			text = values.Encode()
+			return
+		}
+	}
+	text = string(body)
+	return
+}
+
+func (w *TransportWrapper) redactJSON(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for name, item := range typed {
+			if w.redacted[strings.ToLower(name)] {
+				typed[name] = redactedValue
+			} else {
+				typed[name] = w.redactJSON(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = w.redactJSON(item)
+		}
+	}
+	return value
+}
+
+func (w *TransportWrapper) redactValues(values url.Values) {
+	for name := range values {
+		if w.redacted[strings.ToLower(name)] {
+			values[name] = []string{redactedValue}
+		}
+	}
+}
+
+// defaultRedacted contains the names of the headers and attributes that are always redacted.
+var defaultRedacted = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"access_token",
+	"client_secret",
+	"id_token",
+	"offline_token",
+	"password",
+	"refresh_token",
+	"secret",
+	"token",
+}
+
+// redactedValue is the value that replaces the values of the redacted attributes.
+const redactedValue = "REDACTED"
@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recording
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Transport wrapper", func() {
+	var ctx context.Context
+	var directory string
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		directory, err = os.MkdirTemp("", "recording-*")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(directory)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// makeClient creates a clusters management client that uses the given wrapper and sends the
+	// requests to the given address.
+	makeClient := func(wrapper *TransportWrapper, address string) *cmv1.Client {
+		parsed, err := url.Parse(address)
+		Expect(err).ToNot(HaveOccurred())
+		transport := wrapper.Wrap(TransportFunc(
+			func(request *http.Request) (*http.Response, error) {
+				request.URL.Scheme = parsed.Scheme
+				request.URL.Host = parsed.Host
+				return http.DefaultTransport.RoundTrip(request)
+			},
+		))
+		return cmv1.NewClient(transport, "/api/clusters_mgmt/v1")
+	}
+
+	It("Can't be created without directory", func() {
+		_, err := NewTransportWrapper().Logger(logger).Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("directory"))
+	})
+
+	It("Replays recorded interactions", func() {
+		// Record:
+		server := MakeTCPServer()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"state": "installing"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"state": "ready"
+				}`),
+			),
+		)
+		recorder, err := NewTransportWrapper().
+			Logger(logger).
+			Mode(ModeRecord).
+			Directory(directory).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client := makeClient(recorder, server.URL())
+		for _, state := range []cmv1.ClusterState{
+			cmv1.ClusterStateInstalling,
+			cmv1.ClusterStateReady,
+		} {
+			response, err := client.Clusters().Cluster("123").Get().SendContext(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Body().State()).To(Equal(state))
+		}
+		server.Close()
+
+		// Replay, without the server:
+		player, err := NewTransportWrapper().
+			Logger(logger).
+			Mode(ModeReplay).
+			Directory(directory).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client = makeClient(player, "http://127.0.0.1:1")
+		for _, state := range []cmv1.ClusterState{
+			cmv1.ClusterStateInstalling,
+			cmv1.ClusterStateReady,
+			cmv1.ClusterStateReady,
+		} {
+			response, err := client.Clusters().Cluster("123").Get().SendContext(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Body().State()).To(Equal(state))
+		}
+	})
+
+	It("Uses the body to select the interaction", func() {
+		server := MakeTCPServer()
+		defer server.Close()
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusCreated, `{"id": "123"}`),
+			RespondWithJSON(http.StatusCreated, `{"id": "456"}`),
+		)
+		recorder, err := NewTransportWrapper().
+			Logger(logger).
+			Mode(ModeRecord).
+			Directory(directory).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client := makeClient(recorder, server.URL())
+		for _, name := range []string{"first", "second"} {
+			cluster, err := cmv1.NewCluster().Name(name).Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = client.Clusters().Add().Body(cluster).SendContext(ctx)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		player, err := NewTransportWrapper().
+			Logger(logger).
+			Directory(directory).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client = makeClient(player, "http://127.0.0.1:1")
+		cluster, err := cmv1.NewCluster().Name("second").Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := client.Clusters().Add().Body(cluster).SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().ID()).To(Equal("456"))
+	})
+
+	It("Fails if there is no recorded interaction", func() {
+		player, err := NewTransportWrapper().
+			Logger(logger).
+			Directory(directory).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client := makeClient(player, "http://127.0.0.1:1")
+		_, err = client.Clusters().Cluster("123").Get().SendContext(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no recorded interaction"))
+	})
+
+	It("Removes secrets from the fixtures", func() {
+		server := MakeTCPServer()
+		defer server.Close()
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"id": "123",
+				"password": "my-password",
+				"nested": {
+					"client_secret": "my-secret"
+				}
+			}`),
+		)
+		recorder, err := NewTransportWrapper().
+			Logger(logger).
+			Mode(ModeRecord).
+			Directory(directory).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client := makeClient(recorder, server.URL())
+		_, err = client.Clusters().Cluster("123").Get().
+			Header("Authorization", "Bearer my-token").
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		files, err := filepath.Glob(filepath.Join(directory, "*.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		data, err := os.ReadFile(files[0])
+		Expect(err).ToNot(HaveOccurred())
+		text := string(data)
+		Expect(text).ToNot(ContainSubstring("my-password"))
+		Expect(text).ToNot(ContainSubstring("my-secret"))
+		Expect(text).ToNot(ContainSubstring("my-token"))
+		Expect(strings.Count(text, "REDACTED")).To(Equal(2))
+	})
+})
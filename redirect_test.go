@@ -20,7 +20,6 @@ package sdk
 
 import (
 	"net/http"
-	"net/url"
 	"os"
 	"time"
 
@@ -171,29 +170,3 @@ var _ = Describe("Redirect", func() {
 		})
 	})
 })
-
-// RespondWithPermanentRedirect responds with a permanent redirect to the given target URL,
-// changing the scheme, host and port number but preserving the path of the original request.
-func RespondWithPermanentRedirect(target string) http.HandlerFunc {
-	return RespondWithRedirect(http.StatusPermanentRedirect, target)
-}
-
-// RespondWithTemporaryRedirect responds with a permanent redirect to the given target URL,
-// changing the scheme, host and port number but preserving the path of the original request.
-func RespondWithTemporaryRedirect(target string) http.HandlerFunc {
-	return RespondWithRedirect(http.StatusTemporaryRedirect, target)
-}
-
-// RespondTemporaryRedirect responds with a redirect with the given code to the given target URL,
-// changing the scheme, host and port number but preserving the path of the original request.
-func RespondWithRedirect(code int, target string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		parsed, err := url.Parse(target)
-		Expect(err).ToNot(HaveOccurred())
-		location := r.URL
-		location.Scheme = parsed.Scheme
-		location.Host = parsed.Host
-		w.Header().Set("Location", location.String())
-		w.WriteHeader(code)
-	}
-}
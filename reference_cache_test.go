@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the cache of reference data.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Reference cache", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var connection *Connection
+
+	const versions = `{
+		"kind": "VersionList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [
+			{
+				"kind": "Version",
+				"id": "openshift-v4.16.0",
+				"raw_id": "4.16.0"
+			}
+		]
+	}`
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = MakeTCPServer()
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			ReferenceCache(time.Minute).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+		server.Close()
+	})
+
+	It("Returns cached versions", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+				RespondWithJSON(http.StatusOK, versions),
+			),
+		)
+		for i := 0; i < 2; i++ {
+			response, err := connection.ClustersMgmt().V1().Versions().List().SendContext(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Items().Get(0).RawID()).To(Equal("4.16.0"))
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Sends the request again after invalidating the cache", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, versions),
+			RespondWithJSON(http.StatusOK, versions),
+		)
+		_, err := connection.ClustersMgmt().V1().Versions().List().SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		connection.InvalidateCache("/api/clusters_mgmt/v1/versions")
+		_, err = connection.ClustersMgmt().V1().Versions().List().SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Doesn't cache other resources", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{"kind": "ClusterList", "items": []}`),
+			RespondWithJSON(http.StatusOK, `{"kind": "ClusterList", "items": []}`),
+		)
+		for i := 0; i < 2; i++ {
+			_, err := connection.ClustersMgmt().V1().Clusters().List().SendContext(ctx)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the methods of the connection that create connections for regional instances
+// of the API.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-online/ocm-sdk-go/regions"
+)
+
+// ForRegion returns a connection that sends requests to the regional instance of the API with the
+// given name, for example `rh-singapore`. The URL of the regional instance is obtained from the
+// region discovery document of the environment. The returned connection is a clone, so it shares the
+// tokens and HTTP clients of this connection, and requests for paths that have an alternative URL,
+// for example those of services that aren't regionalized, are still sent to that URL. Closing the
+// returned connection doesn't release the shared resources. Note that the tokens are also shared, so
+// this can't be used for instances that use a different authentication service, like FedRAMP; use a
+// separate connection for those.
+//
+// If there is no region with the given name the returned error will be of type
+// *regions.NotFoundError.
+func (c *Connection) ForRegion(name string) (result *Connection, err error) {
+	return c.ForRegionContext(context.Background(), name)
+}
+
+// ForRegionContext is like ForRegion, but uses the given context to retrieve the region discovery
+// document when needed.
+func (c *Connection) ForRegionContext(ctx context.Context, name string) (result *Connection,
+	err error) {
+	discovery, err := c.regions()
+	if err != nil {
+		return
+	}
+	region, err := discovery.Region(ctx, name)
+	if err != nil {
+		return
+	}
+	result, err = c.Clone().URL(region.URL).BuildContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't create connection for region '%s': %w", name, err)
+	}
+	return
+}
+
+// RegionDiscovery returns the object that the connection uses to find the regional instances of
+// the API. It can be used, for example, to list the available regions.
+func (c *Connection) RegionDiscovery() (result *regions.Discovery, err error) {
+	return c.regions()
+}
+
+// regions returns the region discovery object, creating it if needed. Clones use the object copied
+// when they were created or else the one of the connection that they were created from, so that the
+// discovery document is cached only once.
+func (c *Connection) regions() (result *regions.Discovery, err error) {
+	owner := c
+	for owner.parent != nil {
+		owner.regionLock.Lock()
+		result = owner.regionDiscovery
+		owner.regionLock.Unlock()
+		if result != nil {
+			return
+		}
+		owner = owner.parent
+	}
+	owner.regionLock.Lock()
+	defer owner.regionLock.Unlock()
+	if owner.regionDiscovery == nil {
+		owner.regionDiscovery, err = regions.NewDiscovery().
+			Logger(owner.logger).
+			APIURL(owner.URL()).
+			Build()
+		if err != nil {
+			err = fmt.Errorf("can't create region discovery: %w", err)
+			return
+		}
+	}
+	result = owner.regionDiscovery
+	return
+}
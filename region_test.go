@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the connections for regional instances of the API.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/openshift-online/ocm-sdk-go/regions"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Regions", func() {
+	var (
+		ctx        context.Context
+		global     *ghttp.Server
+		regional   *ghttp.Server
+		connection *Connection
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create the servers:
+		global = MakeTCPServer()
+		regional = MakeTCPServer()
+
+		// Prepare the global server to return the discovery document:
+		global.RouteToHandler(
+			http.MethodGet,
+			"/static/ocm-shards.json",
+			RespondWithJSON(http.StatusOK, fmt.Sprintf(`{
+				"rh-us": {
+					"url": "%s"
+				},
+				"rh-singapore": {
+					"url": "%s",
+					"aws": ["ap-southeast-1"]
+				}
+			}`, global.URL(), regional.URL())),
+		)
+
+		// Create the discovery object:
+		discovery, err := regions.NewDiscovery().
+			Logger(logger).
+			URL(global.URL() + "/static/ocm-shards.json").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(global.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			RegionDiscovery(discovery).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the servers:
+		global.Close()
+		regional.Close()
+	})
+
+	It("Sends requests to the regional server", func() {
+		// Prepare the regional server:
+		regional.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "Cluster",
+					"id": "123"
+				}`),
+			),
+		)
+
+		// Get the regional connection and send the request:
+		singapore, err := connection.ForRegionContext(ctx, "rh-singapore")
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err := singapore.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(singapore.URL()).To(Equal(regional.URL()))
+		response, err := singapore.ClustersMgmt().V1().Clusters().Cluster("123").Get().
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().ID()).To(Equal("123"))
+	})
+
+	It("Shares the discovery with the regional connections", func() {
+		singapore, err := connection.ForRegionContext(ctx, "rh-singapore")
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err := singapore.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		us, err := singapore.ForRegionContext(ctx, "rh-us")
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err := us.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(us.URL()).To(Equal(global.URL()))
+		Expect(global.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Fails for unknown region", func() {
+		_, err := connection.ForRegionContext(ctx, "rh-mars")
+		Expect(err).To(HaveOccurred())
+		var notFound *regions.NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+	})
+})
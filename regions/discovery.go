@@ -0,0 +1,306 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the object that discovers the regional instances of the
+// OCM API.
+
+package regions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Default values:
+const (
+	// DefaultTTL is the default time that the list of regions is cached.
+	DefaultTTL = time.Hour
+)
+
+// Region describes a regional instance of the OCM API.
+type Region struct {
+	// Name is the name of the region, for example `rh-singapore`.
+	Name string
+
+	// URL is the base URL of the API gateway of the region.
+	URL string
+
+	// AWS is the list of AWS regions managed by this instance.
+	AWS []string
+
+	// GCP is the list of GCP regions managed by this instance.
+	GCP []string
+}
+
+// NotFoundError is the error returned when the requested region doesn't exist.
+type NotFoundError struct {
+	// Name is the name of the region that was requested.
+	Name string
+}
+
+// Error is the implementation of the error interface.
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("can't find region '%s'", e.Name)
+}
+
+// DiscoveryBuilder contains the data and logic needed to build a region discovery object.
+type DiscoveryBuilder struct {
+	logger logging.Logger
+	url    string
+	apiURL string
+	client *http.Client
+	ttl    time.Duration
+}
+
+// Discovery retrieves the list of regional instances of the OCM API from the discovery document
+// published by the global instance, and caches it. It is safe for concurrent use by multiple
+// goroutines.
+type Discovery struct {
+	logger logging.Logger
+	url    string
+	client *http.Client
+	ttl    time.Duration
+
+	// Cached regions and the time when they were retrieved:
+	lock    sync.Mutex
+	regions []*Region
+	expiry  time.Time
+}
+
+// NewDiscovery creates a builder that can then be used to configure and create a region discovery
+// object.
+func NewDiscovery() *DiscoveryBuilder {
+	return &DiscoveryBuilder{
+		ttl: DefaultTTL,
+	}
+}
+
+// Logger sets the logger that the discovery object will use to write to the log. This is mandatory.
+func (b *DiscoveryBuilder) Logger(value logging.Logger) *DiscoveryBuilder {
+	b.logger = value
+	return b
+}
+
+// URL sets the URL of the discovery document, for example
+// `https://api.openshift.com/static/ocm-shards.json`. This is mandatory unless the URL of the API
+// is set with the APIURL method.
+func (b *DiscoveryBuilder) URL(value string) *DiscoveryBuilder {
+	b.url = value
+	return b
+}
+
+// APIURL sets the base URL of an OCM API gateway, and uses it to calculate the URL of the
+// discovery document. For example, if the URL of the API is `https://api.stage.openshift.com` the
+// discovery document will be retrieved from `https://api.stage.openshift.com/static/ocm-shards.json`.
+// Regional URLs are translated to the URL of the global instance of the same environment. This is
+// ignored if the URL of the discovery document is set explicitly.
+func (b *DiscoveryBuilder) APIURL(value string) *DiscoveryBuilder {
+	b.apiURL = value
+	return b
+}
+
+// HTTPClient sets the HTTP client that will be used to retrieve the discovery document. The
+// document is public, so the client doesn't need credentials. The default is to use the default
+// HTTP client of the standard library.
+func (b *DiscoveryBuilder) HTTPClient(value *http.Client) *DiscoveryBuilder {
+	b.client = value
+	return b
+}
+
+// TTL sets the time that the list of regions will be cached. The default value is one hour.
+func (b *DiscoveryBuilder) TTL(value time.Duration) *DiscoveryBuilder {
+	b.ttl = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new region discovery object.
+func (b *DiscoveryBuilder) Build() (result *Discovery, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.ttl < 0 {
+		err = fmt.Errorf("TTL %s isn't valid, it should be positive", b.ttl)
+		return
+	}
+
+	// Calculate the URL of the discovery document:
+	discoveryURL := b.url
+	if discoveryURL == "" {
+		if b.apiURL == "" {
+			err = errors.New("discovery URL or API URL is mandatory")
+			return
+		}
+		discoveryURL, err = DiscoveryURL(b.apiURL)
+		if err != nil {
+			return
+		}
+	}
+
+	// Use the default client if needed:
+	client := b.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	// Create and populate the object:
+	result = &Discovery{
+		logger: b.logger,
+		url:    discoveryURL,
+		client: client,
+		ttl:    b.ttl,
+	}
+	return
+}
+
+// DiscoveryURL returns the URL of the discovery document for the given URL of an API gateway.
+func DiscoveryURL(apiURL string) (result string, err error) {
+	parsed, err := url.Parse(apiURL)
+	if err != nil {
+		err = fmt.Errorf("can't parse API URL '%s': %w", apiURL, err)
+		return
+	}
+	host := "api.openshift.com"
+	switch {
+	case strings.HasSuffix(parsed.Hostname(), "integration.openshift.com"):
+		host = "api.integration.openshift.com"
+	case strings.HasSuffix(parsed.Hostname(), "stage.openshift.com"):
+		host = "api.stage.openshift.com"
+	}
+	result = fmt.Sprintf("https://%s/static/ocm-shards.json", host)
+	return
+}
+
+// Regions returns the list of regions, sorted by name. The list is retrieved from the server the
+// first time and then cached. The returned objects must not be modified.
+func (d *Discovery) Regions(ctx context.Context) (result []*Region, err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.regions != nil && time.Now().Before(d.expiry) {
+		result = d.regions
+		return
+	}
+	regions, err := d.fetch(ctx)
+	if err != nil {
+		if d.regions != nil {
+			d.logger.Warn(
+				ctx,
+				"Can't refresh regions from '%s', will use cached values: %v",
+				d.url, err,
+			)
+			result = d.regions
+			err = nil
+		}
+		return
+	}
+	d.regions = regions
+	d.expiry = time.Now().Add(d.ttl)
+	result = regions
+	return
+}
+
+// Region returns the region with the given name. If there is no such region it returns an error of
+// type *NotFoundError.
+func (d *Discovery) Region(ctx context.Context, name string) (result *Region, err error) {
+	regions, err := d.Regions(ctx)
+	if err != nil {
+		return
+	}
+	for _, region := range regions {
+		if region.Name == name {
+			result = region
+			return
+		}
+	}
+	err = &NotFoundError{
+		Name: name,
+	}
+	return
+}
+
+// Invalidate discards the cached list of regions, so that the next call retrieves it again.
+func (d *Discovery) Invalidate() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.regions = nil
+}
+
+// regionData is the representation of a region in the discovery document.
+type regionData struct {
+	URL string   `json:"url"`
+	AWS []string `json:"aws"`
+	GCP []string `json:"gcp"`
+}
+
+// fetch retrieves and parses the discovery document.
+func (d *Discovery) fetch(ctx context.Context) (result []*Region, err error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, d.url, nil)
+	if err != nil {
+		err = fmt.Errorf("can't create request for regions: %w", err)
+		return
+	}
+	request.Header.Set("Accept", "application/json")
+	response, err := d.client.Do(request)
+	if err != nil {
+		err = fmt.Errorf("can't retrieve regions from '%s': %w", d.url, err)
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, response.Body)
+		err = fmt.Errorf(
+			"can't retrieve regions from '%s': status code is %d",
+			d.url, response.StatusCode,
+		)
+		return
+	}
+	var data map[string]*regionData
+	err = json.NewDecoder(response.Body).Decode(&data)
+	if err != nil {
+		err = fmt.Errorf("can't decode regions from '%s': %w", d.url, err)
+		return
+	}
+	result = make([]*Region, 0, len(data))
+	for name, item := range data {
+		if item == nil || item.URL == "" {
+			d.logger.Warn(ctx, "Ignoring region '%s' because it doesn't have an URL", name)
+			continue
+		}
+		result = append(result, &Region{
+			Name: name,
+			URL:  item.URL,
+			AWS:  item.AWS,
+			GCP:  item.GCP,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	d.logger.Debug(ctx, "Retrieved %d regions from '%s'", len(result), d.url)
+	return
+}
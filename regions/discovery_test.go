@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package regions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/ginkgo/v2/dsl/table"            // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Discovery", func() {
+	// document is the discovery document used by the tests.
+	const document = `{
+		"rh-us": {
+			"url": "https://api.openshift.com",
+			"aws": ["us-east-1", "us-west-2"],
+			"gcp": ["us-east1"]
+		},
+		"rh-singapore": {
+			"url": "https://api.aws.ap-southeast-1.openshift.com",
+			"aws": ["ap-southeast-1"]
+		}
+	}`
+
+	var (
+		ctx    context.Context
+		server *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = MakeTCPServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	// makeDiscovery creates a discovery object that uses the test server.
+	makeDiscovery := func(ttl time.Duration) *Discovery {
+		discovery, err := NewDiscovery().
+			Logger(logger).
+			URL(server.URL() + "/static/ocm-shards.json").
+			TTL(ttl).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return discovery
+	}
+
+	It("Returns the regions sorted by name", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/static/ocm-shards.json"),
+				RespondWithJSON(http.StatusOK, document),
+			),
+		)
+		regions, err := makeDiscovery(time.Hour).Regions(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(regions).To(HaveLen(2))
+		Expect(regions[0].Name).To(Equal("rh-singapore"))
+		Expect(regions[0].URL).To(Equal("https://api.aws.ap-southeast-1.openshift.com"))
+		Expect(regions[0].AWS).To(ConsistOf("ap-southeast-1"))
+		Expect(regions[1].Name).To(Equal("rh-us"))
+		Expect(regions[1].GCP).To(ConsistOf("us-east1"))
+	})
+
+	It("Caches the regions", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, document),
+		)
+		discovery := makeDiscovery(time.Hour)
+		_, err := discovery.Regions(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		region, err := discovery.Region(ctx, "rh-singapore")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(region.URL).To(Equal("https://api.aws.ap-southeast-1.openshift.com"))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Retrieves the regions again after invalidation", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, document),
+			RespondWithJSON(http.StatusOK, document),
+		)
+		discovery := makeDiscovery(time.Hour)
+		_, err := discovery.Regions(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		discovery.Invalidate()
+		_, err = discovery.Regions(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Uses cached regions if refresh fails", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, document),
+			RespondWithJSON(http.StatusServiceUnavailable, `{}`),
+		)
+		discovery := makeDiscovery(time.Nanosecond)
+		_, err := discovery.Regions(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		time.Sleep(time.Millisecond)
+		regions, err := discovery.Regions(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(regions).To(HaveLen(2))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Returns not found error for unknown region", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, document),
+		)
+		_, err := makeDiscovery(time.Hour).Region(ctx, "rh-mars")
+		Expect(err).To(HaveOccurred())
+		var notFound *NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+		Expect(notFound.Name).To(Equal("rh-mars"))
+	})
+
+	It("Fails if the server returns an error", func() {
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusInternalServerError, `{}`),
+		)
+		_, err := makeDiscovery(time.Hour).Regions(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("500"))
+	})
+
+	It("Can't be created without logger", func() {
+		_, err := NewDiscovery().
+			URL(server.URL()).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("logger"))
+	})
+
+	It("Can't be created without URL", func() {
+		_, err := NewDiscovery().
+			Logger(logger).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("URL"))
+	})
+})
+
+var _ = DescribeTable(
+	"Discovery URL",
+	func(apiURL, expected string) {
+		actual, err := DiscoveryURL(apiURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+	},
+	Entry(
+		"Production",
+		"https://api.openshift.com",
+		"https://api.openshift.com/static/ocm-shards.json",
+	),
+	Entry(
+		"Regional production",
+		"https://api.aws.ap-southeast-1.openshift.com",
+		"https://api.openshift.com/static/ocm-shards.json",
+	),
+	Entry(
+		"Staging",
+		"https://api.stage.openshift.com",
+		"https://api.stage.openshift.com/static/ocm-shards.json",
+	),
+	Entry(
+		"Integration",
+		"https://api.integration.openshift.com",
+		"https://api.integration.openshift.com/static/ocm-shards.json",
+	),
+)
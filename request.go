@@ -84,6 +84,14 @@ func (r *Request) String(value string) *Request {
 	return r
 }
 
+// DryRun sets the `dryRun` query parameter. When true the server validates the request and returns
+// the result, but doesn't apply the changes. Note that services that don't support this parameter
+// may ignore it and apply the changes anyhow.
+func (r *Request) DryRun(value bool) *Request {
+	internal.AddValue(&r.query, "dryRun", value)
+	return r
+}
+
 // Send sends this request to the server and returns the corresponding response, or an error if
 // something fails. Note that any HTTP status code returned by the server is considered a valid
 // response, and will not be translated into an error. It is up to the caller to check the status
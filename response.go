@@ -20,6 +20,9 @@ package sdk
 
 import (
 	"net/http"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/helpers"
 )
 
 // Response contains the information extracted from an HTTP POST response.
@@ -52,3 +55,26 @@ func (r *Response) Header(name string) string {
 	}
 	return r.header.Get(name)
 }
+
+// ETag returns the value of the `ETag` header of the response.
+func (r *Response) ETag() string {
+	return helpers.ETag(r.header)
+}
+
+// OperationID returns the value of the `X-Operation-ID` header of the response, the identifier
+// that the server assigned to the request.
+func (r *Response) OperationID() string {
+	return helpers.OperationID(r.header)
+}
+
+// RateLimitRemaining returns the number of requests remaining in the current rate limit window,
+// and a flag indicating if the server reported it.
+func (r *Response) RateLimitRemaining() (value int, ok bool) {
+	return helpers.RateLimitRemaining(r.header)
+}
+
+// Sunset returns the time when the endpoint will stop being available, as reported by the `Sunset`
+// header of the response, or the zero time if the server didn't report it.
+func (r *Response) Sunset() time.Time {
+	return helpers.Sunset(r.header)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the methods that return well known headers of responses.
+
+package sdk
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Response headers", func() {
+	var server *ghttp.Server
+	var connection *Connection
+
+	BeforeEach(func() {
+		var err error
+		server = MakeTCPServer()
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+		server.Close()
+	})
+
+	// respondWithHeaders creates a handler that responds with all the well known headers.
+	respondWithHeaders := func(body string) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.RespondWith(http.StatusOK, body, http.Header{
+				"Content-Type":        []string{"application/json"},
+				"ETag":                []string{`"123"`},
+				"X-Operation-ID":      []string{"456"},
+				"RateLimit-Remaining": []string{"42"},
+				"Sunset":              []string{"Sat, 31 Dec 2044 23:59:59 GMT"},
+			}),
+		)
+	}
+
+	It("Returns the headers of the response", func() {
+		server.AppendHandlers(respondWithHeaders(`{}`))
+		response, err := connection.Get().Path("/api/clusters_mgmt/v1/clusters/123").Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.ETag()).To(Equal(`"123"`))
+		Expect(response.OperationID()).To(Equal("456"))
+		remaining, ok := response.RateLimitRemaining()
+		Expect(ok).To(BeTrue())
+		Expect(remaining).To(Equal(42))
+		Expect(response.Sunset()).To(Equal(time.Date(2044, 12, 31, 23, 59, 59, 0, time.UTC)))
+	})
+
+	It("Accepts the legacy rate limit header", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{}`, http.Header{
+				"Content-Type":          []string{"application/json"},
+				"X-RateLimit-Remaining": []string{"7"},
+			}),
+		)
+		response, err := connection.Get().Path("/api/clusters_mgmt/v1").Send()
+		Expect(err).ToNot(HaveOccurred())
+		remaining, ok := response.RateLimitRemaining()
+		Expect(ok).To(BeTrue())
+		Expect(remaining).To(Equal(7))
+	})
+
+	It("Returns empty values when the headers aren't present", func() {
+		server.AppendHandlers(RespondWithJSON(http.StatusOK, `{}`))
+		response, err := connection.Get().Path("/api/clusters_mgmt/v1").Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.ETag()).To(BeEmpty())
+		Expect(response.OperationID()).To(BeEmpty())
+		_, ok := response.RateLimitRemaining()
+		Expect(ok).To(BeFalse())
+		Expect(response.Sunset().IsZero()).To(BeTrue())
+	})
+})
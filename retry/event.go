@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types used to report retries to the caller.
+
+package retry
+
+import (
+	"context"
+	"time"
+)
+
+// Event contains the details of a request that failed and that will be retried.
+type Event struct {
+	// Attempt is the number of the attempt that failed, starting with one.
+	Attempt int
+
+	// Delay is the time that will be waited before the next attempt.
+	Delay time.Duration
+
+	// Cause is the reason why the attempt failed. For failures that returned an HTTP response it
+	// describes the status code.
+	Cause error
+
+	// Status is the HTTP status code of the response, or zero if the attempt failed without a
+	// response.
+	Status int
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Path is the path of the request.
+	Path string
+}
+
+// EventHandler is the type of the functions that receive retry events. They are called before
+// waiting for the next attempt, from the goroutine that sent the request, so they should return
+// quickly.
+type EventHandler func(ctx context.Context, event *Event)
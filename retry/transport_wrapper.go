@@ -30,6 +30,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/openshift-online/ocm-sdk-go/internal"
 	"github.com/openshift-online/ocm-sdk-go/logging"
 )
 
@@ -47,6 +48,7 @@ type TransportWrapperBuilder struct {
 	limit    int
 	interval time.Duration
 	jitter   float64
+	handler  EventHandler
 }
 
 // TransportWrapper contains the data and logic needed to wrap an HTTP round tripper with another
@@ -56,6 +58,7 @@ type TransportWrapper struct {
 	limit    int
 	interval time.Duration
 	jitter   float64
+	handler  EventHandler
 }
 
 // roundTripper is a round tripper that adds retry logic.
@@ -64,6 +67,7 @@ type roundTripper struct {
 	limit     int
 	interval  time.Duration
 	jitter    float64
+	handler   EventHandler
 	transport http.RoundTripper
 }
 
@@ -97,7 +101,8 @@ func (b *TransportWrapperBuilder) Limit(value int) *TransportWrapperBuilder {
 // Interval sets the time to wait before the first retry. The interval time will be doubled for each
 // retry. For example, if this is set to one second then the first retry will happen approximately
 // one second after the failure of the initial request, the second retry will happen affer four
-// seconds, the third will happen after eitght seconds, so on.
+// seconds, the third will happen after eitght seconds, so on. Requests sent with low priority wait
+// twice as long.
 func (b *TransportWrapperBuilder) Interval(value time.Duration) *TransportWrapperBuilder {
 	b.interval = value
 	return b
@@ -112,6 +117,13 @@ func (b *TransportWrapperBuilder) Jitter(value float64) *TransportWrapperBuilder
 	return b
 }
 
+// Handler sets a function that will be called each time that a request is retried, with the details
+// of the failure and of the time that will be waited before the next attempt.
+func (b *TransportWrapperBuilder) Handler(value EventHandler) *TransportWrapperBuilder {
+	b.handler = value
+	return b
+}
+
 // Build uses the information stored in the builder to create a new transport wrapper.
 func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportWrapper, err error) {
 	// Check parameters:
@@ -147,6 +159,7 @@ func (b *TransportWrapperBuilder) Build(ctx context.Context) (result *TransportW
 		limit:    b.limit,
 		interval: b.interval,
 		jitter:   b.jitter,
+		handler:  b.handler,
 	}
 
 	return
@@ -159,6 +172,7 @@ func (w *TransportWrapper) Wrap(transport http.RoundTripper) http.RoundTripper {
 		limit:     w.limit,
 		interval:  w.interval,
 		jitter:    w.jitter,
+		handler:   w.handler,
 		transport: transport,
 	}
 }
@@ -198,17 +212,24 @@ func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response
 	var bodyCopy []byte
 	if originalBody != nil {
 		bodyCopy, err = io.ReadAll(originalBody)
+		closeErr := originalBody.Close()
 		if err != nil {
 			return
 		}
+		if closeErr != nil {
+			err = closeErr
+			return
+		}
 	}
 
 	// Try to send the request till it succeeds or else the retry limit is exceeded:
 	attempt := 0
+	var cause error
+	var status int
 	for {
 		// If this is not the first attempt then we should wait:
 		if attempt > 0 {
-			t.sleep(ctx, attempt)
+			t.sleep(ctx, request, attempt, cause, status)
 		}
 
 		// Each time that we retry the request we need to rewind the request body:
@@ -234,6 +255,7 @@ func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response
 						"will try again: %v",
 					request.Method, request.URL, err,
 				)
+				cause, status = err, 0
 				continue
 			case strings.Contains(message, "connection reset by peer"):
 				t.logger.Warn(
@@ -242,6 +264,7 @@ func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response
 						"reset by peer, will try again: %v",
 					request.Method, request.URL, err,
 				)
+				cause, status = err, 0
 				continue
 			case strings.Contains(message, "PROTOCOL_ERROR"):
 				t.logger.Warn(
@@ -250,6 +273,7 @@ func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response
 						"will try again: %v",
 					request.Method, request.URL, err,
 				)
+				cause, status = err, 0
 				continue
 			case strings.Contains(message, "REFUSED_STREAM"):
 				t.logger.Warn(
@@ -258,6 +282,7 @@ func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response
 						"will try again: %v",
 					request.Method, request.URL, err,
 				)
+				cause, status = err, 0
 				continue
 			default:
 				// For any other error we just report it to the caller:
@@ -287,6 +312,7 @@ func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response
 					request.Method, request.URL,
 				)
 			}
+			cause, status = fmt.Errorf("request failed with status code %d", code), code
 			continue
 		case code >= 500 && method == http.MethodGet:
 			// For any other 5xx status code we can't be sure if the server processed
@@ -306,6 +332,7 @@ func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response
 					request.Method, request.URL,
 				)
 			}
+			cause, status = fmt.Errorf("request failed with status code %d", code), code
 			continue
 		default:
 			// For any other status code we can't be sure if the server processed the
@@ -315,21 +342,40 @@ func (t *roundTripper) RoundTrip(request *http.Request) (response *http.Response
 	}
 }
 
-// sleep calculates a retry interval taking into account the configured interval and jitter factor
-// and then waits that time.
-func (t *roundTripper) sleep(ctx context.Context, attempt int) {
+// sleep calculates a retry interval taking into account the configured interval and jitter factor,
+// reports the retry to the event handler and then waits that time.
+func (t *roundTripper) sleep(ctx context.Context, request *http.Request, attempt int, cause error,
+	status int) {
 	// Start with the configured interval:
 	interval := t.interval
 
 	// Double the interval for each attempt:
 	interval *= 1 << (attempt - 1)
 
+	// Requests with low priority wait twice as long, so that they leave room for requests with
+	// higher priority when the server is overloaded:
+	if internal.PriorityFromContext(ctx) == internal.PriorityLow {
+		interval *= 2
+	}
+
 	// Adjust the interval adding or subtracting a random amount. For example, if the jitter
 	// factor given in the configuration is 0.1 will add or sustract up to a 10%.
 	factor := t.jitter * (1 - 2*rand.Float64())
 	delta := time.Duration(float64(interval) * factor)
 	interval += delta
 
+	// Report the retry:
+	if t.handler != nil {
+		t.handler(ctx, &Event{
+			Attempt: attempt,
+			Delay:   interval,
+			Cause:   cause,
+			Status:  status,
+			Method:  request.Method,
+			Path:    request.URL.Path,
+		})
+	}
+
 	// Go sleep for a while:
 	t.logger.Debug(ctx, "Wating %s before next attempt", interval)
 	time.Sleep(interval)
@@ -877,3 +877,86 @@ func Serve(conn net.Conn, handler http.HandlerFunc) {
 		Handler: handler,
 	})
 }
+
+var _ = Describe("Events", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("Reports retries to the handler", func() {
+		// Create a transport that returns a 503 error for the first two requests and 200 for
+		// the third:
+		transport := CombineTransports(
+			TextTransport(http.StatusServiceUnavailable, `ko`),
+			TextTransport(http.StatusServiceUnavailable, `ko`),
+			JSONTransport(http.StatusOK, `{ "ok": true }`),
+		)
+
+		// Create the wrapper:
+		var events []*Event
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Interval(10 * time.Millisecond).
+			Jitter(0).
+			Handler(func(ctx context.Context, event *Event) {
+				events = append(events, event)
+			}).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Create the client:
+		client := &http.Client{
+			Transport: wrapper.Wrap(transport),
+			Timeout:   10 * time.Second,
+		}
+
+		// Send the request:
+		response, err := client.Get("http://api.example.com/mypath")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+		// Check the events:
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Attempt).To(Equal(1))
+		Expect(events[0].Delay).To(Equal(10 * time.Millisecond))
+		Expect(events[0].Status).To(Equal(http.StatusServiceUnavailable))
+		Expect(events[0].Cause).To(MatchError(ContainSubstring("503")))
+		Expect(events[0].Method).To(Equal(http.MethodGet))
+		Expect(events[0].Path).To(Equal("/mypath"))
+		Expect(events[1].Attempt).To(Equal(2))
+		Expect(events[1].Delay).To(Equal(20 * time.Millisecond))
+	})
+
+	It("Doesn't report requests that aren't retried", func() {
+		// Create a transport that returns a 200 response:
+		transport := JSONTransport(http.StatusOK, `{ "ok": true }`)
+
+		// Create the wrapper:
+		var events []*Event
+		wrapper, err := NewTransportWrapper().
+			Logger(logger).
+			Handler(func(ctx context.Context, event *Event) {
+				events = append(events, event)
+			}).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() {
+			err = wrapper.Close()
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Send the request:
+		client := &http.Client{
+			Transport: wrapper.Wrap(transport),
+		}
+		_, err = client.Get("http://api.example.com/mypath")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(BeEmpty())
+	})
+})
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types used to report retries to the application.
+
+package sdk
+
+import (
+	"github.com/openshift-online/ocm-sdk-go/retry"
+)
+
+// RetryEvent contains the details of a token or API request that failed and will be retried. Use
+// the RetryHandler method of the connection builder to receive them.
+type RetryEvent = retry.Event
+
+// RetryHandler is the type of the functions that receive retry events. For example, to log all the
+// retries:
+//
+//	connection, err := sdk.NewConnectionBuilder().
+//		RetryHandler(func(ctx context.Context, event *sdk.RetryEvent) {
+//			log.Printf(
+//				"Attempt %d of %s %s failed, will retry in %s: %v",
+//				event.Attempt, event.Method, event.Path, event.Delay, event.Cause,
+//			)
+//		}).
+//		Build()
+type RetryHandler = retry.EventHandler
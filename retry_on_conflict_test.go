@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the function that retries updates after conflicts.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Retry on conflict", func() {
+	var (
+		ctx        context.Context
+		server     *ghttp.Server
+		connection *Connection
+		client     *cmv1.MachinePoolClient
+		update     func(context.Context) error
+		policy     *helpers.RetryPolicy
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create the server:
+		server = MakeTCPServer()
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the client and the function that does the read-modify-write update:
+		client = connection.ClustersMgmt().V1().Clusters().Cluster("123").
+			MachinePools().MachinePool("456")
+		update = func(ctx context.Context) error {
+			get, err := client.Get().SendContext(ctx)
+			if err != nil {
+				return err
+			}
+			patch, err := cmv1.NewMachinePool().
+				Replicas(get.Body().Replicas() + 1).
+				Build()
+			if err != nil {
+				return err
+			}
+			_, err = client.Update().Body(patch).SendContext(ctx)
+			return err
+		}
+
+		// Use short intervals so that tests run quickly:
+		policy = &helpers.RetryPolicy{
+			Steps:    3,
+			Duration: 10 * time.Millisecond,
+			Factor:   2,
+		}
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the server:
+		server.Close()
+	})
+
+	// conflict is the response that the server sends when the update fails because the object
+	// has been modified by someone else.
+	conflict := RespondWithJSON(http.StatusConflict, `{
+		"kind": "Error",
+		"id": "409",
+		"reason": "Machine pool '456' has been modified"
+	}`)
+
+	It("Retries till the update succeeds", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "replicas": 1}`),
+			conflict,
+			RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "replicas": 2}`),
+			ghttp.CombineHandlers(
+				ghttp.VerifyJSON(`{"kind": "MachinePool", "replicas": 3}`),
+				RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "replicas": 3}`),
+			),
+		)
+
+		// Update:
+		err := helpers.RetryOnConflict(ctx, update, policy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(4))
+	})
+
+	It("Returns the conflict after all the steps", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "replicas": 1}`),
+			conflict,
+			RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "replicas": 1}`),
+			conflict,
+			RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "replicas": 1}`),
+			conflict,
+		)
+
+		// Update:
+		err := helpers.RetryOnConflict(ctx, update, policy)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, ocmerrors.ErrConflict)).To(BeTrue())
+		Expect(server.ReceivedRequests()).To(HaveLen(6))
+	})
+
+	It("Doesn't retry other errors", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404"
+			}`),
+		)
+
+		// Update:
+		err := helpers.RetryOnConflict(ctx, update, policy)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, ocmerrors.ErrNotFound)).To(BeTrue())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Recognizes typed conflict errors", func() {
+		count := 0
+		err := helpers.RetryOnConflict(ctx, func(ctx context.Context) error {
+			count++
+			if count < 2 {
+				apiErr, err := ocmerrors.NewError().Status(http.StatusConflict).Build()
+				Expect(err).ToNot(HaveOccurred())
+				return &ocmerrors.ConflictError{
+					Err: apiErr,
+				}
+			}
+			return nil
+		}, policy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(2))
+	})
+
+	It("Stops when the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(ctx)
+		err := helpers.RetryOnConflict(ctx, func(ctx context.Context) error {
+			cancel()
+			apiErr, err := ocmerrors.NewError().Status(http.StatusConflict).Build()
+			Expect(err).ToNot(HaveOccurred())
+			return apiErr
+		}, policy)
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	})
+
+	It("Rejects policy without steps", func() {
+		policy.Steps = 0
+		err := helpers.RetryOnConflict(ctx, update, policy)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("steps"))
+	})
+})
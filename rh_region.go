@@ -14,6 +14,9 @@ type Region struct {
 	GCP []string
 }
 
+// GetRhRegions retrieves the regional instances of the API.
+//
+// Deprecated: Use the regions package or the ForRegion method of the connection instead.
 func GetRhRegions(ocmServiceUrl string) (map[string]Region, error) {
 	var regions map[string]Region
 	url, err := DetermineRegionDiscoveryUrl(ocmServiceUrl)
@@ -32,6 +35,9 @@ func GetRhRegions(ocmServiceUrl string) (map[string]Region, error) {
 	return regions, nil
 }
 
+// GetRhRegion retrieves the regional instance of the API with the given name.
+//
+// Deprecated: Use the regions package or the ForRegion method of the connection instead.
 func GetRhRegion(ocmServiceUrl string, regionName string) (Region, error) {
 	regions, err := GetRhRegions(ocmServiceUrl)
 	if err != nil {
@@ -45,6 +51,9 @@ func GetRhRegion(ocmServiceUrl string, regionName string) (Region, error) {
 	return Region{}, fmt.Errorf("Can't find region %s", regionName)
 }
 
+// DetermineRegionDiscoveryUrl returns the URL of the region discovery document.
+//
+// Deprecated: Use the regions.DiscoveryURL function instead.
 func DetermineRegionDiscoveryUrl(ocmServiceUrl string) (string, error) {
 	baseUrl, err := url.Parse(ocmServiceUrl)
 	if err != nil {
@@ -22,19 +22,30 @@ package sdk
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"path"
+	"sync"
 
 	"github.com/openshift-online/ocm-sdk-go/internal"
 )
 
 // RoundTrip is the implementation of the http.RoundTripper interface.
 func (c *Connection) RoundTrip(request *http.Request) (response *http.Response, err error) {
-	// Check if the connection is closed:
-	err = c.checkClosed()
+	// Check if the connection is closed, and register the request so that it can be drained when
+	// the connection is closed. The request is considered finished when the response body is
+	// closed, or when this method fails.
+	err = c.startRequest()
 	if err != nil {
 		return
 	}
+	finish := c.endRequest
+	defer func() {
+		if err != nil {
+			finish()
+		}
+	}()
 
 	// Get the context from the request:
 	ctx := request.Context()
@@ -55,6 +66,15 @@ func (c *Connection) RoundTrip(request *http.Request) (response *http.Response,
 		return
 	}
 	request.URL = server.URL.ResolveReference(request.URL)
+	if server.Path != "" {
+		prefix := &url.URL{
+			Path: server.Path,
+		}
+		if request.URL.RawPath != "" {
+			request.URL.RawPath = prefix.EscapedPath() + request.URL.RawPath
+		}
+		request.URL.Path = server.Path + request.URL.Path
+	}
 
 	// Check the request method and body:
 	switch request.Method {
@@ -85,6 +105,65 @@ func (c *Connection) RoundTrip(request *http.Request) (response *http.Response,
 		request.Header.Set("Content-Type", "application/json")
 	}
 	request.Header.Set("Accept", "application/json")
+	for name, values := range c.headers {
+		if request.Header.Get(name) == "" {
+			request.Header[name] = append([]string(nil), values...)
+		}
+	}
+
+	// Apply the timeout. Note that the context can't be cancelled when this method returns
+	// because the caller still needs to read the response body, so it is cancelled when the body
+	// is closed instead.
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		request = request.WithContext(ctx)
+		end := finish
+		finish = func() {
+			cancel()
+			end()
+		}
+	}
+
+	// Cancel the request when the scope of the connection is cancelled, if it has one. As with
+	// the timeout this is released when the body is closed.
+	if c.scope != nil {
+		if c.scope.Err() != nil {
+			err = fmt.Errorf("group has been cancelled: %w", context.Cause(c.scope))
+			return
+		}
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		request = request.WithContext(ctx)
+		stop := context.AfterFunc(c.scope, func() {
+			cancel(context.Cause(c.scope))
+		})
+		end := finish
+		finish = func() {
+			stop()
+			cancel(nil)
+			end()
+		}
+	}
+
+	// Cancel the request when the connection is closed without waiting for it. As with the
+	// timeout this is released when the body is closed.
+	if c.stopped.Err() != nil {
+		err = fmt.Errorf("connection is closed")
+		return
+	}
+	var cancel context.CancelCauseFunc
+	ctx, cancel = context.WithCancelCause(ctx)
+	request = request.WithContext(ctx)
+	stop := context.AfterFunc(c.stopped, func() {
+		cancel(context.Cause(c.stopped))
+	})
+	end := finish
+	finish = func() {
+		stop()
+		cancel(nil)
+		end()
+	}
 
 	// Select the client:
 	client, err := c.clientSelector.Select(ctx, server)
@@ -104,9 +183,29 @@ func (c *Connection) RoundTrip(request *http.Request) (response *http.Response,
 		return
 	}
 
+	// Make sure that the request is marked as finished when the body is closed:
+	response.Body = &finishReadCloser{
+		ReadCloser: response.Body,
+		finish:     finish,
+	}
+
 	return
 }
 
+// finishReadCloser is a response body that calls a function when it is closed for the first time.
+type finishReadCloser struct {
+	io.ReadCloser
+	finish func()
+	once   sync.Once
+}
+
+// Close closes the underlying body and then calls the finish function.
+func (r *finishReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.finish)
+	return err
+}
+
 // selectServer selects the server that should be used for the given request, according its path and
 // the alternative URLs configured when the connection was created.
 func (c *Connection) selectServer(ctx context.Context,
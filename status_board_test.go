@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the typed clients of the status board service.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	sbv1 "github.com/openshift-online/ocm-sdk-go/statusboard/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Status board", func() {
+	var ctx context.Context
+	var server *ghttp.Server
+	var connection *Connection
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = MakeTCPServer()
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+		server.Close()
+	})
+
+	It("Lists products", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/status-board/v1/products",
+					"search=name+%3D+%27OCM%27",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "ProductList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"kind": "Product",
+							"id": "123",
+							"name": "OCM",
+							"fullname": "OpenShift Cluster Manager"
+						}
+					]
+				}`),
+			),
+		)
+		response, err := connection.StatusBoard().V1().Products().List().
+			Search("name = 'OCM'").
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Total()).To(Equal(1))
+		product := response.Items().Get(0)
+		Expect(product.ID()).To(Equal("123"))
+		Expect(product.Name()).To(Equal("OCM"))
+		Expect(product.Fullname()).To(Equal("OpenShift Cluster Manager"))
+	})
+
+	It("Retrieves a service", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/status-board/v1/services/456"),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "Service",
+					"id": "456",
+					"name": "clusters-service"
+				}`),
+			),
+		)
+		response, err := connection.StatusBoard().V1().Services().Service("456").Get().
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().Name()).To(Equal("clusters-service"))
+	})
+
+	It("Lists the statuses of a set of products", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/status-board/v1/statuses",
+					"product_ids=123%2C456",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "StatusList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"kind": "Status",
+							"id": "789",
+							"status": "green"
+						}
+					]
+				}`),
+			),
+		)
+		response, err := connection.StatusBoard().V1().Statuses().List().
+			ProductIds("123,456").
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Items().Get(0).Status()).To(Equal("green"))
+	})
+
+	It("Adds a status update", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/status-board/v1/status_updates"),
+				VerifyJQ(`.status`, "red"),
+				VerifyJQ(`.service.id`, "456"),
+				RespondWithJSON(http.StatusCreated, `{
+					"kind": "Status",
+					"id": "789",
+					"status": "red"
+				}`),
+			),
+		)
+		update, err := sbv1.NewStatus().
+			Status("red").
+			Service(sbv1.NewService().ID("456")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := connection.StatusBoard().V1().StatusUpdates().Add().
+			Body(update).
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().ID()).To(Equal("789"))
+	})
+
+	It("Polls a product", func() {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "Product",
+				"id": "123",
+				"name": "OCM"
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "Product",
+				"id": "123",
+				"name": "OCM",
+				"fullname": "OpenShift Cluster Manager"
+			}`),
+		)
+		response, err := connection.StatusBoard().V1().Products().Product("123").Poll().
+			Interval(10 * time.Millisecond).
+			Predicate(func(response *sbv1.ProductGetResponse) bool {
+				return response.Body().Fullname() != ""
+			}).
+			StartContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().Fullname()).To(Equal("OpenShift Cluster Manager"))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+})
@@ -45,7 +45,7 @@ func NewMetricsServer() *MetricsServer {
 	// Create the server:
 	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
 	server := NewServer()
-	server.AppendHandlers(handler.ServeHTTP)
+	server.RouteToHandler(http.MethodGet, "/metrics", handler.ServeHTTP)
 
 	// Create and populate the object:
 	return &MetricsServer{
@@ -331,3 +331,29 @@ func LocalhostCertificate() tls.Certificate {
 
 // localhostCertificate contains the TLS certificate returned by the LocalhostCertificate function.
 var localhostCertificate *tls.Certificate
+
+// RespondWithPermanentRedirect responds with a permanent redirect to the given target URL,
+// changing the scheme, host and port number but preserving the path of the original request.
+func RespondWithPermanentRedirect(target string) http.HandlerFunc {
+	return RespondWithRedirect(http.StatusPermanentRedirect, target)
+}
+
+// RespondWithTemporaryRedirect responds with a temporary redirect to the given target URL,
+// changing the scheme, host and port number but preserving the path of the original request.
+func RespondWithTemporaryRedirect(target string) http.HandlerFunc {
+	return RespondWithRedirect(http.StatusTemporaryRedirect, target)
+}
+
+// RespondWithRedirect responds with a redirect with the given code to the given target URL,
+// changing the scheme, host and port number but preserving the path of the original request.
+func RespondWithRedirect(code int, target string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parsed, err := url.Parse(target)
+		Expect(err).ToNot(HaveOccurred())
+		location := r.URL
+		location.Scheme = parsed.Scheme
+		location.Host = parsed.Host
+		w.Header().Set("Location", location.String())
+		w.WriteHeader(code)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega/ghttp"
 
 	. "github.com/onsi/gomega" // nolint
 )
@@ -78,6 +79,29 @@ func MakeTokenString(typ string, life time.Duration) string {
 	return token.Raw
 }
 
+// MakeTokenStringWithClaims generates a token issued by the default OpenID server with the claims
+// resulting from merging the default claims and the claims explicitly given. Claims with a nil
+// value are removed from the default claims. For example, to generate a token that contains the
+// user name and doesn't have an expiration time:
+//
+//	token := MakeTokenStringWithClaims(jwt.MapClaims{
+//		"username": "joe",
+//		"exp":      nil,
+//	})
+func MakeTokenStringWithClaims(claims jwt.MapClaims) string {
+	return MakeTokenObject(claims).Raw
+}
+
+// RespondWithTokens responds with the given access and refresh tokens, like the token endpoint of
+// the OpenID server does. If the refresh token is empty only the access token is returned.
+func RespondWithTokens(accessToken, refreshToken string) http.HandlerFunc {
+	if refreshToken == "" {
+		return RespondWithAccessToken(accessToken)
+	}
+	return RespondWithAccessAndRefreshTokens(accessToken, refreshToken)
+}
+
+// RespondWithAccessAndRefreshTokens responds with the given access and refresh tokens.
 func RespondWithAccessAndRefreshTokens(accessToken, refreshToken string) http.HandlerFunc {
 	return RespondWithJSONTemplate(
 		http.StatusOK,
@@ -90,6 +114,7 @@ func RespondWithAccessAndRefreshTokens(accessToken, refreshToken string) http.Ha
 	)
 }
 
+// RespondWithAccessToken responds with the given access token and without refresh token.
 func RespondWithAccessToken(accessToken string) http.HandlerFunc {
 	return RespondWithJSONTemplate(
 		http.StatusOK,
@@ -100,6 +125,7 @@ func RespondWithAccessToken(accessToken string) http.HandlerFunc {
 	)
 }
 
+// RespondWithTokenError responds with the given OAuth error code and description.
 func RespondWithTokenError(err, description string) http.HandlerFunc {
 	return RespondWithJSONTemplate(
 		http.StatusUnauthorized,
@@ -112,6 +138,41 @@ func RespondWithTokenError(err, description string) http.HandlerFunc {
 	)
 }
 
+// VerifyPasswordGrant verifies that the request is a token request that uses the password grant
+// with the given user name and password.
+func VerifyPasswordGrant(user, password string) http.HandlerFunc {
+	return ghttp.CombineHandlers(
+		ghttp.VerifyRequest(http.MethodPost, "/"),
+		ghttp.VerifyContentType("application/x-www-form-urlencoded"),
+		ghttp.VerifyFormKV("grant_type", "password"),
+		ghttp.VerifyFormKV("username", user),
+		ghttp.VerifyFormKV("password", password),
+	)
+}
+
+// VerifyClientCredentialsGrant verifies that the request is a token request that uses the client
+// credentials grant with the given client identifier and secret.
+func VerifyClientCredentialsGrant(id, secret string) http.HandlerFunc {
+	return ghttp.CombineHandlers(
+		ghttp.VerifyRequest(http.MethodPost, "/"),
+		ghttp.VerifyContentType("application/x-www-form-urlencoded"),
+		ghttp.VerifyBasicAuth(id, secret),
+		ghttp.VerifyFormKV("grant_type", "client_credentials"),
+		ghttp.VerifyFormKV("client_id", id),
+	)
+}
+
+// VerifyRefreshGrant verifies that the request is a token request that uses the refresh token
+// grant with the given refresh token.
+func VerifyRefreshGrant(refreshToken string) http.HandlerFunc {
+	return ghttp.CombineHandlers(
+		ghttp.VerifyRequest(http.MethodPost, "/"),
+		ghttp.VerifyContentType("application/x-www-form-urlencoded"),
+		ghttp.VerifyFormKV("grant_type", "refresh_token"),
+		ghttp.VerifyFormKV("refresh_token", refreshToken),
+	)
+}
+
 // DefaultJWKS generates the JSON web key set used for tests.
 func DefaultJWKS() []byte {
 	// Create a temporary file containing the JSON web key set:
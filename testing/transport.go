@@ -19,8 +19,11 @@ package testing
 import (
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/onsi/gomega/ghttp"
+
 	. "github.com/onsi/gomega" // nolint
 )
 
@@ -86,3 +89,24 @@ func CombineTransports(transports ...http.RoundTripper) http.RoundTripper {
 		return
 	})
 }
+
+// ServerTransport creates a transport that sends all the requests to the given server, replacing the
+// scheme and host of the request URL and adding the content type header like the connection does.
+// This is intended for testing the clients of the generated packages, as they send requests that
+// only contain the path.
+func ServerTransport(server *ghttp.Server) http.RoundTripper {
+	address, err := url.Parse(server.URL())
+	Expect(err).ToNot(HaveOccurred())
+	return TransportFunc(func(request *http.Request) (*http.Response, error) {
+		request.URL.Scheme = address.Scheme
+		request.URL.Host = address.Host
+		switch request.Method {
+		case http.MethodPost, http.MethodPatch, http.MethodPut:
+			if request.Header == nil {
+				request.Header = make(http.Header)
+			}
+			request.Header.Set("Content-Type", "application/json")
+		}
+		return http.DefaultTransport.RoundTrip(request)
+	})
+}
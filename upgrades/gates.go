@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that acknowledge the version gates required to upgrade a cluster.
+
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// PendingVersionGates returns the version gates that need to be acknowledged before the given
+// cluster can be upgraded to the target version, for example `4.15.2` or `openshift-v4.15.2`. This
+// is the dry run counterpart of AckVersionGates: it doesn't create any agreement.
+func PendingVersionGates(ctx context.Context, client *cmv1.Client, clusterID string,
+	targetVersion string) (result []*cmv1.VersionGate, err error) {
+	if clusterID == "" {
+		err = errors.New("cluster identifier is mandatory")
+		return
+	}
+	target := strings.TrimPrefix(targetVersion, "openshift-v")
+	if target == "" {
+		err = errors.New("target version is mandatory")
+		return
+	}
+	cluster, version, err := getClusterVersion(ctx, client, clusterID)
+	if err != nil {
+		return
+	}
+	gates, err := listGates(ctx, client)
+	if err != nil {
+		return
+	}
+	agreed, err := listAgreements(ctx, client, clusterID)
+	if err != nil {
+		return
+	}
+	result = selectGates(
+		gates,
+		agreed,
+		minorVersion(version.RawID()),
+		target,
+		cluster.AWS().STS().Enabled(),
+	)
+	return
+}
+
+// AckVersionGates creates the agreements for the version gates that need to be acknowledged before
+// the given cluster can be upgraded to the target version. It returns the gates that have been
+// acknowledged, which is empty if there was nothing to acknowledge. Use PendingVersionGates to
+// find the gates without creating the agreements.
+func AckVersionGates(ctx context.Context, client *cmv1.Client, clusterID string,
+	targetVersion string) (result []*cmv1.VersionGate, err error) {
+	pending, err := PendingVersionGates(ctx, client, clusterID, targetVersion)
+	if err != nil {
+		return
+	}
+	collection := client.Clusters().Cluster(clusterID).GateAgreements()
+	for _, gate := range pending {
+		var agreement *cmv1.VersionGateAgreement
+		agreement, err = cmv1.NewVersionGateAgreement().
+			VersionGate(cmv1.NewVersionGate().ID(gate.ID())).
+			Build()
+		if err != nil {
+			return
+		}
+		_, err = collection.Add().Body(agreement).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf(
+				"can't acknowledge version gate '%s' for cluster '%s': %w",
+				gate.ID(), clusterID, err,
+			)
+			return
+		}
+		result = append(result, gate)
+	}
+	return
+}
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrades
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Version gates", func() {
+	// appendQueries adds to the server the handlers for the requests that retrieve the cluster,
+	// its version, the gates and the existing agreements.
+	appendQueries := func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"version": {
+						"id": "openshift-v4.14.5"
+					},
+					"aws": {
+						"sts": {
+							"enabled": true
+						}
+					}
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/versions/openshift-v4.14.5",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "openshift-v4.14.5",
+					"raw_id": "4.14.5"
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/version_gates"),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 3,
+					"total": 3,
+					"items": [
+						{
+							"id": "gate-1",
+							"version_raw_id_prefix": "4.15"
+						},
+						{
+							"id": "gate-2",
+							"version_raw_id_prefix": "4.15",
+							"sts_only": true
+						},
+						{
+							"id": "gate-3",
+							"version_raw_id_prefix": "4.16"
+						}
+					]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/gate_agreements",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 0,
+					"total": 0,
+					"items": []
+				}`),
+			),
+		)
+	}
+
+	It("Returns the pending gates without creating agreements", func() {
+		appendQueries()
+		gates, err := PendingVersionGates(ctx, client, "123", "openshift-v4.15.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gates).To(HaveLen(2))
+		Expect(gates[0].ID()).To(Equal("gate-1"))
+		Expect(gates[1].ID()).To(Equal("gate-2"))
+		Expect(server.ReceivedRequests()).To(HaveLen(4))
+	})
+
+	It("Creates the agreements for the pending gates", func() {
+		appendQueries()
+		for _, id := range []string{"gate-1", "gate-2"} {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/gate_agreements",
+					),
+					VerifyJQ(".version_gate.id", id),
+					RespondWithJSON(http.StatusCreated, `{
+						"id": "agreement-`+id+`"
+					}`),
+				),
+			)
+		}
+		gates, err := AckVersionGates(ctx, client, "123", "4.15.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gates).To(HaveLen(2))
+		Expect(server.ReceivedRequests()).To(HaveLen(6))
+	})
+
+	It("Doesn't need gates within the same minor version", func() {
+		appendQueries()
+		gates, err := AckVersionGates(ctx, client, "123", "4.14.6")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gates).To(BeEmpty())
+		Expect(server.ReceivedRequests()).To(HaveLen(4))
+	})
+})
@@ -0,0 +1,239 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that create upgrade policies and wait till they are completed.
+
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+)
+
+// CreateControlPlaneUpgrade schedules the upgrade of the control plane of a cluster with a hosted
+// control plane to the given version. If the next run time is zero the upgrade is scheduled to
+// start as soon as possible.
+func CreateControlPlaneUpgrade(ctx context.Context, client *cmv1.Client, clusterID string,
+	version string, nextRun time.Time) (result *cmv1.ControlPlaneUpgradePolicy, err error) {
+	if nextRun.IsZero() {
+		nextRun = time.Now().Add(startDelay)
+	}
+	policy, err := cmv1.NewControlPlaneUpgradePolicy().
+		UpgradeType(cmv1.UpgradeTypeControlPlane).
+		ScheduleType(cmv1.ScheduleTypeManual).
+		Version(version).
+		NextRun(nextRun).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := client.Clusters().Cluster(clusterID).ControlPlane().UpgradePolicies().Add().
+		Body(policy).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't create control plane upgrade policy for cluster '%s': %w",
+			clusterID, err,
+		)
+		return
+	}
+	result = response.Body()
+	return
+}
+
+// CreateNodePoolUpgrade schedules the upgrade of a node pool of a cluster with a hosted control
+// plane to the given version. If the next run time is zero the upgrade is scheduled to start as
+// soon as possible.
+func CreateNodePoolUpgrade(ctx context.Context, client *cmv1.Client, clusterID string,
+	nodePoolID string, version string,
+	nextRun time.Time) (result *cmv1.NodePoolUpgradePolicy, err error) {
+	if nextRun.IsZero() {
+		nextRun = time.Now().Add(startDelay)
+	}
+	policy, err := cmv1.NewNodePoolUpgradePolicy().
+		UpgradeType(cmv1.UpgradeTypeNodePool).
+		ScheduleType(cmv1.ScheduleTypeManual).
+		Version(version).
+		NextRun(nextRun).
+		Build()
+	if err != nil {
+		return
+	}
+	response, err := client.Clusters().Cluster(clusterID).NodePools().NodePool(nodePoolID).
+		UpgradePolicies().Add().
+		Body(policy).
+		SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf(
+			"can't create upgrade policy for node pool '%s' of cluster '%s': %w",
+			nodePoolID, clusterID, err,
+		)
+		return
+	}
+	result = response.Body()
+	return
+}
+
+// WaitOptions contains the options that control how the functions that wait for upgrades check
+// the state of the upgrade policies. The zero value, or a nil pointer, means that the default
+// values will be used.
+type WaitOptions struct {
+	// Interval is the time to wait between checks. The default is one minute.
+	Interval time.Duration
+
+	// Timeout is the maximum total time to wait. The default is to wait till the context is
+	// cancelled.
+	Timeout time.Duration
+
+	// Progress is a function that will be called after each check of the state of the upgrade
+	// policy that didn't find it completed. It is called synchronously, so it should return
+	// quickly.
+	Progress func(*WaitEvent)
+}
+
+// WaitEvent contains the details of one of the checks performed while waiting for an upgrade.
+type WaitEvent struct {
+	// State is the state of the upgrade policy returned by the server in the last check.
+	State *cmv1.UpgradePolicyState
+
+	// Attempt is the number of the check, starting with one.
+	Attempt int
+
+	// Elapsed is the time elapsed since the wait started.
+	Elapsed time.Duration
+}
+
+// UpgradeError is the error returned by the wait functions when the upgrade fails or is
+// cancelled.
+type UpgradeError struct {
+	// PolicyID is the identifier of the upgrade policy.
+	PolicyID string
+
+	// State is the state of the upgrade policy.
+	State cmv1.UpgradePolicyStateValue
+
+	// Description is the description of the state reported by the server, if any.
+	Description string
+}
+
+// Error is the implementation of the error interface.
+func (e *UpgradeError) Error() string {
+	result := fmt.Sprintf("upgrade policy '%s' is in state '%s'", e.PolicyID, e.State)
+	if e.Description != "" {
+		result = fmt.Sprintf("%s: %s", result, e.Description)
+	}
+	return result
+}
+
+// WaitForControlPlaneUpgrade waits till the given control plane upgrade policy is completed. Note
+// that the server removes upgrade policies once they are completed, so a policy that doesn't exist
+// is considered completed.
+func WaitForControlPlaneUpgrade(ctx context.Context, client *cmv1.Client, clusterID string,
+	policyID string, options *WaitOptions) error {
+	resource := client.Clusters().Cluster(clusterID).ControlPlane().UpgradePolicies().
+		ControlPlaneUpgradePolicy(policyID)
+	return wait(ctx, policyID, options, func(ctx context.Context) (*cmv1.UpgradePolicyState, error) {
+		response, err := resource.Get().SendContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return response.Body().State(), nil
+	})
+}
+
+// WaitForNodePoolUpgrade waits till the given node pool upgrade policy is completed. Note that the
+// server removes upgrade policies once they are completed, so a policy that doesn't exist is
+// considered completed.
+func WaitForNodePoolUpgrade(ctx context.Context, client *cmv1.Client, clusterID string,
+	nodePoolID string, policyID string, options *WaitOptions) error {
+	resource := client.Clusters().Cluster(clusterID).NodePools().NodePool(nodePoolID).
+		UpgradePolicies().NodePoolUpgradePolicy(policyID)
+	return wait(ctx, policyID, options, func(ctx context.Context) (*cmv1.UpgradePolicyState, error) {
+		response, err := resource.Get().SendContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return response.Body().State(), nil
+	})
+}
+
+// wait checks the state of an upgrade policy, using the given function, till it is completed.
+func wait(ctx context.Context, policyID string, options *WaitOptions,
+	check func(context.Context) (*cmv1.UpgradePolicyState, error)) error {
+	if options == nil {
+		options = &WaitOptions{}
+	}
+	interval := options.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		state, err := check(ctx)
+		if errors.Is(err, ocmerrors.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("can't get upgrade policy '%s': %w", policyID, err)
+		}
+		switch state.Value() {
+		case cmv1.UpgradePolicyStateValueCompleted:
+			return nil
+		case cmv1.UpgradePolicyStateValueFailed, cmv1.UpgradePolicyStateValueCancelled:
+			return &UpgradeError{
+				PolicyID:    policyID,
+				State:       state.Value(),
+				Description: state.Description(),
+			}
+		}
+		if options.Progress != nil {
+			options.Progress(&WaitEvent{
+				State:   state,
+				Attempt: attempt,
+				Elapsed: time.Since(start),
+			})
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf(
+				"upgrade policy '%s' didn't complete, last state was '%s': %w",
+				policyID, state.Value(), ctx.Err(),
+			)
+		case <-timer.C:
+		}
+	}
+}
+
+// Default values:
+const (
+	defaultInterval = 1 * time.Minute
+
+	// startDelay is the delay added to the current time when the caller doesn't specify when the
+	// upgrade should start, as the server rejects policies scheduled in the past.
+	startDelay = 5 * time.Minute
+)
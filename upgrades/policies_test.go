@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrades
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Upgrade policies", func() {
+	It("Creates control plane upgrade policy", func() {
+		nextRun := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/clusters_mgmt/v1/clusters/123/control_plane/upgrade_policies",
+				),
+				VerifyJQ(".upgrade_type", "ControlPlane"),
+				VerifyJQ(".schedule_type", "manual"),
+				VerifyJQ(".version", "4.15.0"),
+				VerifyJQ(".next_run", "2026-01-02T03:04:05Z"),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "456",
+					"version": "4.15.0"
+				}`),
+			),
+		)
+		policy, err := CreateControlPlaneUpgrade(ctx, client, "123", "4.15.0", nextRun)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policy.ID()).To(Equal("456"))
+	})
+
+	It("Creates node pool upgrade policy", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodPost,
+					"/api/clusters_mgmt/v1/clusters/123/node_pools/workers/upgrade_policies",
+				),
+				VerifyJQ(".upgrade_type", "NodePool"),
+				VerifyJQ(".version", "4.15.0"),
+				RespondWithJSON(http.StatusCreated, `{
+					"id": "789"
+				}`),
+			),
+		)
+		policy, err := CreateNodePoolUpgrade(ctx, client, "123", "workers", "4.15.0", time.Time{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policy.ID()).To(Equal("789"))
+	})
+
+	It("Waits till the upgrade is completed", func() {
+		path := "/api/clusters_mgmt/v1/clusters/123/control_plane/upgrade_policies/456"
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, path),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "456",
+					"state": {
+						"value": "scheduled"
+					}
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, path),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "456",
+					"state": {
+						"value": "started"
+					}
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, path),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "456",
+					"state": {
+						"value": "completed"
+					}
+				}`),
+			),
+		)
+		var states []cmv1.UpgradePolicyStateValue
+		err := WaitForControlPlaneUpgrade(ctx, client, "123", "456", &WaitOptions{
+			Interval: time.Millisecond,
+			Progress: func(event *WaitEvent) {
+				states = append(states, event.State.Value())
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(states).To(Equal([]cmv1.UpgradePolicyStateValue{
+			cmv1.UpgradePolicyStateValueScheduled,
+			cmv1.UpgradePolicyStateValueStarted,
+		}))
+	})
+
+	It("Considers completed a policy that has been removed", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/node_pools/workers/upgrade_policies/789",
+				),
+				RespondWithJSON(http.StatusNotFound, `{
+					"kind": "Error",
+					"id": "404",
+					"href": "/api/clusters_mgmt/v1/errors/404",
+					"code": "CLUSTERS-MGMT-404",
+					"reason": "Upgrade policy not found"
+				}`),
+			),
+		)
+		err := WaitForNodePoolUpgrade(ctx, client, "123", "workers", "789", nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Fails if the upgrade fails", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/control_plane/upgrade_policies/456",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "456",
+					"state": {
+						"value": "failed",
+						"description": "Upgrade failed"
+					}
+				}`),
+			),
+		)
+		err := WaitForControlPlaneUpgrade(ctx, client, "123", "456", nil)
+		Expect(err).To(HaveOccurred())
+		var upgradeErr *UpgradeError
+		Expect(errors.As(err, &upgradeErr)).To(BeTrue())
+		Expect(upgradeErr.State).To(Equal(cmv1.UpgradePolicyStateValueFailed))
+		Expect(upgradeErr.Description).To(Equal("Upgrade failed"))
+	})
+})
@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions that calculate the versions that a cluster can be upgraded to.
+
+package upgrades
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// AvailableUpgrade describes a version that a cluster can be upgraded to.
+type AvailableUpgrade struct {
+	// Version is the raw identifier of the version, for example `4.15.2`.
+	Version string
+
+	// Gates are the version gates that apply to the upgrade and that haven't been agreed yet.
+	// They need to be acknowledged before the upgrade can be scheduled.
+	Gates []*cmv1.VersionGate
+}
+
+// AvailableUpgrades returns the versions that the given cluster can be upgraded to, in the order
+// returned by the server, together with the version gates that need to be acknowledged for each
+// of them.
+func AvailableUpgrades(ctx context.Context, client *cmv1.Client,
+	clusterID string) (result []*AvailableUpgrade, err error) {
+	// Get the cluster and the details of its current version:
+	cluster, version, err := getClusterVersion(ctx, client, clusterID)
+	if err != nil {
+		return
+	}
+	targets := version.AvailableUpgrades()
+	if len(targets) == 0 {
+		return
+	}
+
+	// Get the gates and the agreements that the cluster already has:
+	gates, err := listGates(ctx, client)
+	if err != nil {
+		return
+	}
+	agreed, err := listAgreements(ctx, client, clusterID)
+	if err != nil {
+		return
+	}
+
+	// Calculate the gates that apply to each target version:
+	current := minorVersion(version.RawID())
+	sts := cluster.AWS().STS().Enabled()
+	result = make([]*AvailableUpgrade, len(targets))
+	for i, target := range targets {
+		result[i] = &AvailableUpgrade{
+			Version: target,
+			Gates:   selectGates(gates, agreed, current, target, sts),
+		}
+	}
+	return
+}
+
+// getClusterVersion retrieves the cluster and the details of its current version.
+func getClusterVersion(ctx context.Context, client *cmv1.Client,
+	clusterID string) (cluster *cmv1.Cluster, version *cmv1.Version, err error) {
+	clusterResponse, err := client.Clusters().Cluster(clusterID).Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't get cluster '%s': %w", clusterID, err)
+		return
+	}
+	cluster = clusterResponse.Body()
+	versionID := cluster.Version().ID()
+	if versionID == "" {
+		err = fmt.Errorf("cluster '%s' doesn't have a version", clusterID)
+		return
+	}
+	versionResponse, err := client.Versions().Version(versionID).Get().SendContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("can't get version '%s': %w", versionID, err)
+		return
+	}
+	version = versionResponse.Body()
+	return
+}
+
+// selectGates returns the gates that apply to an upgrade from the current minor version to the
+// target version and that haven't been agreed yet. Upgrades within the same minor version don't
+// need gates.
+func selectGates(gates []*cmv1.VersionGate, agreed map[string]bool, current, target string,
+	sts bool) (result []*cmv1.VersionGate) {
+	minor := minorVersion(target)
+	if minor == current {
+		return
+	}
+	for _, gate := range gates {
+		if gate.VersionRawIDPrefix() != minor {
+			continue
+		}
+		if gate.STSOnly() && !sts {
+			continue
+		}
+		if agreed[gate.ID()] {
+			continue
+		}
+		result = append(result, gate)
+	}
+	return
+}
+
+// listGates retrieves all the version gates.
+func listGates(ctx context.Context, client *cmv1.Client) (result []*cmv1.VersionGate, err error) {
+	page := 1
+	for {
+		var response *cmv1.VersionGatesListResponse
+		response, err = client.VersionGates().List().Page(page).Size(listSize).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list version gates: %w", err)
+			return
+		}
+		result = append(result, response.Items().Slice()...)
+		if response.Items().Len() < listSize {
+			return
+		}
+		page++
+	}
+}
+
+// listAgreements retrieves the identifiers of the version gates that have already been agreed for
+// the given cluster.
+func listAgreements(ctx context.Context, client *cmv1.Client,
+	clusterID string) (result map[string]bool, err error) {
+	result = map[string]bool{}
+	collection := client.Clusters().Cluster(clusterID).GateAgreements()
+	page := 1
+	for {
+		var response *cmv1.VersionGateAgreementsListResponse
+		response, err = collection.List().Page(page).Size(listSize).SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("can't list version gate agreements of cluster '%s': %w", clusterID, err)
+			return
+		}
+		response.Items().Each(func(item *cmv1.VersionGateAgreement) bool {
+			result[item.VersionGate().ID()] = true
+			return true
+		})
+		if response.Items().Len() < listSize {
+			return
+		}
+		page++
+	}
+}
+
+// minorVersion extracts the major and minor parts of a version, for example `4.15` from `4.15.2`.
+func minorVersion(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// Size of the pages used to retrieve lists:
+const listSize = 100
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrades
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Available upgrades", func() {
+	It("Returns the upgrades with the gates that haven't been agreed", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"version": {
+						"id": "openshift-v4.14.5"
+					},
+					"aws": {
+						"sts": {
+							"enabled": false
+						}
+					}
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/versions/openshift-v4.14.5",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "openshift-v4.14.5",
+					"raw_id": "4.14.5",
+					"available_upgrades": [
+						"4.14.6",
+						"4.15.0"
+					]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/version_gates"),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 3,
+					"total": 3,
+					"items": [
+						{
+							"id": "gate-1",
+							"version_raw_id_prefix": "4.15"
+						},
+						{
+							"id": "gate-2",
+							"version_raw_id_prefix": "4.15"
+						},
+						{
+							"id": "gate-3",
+							"version_raw_id_prefix": "4.15",
+							"sts_only": true
+						}
+					]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/gate_agreements",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+							"id": "agreement-1",
+							"version_gate": {
+								"id": "gate-2"
+							}
+						}
+					]
+				}`),
+			),
+		)
+		upgrades, err := AvailableUpgrades(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upgrades).To(HaveLen(2))
+		Expect(upgrades[0].Version).To(Equal("4.14.6"))
+		Expect(upgrades[0].Gates).To(BeEmpty())
+		Expect(upgrades[1].Version).To(Equal("4.15.0"))
+		Expect(upgrades[1].Gates).To(HaveLen(1))
+		Expect(upgrades[1].Gates[0].ID()).To(Equal("gate-1"))
+	})
+
+	It("Returns nothing if there are no available upgrades", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "123",
+					"version": {
+						"id": "openshift-v4.15.0"
+					}
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/versions/openshift-v4.15.0",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"id": "openshift-v4.15.0",
+					"raw_id": "4.15.0"
+				}`),
+			),
+		)
+		upgrades, err := AvailableUpgrades(ctx, client, "123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upgrades).To(BeEmpty())
+	})
+})
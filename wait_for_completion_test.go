@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the function that waits for the completion of asynchronous
+// operations.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	sdkerrors "github.com/openshift-online/ocm-sdk-go/errors"
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Wait for completion", func() {
+	var (
+		ctx        context.Context
+		server     *ghttp.Server
+		connection *Connection
+	)
+
+	// accepted returns the headers of a response that accepted an operation for asynchronous
+	// processing and that points to the given location.
+	accepted := func(location string) http.Header {
+		return http.Header{
+			"Location": []string{location},
+		}
+	}
+
+	// decodeError converts the responses with error status codes into API errors.
+	decodeError := func(source io.Reader, response *http.Response) error {
+		apiErr, err := sdkerrors.UnmarshalErrorStatus(source, response.StatusCode)
+		if err != nil {
+			return err
+		}
+		return apiErr
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create the server:
+		server = MakeTCPServer()
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the server:
+		server.Close()
+	})
+
+	It("Doesn't poll if the operation isn't accepted", func() {
+		err := helpers.WaitForCompletion(ctx, &helpers.CompletionOptions{
+			Transport: connection,
+			Path:      "/api/clusters_mgmt/v1/clusters/123",
+			Method:    http.MethodDelete,
+			Status:    http.StatusNoContent,
+			Header:    accepted("/api/clusters_mgmt/v1/clusters/123"),
+			Interval:  10 * time.Millisecond,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Polls the location till the object is deleted", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusAccepted, `{}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusNotFound, `{
+					"kind": "Error",
+					"id": "404",
+					"reason": "Cluster '123' not found"
+				}`),
+			),
+		)
+
+		// Wait for the operation:
+		var progress []*helpers.CompletionProgress
+		err := helpers.WaitForCompletion(ctx, &helpers.CompletionOptions{
+			Transport: connection,
+			Path:      "/api/clusters_mgmt/v1/clusters/123",
+			Method:    http.MethodDelete,
+			Status:    http.StatusAccepted,
+			Header:    accepted("/api/clusters_mgmt/v1/clusters/123"),
+			Interval:  10 * time.Millisecond,
+			Progress: func(value *helpers.CompletionProgress) {
+				progress = append(progress, value)
+			},
+			DecodeError: decodeError,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(progress).To(HaveLen(2))
+		Expect(progress[0].Attempt).To(Equal(1))
+		Expect(progress[0].Status).To(Equal(http.StatusAccepted))
+		Expect(progress[0].Done).To(BeFalse())
+		Expect(progress[1].Attempt).To(Equal(2))
+		Expect(progress[1].Status).To(Equal(http.StatusNotFound))
+		Expect(progress[1].Done).To(BeTrue())
+	})
+
+	It("Resolves relative locations", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/operations/456",
+				),
+				RespondWithJSON(http.StatusOK, `{}`),
+			),
+		)
+
+		// Wait for the operation:
+		err := helpers.WaitForCompletion(ctx, &helpers.CompletionOptions{
+			Transport: connection,
+			Path:      "/api/clusters_mgmt/v1/clusters/123/hibernate",
+			Method:    http.MethodPost,
+			Status:    http.StatusAccepted,
+			Header:    accepted("operations/456"),
+			Interval:  10 * time.Millisecond,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Returns the error of the operation", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusBadRequest, `{
+				"kind": "Error",
+				"id": "400",
+				"reason": "Hibernation failed"
+			}`),
+		)
+
+		// Wait for the operation:
+		err := helpers.WaitForCompletion(ctx, &helpers.CompletionOptions{
+			Transport:   connection,
+			Path:        "/api/clusters_mgmt/v1/clusters/123/hibernate",
+			Method:      http.MethodPost,
+			Status:      http.StatusAccepted,
+			Header:      accepted("/api/clusters_mgmt/v1/clusters/123"),
+			Interval:    10 * time.Millisecond,
+			DecodeError: decodeError,
+		})
+		Expect(err).To(HaveOccurred())
+		var sdkErr *sdkerrors.Error
+		Expect(errors.As(err, &sdkErr)).To(BeTrue())
+		Expect(sdkErr.Status()).To(Equal(http.StatusBadRequest))
+		Expect(sdkErr.Reason()).To(Equal("Hibernation failed"))
+	})
+
+	It("Stops when the context is cancelled", func() {
+		// Prepare the server:
+		server.RouteToHandler(
+			http.MethodGet,
+			"/api/clusters_mgmt/v1/clusters/123",
+			RespondWithJSON(http.StatusAccepted, `{}`),
+		)
+
+		// Wait for the operation:
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		err := helpers.WaitForCompletion(ctx, &helpers.CompletionOptions{
+			Transport: connection,
+			Path:      "/api/clusters_mgmt/v1/clusters/123/hibernate",
+			Method:    http.MethodPost,
+			Status:    http.StatusAccepted,
+			Header:    accepted("/api/clusters_mgmt/v1/clusters/123"),
+			Interval:  10 * time.Millisecond,
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+})
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the function that waits for deletion of objects.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	"github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/helpers"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Wait for deletion", func() {
+	var (
+		ctx        context.Context
+		server     *ghttp.Server
+		connection *Connection
+		client     *cmv1.ClusterClient
+		getFn      func(context.Context) error
+		opts       *helpers.WaitForDeletionOptions
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create the server:
+		server = MakeTCPServer()
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(server.URL()).
+			Tokens(MakeTokenString("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the client and the function that checks the cluster:
+		client = connection.ClustersMgmt().V1().Clusters().Cluster("123")
+		getFn = func(ctx context.Context) error {
+			_, err := client.Get().SendContext(ctx)
+			return err
+		}
+
+		// Use short intervals so that tests run quickly:
+		opts = &helpers.WaitForDeletionOptions{
+			Interval:    10 * time.Millisecond,
+			MaxInterval: 20 * time.Millisecond,
+		}
+	})
+
+	AfterEach(func() {
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Stop the server:
+		server.Close()
+	})
+
+	It("Succeeds when the object doesn't exist", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Cluster '123' not found"
+			}`),
+		)
+
+		// Wait:
+		err := helpers.WaitForDeletion(ctx, getFn, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Polls till the object is deleted", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "Cluster",
+				"id": "123",
+				"state": "uninstalling"
+			}`),
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "Cluster",
+				"id": "123",
+				"state": "uninstalling"
+			}`),
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Cluster '123' not found"
+			}`),
+		)
+
+		// Wait:
+		err := helpers.WaitForDeletion(ctx, getFn, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("Returns other errors", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusForbidden, `{
+				"kind": "Error",
+				"id": "403",
+				"reason": "Forbidden"
+			}`),
+		)
+
+		// Wait:
+		err := helpers.WaitForDeletion(ctx, getFn, opts)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Forbidden"))
+	})
+
+	It("Fails when the timeout expires", func() {
+		// Prepare the server:
+		server.RouteToHandler(
+			http.MethodGet,
+			"/api/clusters_mgmt/v1/clusters/123",
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "Cluster",
+				"id": "123"
+			}`),
+		)
+
+		// Wait:
+		opts.Timeout = 100 * time.Millisecond
+		err := helpers.WaitForDeletion(ctx, getFn, opts)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+
+	It("Uses default options when none are given", func() {
+		// Prepare the server:
+		server.AppendHandlers(
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404"
+			}`),
+		)
+
+		// Wait:
+		err := helpers.WaitForDeletion(ctx, getFn, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Rejects negative interval", func() {
+		opts.Interval = -1
+		err := helpers.WaitForDeletion(ctx, getFn, opts)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("interval"))
+	})
+})
@@ -0,0 +1,21 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks contains helpers for applications that receive the notifications that OCM
+// services send to webhooks. The Verifier type checks the signatures of the notifications and
+// parses them into typed events, so that receivers don't need to decode the JSON documents
+// manually, nor implement the signature checks themselves.
+package webhooks
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types that represent the events sent to webhooks.
+
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+)
+
+// EventType is the type of an event sent to a webhook.
+type EventType string
+
+// Types of events:
+const (
+	// ClusterStateChangedEvent is sent when the state of a cluster changes. The details are
+	// available in the ClusterState field of the event.
+	ClusterStateChangedEvent EventType = "cluster.state_changed"
+
+	// ServiceLogCreatedEvent is sent when a service log entry is created. The details are
+	// available in the ServiceLog field of the event.
+	ServiceLogCreatedEvent EventType = "service_log.created"
+)
+
+// Event is a notification sent to a webhook.
+type Event struct {
+	// ID is the unique identifier of the event. Services may send the same event more than
+	// once, so receivers can use this to discard duplicates.
+	ID string
+
+	// Type is the type of the event.
+	Type EventType
+
+	// Time is the time when the event happened.
+	Time time.Time
+
+	// ClusterState contains the details of the event when the type is
+	// ClusterStateChangedEvent.
+	ClusterState *ClusterStateChange
+
+	// ServiceLog contains the log entry when the type is ServiceLogCreatedEvent.
+	ServiceLog *slv1.LogEntry
+
+	// Data contains the raw JSON document of the details of the event. This is intended for
+	// events of types that aren't explicitly supported.
+	Data json.RawMessage
+}
+
+// ClusterStateChange contains the details of a change in the state of a cluster.
+type ClusterStateChange struct {
+	// ClusterID is the identifier of the cluster.
+	ClusterID string `json:"cluster_id"`
+
+	// ExternalID is the external identifier of the cluster.
+	ExternalID string `json:"external_id,omitempty"`
+
+	// Name is the name of the cluster.
+	Name string `json:"name,omitempty"`
+
+	// PreviousState is the state of the cluster before the change.
+	PreviousState cmv1.ClusterState `json:"previous_state,omitempty"`
+
+	// State is the state of the cluster after the change.
+	State cmv1.ClusterState `json:"state"`
+}
+
+// eventData is the representation of an event in the body of the notification.
+type eventData struct {
+	ID   string          `json:"id"`
+	Type EventType       `json:"type"`
+	Time time.Time       `json:"time"`
+	Data json.RawMessage `json:"data"`
+}
+
+// ParseEvent parses the body of a notification. Note that this doesn't check the signature; use the
+// Parse method of the verifier for that.
+func ParseEvent(body []byte) (result *Event, err error) {
+	var data eventData
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		err = fmt.Errorf("can't parse event: %w", err)
+		return
+	}
+	if data.ID == "" {
+		err = fmt.Errorf("event doesn't have an identifier")
+		return
+	}
+	if data.Type == "" {
+		err = fmt.Errorf("event '%s' doesn't have a type", data.ID)
+		return
+	}
+	event := &Event{
+		ID:   data.ID,
+		Type: data.Type,
+		Time: data.Time,
+		Data: data.Data,
+	}
+	switch data.Type {
+	case ClusterStateChangedEvent:
+		event.ClusterState = &ClusterStateChange{}
+		err = json.Unmarshal(data.Data, event.ClusterState)
+	case ServiceLogCreatedEvent:
+		event.ServiceLog, err = slv1.UnmarshalLogEntry([]byte(data.Data))
+	}
+	if err != nil {
+		err = fmt.Errorf("can't parse data of event '%s' of type '%s': %w", data.ID, data.Type, err)
+		return
+	}
+	result = event
+	return
+}
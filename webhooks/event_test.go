@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the parsing of events.
+
+package webhooks
+
+import (
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+)
+
+var _ = Describe("Event", func() {
+	It("Parses a service log event", func() {
+		event, err := ParseEvent([]byte(`{
+			"id": "123",
+			"type": "service_log.created",
+			"time": "2026-10-16T10:00:00Z",
+			"data": {
+				"kind": "ClusterLog",
+				"id": "456",
+				"cluster_uuid": "789",
+				"severity": "Warning",
+				"summary": "Cluster is running out of capacity"
+			}
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Type).To(Equal(ServiceLogCreatedEvent))
+		Expect(event.ServiceLog).ToNot(BeNil())
+		Expect(event.ServiceLog.ID()).To(Equal("456"))
+		Expect(event.ServiceLog.ClusterUUID()).To(Equal("789"))
+		Expect(event.ServiceLog.Severity()).To(Equal(slv1.SeverityWarning))
+		Expect(event.ServiceLog.Summary()).To(Equal("Cluster is running out of capacity"))
+	})
+
+	It("Keeps the data of unknown events", func() {
+		event, err := ParseEvent([]byte(`{
+			"id": "123",
+			"type": "my.event",
+			"data": {
+				"my": "value"
+			}
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Type).To(Equal(EventType("my.event")))
+		Expect(event.ClusterState).To(BeNil())
+		Expect(event.ServiceLog).To(BeNil())
+		Expect(event.Data).To(MatchJSON(`{"my": "value"}`))
+	})
+
+	It("Fails if the event doesn't have a type", func() {
+		_, err := ParseEvent([]byte(`{
+			"id": "123"
+		}`))
+		Expect(err).To(MatchError("event '123' doesn't have a type"))
+	})
+
+	It("Fails if the data doesn't match the type", func() {
+		_, err := ParseEvent([]byte(`{
+			"id": "123",
+			"type": "cluster.state_changed",
+			"data": "junk"
+		}`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't parse data of event '123'"))
+	})
+})
@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the verifier that checks the signatures of the
+// notifications sent to webhooks.
+
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// Names of the headers used to sign notifications:
+const (
+	// SignatureHeader is the header that contains the signatures of the notification, in the
+	// `sha256=...` format. It may contain multiple comma separated signatures, for example
+	// while the secret is being rotated.
+	SignatureHeader = "X-OCM-Signature"
+
+	// TimestampHeader is the header that contains the time when the notification was sent,
+	// as the number of seconds since the Unix epoch.
+	TimestampHeader = "X-OCM-Timestamp"
+)
+
+// Default configuration:
+const (
+	DefaultTolerance   = 5 * time.Minute
+	DefaultMaxBodySize = 1 << 20
+)
+
+// signaturePrefix is the prefix of the signatures generated with HMAC SHA-256.
+const signaturePrefix = "sha256="
+
+// ErrInvalidSignature is returned when a notification doesn't have a valid signature. The errors
+// returned by the verifier wrap it, so use errors.Is to check for it.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// VerifierBuilder contains the data and logic needed to create a new webhook verifier. Don't
+// create objects of this type directly; use the NewVerifier function instead.
+type VerifierBuilder struct {
+	logger      logging.Logger
+	secrets     []string
+	tolerance   time.Duration
+	maxBodySize int64
+}
+
+// Verifier checks the signatures of the notifications sent to webhooks and parses them into
+// events.
+type Verifier struct {
+	logger      logging.Logger
+	secrets     [][]byte
+	tolerance   time.Duration
+	maxBodySize int64
+}
+
+// NewVerifier creates a new builder that can then be used to configure and create a webhook
+// verifier.
+func NewVerifier() *VerifierBuilder {
+	return &VerifierBuilder{
+		tolerance:   DefaultTolerance,
+		maxBodySize: DefaultMaxBodySize,
+	}
+}
+
+// Logger sets the logger that the verifier will use to report rejected notifications. This is
+// mandatory.
+func (b *VerifierBuilder) Logger(value logging.Logger) *VerifierBuilder {
+	b.logger = value
+	return b
+}
+
+// Secret adds a secret that will be accepted for signatures. It can be called multiple times to
+// accept signatures generated with any of the secrets, which is useful while they are rotated. At
+// least one secret is mandatory.
+func (b *VerifierBuilder) Secret(value string) *VerifierBuilder {
+	b.secrets = append(b.secrets, value)
+	return b
+}
+
+// Tolerance sets the maximum difference between the time when the notification was sent and the
+// time when it is verified. Notifications outside of that window are rejected, in order to prevent
+// replay attacks. A value of zero disables this check. The default value is five minutes.
+func (b *VerifierBuilder) Tolerance(value time.Duration) *VerifierBuilder {
+	b.tolerance = value
+	return b
+}
+
+// MaxBodySize sets the maximum size of the bodies of the notifications that the Parse method and
+// the handlers will accept. The default value is one MiB.
+func (b *VerifierBuilder) MaxBodySize(value int64) *VerifierBuilder {
+	b.maxBodySize = value
+	return b
+}
+
+// Build uses the information stored in the builder to create a new webhook verifier.
+func (b *VerifierBuilder) Build() (result *Verifier, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if len(b.secrets) == 0 {
+		err = errors.New("at least one secret is mandatory")
+		return
+	}
+	for _, secret := range b.secrets {
+		if secret == "" {
+			err = errors.New("secrets can't be empty")
+			return
+		}
+	}
+	if b.tolerance < 0 {
+		err = fmt.Errorf(
+			"tolerance %s isn't valid, it should be greater or equal than zero",
+			b.tolerance,
+		)
+		return
+	}
+	if b.maxBodySize <= 0 {
+		err = fmt.Errorf(
+			"maximum body size %d isn't valid, it should be greater than zero",
+			b.maxBodySize,
+		)
+		return
+	}
+
+	// Create and populate the object:
+	secrets := make([][]byte, len(b.secrets))
+	for i, secret := range b.secrets {
+		secrets[i] = []byte(secret)
+	}
+	result = &Verifier{
+		logger:      b.logger,
+		secrets:     secrets,
+		tolerance:   b.tolerance,
+		maxBodySize: b.maxBodySize,
+	}
+	return
+}
+
+// Sign calculates the signature of a notification, in the format used by the SignatureHeader
+// header. This is intended for services that send notifications, and for tests.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(digest([]byte(secret), timestamp.Unix(), body))
+}
+
+// Verify checks that the given headers contain a valid signature for the body of a notification.
+// The returned error wraps ErrInvalidSignature when the signature is missing, doesn't match or is
+// too old.
+func (v *Verifier) Verify(header http.Header, body []byte) error {
+	value := header.Get(TimestampHeader)
+	if value == "" {
+		return fmt.Errorf("%w: header '%s' is missing", ErrInvalidSignature, TimestampHeader)
+	}
+	timestamp, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: value '%s' of header '%s' isn't a valid timestamp",
+			ErrInvalidSignature, value, TimestampHeader,
+		)
+	}
+	if v.tolerance > 0 {
+		age := time.Since(time.Unix(timestamp, 0))
+		if math.Abs(float64(age)) > float64(v.tolerance) {
+			return fmt.Errorf(
+				"%w: timestamp is %s away from the current time, but the tolerance is %s",
+				ErrInvalidSignature, age.Round(time.Second), v.tolerance,
+			)
+		}
+	}
+	signatures := header.Values(SignatureHeader)
+	if len(signatures) == 0 {
+		return fmt.Errorf("%w: header '%s' is missing", ErrInvalidSignature, SignatureHeader)
+	}
+	expected := make([][]byte, len(v.secrets))
+	for i, secret := range v.secrets {
+		expected[i] = digest(secret, timestamp, body)
+	}
+	for _, signature := range signatures {
+		for _, item := range strings.Split(signature, ",") {
+			item = strings.TrimSpace(item)
+			if !strings.HasPrefix(item, signaturePrefix) {
+				continue
+			}
+			actual, err := hex.DecodeString(strings.TrimPrefix(item, signaturePrefix))
+			if err != nil {
+				continue
+			}
+			for _, candidate := range expected {
+				if hmac.Equal(actual, candidate) {
+					return nil
+				}
+			}
+		}
+	}
+	return fmt.Errorf("%w: no signature matches any of the secrets", ErrInvalidSignature)
+}
+
+// Parse reads the body of the given request, checks the signature and parses it into an event.
+func (v *Verifier) Parse(request *http.Request) (result *Event, err error) {
+	body, err := io.ReadAll(io.LimitReader(request.Body, v.maxBodySize+1))
+	if err != nil {
+		err = fmt.Errorf("can't read body: %w", err)
+		return
+	}
+	if int64(len(body)) > v.maxBodySize {
+		err = fmt.Errorf(
+			"body is larger than the maximum of %d bytes",
+			v.maxBodySize,
+		)
+		return
+	}
+	err = v.Verify(request.Header, body)
+	if err != nil {
+		return
+	}
+	result, err = ParseEvent(body)
+	return
+}
+
+// Handler creates an HTTP handler that verifies and parses the notifications and then calls the
+// given function with the resulting events. Notifications with invalid signatures are rejected
+// with the 401 status code, and notifications that can't be parsed with the 400 status code. If
+// the function returns an error the response will have the 500 status code, so that the service
+// can send the notification again later.
+func (v *Verifier) Handler(callback func(ctx context.Context, event *Event) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		event, err := v.Parse(r)
+		if errors.Is(err, ErrInvalidSignature) {
+			v.logger.Warn(ctx, "Rejected webhook notification: %v", err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			v.logger.Warn(ctx, "Can't parse webhook notification: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		err = callback(ctx, event)
+		if err != nil {
+			v.logger.Error(
+				ctx,
+				"Can't process event '%s' of type '%s': %v",
+				event.ID, event.Type, err,
+			)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// digest calculates the HMAC SHA-256 of the timestamp and the body.
+func digest(secret []byte, timestamp int64, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
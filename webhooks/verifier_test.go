@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the webhook verifier.
+
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Verifier", func() {
+	const secret = "my-secret"
+
+	const body = `{
+		"id": "123",
+		"type": "cluster.state_changed",
+		"time": "2026-10-16T10:00:00Z",
+		"data": {
+			"cluster_id": "456",
+			"name": "my-cluster",
+			"previous_state": "installing",
+			"state": "ready"
+		}
+	}`
+
+	var verifier *Verifier
+
+	BeforeEach(func() {
+		var err error
+		verifier, err = NewVerifier().
+			Logger(logger).
+			Secret(secret).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// makeHeader creates the headers for a notification signed with the given secret and time.
+	makeHeader := func(secret string, timestamp time.Time, body string) http.Header {
+		header := http.Header{}
+		header.Set(TimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+		header.Set(SignatureHeader, Sign(secret, timestamp, []byte(body)))
+		return header
+	}
+
+	It("Can't be created without a logger", func() {
+		_, err := NewVerifier().
+			Secret(secret).
+			Build()
+		Expect(err).To(MatchError("logger is mandatory"))
+	})
+
+	It("Can't be created without a secret", func() {
+		_, err := NewVerifier().
+			Logger(logger).
+			Build()
+		Expect(err).To(MatchError("at least one secret is mandatory"))
+	})
+
+	It("Accepts a valid signature", func() {
+		header := makeHeader(secret, time.Now(), body)
+		err := verifier.Verify(header, []byte(body))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Accepts a signature generated with any of the secrets", func() {
+		verifier, err := NewVerifier().
+			Logger(logger).
+			Secret("old-secret").
+			Secret(secret).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		header := makeHeader(secret, time.Now(), body)
+		err = verifier.Verify(header, []byte(body))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Accepts multiple signatures in the header", func() {
+		now := time.Now()
+		header := makeHeader(secret, now, body)
+		header.Set(
+			SignatureHeader,
+			Sign("other-secret", now, []byte(body))+", "+header.Get(SignatureHeader),
+		)
+		err := verifier.Verify(header, []byte(body))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Rejects a signature generated with a different secret", func() {
+		header := makeHeader("other-secret", time.Now(), body)
+		err := verifier.Verify(header, []byte(body))
+		Expect(errors.Is(err, ErrInvalidSignature)).To(BeTrue())
+	})
+
+	It("Rejects a modified body", func() {
+		header := makeHeader(secret, time.Now(), body)
+		err := verifier.Verify(header, []byte(body+" "))
+		Expect(errors.Is(err, ErrInvalidSignature)).To(BeTrue())
+	})
+
+	It("Rejects an old timestamp", func() {
+		header := makeHeader(secret, time.Now().Add(-time.Hour), body)
+		err := verifier.Verify(header, []byte(body))
+		Expect(errors.Is(err, ErrInvalidSignature)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("tolerance"))
+	})
+
+	It("Rejects a missing signature", func() {
+		header := makeHeader(secret, time.Now(), body)
+		header.Del(SignatureHeader)
+		err := verifier.Verify(header, []byte(body))
+		Expect(errors.Is(err, ErrInvalidSignature)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(SignatureHeader))
+	})
+
+	It("Parses a signed request", func() {
+		request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+		request.Header = makeHeader(secret, time.Now(), body)
+		event, err := verifier.Parse(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.ID).To(Equal("123"))
+		Expect(event.Type).To(Equal(ClusterStateChangedEvent))
+		Expect(event.ClusterState).ToNot(BeNil())
+		Expect(event.ClusterState.ClusterID).To(Equal("456"))
+		Expect(event.ClusterState.State).To(Equal(cmv1.ClusterStateReady))
+	})
+
+	It("Rejects a body larger than the maximum", func() {
+		verifier, err := NewVerifier().
+			Logger(logger).
+			Secret(secret).
+			MaxBodySize(10).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+		request.Header = makeHeader(secret, time.Now(), body)
+		_, err = verifier.Parse(request)
+		Expect(err).To(MatchError("body is larger than the maximum of 10 bytes"))
+	})
+
+	Describe("Handler", func() {
+		It("Calls the function for a valid notification", func() {
+			var received *Event
+			handler := verifier.Handler(func(ctx context.Context, event *Event) error {
+				received = event
+				return nil
+			})
+			request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+			request.Header = makeHeader(secret, time.Now(), body)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			Expect(received).ToNot(BeNil())
+			Expect(received.ID).To(Equal("123"))
+		})
+
+		It("Rejects an invalid signature", func() {
+			called := false
+			handler := verifier.Handler(func(ctx context.Context, event *Event) error {
+				called = true
+				return nil
+			})
+			request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+			request.Header = makeHeader("other-secret", time.Now(), body)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(called).To(BeFalse())
+		})
+
+		It("Returns an error when the function fails", func() {
+			handler := verifier.Handler(func(ctx context.Context, event *Event) error {
+				return errors.New("my error")
+			})
+			request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+			request.Header = makeHeader(secret, time.Now(), body)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})